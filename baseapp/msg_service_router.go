@@ -0,0 +1,129 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msgInterfaceProtoName is the public name under which sdk.Msg implementations
+// are registered in the InterfaceRegistry.
+const msgInterfaceProtoName = "cosmos.v1beta1.Msg"
+
+// MsgServiceRouter routes sdk.Msgs to their protobuf Msg service handlers.
+type MsgServiceRouter struct {
+	interfaceRegistry codectypes.InterfaceRegistry
+	routes            map[string]MsgServiceHandler
+}
+
+var _ gogogrpc.Server = &MsgServiceRouter{}
+
+// NewMsgServiceRouter creates a new MsgServiceRouter.
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// MsgServiceHandler defines a function type which handles an sdk.Msg routed
+// to a protobuf Msg service method.
+type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
+
+// Handler returns the MsgServiceHandler for a given sdk.Msg or nil if no
+// Msg service method handles it.
+func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
+	return msr.routes[sdk.MsgTypeURL(msg)]
+}
+
+// RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
+// service description, handler is an object which implements that gRPC service.
+//
+// This function panics if a method's request type does not implement sdk.Msg,
+// if it isn't registered in the router's InterfaceRegistry, or if another
+// service method already handles the same request type.
+func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		// NOTE: the concrete request type of a method is only known to its
+		// generated handler, so we call it with a decoder which records the
+		// type and a no-op interceptor which prevents the service method itself
+		// from running.
+		var requestTypeURL string
+		_, _ = methodHandler(nil, context.Background(), func(i interface{}) error {
+			msg, ok := i.(sdk.Msg)
+			if !ok {
+				panic(fmt.Errorf("unable to register service method %s: %T does not implement sdk.Msg", fqMethod, i))
+			}
+			requestTypeURL = sdk.MsgTypeURL(msg)
+			return nil
+		}, noopInterceptor)
+
+		if msr.interfaceRegistry != nil && !msr.isRegisteredMsg(requestTypeURL) {
+			panic(fmt.Errorf(
+				"unable to register service method %s: type_url %s has not been registered as an sdk.Msg. "+
+					"Before calling RegisterService, you must register all interfaces by calling the "+
+					"`RegisterInterfaces` method on module.BasicManager",
+				fqMethod, requestTypeURL,
+			))
+		}
+
+		if _, found := msr.routes[requestTypeURL]; found {
+			panic(fmt.Errorf(
+				"msg service %s has already been registered. Please make sure to only register each service once",
+				requestTypeURL,
+			))
+		}
+
+		msr.routes[requestTypeURL] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
+				return handler(goCtx, req)
+			}
+
+			// call the method handler from the service description with the handler object,
+			// a wrapped sdk.Context and the already decoded sdk.Msg as request
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), noopDecoder, interceptor)
+			if err != nil {
+				return nil, err
+			}
+
+			resMsg, ok := res.(proto.Message)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting proto.Message, got %T", res)
+			}
+
+			return sdk.WrapServiceResult(ctx, resMsg, nil)
+		}
+	}
+}
+
+// SetInterfaceRegistry sets the interface registry for the router.
+func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
+	msr.interfaceRegistry = interfaceRegistry
+}
+
+// isRegisteredMsg returns true if typeURL is registered in the router's
+// InterfaceRegistry as an sdk.Msg implementation.
+func (msr *MsgServiceRouter) isRegisteredMsg(typeURL string) bool {
+	for _, impl := range msr.interfaceRegistry.ListImplementations(msgInterfaceProtoName) {
+		if impl == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+func noopDecoder(_ interface{}) error { return nil }
+func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+	return nil, nil
+}
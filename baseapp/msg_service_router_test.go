@@ -0,0 +1,104 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testBankMsgServer is a minimal bank MsgServer used to exercise the
+// MsgServiceRouter.
+type testBankMsgServer struct{}
+
+var _ banktypes.MsgServer = testBankMsgServer{}
+
+func (testBankMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Amount.Empty() {
+		return nil, sdkerrors.ErrInvalidCoins
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("send", sdk.NewAttribute("amount", msg.Amount.String())))
+
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func (testBankMsgServer) MultiSend(context.Context, *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	return &banktypes.MsgMultiSendResponse{}, nil
+}
+
+func newTestMsgInterfaceRegistry() codectypes.InterfaceRegistry {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	return interfaceRegistry
+}
+
+func TestRegisterMsgService(t *testing.T) {
+	msr := NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(newTestMsgInterfaceRegistry())
+
+	require.Nil(t, msr.Handler(&banktypes.MsgSend{}))
+	banktypes.RegisterMsgServer(msr, testBankMsgServer{})
+	require.NotNil(t, msr.Handler(&banktypes.MsgSend{}))
+	require.NotNil(t, msr.Handler(&banktypes.MsgMultiSend{}))
+
+	// registering the same service twice panics
+	require.Panics(t, func() {
+		banktypes.RegisterMsgServer(msr, testBankMsgServer{})
+	})
+}
+
+func TestRegisterMsgServiceUnregisteredMsg(t *testing.T) {
+	msr := NewMsgServiceRouter()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(interfaceRegistry)
+	msr.SetInterfaceRegistry(interfaceRegistry)
+
+	require.Panics(t, func() {
+		banktypes.RegisterMsgServer(msr, testBankMsgServer{})
+	})
+}
+
+func TestMsgServiceHandler(t *testing.T) {
+	app := setupBaseApp(t, func(app *BaseApp) {
+		app.SetInterfaceRegistry(newTestMsgInterfaceRegistry())
+		banktypes.RegisterMsgServer(app.MsgServiceRouter(), testBankMsgServer{})
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	ctx := app.NewContext(false, tmproto.Header{})
+	addr := sdk.AccAddress([]byte("addr"))
+
+	handler := app.MsgServiceRouter().Handler(&banktypes.MsgSend{})
+	require.NotNil(t, handler)
+
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "send", res.Events[0].Type)
+
+	var resData banktypes.MsgSendResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &resData))
+
+	_, err = handler(ctx, banktypes.NewMsgSend(addr, addr, sdk.NewCoins()))
+	require.True(t, sdkerrors.ErrInvalidCoins.Is(err))
+
+	// messages with a Msg service are routed through the MsgServiceRouter
+	// rather than the legacy router, which has no bank route
+	res, err = app.runMsgs(ctx, []sdk.Msg{msg}, runTxModeDeliver)
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+	require.Equal(t, sdk.EventTypeMessage, res.Events[0].Type)
+	require.Equal(t, "send", res.Events[1].Type)
+}
@@ -0,0 +1,106 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+// mockStreamingService records the ABCI messages and state changes it
+// receives, in order.
+type mockStreamingService struct {
+	storeKey store.StoreKey
+	calls    []string
+	writes   []string
+}
+
+func (m *mockStreamingService) OnWrite(storeKey store.StoreKey, key []byte, _ []byte, delete bool) error {
+	if storeKey != m.storeKey {
+		panic("unexpected store key")
+	}
+	if delete {
+		m.writes = append(m.writes, "delete:"+string(key))
+	} else {
+		m.writes = append(m.writes, "set:"+string(key))
+	}
+	m.calls = append(m.calls, "write")
+	return nil
+}
+
+func (m *mockStreamingService) Listeners() map[store.StoreKey][]store.WriteListener {
+	return map[store.StoreKey][]store.WriteListener{m.storeKey: {m}}
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.calls = append(m.calls, "begin")
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.calls = append(m.calls, "end")
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	m.calls = append(m.calls, "deliver")
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	m.calls = append(m.calls, "commit")
+	return nil
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	streamingService := &mockStreamingService{storeKey: capKey1}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(streamingService) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	// CheckTx writes to the check state only and must not be streamed
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	require.Empty(t, streamingService.calls)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK())
+
+	// a failing tx is streamed, but its state changes are discarded
+	failTx := newTxCounter(1, 1)
+	failTx.FailOnAnte = true
+	failTxBytes, err := codec.MarshalBinaryBare(failTx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: failTxBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.Equal(t, []string{"begin", "deliver", "deliver", "end", "write", "write", "commit"}, streamingService.calls)
+	require.Equal(t, []string{"set:" + string(anteKey), "set:" + string(deliverKey)}, streamingService.writes)
+}
@@ -22,6 +22,7 @@ import (
 // handling and queries.
 type Context struct {
 	FromAddress       sdk.AccAddress
+	FeeGranter        sdk.AccAddress
 	Client            rpcclient.Client
 	ChainID           string
 	JSONMarshaler     codec.JSONMarshaler
@@ -165,6 +166,13 @@ func (ctx Context) WithFromAddress(addr sdk.AccAddress) Context {
 	return ctx
 }
 
+// WithFeeGranterAddress returns a copy of the context with an updated fee
+// granter account address.
+func (ctx Context) WithFeeGranterAddress(addr sdk.AccAddress) Context {
+	ctx.FeeGranter = addr
+	return ctx
+}
+
 // WithBroadcastMode returns a copy of the context with an updated broadcast
 // mode.
 func (ctx Context) WithBroadcastMode(mode string) Context {
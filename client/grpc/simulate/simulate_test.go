@@ -105,7 +105,7 @@ func (s IntegrationTestSuite) TestSimulateService() {
 	s.Require().NoError(err)
 
 	// Check the result and gas used are correct.
-	s.Require().Equal(len(res.GetResult().GetEvents()), 4) // 1 transfer, 3 messages.
+	s.Require().Equal(len(res.GetResult().GetEvents()), 5) // 1 transfer, 1 typed transfer, 3 messages.
 	s.Require().True(res.GetGasInfo().GetGasUsed() > 0)    // Gas used sometimes change, just check it's not empty.
 }
 
@@ -69,6 +69,11 @@ func (ctx Context) GetFromAddress() sdk.AccAddress {
 	return ctx.FromAddress
 }
 
+// GetFeeGranterAddress returns the fee granter address from the context
+func (ctx Context) GetFeeGranterAddress() sdk.AccAddress {
+	return ctx.FeeGranter
+}
+
 // GetFromName returns the key name for the current context.
 func (ctx Context) GetFromName() string {
 	return ctx.FromName
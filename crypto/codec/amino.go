@@ -6,7 +6,9 @@ import (
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdked25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
 
@@ -27,6 +29,10 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		sr25519.PubKeyName, nil)
 	cdc.RegisterConcrete(secp256k1.PubKey{},
 		secp256k1.PubKeyName, nil)
+	cdc.RegisterConcrete(sdked25519.PubKey{},
+		sdked25519.PubKeyName, nil)
+	cdc.RegisterConcrete(secp256r1.PubKey{},
+		secp256r1.PubKeyName, nil)
 	cdc.RegisterConcrete(multisig.PubKeyMultisigThreshold{},
 		multisig.PubKeyAminoRoute, nil)
 
@@ -37,6 +43,10 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		sr25519.PrivKeyName, nil)
 	cdc.RegisterConcrete(secp256k1.PrivKey{},
 		secp256k1.PrivKeyName, nil)
+	cdc.RegisterConcrete(sdked25519.PrivKey{},
+		sdked25519.PrivKeyName, nil)
+	cdc.RegisterConcrete(secp256r1.PrivKey{},
+		secp256r1.PrivKeyName, nil)
 }
 
 // PrivKeyFromBytes unmarshals private key bytes and returns a PrivKey
@@ -4,7 +4,9 @@ import (
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 // PubKeyType defines an algorithm to derive key-pairs which can be used for cryptographic signing.
@@ -16,15 +18,22 @@ const (
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is currently not supported for ledgers.
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	// It is currently not supported for ledgers.
+	Secp256r1Type = PubKeyType("secp256r1")
 )
 
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Ed25519 uses the Ed25519 signature system.
+	Ed25519 = ed25519Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -44,20 +53,7 @@ func (s secp256k1Algo) Name() PubKeyType {
 
 // Derive derives and returns the secp256k1 private key for the given seed and HD path.
 func (s secp256k1Algo) Derive() DeriveFn {
-	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
-		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
-		if err != nil {
-			return nil, err
-		}
-
-		masterPriv, ch := ComputeMastersFromSeed(seed)
-		if len(hdPath) == 0 {
-			return masterPriv[:], nil
-		}
-		derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
-
-		return derivedKey, err
-	}
+	return deriveBIP32
 }
 
 // Generate generates a secp256k1 private key from the given bytes.
@@ -69,3 +65,62 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return secp256k1.PrivKey(bzArr)
 	}
 }
+
+type ed25519Algo struct {
+}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives the secret of the ed25519 private key for the given seed and
+// HD path. It uses the BIP32 derivation of secp256k1 keys, and thus derives
+// keys which differ from SLIP-0010 ed25519 wallets.
+func (s ed25519Algo) Derive() DeriveFn {
+	return deriveBIP32
+}
+
+// Generate generates an ed25519 private key from the given secret.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		return ed25519.GenPrivKeyFromSecret(bz)
+	}
+}
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives the secret of the secp256r1 private key for the given seed
+// and HD path. It uses the BIP32 derivation of secp256k1 keys, and thus
+// derives keys which differ from SLIP-0010 NIST P-256 wallets.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return deriveBIP32
+}
+
+// Generate generates a secp256r1 private key from the given secret.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		return secp256r1.GenPrivKeyFromSecret(bz)
+	}
+}
+
+// deriveBIP32 derives the secp256k1 private key bytes for the given mnemonic
+// and HD path.
+func deriveBIP32(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPriv, ch := ComputeMastersFromSeed(seed)
+	if len(hdPath) == 0 {
+		return masterPriv[:], nil
+	}
+	derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
+
+	return derivedKey, err
+}
@@ -13,4 +13,31 @@ func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
+	require.Equal(t, hd.PubKeyType("secp256r1"), hd.Secp256r1Type)
+}
+
+func TestAlgosDeriveSameSecret(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	path := hd.NewFundraiserParams(0, 118, 0).String()
+
+	secret, err := hd.Secp256k1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+
+	for _, algo := range []interface {
+		Derive() hd.DeriveFn
+		Generate() hd.GenerateFn
+	}{hd.Ed25519, hd.Secp256r1} {
+		bz, err := algo.Derive()(mnemonic, "", path)
+		require.NoError(t, err)
+		require.Equal(t, secret, bz)
+
+		// keys are generated deterministically from the derived secret
+		privKey := algo.Generate()(bz)
+		require.Equal(t, privKey, algo.Generate()(bz))
+
+		msg := []byte("some message")
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+	}
 }
@@ -728,6 +728,34 @@ func TestInMemorySeedPhrase(t *testing.T) {
 	require.Equal(t, info.GetPubKey(), newInfo.GetPubKey())
 }
 
+func TestInMemorySignWithAlgos(t *testing.T) {
+	keyring := NewInMemory()
+
+	for _, algo := range []SignatureAlgo{hd.Ed25519, hd.Secp256r1} {
+		uid := string(algo.Name())
+		info, _, err := keyring.NewMnemonic(uid, English, sdk.FullFundraiserPath, algo)
+		require.NoError(t, err)
+		require.Equal(t, algo.Name(), info.GetAlgo())
+		require.Equal(t, string(algo.Name()), info.GetPubKey().Type())
+
+		msg := []byte("some message")
+		sign, key, err := keyring.Sign(uid, msg)
+		require.NoError(t, err)
+		require.True(t, key.VerifySignature(msg, sign))
+
+		// the private key survives an export and import
+		armor, err := keyring.ExportPrivKeyArmor(uid, "somePass")
+		require.NoError(t, err)
+		require.NoError(t, keyring.Delete(uid))
+		require.NoError(t, keyring.ImportPrivKey(uid, armor, "somePass"))
+
+		imported, err := keyring.Key(uid)
+		require.NoError(t, err)
+		require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+		require.Equal(t, algo.Name(), imported.GetAlgo())
+	}
+}
+
 func TestKeyChain_ShouldFailWhenAddingSameGeneratedAccount(t *testing.T) {
 	dir, clean := testutil.NewTestCaseDir(t)
 	t.Cleanup(clean)
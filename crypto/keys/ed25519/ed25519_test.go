@@ -0,0 +1,70 @@
+package ed25519_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+func TestSignAndValidateEd25519(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+
+	// Test the signature
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// Signatures of the wrong size are rejected.
+	require.False(t, pubKey.VerifySignature(msg, sig[:ed25519.SignatureSize-1]))
+}
+
+func TestTendermintCompatibility(t *testing.T) {
+	tmPrivKey := tmed25519.GenPrivKey()
+	privKey := ed25519.PrivKey(tmPrivKey.Bytes())
+
+	// keys and addresses are the same as the Tendermint ones
+	require.Equal(t, tmPrivKey.PubKey().Bytes(), privKey.PubKey().Bytes())
+	require.Equal(t, tmPrivKey.PubKey().Address(), privKey.PubKey().Address())
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, tmPrivKey.PubKey().VerifySignature(msg, sig))
+
+	tmSig, err := tmPrivKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, tmSig))
+
+	// keys of different types are never equal
+	require.False(t, privKey.PubKey().Equals(tmPrivKey.PubKey()))
+	require.False(t, privKey.Equals(tmPrivKey))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey := ed25519.GenPrivKeyFromSecret([]byte("secret"))
+	require.Len(t, privKey, ed25519.PrivKeySize)
+	require.Equal(t, privKey, ed25519.GenPrivKeyFromSecret([]byte("secret")))
+	require.NotEqual(t, privKey, ed25519.GenPrivKeyFromSecret([]byte("other secret")))
+	require.True(t, privKey.Equals(ed25519.GenPrivKeyFromSecret([]byte("secret"))))
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	require.Len(t, pubKey.Address(), 20)
+	require.Equal(t, "ed25519", pubKey.Type())
+	require.True(t, pubKey.Equals(ed25519.PubKey(pubKey.Bytes())))
+	require.False(t, pubKey.Equals(ed25519.GenPrivKey().PubKey()))
+}
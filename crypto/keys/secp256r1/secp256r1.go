@@ -0,0 +1,262 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+var _ crypto.PrivKey = PrivKey{}
+
+const (
+	// PrivKeySize is the size, in bytes, of a private key scalar.
+	PrivKeySize = 32
+	// PubKeySize is comprised of 32 bytes for the x-coordinate, plus one byte
+	// for the parity of the y-coordinate.
+	PubKeySize = 33
+	// SignatureSize is the size of a signature of the form R || S.
+	SignatureSize = 64
+
+	keyType     = "secp256r1"
+	PrivKeyName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyName  = "cosmos-sdk/PubKeySecp256r1"
+)
+
+var (
+	one = big.NewInt(1)
+
+	// secp256r1halfN is used to reject malleable signatures
+	secp256r1halfN = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+)
+
+// PrivKey implements crypto.PrivKey for the NIST P-256 curve, also known as
+// secp256r1 or prime256v1. It is the big-endian encoding of the private
+// scalar.
+type PrivKey []byte
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	x, y := elliptic.P256().ScalarBaseMult(privKey)
+	return PubKey(compress(x, y))
+}
+
+// Sign creates an ECDSA signature on curve secp256r1, using SHA256 on the msg.
+// The returned signature will be of the form R || S (in lower-S form).
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv := privKey.toECDSA()
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), priv, crypto.Sha256(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	// use the lower-S form, as VerifySignature rejects malleable signatures
+	if s.Cmp(secp256r1halfN) > 0 {
+		s = new(big.Int).Sub(elliptic.P256().Params().N, s)
+	}
+
+	return serializeSig(r, s), nil
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherSecp, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKey) Type() string {
+	return keyType
+}
+
+func (privKey PrivKey) toECDSA() *ecdsa.PrivateKey {
+	priv := new(ecdsa.PrivateKey)
+	priv.Curve = elliptic.P256()
+	priv.D = new(big.Int).SetBytes(privKey)
+	priv.X, priv.Y = elliptic.P256().ScalarBaseMult(privKey)
+
+	return priv
+}
+
+// GenPrivKey generates a new ECDSA private key on curve secp256r1.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand)
+	if err != nil {
+		panic(err)
+	}
+
+	return fromScalar(priv.D)
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	secHash := sha256.Sum256(secret)
+
+	fe := new(big.Int).SetBytes(secHash[:])
+	n := new(big.Int).Sub(elliptic.P256().Params().N, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	return fromScalar(fe)
+}
+
+// fromScalar returns the private key of the scalar d, padded to PrivKeySize.
+func fromScalar(d *big.Int) PrivKey {
+	bz := d.Bytes()
+	privKey := make([]byte, PrivKeySize)
+	copy(privKey[PrivKeySize-len(bz):], bz)
+
+	return PrivKey(privKey)
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKey{}
+
+// PubKey implements crypto.PubKey.
+// It is the compressed form of the pubkey. The first byte is a 0x02 byte if
+// the y-coordinate is even, and a 0x03 byte otherwise. This prefix is followed
+// by the x-coordinate.
+type PubKey []byte
+
+// Address is the SHA256-20 of the compressed pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	if len(pubKey) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	return crypto.Address(tmhash.SumTruncated(pubKey))
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature verifies a signature of the form R || S.
+// It rejects signatures which are not in lower-S form.
+func (pubKey PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize {
+		return false
+	}
+
+	x, y, ok := decompress(pubKey)
+	if !ok {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sigStr[:32])
+	s := new(big.Int).SetBytes(sigStr[32:])
+	if s.Cmp(secp256r1halfN) > 0 {
+		return false
+	}
+
+	pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	return ecdsa.Verify(pub, crypto.Sha256(msg), r, s)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", []byte(pubKey))
+}
+
+func (pubKey PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherSecp, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+	return false
+}
+
+// compress returns the compressed form of the point (x, y).
+func compress(x, y *big.Int) []byte {
+	bz := make([]byte, PubKeySize)
+	bz[0] = 0x02 + byte(y.Bit(0))
+
+	xBytes := x.Bytes()
+	copy(bz[PubKeySize-len(xBytes):], xBytes)
+
+	return bz
+}
+
+// decompress returns the point of the compressed public key bz, and false if
+// bz isn't the compressed form of a point of the curve.
+func decompress(bz []byte) (x, y *big.Int, ok bool) {
+	if len(bz) != PubKeySize || (bz[0] != 0x02 && bz[0] != 0x03) {
+		return nil, nil, false
+	}
+
+	params := elliptic.P256().Params()
+	x = new(big.Int).SetBytes(bz[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil, false
+	}
+
+	// y² = x³ - 3x + b
+	x3 := new(big.Int).Mul(x, x)
+	x3.Mul(x3, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	y2 := new(big.Int).Sub(x3, threeX)
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+
+	y = new(big.Int).ModSqrt(y2, params.P)
+	if y == nil {
+		return nil, nil, false
+	}
+	if y.Bit(0) != uint(bz[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	if !elliptic.P256().IsOnCurve(x, y) {
+		return nil, nil, false
+	}
+
+	return x, y, true
+}
+
+// serializeSig serializes a signature to R || S.
+// R, S are padded to 32 bytes respectively.
+func serializeSig(r, s *big.Int) []byte {
+	rBytes := r.Bytes()
+	sBytes := s.Bytes()
+	sigBytes := make([]byte, SignatureSize)
+	// 0 pad the byte arrays from the left if they aren't big enough.
+	copy(sigBytes[32-len(rBytes):32], rBytes)
+	copy(sigBytes[64-len(sBytes):64], sBytes)
+	return sigBytes
+}
@@ -0,0 +1,104 @@
+package secp256r1_test
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+func TestSignAndValidateSecp256r1(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, privKey, secp256r1.PrivKeySize)
+	require.Len(t, pubKey.Bytes(), secp256r1.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// signatures of another message are rejected
+	require.False(t, pubKey.VerifySignature(crypto.CRandBytes(128), sig))
+
+	// Mutate the signature, just one bit.
+	sig[3] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// signatures of the wrong size are rejected
+	require.False(t, pubKey.VerifySignature(msg, sig[:63]))
+}
+
+func TestSignatureMalleability(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	// signatures are in lower-S form
+	halfN := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	s := new(big.Int).SetBytes(sig[32:])
+	require.True(t, s.Cmp(halfN) <= 0)
+
+	// the high-S form of a valid signature is rejected
+	highS := new(big.Int).Sub(elliptic.P256().Params().N, s).Bytes()
+	malleable := make([]byte, 64)
+	copy(malleable, sig[:32])
+	copy(malleable[64-len(highS):], highS)
+	require.False(t, pubKey.VerifySignature(msg, malleable))
+}
+
+func TestInvalidPubKeys(t *testing.T) {
+	pubKey := secp256r1.GenPrivKey().PubKey().(secp256r1.PubKey)
+	msg := crypto.CRandBytes(128)
+
+	// wrong prefix
+	invalid := make(secp256r1.PubKey, secp256r1.PubKeySize)
+	copy(invalid, pubKey)
+	invalid[0] = 0x04
+	sig, err := secp256r1.GenPrivKey().Sign(msg)
+	require.NoError(t, err)
+	require.False(t, invalid.VerifySignature(msg, sig))
+
+	// wrong length
+	require.False(t, pubKey[:32].VerifySignature(msg, sig))
+
+	// x-coordinate out of range
+	invalid = make(secp256r1.PubKey, secp256r1.PubKeySize)
+	invalid[0] = 0x02
+	for i := 1; i < len(invalid); i++ {
+		invalid[i] = 0xff
+	}
+	require.False(t, invalid.VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey := secp256r1.GenPrivKeyFromSecret([]byte("secret"))
+	require.Len(t, privKey, secp256r1.PrivKeySize)
+	require.Equal(t, privKey, secp256r1.GenPrivKeyFromSecret([]byte("secret")))
+	require.NotEqual(t, privKey, secp256r1.GenPrivKeyFromSecret([]byte("other secret")))
+
+	msg := crypto.CRandBytes(32)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	require.Len(t, pubKey.Address(), 20)
+	require.Equal(t, "secp256r1", pubKey.Type())
+	require.True(t, pubKey.Equals(secp256r1.PubKey(pubKey.Bytes())))
+	require.False(t, pubKey.Equals(secp256r1.GenPrivKey().PubKey()))
+	require.True(t, privKey.Equals(secp256r1.PrivKey(privKey.Bytes())))
+}
@@ -37,10 +37,14 @@ func ExamplePrintRegisteredTypes() {
 	// | PubKey | tendermint/PubKeyEd25519 | 0x1624DE64 | variable |  |
 	// | PubKey | tendermint/PubKeySr25519 | 0x0DFB1005 | variable |  |
 	// | PubKey | tendermint/PubKeySecp256k1 | 0xEB5AE987 | variable |  |
+	// | PubKey | cosmos-sdk/PubKeyEd25519 | 0x9C27DD77 | variable |  |
+	// | PubKey | cosmos-sdk/PubKeySecp256r1 | 0x31F2B5CC | variable |  |
 	// | PubKeyMultisigThreshold | tendermint/PubKeyMultisigThreshold | 0x22C1F7E2 | variable |  |
 	// | PrivKey | tendermint/PrivKeyEd25519 | 0xA3288910 | variable |  |
 	// | PrivKey | tendermint/PrivKeySr25519 | 0x2F82D78B | variable |  |
 	// | PrivKey | tendermint/PrivKeySecp256k1 | 0xE1B0F79B | variable |  |
+	// | PrivKey | cosmos-sdk/PrivKeyEd25519 | 0x2D3B87F3 | variable |  |
+	// | PrivKey | cosmos-sdk/PrivKeySecp256r1 | 0x94C8A583 | variable |  |
 }
 
 func TestNilEncodings(t *testing.T) {
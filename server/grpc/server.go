@@ -8,13 +8,19 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(app types.Application, address string) (*grpc.Server, error) {
+// StartGRPCServer starts a gRPC server on the given address. Besides the
+// application's services, it serves the Tx service, which broadcasts and
+// queries txs through the client.Context's Tendermint RPC client.
+func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
 	grpcSrv := grpc.NewServer()
 	app.RegisterGRPCServer(grpcSrv)
+	txtypes.RegisterServiceServer(grpcSrv, authtx.NewTxServer(clientCtx))
 
 	// Reflection allows external clients to see what services and methods
 	// the gRPC server exposes.
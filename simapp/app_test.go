@@ -7,9 +7,20 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExport(t *testing.T) {
@@ -49,3 +60,81 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+// ensure that the first upgrade of a chain which did not persist its module
+// VersionMap migrates the existing state instead of re-initializing it
+func TestUpgradeFromEmptyVersionMap(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	store := prefix.NewStore(ctx.KVStore(app.keys[upgradetypes.StoreKey]), []byte{upgradetypes.VersionMapByte})
+	for moduleName := range app.mm.Modules {
+		store.Delete([]byte(moduleName))
+	}
+	require.Empty(t, app.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 7
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+	addrs := AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	accounts := app.AccountKeeper.GetAllAccounts(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	require.False(t, supply.IsZero())
+
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "test", Height: ctx.BlockHeight()})
+
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+	require.Equal(t, stakingParams, app.StakingKeeper.GetParams(ctx))
+	require.Len(t, app.AccountKeeper.GetAllAccounts(ctx), len(accounts))
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	for _, addr := range addrs {
+		require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount)
+	}
+}
+
+func TestSignModeHandlerTextual(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	})
+	app.Commit()
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = app.BaseApp.NewContext(false, header)
+
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	txBuilder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)))
+	txBuilder.SetGasLimit(20000)
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{PubKey: pubkey, Data: sigData}))
+
+	signerData := authsigning.SignerData{ChainID: "test-chain"}
+	handler := app.signModeHandler()
+
+	// without an sdk.Context, the denom metadata is read from the committed state
+	signBytes, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	screens, err := textual.DecodeScreens(signBytes)
+	require.NoError(t, err)
+	require.Contains(t, screens, textual.Screen{Text: "Fees: 0.0015 atom"})
+
+	// the ante handler renders the same sign bytes
+	handlerWithContext, ok := handler.(authsigning.SignModeHandlerWithContext)
+	require.True(t, ok)
+	anteSignBytes, err := handlerWithContext.GetSignBytesWithContext(sdk.WrapSDKContext(ctx), signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, anteSignBytes)
+}
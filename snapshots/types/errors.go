@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SnapshotCodespace defines the snapshot codespace.
+const SnapshotCodespace = "snapshot"
+
+var (
+	// ErrUnknownFormat is returned when an unknown format is used.
+	ErrUnknownFormat = sdkerrors.Register(SnapshotCodespace, 2, "unknown snapshot format")
+
+	// ErrChunkHashMismatch is returned when chunk hash verification failed.
+	ErrChunkHashMismatch = sdkerrors.Register(SnapshotCodespace, 3, "chunk hash verification failed")
+
+	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
+	ErrInvalidMetadata = sdkerrors.Register(SnapshotCodespace, 4, "invalid snapshot metadata")
+)
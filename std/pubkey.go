@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
@@ -55,6 +57,15 @@ func (cdc DefaultPublicKeyCodec) Decode(key *types.PublicKey) (crypto.PubKey, er
 		res := make(sr25519.PubKey, sr25519.PubKeySize)
 		copy(res, key.Sr25519)
 
+		return res, nil
+	case *types.PublicKey_Secp256R1:
+		n := len(key.Secp256R1)
+		if n != secp256r1.PubKeySize {
+			return nil, fmt.Errorf("wrong length %d for secp256r1 public key", n)
+		}
+
+		res := make(secp256r1.PubKey, secp256r1.PubKeySize)
+		copy(res, key.Secp256R1)
 		return res, nil
 	case *types.PublicKey_Multisig:
 		pubKeys := key.Multisig.PubKeys
@@ -83,6 +94,11 @@ func (cdc DefaultPublicKeyCodec) Encode(key crypto.PubKey) (*types.PublicKey, er
 		return &types.PublicKey{Sum: &types.PublicKey_Secp256K1{Secp256K1: key}}, nil
 	case ed25519.PubKey:
 		return &types.PublicKey{Sum: &types.PublicKey_Ed25519{Ed25519: key}}, nil
+	case tmed25519.PubKey:
+		// Tendermint ed25519 keys are decoded as SDK ed25519 keys
+		return &types.PublicKey{Sum: &types.PublicKey_Ed25519{Ed25519: key}}, nil
+	case secp256r1.PubKey:
+		return &types.PublicKey{Sum: &types.PublicKey_Secp256R1{Secp256R1: key}}, nil
 	case sr25519.PubKey:
 		return &types.PublicKey{Sum: &types.PublicKey_Sr25519{Sr25519: key}}, nil
 	case multisig.PubKeyMultisigThreshold:
@@ -5,10 +5,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/std"
 )
@@ -37,8 +39,24 @@ func TestDefaultPublicKeyCodec(t *testing.T) {
 	pubKeySr25519 := sr25519.GenPrivKey().PubKey()
 	roundTripTest(t, pubKeySr25519)
 
+	pubKeySecp256r1 := secp256r1.GenPrivKey().PubKey()
+	roundTripTest(t, pubKeySecp256r1)
+
 	pubKeyMultisig := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
-		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519,
+		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519, pubKeySecp256r1,
 	})
 	roundTripTest(t, pubKeyMultisig)
 }
+
+func TestTendermintEd25519PublicKey(t *testing.T) {
+	cdc := std.DefaultPublicKeyCodec{}
+	pubKey := tmed25519.GenPrivKey().PubKey()
+
+	// Tendermint ed25519 keys decode as SDK ed25519 keys
+	pubKeyEnc, err := cdc.Encode(pubKey)
+	require.NoError(t, err)
+	pubKeyDec, err := cdc.Decode(pubKeyEnc)
+	require.NoError(t, err)
+	require.Equal(t, ed25519.PubKey(pubKey.Bytes()), pubKeyDec)
+	require.Equal(t, pubKey.Address(), pubKeyDec.Address())
+}
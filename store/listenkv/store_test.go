@@ -0,0 +1,156 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var (
+	testStoreKey      = types.NewKVStoreKey("listen_test")
+	interfaceRegistry = codectypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+)
+
+func newListenKVStore(w *bytes.Buffer) *listenkv.Store {
+	store := newEmptyListenKVStore(w)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(w *bytes.Buffer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w, testMarshaller)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPair(t *testing.T, buf *bytes.Buffer) types.StoreKVPair {
+	var kvPair types.StoreKVPair
+	require.NoError(t, testMarshaller.UnmarshalBinaryLengthPrefixed(buf.Bytes(), &kvPair))
+	return kvPair
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+	require.Nil(t, store.Get(bz("does-not-exist")))
+	require.True(t, store.Has(kvPairs[0].Key))
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	testCases := []struct {
+		key         []byte
+		value       []byte
+		expectedOut types.StoreKVPair
+	}{
+		{
+			key:   kvPairs[0].Key,
+			value: kvPairs[0].Value,
+			expectedOut: types.StoreKVPair{
+				Key:      kvPairs[0].Key,
+				Value:    kvPairs[0].Value,
+				StoreKey: testStoreKey.Name(),
+				Delete:   false,
+			},
+		},
+		{
+			key:   kvPairs[2].Key,
+			value: valFmt(4),
+			expectedOut: types.StoreKVPair{
+				Key:      kvPairs[2].Key,
+				Value:    valFmt(4),
+				StoreKey: testStoreKey.Name(),
+				Delete:   false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+
+		store := newEmptyListenKVStore(&buf)
+		store.Set(tc.key, tc.value)
+
+		require.Equal(t, tc.expectedOut, readKVPair(t, &buf))
+		require.Equal(t, tc.value, store.Get(tc.key))
+	}
+
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+	require.Panics(t, func() { store.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	store.Delete(kvPairs[0].Key)
+
+	expected := types.StoreKVPair{
+		Key:      kvPairs[0].Key,
+		StoreKey: testStoreKey.Name(),
+		Delete:   true,
+	}
+	require.Equal(t, expected, readKVPair(t, &buf))
+	require.False(t, store.Has(kvPairs[0].Key))
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	cache := store.CacheWrap().(types.CacheKVStore)
+
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	require.Zero(t, buf.Len(), "cached writes should not reach the listeners")
+	require.Nil(t, store.Get(kvPairs[0].Key))
+
+	cache.Write()
+
+	expected := types.StoreKVPair{
+		Key:      kvPairs[0].Key,
+		Value:    kvPairs[0].Value,
+		StoreKey: testStoreKey.Name(),
+	}
+	require.Equal(t, expected, readKVPair(t, &buf))
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
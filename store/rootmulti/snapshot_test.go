@@ -0,0 +1,163 @@
+package rootmulti
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newMultiStoreWithGeneratedData(t *testing.T, db dbm.DB, stores, keys int) *Store {
+	multiStore := NewStore(db)
+	for i := 0; i < stores; i++ {
+		multiStore.MountStoreWithDB(types.NewKVStoreKey(fmt.Sprintf("store%v", i)), types.StoreTypeIAVL, nil)
+	}
+	multiStore.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+	require.NoError(t, multiStore.LoadLatestVersion())
+
+	for i := 0; i < stores; i++ {
+		store := multiStore.GetKVStore(multiStore.keysByName[fmt.Sprintf("store%v", i)])
+		for j := 0; j < keys; j++ {
+			store.Set([]byte(fmt.Sprintf("key%v-%v", i, j)), []byte(fmt.Sprintf("value%v-%v", i, j)))
+		}
+	}
+	multiStore.Commit()
+
+	// overwrite some keys at the second height, so the snapshot contains several versions
+	store := multiStore.GetKVStore(multiStore.keysByName["store0"])
+	store.Set([]byte("key0-0"), []byte("updated"))
+	store.Delete([]byte("key0-1"))
+	multiStore.Commit()
+
+	return multiStore
+}
+
+func readChunks(t *testing.T, chunks <-chan io.ReadCloser) [][]byte {
+	bodies := [][]byte{}
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		bodies = append(bodies, body)
+	}
+	return bodies
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithGeneratedData(t, dbm.NewMemDB(), 2, 4)
+
+	testcases := map[string]struct {
+		height uint64
+		format uint32
+		expErr error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown height": {9, snapshottypes.CurrentFormat, nil},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+			if tc.expErr != nil {
+				require.True(t, errors.Is(err, tc.expErr))
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	testcases := map[string]struct {
+		height uint64
+		format uint32
+		expErr error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.Restore(tc.height, tc.format, nil, nil)
+			require.Error(t, err)
+			if tc.expErr != nil {
+				require.True(t, errors.Is(err, tc.expErr))
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(t, dbm.NewMemDB(), 3, 10)
+	target := NewStore(dbm.NewMemDB())
+	for key := range source.keysByName {
+		if key == "transient" {
+			target.MountStoreWithDB(types.NewTransientStoreKey(key), types.StoreTypeTransient, nil)
+		} else {
+			target.MountStoreWithDB(types.NewKVStoreKey(key), types.StoreTypeIAVL, nil)
+		}
+	}
+	require.NoError(t, target.LoadLatestVersion())
+
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 2, version)
+
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	bodies := readChunks(t, chunks)
+	require.NotEmpty(t, bodies)
+
+	restoreChunks := make(chan io.ReadCloser, len(bodies))
+	for _, body := range bodies {
+		restoreChunks <- ioutil.NopCloser(bytes.NewReader(body))
+	}
+	close(restoreChunks)
+
+	ready := make(chan struct{})
+	err = target.Restore(version, snapshottypes.CurrentFormat, restoreChunks, ready)
+	require.NoError(t, err)
+
+	// the restore must signal readiness
+	_, ok := <-ready
+	require.False(t, ok)
+
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStoreKey := range source.keysByName {
+		if key == "transient" {
+			continue
+		}
+		sourceStore := source.GetCommitKVStore(sourceStoreKey)
+		targetStore := target.getStoreByName(key).(types.CommitKVStore)
+		require.Equal(t, sourceStore.LastCommitID(), targetStore.LastCommitID())
+
+		sourceIter := sourceStore.Iterator(nil, nil)
+		targetIter := targetStore.Iterator(nil, nil)
+		for ; sourceIter.Valid(); sourceIter.Next() {
+			require.True(t, targetIter.Valid())
+			require.Equal(t, sourceIter.Key(), targetIter.Key())
+			require.Equal(t, sourceIter.Value(), targetIter.Value())
+			targetIter.Next()
+		}
+		require.False(t, targetIter.Valid())
+		sourceIter.Close()
+		targetIter.Close()
+	}
+
+	// the restored store must be able to keep committing
+	target.Commit()
+	require.EqualValues(t, version+1, target.LastCommitID().Version)
+}
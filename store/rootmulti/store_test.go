@@ -516,6 +516,59 @@ func TestSetInitialVersion(t *testing.T) {
 	require.Equal(t, int64(5), multi.LastCommitID().Version)
 }
 
+type recordingListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func TestMultiStore_Listeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	require.False(t, multi.ListeningEnabled(key1))
+
+	listener := &recordingListener{}
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(key2))
+
+	// writes to a cache-wrapped multi-store are forwarded once written
+	cacheMulti := multi.CacheMultiStore()
+	cacheMulti.GetKVStore(key1).Set([]byte("foo"), []byte("bar"))
+	cacheMulti.GetKVStore(key1).Delete([]byte("baz"))
+	cacheMulti.GetKVStore(key2).Set([]byte("foo"), []byte("bar"))
+
+	// nested caches must not forward the writes twice
+	nested := cacheMulti.CacheMultiStore()
+	nested.GetKVStore(key1).Set([]byte("qux"), []byte("quux"))
+	nested.Write()
+	require.Empty(t, listener.pairs)
+
+	cacheMulti.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("baz"), Delete: true},
+		{StoreKey: "store1", Key: []byte("foo"), Value: []byte("bar")},
+		{StoreKey: "store1", Key: []byte("qux"), Value: []byte("quux")},
+	}, listener.pairs)
+
+	// direct writes to the root store are forwarded as well
+	multi.GetKVStore(key1).Set([]byte("direct"), []byte("write"))
+	require.Len(t, listener.pairs, 4)
+
+	// stores loaded at a previous version are never listened to
+	multi.Commit()
+	versioned, err := multi.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	versioned.GetKVStore(key1).Set([]byte("foo"), []byte("baz"))
+	require.Len(t, listener.pairs, 4)
+}
+
 //-----------------------------------------------------------------------
 // utils
 
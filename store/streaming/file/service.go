@@ -0,0 +1,173 @@
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that writes state changes and ABCI messages out to files.
+//
+// For every block the following files are written to the write directory,
+// each holding a sequence of length-prefixed protobuf messages:
+//
+//	{prefix}block-{N}-begin   RequestBeginBlock, ResponseBeginBlock
+//	{prefix}block-{N}-tx-{M}  RequestDeliverTx, ResponseDeliverTx
+//	{prefix}block-{N}-end     RequestEndBlock, ResponseEndBlock
+//	{prefix}block-{N}-commit  StoreKVPair..., ResponseCommit
+//
+// State changes are flushed to the root multi-store on Commit, so every
+// StoreKVPair of block N is found in its commit file, ahead of the
+// ResponseCommit which is always the last message of the file.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix string                                   // optional prefix for each of the generated files
+	writeDir   string                                   // directory to write files into
+	codec      codec.BinaryMarshaler                    // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
+
+	stateCache     [][]byte    // cache the protobuf binary encoded StoreKVPairs in the order they are received
+	stateCacheLock *sync.Mutex // mutex for the state cache
+
+	currentBlockNumber int64 // the current block number
+	currentTxIndex     int64 // the index of the current tx
+}
+
+// intermediateWriter is used so that we do not need to update the underlying
+// io.Writer inside the StoreKVPairWriteListener everytime we begin writing to
+// a new file.
+type intermediateWriter struct {
+	fss *StreamingService
+}
+
+// Write satisfies io.Writer by caching the written bytes in the service's
+// state cache.
+func (iw *intermediateWriter) Write(b []byte) (int, error) {
+	iw.fss.stateCacheLock.Lock()
+	defer iw.fss.stateCacheLock.Unlock()
+
+	iw.fss.stateCache = append(iw.fss.stateCache, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+// NewStreamingService creates a new StreamingService for the provided
+// writeDir, (optional) filePrefix and storeKeys.
+func NewStreamingService(
+	writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryMarshaler,
+) (*StreamingService, error) {
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	fss := &StreamingService{
+		filePrefix:     filePrefix,
+		writeDir:       writeDir,
+		codec:          c,
+		stateCache:     make([][]byte, 0),
+		stateCacheLock: new(sync.Mutex),
+	}
+
+	// sort storeKeys into a map of listeners sharing the same writer
+	listener := types.NewStoreKVPairWriteListener(&intermediateWriter{fss}, c)
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		fss.listeners[key] = []types.WriteListener{listener}
+	}
+
+	return fss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface.
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It writes
+// the BeginBlock request and response to the block's begin file.
+func (fss *StreamingService) ListenBeginBlock(
+	_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock,
+) error {
+	fss.currentBlockNumber = req.GetHeader().Height
+	fss.currentTxIndex = 0
+
+	return fss.writeFile(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber), nil, &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface. It writes
+// the DeliverTx request and response to a file for the transaction.
+func (fss *StreamingService) ListenDeliverTx(
+	_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx,
+) error {
+	name := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
+	fss.currentTxIndex++
+
+	return fss.writeFile(name, nil, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface. It writes the
+// EndBlock request and response to the block's end file.
+func (fss *StreamingService) ListenEndBlock(
+	_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock,
+) error {
+	return fss.writeFile(fmt.Sprintf("block-%d-end", fss.currentBlockNumber), nil, &req, &res)
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface. It writes the
+// state changes of the block, followed by the Commit response, to the block's
+// commit file.
+func (fss *StreamingService) ListenCommit(_ sdk.Context, res abci.ResponseCommit) error {
+	fss.stateCacheLock.Lock()
+	kvPairs := fss.stateCache
+	fss.stateCache = make([][]byte, 0)
+	fss.stateCacheLock.Unlock()
+
+	return fss.writeFile(fmt.Sprintf("block-%d-commit", fss.currentBlockNumber), kvPairs, &res)
+}
+
+// Close satisfies the io.Closer interface. The StreamingService does not keep
+// any files open between blocks, so there is nothing to release.
+func (fss *StreamingService) Close() error {
+	return nil
+}
+
+// writeFile writes the already encoded entries followed by the length-prefixed
+// messages to a new file in the write directory.
+func (fss *StreamingService) writeFile(name string, entries [][]byte, msgs ...codec.ProtoMarshaler) error {
+	var out []byte
+	for _, entry := range entries {
+		out = append(out, entry...)
+	}
+
+	for _, msg := range msgs {
+		bz, err := fss.codec.MarshalBinaryLengthPrefixed(msg)
+		if err != nil {
+			return err
+		}
+
+		out = append(out, bz...)
+	}
+
+	return ioutil.WriteFile(filepath.Join(fss.writeDir, fss.filePrefix+name), out, 0600)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
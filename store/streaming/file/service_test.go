@@ -0,0 +1,152 @@
+package file
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codectypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	testPrefix = "testPrefix-"
+)
+
+func newTestService(t *testing.T) (*StreamingService, string) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	fss, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+
+	return fss, dir
+}
+
+// readMessages reads the length-prefixed messages in the given file into the
+// provided messages and ensures the whole file is consumed.
+func readMessages(t *testing.T, dir, name string, msgs ...codec.ProtoMarshaler) {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, testPrefix+name))
+	require.NoError(t, err)
+
+	for _, msg := range msgs {
+		size, n := binary.Uvarint(bz)
+		require.True(t, n > 0)
+		require.NoError(t, testMarshaller.UnmarshalBinaryBare(bz[n:n+int(size)], msg))
+		bz = bz[n+int(size):]
+	}
+
+	require.Empty(t, bz, "unexpected trailing data in %s", name)
+}
+
+func TestNewStreamingService(t *testing.T) {
+	fss, dir := newTestService(t)
+
+	require.Equal(t, dir, fss.writeDir)
+	require.Equal(t, testPrefix, fss.filePrefix)
+	require.Len(t, fss.Listeners(), 2)
+	require.Len(t, fss.Listeners()[mockStoreKey1], 1)
+	require.Equal(t, fss.Listeners()[mockStoreKey1], fss.Listeners()[mockStoreKey2])
+
+	_, err := NewStreamingService(filepath.Join(dir, "does-not-exist"), "", nil, testMarshaller)
+	require.Error(t, err)
+}
+
+func TestStreamingServiceBlock(t *testing.T) {
+	fss, dir := newTestService(t)
+	ctx := sdk.Context{}
+
+	beginReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	var txReqs []abci.RequestDeliverTx
+	var txRess []abci.ResponseDeliverTx
+	for i := 0; i < 2; i++ {
+		req := abci.RequestDeliverTx{Tx: []byte(fmt.Sprintf("tx%d", i))}
+		res := abci.ResponseDeliverTx{Code: uint32(i), Log: fmt.Sprintf("log%d", i)}
+		require.NoError(t, fss.ListenDeliverTx(ctx, req, res))
+
+		txReqs = append(txReqs, req)
+		txRess = append(txRess, res)
+	}
+
+	endReq := abci.RequestEndBlock{Height: 3}
+	endRes := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, endRes))
+
+	// state changes are flushed on commit
+	expectedPairs := []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: mockStoreKey2.Name(), Key: []byte("key2"), Delete: true},
+	}
+	for _, pair := range expectedPairs {
+		key := mockStoreKey1
+		if pair.StoreKey == mockStoreKey2.Name() {
+			key = mockStoreKey2
+		}
+		for _, l := range fss.Listeners()[key] {
+			require.NoError(t, l.OnWrite(key, pair.Key, pair.Value, pair.Delete))
+		}
+	}
+
+	commitRes := abci.ResponseCommit{Data: []byte("app hash")}
+	require.NoError(t, fss.ListenCommit(ctx, commitRes))
+
+	var (
+		gotBeginReq abci.RequestBeginBlock
+		gotBeginRes abci.ResponseBeginBlock
+	)
+	readMessages(t, dir, "block-3-begin", &gotBeginReq, &gotBeginRes)
+	require.Equal(t, beginReq, gotBeginReq)
+	require.Equal(t, beginRes, gotBeginRes)
+
+	for i := range txReqs {
+		var (
+			gotReq abci.RequestDeliverTx
+			gotRes abci.ResponseDeliverTx
+		)
+		readMessages(t, dir, fmt.Sprintf("block-3-tx-%d", i), &gotReq, &gotRes)
+		require.Equal(t, txReqs[i], gotReq)
+		require.Equal(t, txRess[i], gotRes)
+	}
+
+	var (
+		gotEndReq abci.RequestEndBlock
+		gotEndRes abci.ResponseEndBlock
+	)
+	readMessages(t, dir, "block-3-end", &gotEndReq, &gotEndRes)
+	require.Equal(t, endReq, gotEndReq)
+	require.Equal(t, endRes, gotEndRes)
+
+	var (
+		gotPair1, gotPair2 types.StoreKVPair
+		gotCommitRes       abci.ResponseCommit
+	)
+	readMessages(t, dir, "block-3-commit", &gotPair1, &gotPair2, &gotCommitRes)
+	require.Equal(t, expectedPairs, []types.StoreKVPair{gotPair1, gotPair2})
+	require.Equal(t, commitRes, gotCommitRes)
+
+	// the next block starts with an empty state cache
+	require.NoError(t, fss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 4}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.ListenCommit(ctx, commitRes))
+	readMessages(t, dir, "block-4-commit", &gotCommitRes)
+
+	require.NoError(t, fss.Close())
+}
@@ -84,7 +84,7 @@ func startInProcess(cfg Config, val *Validator) error {
 	}
 
 	if val.AppConfig.GRPC.Enable {
-		grpcSrv, err := servergrpc.StartGRPCServer(app, val.AppConfig.GRPC.Address)
+		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx.WithClient(val.RPCClient), app, val.AppConfig.GRPC.Address)
 		if err != nil {
 			return err
 		}
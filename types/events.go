@@ -1,11 +1,17 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
+	"github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // ----------------------------------------------------------------------------
@@ -34,11 +40,108 @@ func (em *EventManager) EmitEvents(events Events) {
 	em.events = em.events.AppendEvents(events)
 }
 
+// EmitTypedEvent takes a typed event and emits it as an Event.
+func (em *EventManager) EmitTypedEvent(tev proto.Message) error {
+	event, err := TypedEventToEvent(tev)
+	if err != nil {
+		return err
+	}
+
+	em.EmitEvent(event)
+	return nil
+}
+
+// EmitTypedEvents takes a series of typed events and emits them as Events.
+func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
+	events := make(Events, len(tevs))
+	for i, tev := range tevs {
+		event, err := TypedEventToEvent(tev)
+		if err != nil {
+			return err
+		}
+		events[i] = event
+	}
+
+	em.EmitEvents(events)
+	return nil
+}
+
 // ABCIEvents returns all stored Event objects as abci.Event objects.
 func (em EventManager) ABCIEvents() []abci.Event {
 	return em.events.ToABCIEvents()
 }
 
+// TypedEventToEvent converts a typed event to an Event. The type of the Event
+// is the fully-qualified name of the protobuf message, and there is one
+// attribute per field of the message, with the JSON encoding of the field
+// value as its value. Attributes are sorted by key.
+func TypedEventToEvent(tev proto.Message) (Event, error) {
+	evtType := proto.MessageName(tev)
+	if evtType == "" {
+		return Event{}, fmt.Errorf("unregistered typed event %T", tev)
+	}
+
+	evtJSON, err := codec.ProtoMarshalJSON(tev)
+	if err != nil {
+		return Event{}, err
+	}
+
+	var attrMap map[string]json.RawMessage
+	if err := json.Unmarshal(evtJSON, &attrMap); err != nil {
+		return Event{}, err
+	}
+
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]abci.EventAttribute, len(keys))
+	for i, k := range keys {
+		attrs[i] = abci.EventAttribute{Key: []byte(k), Value: attrMap[k]}
+	}
+
+	return Event{Type: evtType, Attributes: attrs}, nil
+}
+
+// ParseTypedEvent converts an Event emitted by EmitTypedEvent back to the
+// typed event.
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	var value reflect.Value
+	if concreteGoType.Kind() == reflect.Ptr {
+		value = reflect.New(concreteGoType.Elem())
+	} else {
+		value = reflect.Zero(concreteGoType)
+	}
+
+	protoMsg, ok := value.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	attrMap := make(map[string]json.RawMessage)
+	for _, attr := range event.Attributes {
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonpb.Unmarshal(strings.NewReader(string(attrBytes)), protoMsg); err != nil {
+		return nil, err
+	}
+
+	return protoMsg, nil
+}
+
 // ----------------------------------------------------------------------------
 // Events
 // ----------------------------------------------------------------------------
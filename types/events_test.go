@@ -54,6 +54,44 @@ func TestEventManager(t *testing.T) {
 	require.Equal(t, em.Events(), events.AppendEvent(event))
 }
 
+func TestEmitTypedEvent(t *testing.T) {
+	em := NewEventManager()
+	coin := NewCoin("fakedenom", NewInt(1999999))
+
+	require.NoError(t, em.EmitTypedEvent(&coin))
+	require.Len(t, em.Events(), 1)
+
+	event := em.Events()[0]
+	require.Equal(t, "cosmos.base.v1beta1.Coin", event.Type)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: []byte("amount"), Value: []byte(`"1999999"`)},
+		{Key: []byte("denom"), Value: []byte(`"fakedenom"`)},
+	}, event.Attributes)
+
+	msg, err := ParseTypedEvent(abci.Event(event))
+	require.NoError(t, err)
+	require.Equal(t, &coin, msg)
+
+	require.NoError(t, em.EmitTypedEvents(&coin, &DecCoin{Denom: "fakedenom", Amount: NewDecWithPrec(15, 1)}))
+	require.Len(t, em.Events(), 3)
+	require.Equal(t, "cosmos.base.v1beta1.DecCoin", em.Events()[2].Type)
+
+	msg, err = ParseTypedEvent(abci.Event(em.Events()[2]))
+	require.NoError(t, err)
+	require.True(t, msg.(*DecCoin).IsEqual(NewDecCoinFromDec("fakedenom", NewDecWithPrec(15, 1))))
+}
+
+func TestParseTypedEventErrors(t *testing.T) {
+	_, err := ParseTypedEvent(abci.Event{Type: "unknown.Event"})
+	require.Error(t, err)
+
+	_, err = ParseTypedEvent(abci.Event{
+		Type:       "cosmos.base.v1beta1.Coin",
+		Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("not json")}},
+	})
+	require.Error(t, err)
+}
+
 func TestStringifyEvents(t *testing.T) {
 	e := Events{
 		NewEvent("message", NewAttribute("sender", "foo")),
@@ -30,8 +30,8 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
 
-	"github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/gorilla/mux"
@@ -42,6 +42,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 //__________________________________________________________________________________________
@@ -166,12 +167,19 @@ type AppModule interface {
 
 	// routes
 	Route() sdk.Route
-	// Deprecated: use RegisterQueryService
+	// Deprecated: use RegisterServices
 	QuerierRoute() string
-	// Deprecated: use RegisterQueryService
+	// Deprecated: use RegisterServices
 	LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier
-	// RegisterQueryService allows a module to register a gRPC query service
-	RegisterQueryService(grpc.Server)
+	// RegisterServices allows a module to register its protobuf Msg and gRPC
+	// query services, as well as its in-place store migrations
+	RegisterServices(Configurator)
+
+	// ConsensusVersion is a sequence number for state-breaking change of the
+	// module. It should be incremented on each consensus-breaking change
+	// introduced by the module. To avoid wrong/empty versions, the initial version
+	// should be set to 1.
+	ConsensusVersion() uint64
 
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
@@ -204,8 +212,11 @@ func (GenesisOnlyAppModule) QuerierRoute() string { return "" }
 // LegacyQuerierHandler returns an empty module querier
 func (gam GenesisOnlyAppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier { return nil }
 
-// RegisterQueryService registers all gRPC query services.
-func (gam GenesisOnlyAppModule) RegisterQueryService(grpc.Server) {}
+// RegisterServices registers all services.
+func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
@@ -225,6 +236,7 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderMigrations    []string
 }
 
 // NewManager creates a new Manager object
@@ -243,6 +255,7 @@ func NewManager(modules ...AppModule) *Manager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		OrderMigrations:    modulesStr,
 	}
 }
 
@@ -266,6 +279,12 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// SetOrderMigrations sets the order of in-place store migrations run by
+// RunMigrations
+func (m *Manager) SetOrderMigrations(moduleNames ...string) {
+	m.OrderMigrations = moduleNames
+}
+
 // RegisterInvariants registers all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -285,11 +304,96 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter,
 	}
 }
 
-// RegisterQueryServices registers all module query services
-func (m *Manager) RegisterQueryServices(grpcRouter grpc.Server) {
+// RegisterServices registers all module services
+func (m *Manager) RegisterServices(cfg Configurator) {
 	for _, module := range m.Modules {
-		module.RegisterQueryService(grpcRouter)
+		module.RegisterServices(cfg)
+	}
+}
+
+// MigrationHandler is the migration function that each module registers.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of moduleName -> version, where version denotes the
+// version from which we should perform the migration for each module.
+type VersionMap map[string]uint64
+
+// RunMigrations performs in-place store migrations for all modules, in the
+// order given by OrderMigrations. It is meant to be called from an upgrade
+// handler, with the VersionMap persisted by the previous binary.
+//
+// For each module, the migrations registered via Configurator.RegisterMigration
+// are run one by one from the module's version in fromVM up to its current
+// ConsensusVersion. Modules which are not present in fromVM are considered new,
+// and are initialized with their default genesis state instead.
+//
+// An empty fromVM means that the app is upgraded from a binary which did not
+// persist a VersionMap. All the registered modules are then considered to be at
+// their initial version 1, so a module added by such an upgrade must be
+// initialized by the upgrade handler itself.
+//
+// The returned VersionMap contains the ConsensusVersion of every module and
+// should be persisted as the new VersionMap of the app.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
+	}
+
+	if len(fromVM) == 0 {
+		fromVM = m.initialVersionMap()
+	}
+
+	updatedVM := make(VersionMap)
+	for _, moduleName := range m.OrderMigrations {
+		module, ok := m.Modules[moduleName]
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "module %s in the migration order is not registered", moduleName)
+		}
+
+		fromVersion, exists := fromVM[moduleName]
+		toVersion := module.ConsensusVersion()
+
+		if exists {
+			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+			// The module manager assumes only one module will update the
+			// validator set, and that it will not be a new module.
+			if len(moduleValUpdates) > 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis updates already set by a previous module")
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// initialVersionMap returns a VersionMap with all the modules at version 1, the
+// version of the modules of an app which did not persist its VersionMap.
+func (m *Manager) initialVersionMap() VersionMap {
+	vermap := make(VersionMap)
+	for moduleName := range m.Modules {
+		vermap[moduleName] = 1
+	}
+
+	return vermap
+}
+
+// GetVersionMap gets consensus version from all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vermap := make(VersionMap)
+	for _, v := range m.Modules {
+		vermap[v.Name()] = v.ConsensusVersion()
 	}
+
+	return vermap
 }
 
 // InitGenesis performs init genesis functionality for modules
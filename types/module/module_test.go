@@ -12,11 +12,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -113,6 +115,10 @@ func TestManagerOrderSetters(t *testing.T) {
 	require.Equal(t, []string{"module1", "module2"}, mm.OrderEndBlockers)
 	mm.SetOrderEndBlockers("module2", "module1")
 	require.Equal(t, []string{"module2", "module1"}, mm.OrderEndBlockers)
+
+	require.Equal(t, []string{"module1", "module2"}, mm.OrderMigrations)
+	mm.SetOrderMigrations("module2", "module1")
+	require.Equal(t, []string{"module2", "module1"}, mm.OrderMigrations)
 }
 
 func TestManager_RegisterInvariants(t *testing.T) {
@@ -162,14 +168,14 @@ func TestManager_RegisterRoutes(t *testing.T) {
 	mockAppModule1.EXPECT().QuerierRoute().Times(2).Return("querierRoute1")
 	mockAppModule2.EXPECT().QuerierRoute().Times(1).Return("")
 	handler3 := sdk.Querier(nil)
-	mockAppModule1.EXPECT().NewQuerierHandler().Times(1).Return(handler3)
+	mockAppModule1.EXPECT().LegacyQuerierHandler(gomock.Any()).Times(1).Return(handler3)
 	queryRouter.EXPECT().AddRoute(gomock.Eq("querierRoute1"), gomock.Eq(handler3)).Times(1)
 
 	amino := codec.NewLegacyAmino()
 	mm.RegisterRoutes(router, queryRouter, amino)
 }
 
-func TestManager_RegisterQueryServices(t *testing.T) {
+func TestManager_RegisterServices(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
 
@@ -181,11 +187,102 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 	require.NotNil(t, mm)
 	require.Equal(t, 2, len(mm.Modules))
 
+	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	mockAppModule1.EXPECT().RegisterQueryService(queryRouter).Times(1)
-	mockAppModule2.EXPECT().RegisterQueryService(queryRouter).Times(1)
+	cfg := module.NewConfigurator(codec.NewProtoCodec(types.NewInterfaceRegistry()), msgRouter, queryRouter)
+	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
+	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
+
+	mm.RegisterServices(cfg)
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+	require.Equal(t, 2, len(mm.Modules))
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, mocks.NewMockServer(mockCtrl), mocks.NewMockServer(mockCtrl))
+
+	var migrated []uint64
+	for _, version := range []uint64{1, 2} {
+		version := version
+		require.NoError(t, cfg.RegisterMigration("module1", version, func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}))
+	}
+	require.Error(t, cfg.RegisterMigration("module1", 1, func(sdk.Context) error { return nil }))
+	require.Error(t, cfg.RegisterMigration("module1", 0, func(sdk.Context) error { return nil }))
+
+	// module1 is migrated from version 1 to 3, module2 is new and initialized
+	// with its default genesis
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// no migration is registered for version 3 of module1
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(4))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.True(t, sdkerrors.ErrNotFound.Is(err))
+
+	// modules cannot be downgraded
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.True(t, sdkerrors.ErrInvalidVersion.Is(err))
+
+	// a failing migration aborts the upgrade
+	require.NoError(t, cfg.RegisterMigration("module2", 1, func(sdk.Context) error { return errFoo }))
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(2))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.Equal(t, errFoo, err)
+
+	// an app upgraded from a binary which did not persist its VersionMap has
+	// all its modules at version 1, none of them is initialized again
+	migrated = nil
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	vm, err = mm.RunMigrations(ctx, cfg, module.VersionMap{})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// modules in the migration order must be registered
+	mm.SetOrderMigrations("module1", "module3")
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.True(t, sdkerrors.ErrNotFound.Is(err))
+}
+
+func TestManager_GetVersionMap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(3).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(3).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
 
-	mm.RegisterQueryServices(queryRouter)
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(2))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	require.Equal(t, module.VersionMap{"module1": 2, "module2": 1}, mm.GetVersionMap())
 }
 
 func TestManager_InitGenesis(t *testing.T) {
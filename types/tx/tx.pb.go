@@ -659,6 +659,10 @@ type Fee struct {
 	// gas_limit is the maximum gas that can be used in transaction processing
 	// before an out of gas error occurs
 	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// granter is the address of the account paying the fees out of a fee
+	// allowance granted to the first signer of the transaction. If empty, the
+	// fees are paid by the first signer.
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -708,6 +712,13 @@ func (m *Fee) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *Fee) GetGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
@@ -724,59 +735,61 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x5f, 0xef, 0x6e, 0x36, 0xde, 0x97, 0xa4, 0xa5, 0xa3, 0x0a, 0x6d, 0x36, 0xaa, 0x1b, 0x8c,
-	0x02, 0x2b, 0xa1, 0xda, 0x6d, 0x38, 0xf0, 0x47, 0x48, 0x90, 0x6d, 0x29, 0xa9, 0xa0, 0x80, 0x26,
-	0x39, 0xf5, 0x62, 0x8d, 0xbd, 0x13, 0xef, 0xa8, 0xeb, 0x99, 0xc5, 0x33, 0x2e, 0xf6, 0x27, 0xe0,
-	0x02, 0x12, 0x42, 0xe2, 0x3b, 0x20, 0x3e, 0x49, 0x8f, 0x3d, 0x72, 0x40, 0x80, 0x92, 0x0f, 0x02,
-	0x9a, 0xf1, 0xd8, 0x0d, 0x25, 0xda, 0xbd, 0x70, 0xda, 0x79, 0x6f, 0x7e, 0xbf, 0xdf, 0xfb, 0xed,
-	0x7b, 0xf3, 0x0c, 0xe3, 0x44, 0xc8, 0x4c, 0xc8, 0x50, 0x95, 0xe1, 0xb3, 0x7b, 0x31, 0x55, 0xe4,
-	0x5e, 0xa8, 0xca, 0x60, 0x99, 0x0b, 0x25, 0xd0, 0x8d, 0xfa, 0x2e, 0x50, 0x65, 0x60, 0xef, 0xc6,
-	0x37, 0x53, 0x91, 0x0a, 0x73, 0x1b, 0xea, 0x53, 0x0d, 0x1c, 0xbf, 0x6d, 0x45, 0x62, 0x22, 0x69,
-	0x98, 0xe4, 0xd5, 0x52, 0x89, 0x56, 0xad, 0x0e, 0x2d, 0xd0, 0xbb, 0x0c, 0x6c, 0x11, 0x82, 0xf1,
-	0x57, 0x84, 0x54, 0x19, 0x4a, 0x96, 0x72, 0xc6, 0xd3, 0x16, 0x65, 0x63, 0x0b, 0xdc, 0x4d, 0x85,
-	0x48, 0x17, 0x34, 0x34, 0x51, 0x5c, 0x9c, 0x85, 0x84, 0x57, 0xf5, 0x95, 0xff, 0x83, 0x03, 0xdd,
-	0xd3, 0x12, 0xdd, 0x81, 0x7e, 0x2c, 0x66, 0xd5, 0xc8, 0xd9, 0x77, 0x26, 0x5b, 0x87, 0xbb, 0xc1,
-	0x7f, 0xfe, 0x4b, 0x70, 0x5a, 0x4e, 0xc5, 0xac, 0xc2, 0x06, 0x86, 0xde, 0x87, 0x21, 0x29, 0xd4,
-	0x3c, 0x62, 0xfc, 0x4c, 0x8c, 0xba, 0x86, 0xb3, 0x77, 0x05, 0xe7, 0xa8, 0x50, 0xf3, 0x47, 0xfc,
-	0x4c, 0x60, 0x97, 0xd8, 0x13, 0xf2, 0x00, 0xb4, 0x37, 0xa2, 0x8a, 0x9c, 0xca, 0x51, 0x6f, 0xbf,
-	0x37, 0xd9, 0xc6, 0x97, 0x32, 0x3e, 0x87, 0x8d, 0xd3, 0x12, 0x93, 0x6f, 0xd1, 0x2d, 0x00, 0x5d,
-	0x2a, 0x8a, 0x2b, 0x45, 0xa5, 0xf1, 0xb5, 0x8d, 0x87, 0x3a, 0x33, 0xd5, 0x09, 0xf4, 0x16, 0x5c,
-	0x6f, 0x1d, 0x58, 0x4c, 0xd7, 0x60, 0x76, 0x9a, 0x52, 0x35, 0x6e, 0x5d, 0xbd, 0x9f, 0x1c, 0xd8,
-	0x3c, 0x61, 0x29, 0x7f, 0x20, 0x92, 0xff, 0xab, 0xe4, 0x2e, 0xb8, 0xc9, 0x9c, 0x30, 0x1e, 0xb1,
-	0xd9, 0xa8, 0xb7, 0xef, 0x4c, 0x86, 0x78, 0xd3, 0xc4, 0x8f, 0x66, 0xe8, 0x00, 0xae, 0x91, 0x24,
-	0x11, 0x05, 0x57, 0x11, 0x2f, 0xb2, 0x98, 0xe6, 0xa3, 0xfe, 0xbe, 0x33, 0xe9, 0xe3, 0x1d, 0x9b,
-	0xfd, 0xd2, 0x24, 0xfd, 0x9f, 0xbb, 0x30, 0xa8, 0xfb, 0x8d, 0xee, 0x82, 0x9b, 0x51, 0x29, 0x49,
-	0x6a, 0x1c, 0xf5, 0x26, 0x5b, 0x87, 0x37, 0x83, 0x7a, 0x9a, 0x41, 0x33, 0xcd, 0xe0, 0x88, 0x57,
-	0xb8, 0x45, 0x21, 0x04, 0xfd, 0x8c, 0x66, 0xf5, 0x58, 0x86, 0xd8, 0x9c, 0x75, 0x5d, 0xc5, 0x32,
-	0x2a, 0x0a, 0x15, 0xcd, 0x29, 0x4b, 0xe7, 0xca, 0x18, 0xeb, 0xe3, 0x1d, 0x9b, 0x3d, 0x36, 0x49,
-	0x34, 0x85, 0x1b, 0xb4, 0x54, 0x94, 0x4b, 0x26, 0x78, 0x24, 0x96, 0x8a, 0x09, 0x2e, 0x47, 0x7f,
-	0x6f, 0xae, 0x28, 0xfb, 0x5a, 0x8b, 0xff, 0xaa, 0x86, 0xa3, 0x27, 0xe0, 0x71, 0xc1, 0xa3, 0x24,
-	0x67, 0x8a, 0x25, 0x64, 0x11, 0x5d, 0x21, 0x78, 0x7d, 0x85, 0xe0, 0x1e, 0x17, 0xfc, 0xbe, 0xe5,
-	0x7e, 0xfa, 0x8a, 0xb6, 0xff, 0x0c, 0xdc, 0xe6, 0x49, 0xa1, 0x4f, 0x60, 0x5b, 0x8f, 0x91, 0xe6,
-	0x66, 0x1e, 0x4d, 0x73, 0x6e, 0x5d, 0xf1, 0x0a, 0x4f, 0x0c, 0xcc, 0xbc, 0xc3, 0x2d, 0xd9, 0x9e,
-	0x25, 0x9a, 0x40, 0xef, 0x8c, 0x52, 0xfb, 0x7c, 0x5f, 0xbf, 0x82, 0xf8, 0x90, 0x52, 0xac, 0x21,
-	0xfe, 0x2f, 0x0e, 0xc0, 0x4b, 0x15, 0xf4, 0x00, 0x60, 0x59, 0xc4, 0x0b, 0x96, 0x44, 0x4f, 0x69,
-	0xb3, 0x32, 0x07, 0x0d, 0x5f, 0x2f, 0x6b, 0x60, 0xd7, 0xb8, 0x11, 0xfa, 0xda, 0xa0, 0x3f, 0xa7,
-	0x15, 0x1e, 0x2e, 0x9b, 0xa3, 0xde, 0xa1, 0x4c, 0xcc, 0xe8, 0xba, 0x1d, 0x7a, 0x2c, 0x66, 0xb4,
-	0xde, 0xa1, 0xcc, 0x9e, 0xd0, 0x18, 0x5c, 0x49, 0xbf, 0x29, 0x28, 0x4f, 0xa8, 0x9d, 0x63, 0x1b,
-	0xfb, 0xbf, 0x77, 0xc1, 0x6d, 0x28, 0xe8, 0x23, 0x18, 0x48, 0xc6, 0xd3, 0x05, 0xb5, 0x26, 0xfd,
-	0x15, 0xfa, 0xc1, 0x89, 0x41, 0x1e, 0x77, 0xb0, 0xe5, 0xa0, 0x0f, 0x60, 0x23, 0x2b, 0x16, 0x8a,
-	0x59, 0x73, 0x6f, 0xac, 0x22, 0x3f, 0xd6, 0xc0, 0xe3, 0x0e, 0xae, 0x19, 0xe3, 0x23, 0x18, 0xd4,
-	0x72, 0xe8, 0x3d, 0xe8, 0x6b, 0xdf, 0xc6, 0xc0, 0xb5, 0xc3, 0x37, 0x2f, 0x69, 0x34, 0x9f, 0xa8,
-	0xcb, 0x63, 0xd2, 0x7a, 0xd8, 0x10, 0xc6, 0xdf, 0x3b, 0xb0, 0x61, 0x54, 0xd1, 0x67, 0xe0, 0xc6,
-	0x4c, 0x91, 0x3c, 0x27, 0x4d, 0xb3, 0xdf, 0x59, 0xd5, 0xec, 0xfb, 0x22, 0x5b, 0x92, 0x44, 0x4d,
-	0x99, 0x3a, 0xd2, 0x14, 0xdc, 0x92, 0xd1, 0x87, 0x00, 0x6d, 0xc7, 0xf5, 0xee, 0xf6, 0xd6, 0xb5,
-	0x7c, 0xd8, 0xb4, 0x5c, 0x4e, 0x37, 0xa0, 0x27, 0x8b, 0xcc, 0xff, 0xce, 0x81, 0xde, 0x43, 0x4a,
-	0x51, 0x02, 0x03, 0x92, 0xe9, 0x8d, 0xb5, 0xef, 0x6e, 0xf7, 0x5f, 0x8e, 0x5e, 0x5a, 0x61, 0x7c,
-	0x7a, 0xf7, 0xf9, 0x1f, 0xb7, 0x3b, 0xbf, 0xfe, 0x79, 0x7b, 0x92, 0x32, 0x35, 0x2f, 0xe2, 0x20,
-	0x11, 0x59, 0x68, 0x3f, 0xdc, 0xf5, 0xcf, 0x1d, 0x39, 0x7b, 0x1a, 0xaa, 0x6a, 0x49, 0xa5, 0x21,
-	0x48, 0x6c, 0xa5, 0xd1, 0x1e, 0x0c, 0x53, 0x22, 0xa3, 0x05, 0xcb, 0x98, 0x32, 0x43, 0xe8, 0x63,
-	0x37, 0x25, 0xf2, 0x0b, 0x1d, 0x4f, 0x3f, 0x7e, 0x7e, 0xee, 0x39, 0x2f, 0xce, 0x3d, 0xe7, 0xaf,
-	0x73, 0xcf, 0xf9, 0xf1, 0xc2, 0xeb, 0xbc, 0xb8, 0xf0, 0x3a, 0xbf, 0x5d, 0x78, 0x9d, 0x27, 0x07,
-	0xeb, 0x0b, 0x85, 0xaa, 0x8c, 0x07, 0x66, 0xef, 0xde, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1d,
-	0xe3, 0xfb, 0xd0, 0xd4, 0x06, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x8e, 0x63, 0xbf, 0x24, 0x2d, 0x1d, 0x55, 0xc8, 0x71, 0xd4, 0x4d, 0x30, 0x0a,
+	0x58, 0x42, 0xd9, 0x6d, 0xc2, 0x81, 0x3f, 0x42, 0x02, 0xbb, 0xa5, 0xa4, 0x2a, 0x05, 0x34, 0xc9,
+	0xa9, 0x97, 0xd5, 0xec, 0xee, 0x64, 0x3d, 0xaa, 0x77, 0xc6, 0xec, 0xcc, 0x96, 0xf5, 0x77, 0x00,
+	0x09, 0x21, 0xf1, 0x1d, 0x10, 0x9f, 0xa4, 0xc7, 0x1e, 0x38, 0x70, 0x40, 0x05, 0x25, 0xdf, 0x82,
+	0x0b, 0x68, 0x66, 0x67, 0xb7, 0x21, 0x44, 0x36, 0x07, 0x4e, 0x7e, 0xef, 0xcd, 0xef, 0xf7, 0x7b,
+	0xcf, 0xef, 0xcf, 0xc2, 0x20, 0x12, 0x32, 0x15, 0xd2, 0x57, 0x85, 0xff, 0xec, 0x30, 0xa4, 0x8a,
+	0x1c, 0xfa, 0xaa, 0xf0, 0xe6, 0x99, 0x50, 0x02, 0xdd, 0x2a, 0xdf, 0x3c, 0x55, 0x78, 0xf6, 0x6d,
+	0x70, 0x3b, 0x11, 0x89, 0x30, 0xaf, 0xbe, 0xb6, 0x4a, 0xe0, 0xe0, 0x6d, 0x2b, 0x12, 0x12, 0x49,
+	0xfd, 0x28, 0x5b, 0xcc, 0x95, 0xa8, 0xd5, 0x4a, 0xd7, 0x02, 0xdd, 0xcb, 0xc0, 0x1a, 0x21, 0x18,
+	0xbf, 0x22, 0xa4, 0x0a, 0x5f, 0xb2, 0x84, 0x33, 0x9e, 0xd4, 0x28, 0xeb, 0x5b, 0xe0, 0x76, 0x22,
+	0x44, 0x32, 0xa3, 0xbe, 0xf1, 0xc2, 0xfc, 0xcc, 0x27, 0x7c, 0x51, 0x3e, 0x0d, 0xbf, 0x73, 0xa0,
+	0x79, 0x5a, 0xa0, 0x03, 0x68, 0x87, 0x22, 0x5e, 0xf4, 0x9d, 0x3d, 0x67, 0xb4, 0x71, 0xb4, 0xed,
+	0xfd, 0xeb, 0xbf, 0x78, 0xa7, 0xc5, 0x44, 0xc4, 0x0b, 0x6c, 0x60, 0xe8, 0x7d, 0xe8, 0x91, 0x5c,
+	0x4d, 0x03, 0xc6, 0xcf, 0x44, 0xbf, 0x69, 0x38, 0x3b, 0xd7, 0x70, 0xc6, 0xb9, 0x9a, 0x3e, 0xe4,
+	0x67, 0x02, 0x77, 0x89, 0xb5, 0x90, 0x0b, 0xa0, 0x6b, 0x23, 0x2a, 0xcf, 0xa8, 0xec, 0xb7, 0xf6,
+	0x5a, 0xa3, 0x4d, 0x7c, 0x29, 0x32, 0xe4, 0xb0, 0x76, 0x5a, 0x60, 0xf2, 0x0d, 0xba, 0x03, 0xa0,
+	0x53, 0x05, 0xe1, 0x42, 0x51, 0x69, 0xea, 0xda, 0xc4, 0x3d, 0x1d, 0x99, 0xe8, 0x00, 0x7a, 0x0b,
+	0x6e, 0xd6, 0x15, 0x58, 0x4c, 0xd3, 0x60, 0xb6, 0xaa, 0x54, 0x25, 0x6e, 0x55, 0xbe, 0x1f, 0x1c,
+	0x58, 0x3f, 0x61, 0x09, 0xbf, 0x2f, 0xa2, 0xff, 0x2b, 0xe5, 0x36, 0x74, 0xa3, 0x29, 0x61, 0x3c,
+	0x60, 0x71, 0xbf, 0xb5, 0xe7, 0x8c, 0x7a, 0x78, 0xdd, 0xf8, 0x0f, 0x63, 0xb4, 0x0f, 0x37, 0x48,
+	0x14, 0x89, 0x9c, 0xab, 0x80, 0xe7, 0x69, 0x48, 0xb3, 0x7e, 0x7b, 0xcf, 0x19, 0xb5, 0xf1, 0x96,
+	0x8d, 0x7e, 0x61, 0x82, 0xc3, 0x1f, 0x9b, 0xd0, 0x29, 0xfb, 0x8d, 0xee, 0x42, 0x37, 0xa5, 0x52,
+	0x92, 0xc4, 0x54, 0xd4, 0x1a, 0x6d, 0x1c, 0xdd, 0xf6, 0xca, 0x69, 0x7a, 0xd5, 0x34, 0xbd, 0x31,
+	0x5f, 0xe0, 0x1a, 0x85, 0x10, 0xb4, 0x53, 0x9a, 0x96, 0x63, 0xe9, 0x61, 0x63, 0xeb, 0xbc, 0x8a,
+	0xa5, 0x54, 0xe4, 0x2a, 0x98, 0x52, 0x96, 0x4c, 0x95, 0x29, 0xac, 0x8d, 0xb7, 0x6c, 0xf4, 0xd8,
+	0x04, 0xd1, 0x04, 0x6e, 0xd1, 0x42, 0x51, 0x2e, 0x99, 0xe0, 0x81, 0x98, 0x2b, 0x26, 0xb8, 0xec,
+	0xff, 0xb5, 0xbe, 0x24, 0xed, 0x6b, 0x35, 0xfe, 0xcb, 0x12, 0x8e, 0x9e, 0x80, 0xcb, 0x05, 0x0f,
+	0xa2, 0x8c, 0x29, 0x16, 0x91, 0x59, 0x70, 0x8d, 0xe0, 0xcd, 0x25, 0x82, 0x3b, 0x5c, 0xf0, 0x7b,
+	0x96, 0xfb, 0xe9, 0x15, 0xed, 0xe1, 0x33, 0xe8, 0x56, 0x2b, 0x85, 0x3e, 0x81, 0x4d, 0x3d, 0x46,
+	0x9a, 0x99, 0x79, 0x54, 0xcd, 0xb9, 0x73, 0xcd, 0x16, 0x9e, 0x18, 0x98, 0xd9, 0xc3, 0x0d, 0x59,
+	0xdb, 0x12, 0x8d, 0xa0, 0x75, 0x46, 0xa9, 0x5d, 0xdf, 0xd7, 0xaf, 0x21, 0x3e, 0xa0, 0x14, 0x6b,
+	0xc8, 0xf0, 0x27, 0x07, 0xe0, 0x95, 0x0a, 0xba, 0x0f, 0x30, 0xcf, 0xc3, 0x19, 0x8b, 0x82, 0xa7,
+	0xb4, 0x3a, 0x99, 0xfd, 0x8a, 0xaf, 0x8f, 0xd5, 0xb3, 0x67, 0x5c, 0x09, 0x7d, 0x65, 0xd0, 0x8f,
+	0xe8, 0x02, 0xf7, 0xe6, 0x95, 0xa9, 0x6f, 0x28, 0x15, 0x31, 0x5d, 0x75, 0x43, 0x8f, 0x45, 0x4c,
+	0xcb, 0x1b, 0x4a, 0xad, 0x85, 0x06, 0xd0, 0x95, 0xf4, 0xeb, 0x9c, 0xf2, 0x88, 0xda, 0x39, 0xd6,
+	0xfe, 0xf0, 0xb7, 0x26, 0x74, 0x2b, 0x0a, 0xfa, 0x08, 0x3a, 0x92, 0xf1, 0x64, 0x46, 0x6d, 0x91,
+	0xc3, 0x25, 0xfa, 0xde, 0x89, 0x41, 0x1e, 0x37, 0xb0, 0xe5, 0xa0, 0x0f, 0x60, 0x2d, 0xcd, 0x67,
+	0x8a, 0xd9, 0xe2, 0xde, 0x58, 0x46, 0x7e, 0xac, 0x81, 0xc7, 0x0d, 0x5c, 0x32, 0x06, 0x63, 0xe8,
+	0x94, 0x72, 0xe8, 0x3d, 0x68, 0xeb, 0xba, 0x4d, 0x01, 0x37, 0x8e, 0xde, 0xbc, 0xa4, 0x51, 0x7d,
+	0xa2, 0x2e, 0x8f, 0x49, 0xeb, 0x61, 0x43, 0x18, 0x7c, 0xeb, 0xc0, 0x9a, 0x51, 0x45, 0x9f, 0x41,
+	0x37, 0x64, 0x8a, 0x64, 0x19, 0xa9, 0x9a, 0xfd, 0xce, 0xb2, 0x66, 0xdf, 0x13, 0xe9, 0x9c, 0x44,
+	0x6a, 0xc2, 0xd4, 0x58, 0x53, 0x70, 0x4d, 0x46, 0x1f, 0x02, 0xd4, 0x1d, 0xd7, 0xb7, 0xdb, 0x5a,
+	0xd5, 0xf2, 0x5e, 0xd5, 0x72, 0x39, 0x59, 0x83, 0x96, 0xcc, 0xd3, 0xe1, 0x2f, 0x0e, 0xb4, 0x1e,
+	0x50, 0x8a, 0x22, 0xe8, 0x90, 0x54, 0x5f, 0xac, 0xdd, 0xbb, 0xed, 0x7f, 0x54, 0xf4, 0xaa, 0x14,
+	0xc6, 0x27, 0x77, 0x9f, 0xbf, 0xdc, 0x6d, 0xfc, 0xfc, 0xfb, 0xee, 0x28, 0x61, 0x6a, 0x9a, 0x87,
+	0x5e, 0x24, 0x52, 0xdf, 0x7e, 0xb8, 0xcb, 0x9f, 0x03, 0x19, 0x3f, 0xf5, 0xd5, 0x62, 0x4e, 0xa5,
+	0x21, 0x48, 0x6c, 0xa5, 0xd1, 0x0e, 0xf4, 0x12, 0x22, 0x83, 0x19, 0x4b, 0x99, 0x32, 0x43, 0x68,
+	0xe3, 0x6e, 0x42, 0xe4, 0xe7, 0xda, 0x47, 0x8f, 0x60, 0x3d, 0xc9, 0x08, 0x57, 0x34, 0x33, 0x3b,
+	0xb0, 0x39, 0x39, 0xfc, 0xf3, 0xe5, 0xee, 0xc1, 0x7f, 0xc8, 0x31, 0x8e, 0xa2, 0x71, 0x1c, 0x67,
+	0x54, 0x4a, 0x5c, 0x29, 0x4c, 0x3e, 0x7e, 0x7e, 0xee, 0x3a, 0x2f, 0xce, 0x5d, 0xe7, 0x8f, 0x73,
+	0xd7, 0xf9, 0xfe, 0xc2, 0x6d, 0xbc, 0xb8, 0x70, 0x1b, 0xbf, 0x5e, 0xb8, 0x8d, 0x27, 0xfb, 0xab,
+	0x15, 0x7d, 0x55, 0x84, 0x1d, 0x73, 0xc4, 0xef, 0xfe, 0x3d, 0x00, 0xa4, 0x59, 0x53, 0x23, 0x21,
+	0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1283,6 +1296,13 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -1541,6 +1561,10 @@ func (m *Fee) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2905,6 +2929,40 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
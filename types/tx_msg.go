@@ -62,6 +62,7 @@ type (
 		GetGas() uint64
 		GetFee() Coins
 		FeePayer() AccAddress
+		FeeGranter() AccAddress
 	}
 
 	// Tx must have GetMemo() method to use ValidateMemoDecorator
@@ -84,3 +85,9 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the TypeURL of a `sdk.Msg`, which is also the key the
+// MsgServiceRouter uses to route it.
+func MsgTypeURL(msg Msg) string {
+	return "/" + proto.MessageName(msg)
+}
@@ -8,9 +8,10 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer, or from the fee granter if one is set on the transaction. The
+// feegrantKeeper may be nil, in which case fee grants are not supported.
 func NewAnteHandler(
-	ak AccountKeeper, bankKeeper types.BankKeeper,
+	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper FeegrantKeeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
@@ -24,7 +25,7 @@ func NewAnteHandler(
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		NewDeductFeeDecorator(ak, bankKeeper, feegrantKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak, signModeHandler),
 		NewIncrementSequenceDecorator(ak),
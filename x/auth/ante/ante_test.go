@@ -9,9 +9,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -893,16 +894,14 @@ func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []crypto.P
 	signatures = make([][]byte, n)
 	for i := 0; i < n; i++ {
 		var privkey crypto.PrivKey
-		privkey = secp256k1.GenPrivKey()
-
-		// TODO: also generate ed25519 keys as below when ed25519 keys are
-		//  actually supported, https://github.com/cosmos/cosmos-sdk/issues/4789
-		// for now this fails:
-		//if rand.Int63()%2 == 0 {
-		//	privkey = ed25519.GenPrivKey()
-		//} else {
-		//	privkey = secp256k1.GenPrivKey()
-		//}
+		switch i % 3 {
+		case 0:
+			privkey = secp256k1.GenPrivKey()
+		case 1:
+			privkey = ed25519.GenPrivKey()
+		default:
+			privkey = secp256r1.GenPrivKey()
+		}
 
 		pubkeys[i] = privkey.PubKey()
 		signatures[i], _ = privkey.Sign(msg)
@@ -919,6 +918,8 @@ func expectedGasCostByKeys(pubkeys []crypto.PubKey) uint64 {
 			cost += types.DefaultParams().SigVerifyCostED25519
 		case strings.Contains(pubkeyType, "secp256k1"):
 			cost += types.DefaultParams().SigVerifyCostSecp256k1
+		case strings.Contains(pubkeyType, "secp256r1"):
+			cost += types.DefaultParams().SigVerifyCostSecp256r1()
 		default:
 			panic("unexpected key type")
 		}
@@ -1000,7 +1001,7 @@ func (suite *AnteTestSuite) TestCustomSignatureVerificationGasConsumer() {
 	suite.SetupTest(true) // setup
 
 	// setup an ante handler that only accepts PubKeyEd25519
-	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.BankKeeper, func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error {
+	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper, func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error {
 		switch pubkey := sig.PubKey.(type) {
 		case ed25519.PubKey:
 			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -1065,6 +1066,42 @@ func (suite *AnteTestSuite) TestCustomSignatureVerificationGasConsumer() {
 	}
 }
 
+// Test that the default ante handler accepts all supported account key types
+func (suite *AnteTestSuite) TestAnteHandlerKeyTypes() {
+	suite.SetupTest(true) // setup
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	for i, priv := range []crypto.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256r1.GenPrivKey()} {
+		priv := priv
+		accNum := uint64(i)
+
+		tc := TestCase{
+			fmt.Sprintf("verify that an account with a %s key gets accepted", priv.PubKey().Type()),
+			func() {},
+			false,
+			true,
+			nil,
+		}
+
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(accNum))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, addr, feeAmount))
+
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+		suite.RunTestCase([]crypto.PrivKey{priv}, msgs, feeAmount, gasLimit, []uint64{accNum}, []uint64{0}, suite.ctx.ChainID(), tc)
+
+		// the pubkey of the account is set by the ante handler
+		pubKey, err := suite.app.AccountKeeper.GetPubKey(suite.ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(priv.PubKey(), pubKey)
+	}
+}
+
 func (suite *AnteTestSuite) TestAnteHandlerReCheck() {
 	suite.SetupTest(true) // setup
 	// Set recheck=true
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -85,7 +86,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
 	suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10))))
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -100,3 +101,52 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+func (suite *AnteTestSuite) TestDeductFeesWithGrant() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+	suite.txBuilder.SetFeeGranter(granter)
+
+	privs, accNums, accSeqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the fee payer has no funds, the granter does
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	granterAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, granterAcc)
+	suite.app.BankKeeper.SetBalances(suite.ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)))
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Tx did not error without a fee allowance")
+
+	err = suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, granter, addr1, &feegranttypes.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+	})
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Tx errored with a sufficient fee allowance")
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 350), suite.app.BankKeeper.GetBalance(suite.ctx, granter, "atom"))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1).IsZero())
+
+	// the remaining allowance does not cover the fee
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Tx did not error when the fee allowance was exceeded")
+}
@@ -2,17 +2,52 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ types.QueryServer = AccountKeeper{}
 
+// Accounts returns all the existing accounts
+func (ak AccountKeeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(ak.key)
+	accountsStore := prefix.NewStore(store, types.AddressStoreKeyPrefix)
+
+	var accounts []*codectypes.Any
+	pageRes, err := query.Paginate(accountsStore, req.Pagination, func(key, value []byte) error {
+		account, err := ak.UnmarshalAccount(value)
+		if err != nil {
+			return err
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, any)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
 // Account returns account details based on address
 func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
@@ -47,3 +82,62 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// ModuleAccounts returns all the module accounts registered with the account
+// keeper, sorted by module name.
+func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	moduleNames := make([]string, 0, len(ak.permAddrs))
+	for moduleName := range ak.permAddrs {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	modAccounts := make([]*codectypes.Any, 0, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		account := ak.GetModuleAccount(ctx, moduleName)
+		if account == nil {
+			return nil, status.Errorf(codes.NotFound, "module account %s not found", moduleName)
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		modAccounts = append(modAccounts, any)
+	}
+
+	return &types.QueryModuleAccountsResponse{Accounts: modAccounts}, nil
+}
+
+// ModuleAccountByName returns the module account registered with the account
+// keeper under the given module name.
+func (ak AccountKeeper) ModuleAccountByName(c context.Context, req *types.QueryModuleAccountByNameRequest) (*types.QueryModuleAccountByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account := ak.GetModuleAccount(ctx, req.Name)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "module account %s not found", req.Name)
+	}
+
+	any, err := codectypes.NewAnyWithValue(account)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleAccountByNameResponse{Account: any}, nil
+}
@@ -2,12 +2,90 @@ package keeper_test
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+func (suite *KeeperTestSuite) TestGRPCQueryAccounts() {
+	var req *types.QueryAccountsRequest
+	_, _, first := testdata.KeyTestPubAddr()
+	_, _, second := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryAccountsResponse)
+	}{
+		{
+			"success",
+			func() {
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, first))
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, second))
+				req = &types.QueryAccountsRequest{}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				var addresses []sdk.AccAddress
+				for _, any := range res.Accounts {
+					var acc types.AccountI
+					err := suite.app.InterfaceRegistry().UnpackAny(any, &acc)
+					suite.Require().NoError(err)
+					addresses = append(addresses, acc.GetAddress())
+				}
+
+				suite.Require().Len(addresses, len(suite.app.AccountKeeper.GetAllAccounts(suite.ctx)))
+				suite.Require().Contains(addresses, first)
+				suite.Require().Contains(addresses, second)
+			},
+		},
+		{
+			"success with pagination",
+			func() {
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, first))
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, second))
+				req = &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Len(res.Accounts, 1)
+				suite.Require().NotNil(res.Pagination.NextKey)
+				suite.Require().Equal(uint64(len(suite.app.AccountKeeper.GetAllAccounts(suite.ctx))), res.Pagination.Total)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.Accounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
 	var (
 		req *types.QueryAccountRequest
@@ -133,3 +211,89 @@ func (suite *KeeperTestSuite) TestGRPCQueryParameters() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccounts() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.ModuleAccounts(ctx, &types.QueryModuleAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var names []string
+	for _, any := range res.Accounts {
+		var acc types.ModuleAccountI
+		err := suite.app.InterfaceRegistry().UnpackAny(any, &acc)
+		suite.Require().NoError(err)
+		names = append(names, acc.GetName())
+
+		if acc.GetName() == minttypes.ModuleName {
+			suite.Require().Equal([]string{types.Minter}, acc.GetPermissions())
+		}
+	}
+
+	suite.Require().Contains(names, minttypes.ModuleName)
+	suite.Require().True(sort.StringsAreSorted(names))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountByName() {
+	var req *types.QueryModuleAccountByNameRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryModuleAccountByNameResponse)
+	}{
+		{
+			"empty module name",
+			func() {
+				req = &types.QueryModuleAccountByNameRequest{}
+			},
+			false,
+			func(res *types.QueryModuleAccountByNameResponse) {},
+		},
+		{
+			"module account not found",
+			func() {
+				req = &types.QueryModuleAccountByNameRequest{Name: "unknown"}
+			},
+			false,
+			func(res *types.QueryModuleAccountByNameResponse) {},
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryModuleAccountByNameRequest{Name: minttypes.ModuleName}
+			},
+			true,
+			func(res *types.QueryModuleAccountByNameResponse) {
+				var acc types.ModuleAccountI
+				err := suite.app.InterfaceRegistry().UnpackAny(res.Account, &acc)
+				suite.Require().NoError(err)
+				suite.Require().Equal(minttypes.ModuleName, acc.GetName())
+				suite.Require().Equal([]string{types.Minter}, acc.GetPermissions())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.ModuleAccountByName(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
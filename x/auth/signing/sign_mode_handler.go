@@ -1,6 +1,8 @@
 package signing
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -20,6 +22,29 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is implemented by SignModeHandlers whose sign bytes
+// depend on chain state, such as the denom metadata displayed in
+// SIGN_MODE_TEXTUAL. When verifying signatures in a transaction, the context
+// wraps the sdk.Context of the transaction.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+// SignerData and Tx using the given handler, passing it the context if it is a
+// SignModeHandlerWithContext.
+func GetSignBytesWithContext(ctx context.Context, h SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if hc, ok := h.(SignModeHandlerWithContext); ok {
+		return hc.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return h.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 type config struct {
@@ -26,9 +27,25 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec, PublicKeyCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return newTxConfig(protoCodec, pubkeyCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, which also supports SIGN_MODE_TEXTUAL if it
+// is enabled. The coin metadata query function is used to display coins in their display denom.
+func NewTxConfigWithTextual(
+	protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode,
+	coinMetadataQuerier textual.CoinMetadataQueryFn,
+) client.TxConfig {
+	return newTxConfig(protoCodec, pubkeyCodec, enabledSignModes, textual.NewTextual(coinMetadataQuerier))
+}
+
+func newTxConfig(
+	protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode,
+	textualRenderer *textual.Textual,
+) client.TxConfig {
 	return &config{
 		pubkeyCodec: pubkeyCodec,
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, textualRenderer),
 		decoder:     DefaultTxDecoder(protoCodec, pubkeyCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec, pubkeyCodec),
@@ -5,6 +5,7 @@ import (
 
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -13,9 +14,18 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 }
 
+// NewSignModeHandlerWithTextual returns a SignModeHandler supporting the given
+// sign modes, which may include SIGN_MODE_TEXTUAL. The coin metadata query
+// function is used to display coins in their display denom. The first mode
+// becomes the default sign mode.
+func NewSignModeHandlerWithTextual(modes []signingtypes.SignMode, coinMetadataQuerier textual.CoinMetadataQueryFn) signing.SignModeHandler {
+	return makeSignModeHandler(modes, textual.NewTextual(coinMetadataQuerier))
+}
+
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON and, if textual is not nil,
+// SIGN_MODE_TEXTUAL.
+func makeSignModeHandler(modes []signingtypes.SignMode, textualRenderer *textual.Textual) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -28,6 +38,11 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeDirectHandler{}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			if textualRenderer == nil {
+				panic(fmt.Errorf("%s requires a coin metadata query function, see NewSignModeHandlerWithTextual", mode))
+			}
+			handlers[i] = signModeTextualHandler{textual: textualRenderer}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
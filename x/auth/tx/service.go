@@ -0,0 +1,219 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// defaultLimit is the default number of txs returned by GetTxsEvent when the
+// request does not specify a limit.
+const defaultLimit = 100
+
+const eventFormat = "{eventType}.{eventAttribute}={value}"
+
+// txServer is the server for the protobuf Tx service. It queries and
+// broadcasts txs through the Tendermint RPC client of the client.Context.
+type txServer struct {
+	clientCtx client.Context
+}
+
+// NewTxServer creates a new Tx service server.
+func NewTxServer(clientCtx client.Context) txtypes.ServiceServer {
+	return txServer{
+		clientCtx: clientCtx,
+	}
+}
+
+var _ txtypes.ServiceServer = txServer{}
+
+// GetTxsEvent implements the ServiceServer.GetTxsEvent RPC method.
+func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.Events) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
+	}
+
+	for _, event := range req.Events {
+		if strings.Count(event, "=") != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event; event %s should be of the format: %s", event, eventFormat)
+		}
+	}
+
+	page, limit, err := parsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTxs, err := node.TxSearch(strings.Join(req.Events, " AND "), false, &page, &limit, "")
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*txtypes.Tx, len(resTxs.Txs))
+	txResponses := make([]*sdk.TxResponse, len(resTxs.Txs))
+	resBlocks := make(map[int64]*ctypes.ResultBlock)
+	for i, resTx := range resTxs.Txs {
+		if _, ok := resBlocks[resTx.Height]; !ok {
+			resBlocks[resTx.Height], err = node.Block(&resTx.Height)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		txs[i], txResponses[i], err = s.formatTxResult(resTx, resBlocks[resTx.Height])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		Txs:         txs,
+		TxResponses: txResponses,
+		Pagination: &query.PageResponse{
+			Total: uint64(resTxs.TotalCount),
+		},
+	}, nil
+}
+
+// GetTx implements the ServiceServer.GetTx RPC method.
+func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %s: %s", req.Hash, err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(hash, false)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found: %s", req.Hash, err)
+	}
+
+	resBlock, err := node.Block(&resTx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTx, txResponse, err := s.formatTxResult(resTx, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxResponse{
+		Tx:         protoTx,
+		TxResponse: txResponse,
+	}, nil
+}
+
+// BroadcastTx implements the ServiceServer.BroadcastTx RPC method.
+func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	var mode string
+	switch req.Mode {
+	case txtypes.BroadcastMode_BROADCAST_MODE_BLOCK:
+		mode = flags.BroadcastBlock
+	case txtypes.BroadcastMode_BROADCAST_MODE_SYNC:
+		mode = flags.BroadcastSync
+	case txtypes.BroadcastMode_BROADCAST_MODE_ASYNC:
+		mode = flags.BroadcastAsync
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported broadcast mode %s", req.Mode)
+	}
+
+	res, err := s.clientCtx.WithBroadcastMode(mode).BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.BroadcastTxResponse{
+		TxResponse: res,
+	}, nil
+}
+
+// RegisterGRPCGatewayRoutes mounts the Tx service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := txtypes.RegisterServiceHandlerServer(context.Background(), mux, NewTxServer(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// formatTxResult decodes the tx of the given result into its protobuf
+// representation and builds the matching TxResponse.
+func (s txServer) formatTxResult(resTx *ctypes.ResultTx, resBlock *ctypes.ResultBlock) (*txtypes.Tx, *sdk.TxResponse, error) {
+	sdkTx, err := s.clientCtx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txBuilder, err := s.clientCtx.TxConfig.WrapTxBuilder(sdkTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protoTx := txBuilder.GetProtoTx()
+	any, err := codectypes.NewAnyWithValue(protoTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txResponse := sdk.NewResponseResultTx(resTx, nil, resBlock.Block.Time.Format(time.RFC3339))
+	txResponse.Tx = any
+
+	return protoTx, txResponse, nil
+}
+
+// parsePagination converts an offset based PageRequest into the page and
+// limit used by the Tendermint tx search.
+func parsePagination(pageReq *query.PageRequest) (int, int, error) {
+	if pageReq == nil {
+		return 1, defaultLimit, nil
+	}
+
+	if len(pageReq.Key) > 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset instead")
+	}
+
+	limit := int(pageReq.Limit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	if pageReq.Offset%uint64(limit) != 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "offset %d must be a multiple of the limit %d", pageReq.Offset, limit)
+	}
+
+	return int(pageReq.Offset)/limit + 1, limit, nil
+}
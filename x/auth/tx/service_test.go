@@ -0,0 +1,211 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// mockNode serves a fixed set of txs in place of a Tendermint node.
+type mockNode struct {
+	rpcclient.Client
+
+	txs       []*ctypes.ResultTx
+	blockTime time.Time
+
+	query           string
+	page, perPage   int
+	broadcastMethod string
+}
+
+func (n *mockNode) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	for _, tx := range n.txs {
+		if tx.Hash.String() == fmt.Sprintf("%X", hash) {
+			return tx, nil
+		}
+	}
+
+	return nil, fmt.Errorf("tx (%X) not found", hash)
+}
+
+func (n *mockNode) TxSearch(query string, _ bool, page, perPage *int, _ string) (*ctypes.ResultTxSearch, error) {
+	n.query, n.page, n.perPage = query, *page, *perPage
+
+	return &ctypes.ResultTxSearch{Txs: n.txs, TotalCount: len(n.txs)}, nil
+}
+
+func (n *mockNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	block := &tmtypes.Block{}
+	block.Height = *height
+	block.Time = n.blockTime
+
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (n *mockNode) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	n.broadcastMethod = "sync"
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *mockNode) BroadcastTxAsync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	n.broadcastMethod = "async"
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *mockNode) BroadcastTxCommit(tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	n.broadcastMethod = "block"
+	return &ctypes.ResultBroadcastTxCommit{Hash: tx.Hash(), Height: 5}, nil
+}
+
+func newTestTxServer(t *testing.T) (txtypes.ServiceServer, *mockNode, []*txtypes.Tx) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), std.DefaultPublicKeyCodec{}, DefaultSignModes)
+
+	node := &mockNode{blockTime: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)}
+	var protoTxs []*txtypes.Tx
+	for i := 0; i < 2; i++ {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetMemo(fmt.Sprintf("memo%d", i))
+		txBuilder.SetGasLimit(20000)
+
+		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+
+		node.txs = append(node.txs, &ctypes.ResultTx{
+			Hash:     tmtypes.Tx(txBytes).Hash(),
+			Height:   int64(i + 1),
+			TxResult: abci.ResponseDeliverTx{GasWanted: 20000, GasUsed: 10000},
+			Tx:       txBytes,
+		})
+		protoTxs = append(protoTxs, txBuilder.GetProtoTx())
+	}
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithInterfaceRegistry(interfaceRegistry).
+		WithClient(node)
+
+	return NewTxServer(clientCtx), node, protoTxs
+}
+
+func TestTxServiceGetTx(t *testing.T) {
+	server, node, protoTxs := newTestTxServer(t)
+
+	res, err := server.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: node.txs[1].Hash.String()})
+	require.NoError(t, err)
+	require.Equal(t, "memo1", res.Tx.Body.Memo)
+	require.Equal(t, protoTxs[1].Body.Memo, res.Tx.Body.Memo)
+	require.Equal(t, node.txs[1].Hash.String(), res.TxResponse.TxHash)
+	require.Equal(t, int64(2), res.TxResponse.Height)
+	require.Equal(t, int64(10000), res.TxResponse.GasUsed)
+	require.Equal(t, "2020-10-01T00:00:00Z", res.TxResponse.Timestamp)
+	require.Equal(t, "/cosmos.tx.v1beta1.Tx", res.TxResponse.Tx.TypeUrl)
+
+	_, err = server.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "not hex"})
+	require.Error(t, err)
+
+	_, err = server.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "ABCD"})
+	require.Error(t, err)
+
+	_, err = server.GetTx(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func TestTxServiceGetTxsEvent(t *testing.T) {
+	server, node, _ := newTestTxServer(t)
+
+	testCases := []struct {
+		name       string
+		req        *txtypes.GetTxsEventRequest
+		expErr     bool
+		expPage    int
+		expPerPage int
+	}{
+		{"nil request", nil, true, 0, 0},
+		{"no events", &txtypes.GetTxsEventRequest{}, true, 0, 0},
+		{"invalid event", &txtypes.GetTxsEventRequest{Events: []string{"message.action"}}, true, 0, 0},
+		{
+			"key pagination",
+			&txtypes.GetTxsEventRequest{Events: []string{"message.action='send'"}, Pagination: &query.PageRequest{Key: []byte("key")}},
+			true, 0, 0,
+		},
+		{
+			"offset not a multiple of the limit",
+			&txtypes.GetTxsEventRequest{Events: []string{"message.action='send'"}, Pagination: &query.PageRequest{Offset: 3, Limit: 2}},
+			true, 0, 0,
+		},
+		{
+			"default pagination",
+			&txtypes.GetTxsEventRequest{Events: []string{"message.action='send'", "message.module='bank'"}},
+			false, 1, defaultLimit,
+		},
+		{
+			"offset pagination",
+			&txtypes.GetTxsEventRequest{Events: []string{"message.action='send'"}, Pagination: &query.PageRequest{Offset: 4, Limit: 2}},
+			false, 3, 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := server.GetTxsEvent(context.Background(), tc.req)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expPage, node.page)
+			require.Equal(t, tc.expPerPage, node.perPage)
+			require.Len(t, res.Txs, 2)
+			require.Len(t, res.TxResponses, 2)
+			require.Equal(t, uint64(2), res.Pagination.Total)
+			require.Equal(t, "memo0", res.Txs[0].Body.Memo)
+			require.Equal(t, node.txs[0].Hash.String(), res.TxResponses[0].TxHash)
+		})
+	}
+
+	require.Equal(t, "message.action='send'", node.query)
+}
+
+func TestTxServiceBroadcastTx(t *testing.T) {
+	server, node, _ := newTestTxServer(t)
+	txBytes := []byte(node.txs[0].Tx)
+
+	for mode, method := range map[txtypes.BroadcastMode]string{
+		txtypes.BroadcastMode_BROADCAST_MODE_SYNC:  "sync",
+		txtypes.BroadcastMode_BROADCAST_MODE_ASYNC: "async",
+		txtypes.BroadcastMode_BROADCAST_MODE_BLOCK: "block",
+	} {
+		res, err := server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: txBytes, Mode: mode})
+		require.NoError(t, err)
+		require.Equal(t, method, node.broadcastMethod)
+		require.Equal(t, node.txs[0].Hash.String(), res.TxResponse.TxHash)
+	}
+
+	_, err := server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: txBytes})
+	require.Error(t, err)
+
+	_, err = server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{Mode: txtypes.BroadcastMode_BROADCAST_MODE_SYNC})
+	require.Error(t, err)
+}
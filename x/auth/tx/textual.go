@@ -0,0 +1,82 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+)
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler, which
+// signs over a human-readable rendering of the transaction.
+type signModeTextualHandler struct {
+	textual *textual.Textual
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	envelope, err := textualEnvelope(protoTx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	screens, err := h.textual.FormatEnvelope(ctx, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return textual.EncodeScreens(screens), nil
+}
+
+// textualEnvelope returns the data of tx displayed in SIGN_MODE_TEXTUAL.
+func textualEnvelope(protoTx *wrapper, data signing.SignerData) (*textual.Envelope, error) {
+	// the hash covers the body and auth info bytes, as signed in SIGN_MODE_DIRECT
+	signDoc, err := DirectSignBytes(protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), data.ChainID, data.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(signDoc)
+
+	return &textual.Envelope{
+		ChainID:        data.ChainID,
+		AccountNumber:  data.AccountNumber,
+		Sequence:       data.Sequence,
+		Messages:       protoTx.tx.Body.Messages,
+		Memo:           protoTx.GetMemo(),
+		Fees:           protoTx.GetFee(),
+		FeeGranter:     protoTx.FeeGranter(),
+		GasLimit:       protoTx.GetGas(),
+		TimeoutHeight:  protoTx.GetTimeoutHeight(),
+		HashOfRawBytes: hash[:],
+	}, nil
+}
@@ -0,0 +1,170 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// coinRenderer renders a coin in the display denom of its metadata, if it has
+// any, e.g. "1.5 atom" for 1500000uatom.
+type coinRenderer struct {
+	t *Textual
+}
+
+func (r coinRenderer) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	text, err := r.t.formatCoin(ctx, v.(sdk.Coin))
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: text}}, nil
+}
+
+func (r coinRenderer) Parse(ctx context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.t.parseCoin(ctx, text)
+}
+
+// coinsRenderer renders coins like coinRenderer, separated by commas.
+type coinsRenderer struct {
+	t *Textual
+}
+
+func (r coinsRenderer) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	coins := v.(sdk.Coins)
+	if len(coins) == 0 {
+		return []Screen{{Text: "zero"}}, nil
+	}
+
+	texts := make([]string, len(coins))
+	for i, coin := range coins {
+		text, err := r.t.formatCoin(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
+		texts[i] = text
+	}
+
+	return []Screen{{Text: strings.Join(texts, ", ")}}, nil
+}
+
+func (r coinsRenderer) Parse(ctx context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	if text == "zero" {
+		return sdk.Coins{}, nil
+	}
+
+	texts := strings.Split(text, ", ")
+	coins := make(sdk.Coins, len(texts))
+	for i, text := range texts {
+		coin, err := r.t.parseCoin(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		coins[i] = coin
+	}
+
+	return coins, nil
+}
+
+// formatCoin renders coin in the display denom of its metadata, if it has any.
+func (t *Textual) formatCoin(ctx context.Context, coin sdk.Coin) (string, error) {
+	if coin.Amount.IsNil() {
+		return "", fmt.Errorf("nil amount for %s", coin.Denom)
+	}
+
+	metadata, err := t.coinMetadataQuerier(ctx, coin.Denom)
+	if err != nil {
+		return "", err
+	}
+
+	amount, denom := sdk.NewDecFromInt(coin.Amount), coin.Denom
+	if exponent, ok := displayExponent(metadata, coin.Denom); ok {
+		amount, denom = sdk.NewDecFromIntWithPrec(coin.Amount, exponent), metadata.Display
+	}
+
+	return formatDecimal(amount) + " " + denom, nil
+}
+
+// parseCoin is the inverse of formatCoin. The metadata of denoms rendered in
+// their display denom is looked up by that display denom, so parsing them
+// requires a CoinMetadataQueryFn which resolves display denoms as well as base
+// denoms.
+func (t *Textual) parseCoin(ctx context.Context, text string) (sdk.Coin, error) {
+	parts := strings.Split(text, " ")
+	if len(parts) != 2 {
+		return sdk.Coin{}, fmt.Errorf("invalid coin %q", text)
+	}
+
+	amount, err := parseDecimal(parts[0])
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	denom := parts[1]
+	metadata, err := t.coinMetadataQuerier(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if metadata != nil && metadata.Display == denom {
+		if exponent, ok := displayExponent(metadata, metadata.Base); ok {
+			amount = amount.MulInt(sdk.NewIntWithDecimal(1, int(exponent)))
+			denom = metadata.Base
+		}
+	}
+
+	if !amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("invalid amount of %s: %s", denom, parts[0])
+	}
+
+	coin := sdk.Coin{Denom: denom, Amount: amount.TruncateInt()}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// reject non-canonical renderings, such as amounts in base denoms which
+	// have a display denom
+	if canonical, err := t.formatCoin(ctx, coin); err != nil || canonical != text {
+		return sdk.Coin{}, fmt.Errorf("invalid coin %q", text)
+	}
+
+	return coin, nil
+}
+
+// displayExponent returns the power of ten by which amounts of the given base
+// denom are divided to be expressed in the display denom of metadata, if any.
+func displayExponent(metadata *banktypes.Metadata, base string) (int64, bool) {
+	if metadata == nil || metadata.Base != base || metadata.Display == "" || metadata.Display == base {
+		return 0, false
+	}
+
+	var baseExponent, displayExponent uint32
+	var found bool
+	for _, unit := range metadata.DenomUnits {
+		switch unit.Denom {
+		case metadata.Base:
+			baseExponent = unit.Exponent
+		case metadata.Display:
+			displayExponent, found = unit.Exponent, true
+		}
+	}
+
+	if !found || displayExponent <= baseExponent || displayExponent-baseExponent > sdk.Precision {
+		return 0, false
+	}
+
+	return int64(displayExponent - baseExponent), true
+}
@@ -0,0 +1,145 @@
+package textual
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EncodeScreens encodes screens into the bytes signed in SIGN_MODE_TEXTUAL,
+// one line per screen. Each line is prefixed by a "*" for expert screens and
+// by a ">" per indentation level, followed by a space if there is any prefix.
+// Backslashes, control characters and leading "*" or ">" characters of the
+// screen text are escaped with a backslash, so that the encoding is
+// unambiguous.
+func EncodeScreens(screens []Screen) []byte {
+	var buf bytes.Buffer
+
+	for i, s := range screens {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+
+		prefix := strings.Repeat(">", s.Indent)
+		if s.Expert {
+			prefix = "*" + prefix
+		}
+		if prefix != "" {
+			buf.WriteString(prefix + " ")
+		}
+
+		buf.WriteString(escape(s.Text))
+	}
+
+	return buf.Bytes()
+}
+
+// DecodeScreens is the inverse of EncodeScreens.
+func DecodeScreens(bz []byte) ([]Screen, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	lines := strings.Split(string(bz), "\n")
+	screens := make([]Screen, len(lines))
+
+	for i, line := range lines {
+		var s Screen
+
+		if strings.HasPrefix(line, "*") {
+			s.Expert = true
+			line = line[1:]
+		}
+
+		for strings.HasPrefix(line, ">") {
+			s.Indent++
+			line = line[1:]
+		}
+
+		if s.Expert || s.Indent > 0 {
+			if !strings.HasPrefix(line, " ") {
+				return nil, fmt.Errorf("missing space after prefix of line %d", i+1)
+			}
+			line = line[1:]
+		}
+
+		text, err := unescape(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		s.Text = text
+		screens[i] = s
+	}
+
+	// reject encodings which are accepted, but not produced by EncodeScreens
+	if !bytes.Equal(EncodeScreens(screens), bz) {
+		return nil, fmt.Errorf("non-canonical encoding of screens")
+	}
+
+	return screens, nil
+}
+
+func escape(text string) string {
+	var b strings.Builder
+
+	for i, r := range text {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case unicode.IsControl(r):
+			b.WriteString(fmt.Sprintf(`\u%04X`, r))
+		case i == 0 && (r == '*' || r == '>'):
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func unescape(line string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(line); i++ {
+		if line[i] != '\\' {
+			b.WriteByte(line[i])
+			continue
+		}
+
+		if i+1 >= len(line) {
+			return "", fmt.Errorf("invalid escape sequence at the end of %q", line)
+		}
+
+		i++
+		switch c := line[i]; {
+		case c == '\\':
+			b.WriteByte('\\')
+		case c == 'n':
+			b.WriteByte('\n')
+		case c == 't':
+			b.WriteByte('\t')
+		case (c == '*' || c == '>') && i == 1:
+			b.WriteByte(c)
+		case c == 'u' && i+4 < len(line):
+			r, err := strconv.ParseUint(line[i+1:i+5], 16, 32)
+			if err != nil || !unicode.IsControl(rune(r)) || r == '\n' || r == '\t' {
+				return "", fmt.Errorf("invalid escape sequence in %q", line)
+			}
+			b.WriteRune(rune(r))
+			i += 4
+		default:
+			return "", fmt.Errorf("invalid escape sequence in %q", line)
+		}
+	}
+
+	return b.String(), nil
+}
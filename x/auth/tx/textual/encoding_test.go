@@ -0,0 +1,68 @@
+package textual_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+)
+
+func TestEncodeScreens(t *testing.T) {
+	testCases := []struct {
+		name    string
+		screens []textual.Screen
+		encoded string
+	}{
+		{"empty", nil, ""},
+		{"simple", []textual.Screen{{Text: "Chain id: test"}}, "Chain id: test"},
+		{
+			"indent and expert",
+			[]textual.Screen{{Text: "a"}, {Text: "b", Indent: 1}, {Text: "c", Indent: 2, Expert: true}, {Text: "d", Expert: true}},
+			"a\n> b\n*>> c\n* d",
+		},
+		{"newline and tab", []textual.Screen{{Text: "a\nb\tc"}}, `a\nb\tc`},
+		{"backslash", []textual.Screen{{Text: `a\b`}}, `a\\b`},
+		{"control character", []textual.Screen{{Text: "a\x00b\x7f"}}, `a\u0000b\u007F`},
+		{"leading prefix characters", []textual.Screen{{Text: "> a"}, {Text: "*b", Indent: 1}}, "\\> a\n> \\*b"},
+		{"inner prefix characters", []textual.Screen{{Text: "a > b * c"}}, "a > b * c"},
+		{"unicode", []textual.Screen{{Text: "ﬁ ✓"}}, "ﬁ ✓"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bz := textual.EncodeScreens(tc.screens)
+			require.Equal(t, tc.encoded, string(bz))
+
+			screens, err := textual.DecodeScreens(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.screens, screens)
+		})
+	}
+}
+
+func TestDecodeInvalidScreens(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded string
+	}{
+		{"missing space after prefix", ">a"},
+		{"dangling backslash", `a\`},
+		{"unknown escape", `a\x`},
+		{"escaped newline as unicode", `a\u000A`},
+		{"escaped printable character", `a\u0041`},
+		{"lower case unicode escape", `a\u007f`},
+		{"short unicode escape", `a\u00`},
+		{"unescaped control character", "a\x00"},
+		{"unescaped leading prefix character", "* *a"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := textual.DecodeScreens([]byte(tc.encoded))
+			require.Error(t, err)
+		})
+	}
+}
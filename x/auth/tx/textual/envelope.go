@@ -0,0 +1,44 @@
+package textual
+
+import (
+	"context"
+	"reflect"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Envelope holds the data of a transaction which is displayed to its signer.
+type Envelope struct {
+	ChainID       string            `textual:"chain_id"`
+	AccountNumber uint64            `textual:"account_number"`
+	Sequence      uint64            `textual:"sequence"`
+	Messages      []*codectypes.Any `textual:"message"`
+	Memo          string            `textual:"memo"`
+	Fees          sdk.Coins         `textual:"fees"`
+	FeeGranter    sdk.AccAddress    `textual:"fee_granter"`
+	GasLimit      uint64            `textual:"gas_limit,expert"`
+	TimeoutHeight uint64            `textual:"timeout_height"`
+
+	// HashOfRawBytes is a hash of the encoded transaction, which covers the
+	// parts of the transaction which aren't displayed, such as the public keys
+	// of the signers.
+	HashOfRawBytes []byte `textual:"hash_of_raw_bytes,expert"`
+}
+
+var envelopeType = reflect.TypeOf((*Envelope)(nil))
+
+// FormatEnvelope renders the given transaction data into screens.
+func (t *Textual) FormatEnvelope(ctx context.Context, envelope *Envelope) ([]Screen, error) {
+	return fieldsRenderer{t, envelopeType}.Format(ctx, envelope)
+}
+
+// ParseEnvelope is the inverse of FormatEnvelope.
+func (t *Textual) ParseEnvelope(ctx context.Context, screens []Screen) (*Envelope, error) {
+	v, err := fieldsRenderer{t, envelopeType}.Parse(ctx, screens)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*Envelope), nil
+}
@@ -0,0 +1,463 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// field describes how a struct field is rendered.
+type field struct {
+	index    int
+	name     string
+	expert   bool
+	repeated bool
+	oneof    bool
+	renderer ValueRenderer
+}
+
+// repeatedLabelRegexp matches the labels of the elements of repeated fields,
+// e.g. "Message (1/2)".
+var repeatedLabelRegexp = regexp.MustCompile(`^(.+) \(([1-9][0-9]*)/([1-9][0-9]*)\)$`)
+
+// fieldsRenderer is the default renderer of the fields of messages. It renders
+// each field on its own screen, labelled with the field name, and omits the
+// fields which have their default value. The elements of repeated fields are
+// rendered one after the other, e.g. "Message (1/2)" and "Message (2/2)".
+type fieldsRenderer struct {
+	t   *Textual
+	typ reflect.Type
+}
+
+func (r fieldsRenderer) fields() ([]field, error) {
+	st := r.typ.Elem()
+	var fields []field
+
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		if _, ok := sf.Tag.Lookup("protobuf_oneof"); ok {
+			fields = append(fields, field{index: i, name: sf.Name, oneof: true})
+			continue
+		}
+
+		name, expert, enum := parseFieldTags(sf)
+		if name == "" {
+			continue
+		}
+
+		f := field{index: i, name: name, expert: expert}
+
+		typ := sf.Type
+		if typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8 && typ != coinsType {
+			f.repeated = true
+			typ = typ.Elem()
+		}
+
+		if enum != "" {
+			f.renderer = enumRenderer{typ, enum}
+		} else {
+			vr, err := r.t.GetValueRenderer(typ)
+			if err != nil {
+				return nil, fmt.Errorf("field %s of %s: %w", sf.Name, st, err)
+			}
+			f.renderer = vr
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+func (r fieldsRenderer) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	fields, err := r.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return nil, nil
+	}
+
+	var screens []Screen
+	for _, f := range fields {
+		fv := rv.Elem().Field(f.index)
+		if isDefault(fv) {
+			continue
+		}
+
+		if f.oneof {
+			return nil, fmt.Errorf("oneof field %s of %s is not supported", f.name, r.typ.Elem())
+		}
+
+		if !f.repeated {
+			fieldScreens, err := f.renderer.Format(ctx, fv.Interface())
+			if err != nil {
+				return nil, err
+			}
+			fieldScreens, err = label(f.name, fieldScreens, f.expert)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, fieldScreens...)
+			continue
+		}
+
+		n := fv.Len()
+		for i := 0; i < n; i++ {
+			elem := fv.Index(i)
+			if (elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface) && elem.IsNil() {
+				return nil, fmt.Errorf("nil element in field %s of %s", f.name, r.typ.Elem())
+			}
+
+			elemScreens, err := f.renderer.Format(ctx, elem.Interface())
+			if err != nil {
+				return nil, err
+			}
+			elemScreens, err = label(fmt.Sprintf("%s (%d/%d)", f.name, i+1, n), elemScreens, f.expert)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+	}
+
+	return screens, nil
+}
+
+func (r fieldsRenderer) Parse(ctx context.Context, screens []Screen) (interface{}, error) {
+	fields, err := r.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsByName := make(map[string]field, len(fields))
+	for _, f := range fields {
+		if !f.oneof {
+			fieldsByName[f.name] = f
+		}
+	}
+
+	rv := reflect.New(r.typ.Elem())
+
+	// lastIndex is the index of the last parsed field, and lastCount its
+	// expected number of elements if it is repeated
+	lastIndex, lastCount := -1, 0
+	checkComplete := func() error {
+		if lastCount > 0 && rv.Elem().Field(lastIndex).Len() != lastCount {
+			return fmt.Errorf("missing elements of repeated field %s of %s", r.typ.Elem().Field(lastIndex).Name, r.typ.Elem())
+		}
+		return nil
+	}
+
+	for i := 0; i < len(screens); {
+		if screens[i].Indent != 0 {
+			return nil, fmt.Errorf("unexpected indentation of screen %q", screens[i].Text)
+		}
+
+		// the screens of a field are its labelled screen and the following
+		// screens with a higher indentation
+		j := i + 1
+		for j < len(screens) && screens[j].Indent > 0 {
+			j++
+		}
+
+		fieldScreens := make([]Screen, j-i)
+		copy(fieldScreens, screens[i:j])
+		i = j
+
+		parts := strings.SplitN(fieldScreens[0].Text, ": ", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid screen %q", fieldScreens[0].Text)
+		}
+		name, text := parts[0], parts[1]
+		fieldScreens[0].Text = text
+
+		index, count := 0, 0
+		if matches := repeatedLabelRegexp.FindStringSubmatch(name); matches != nil {
+			name = matches[1]
+			index, _ = strconv.Atoi(matches[2])
+			count, _ = strconv.Atoi(matches[3])
+		}
+
+		f, ok := fieldsByName[name]
+		if !ok || f.repeated != (count > 0) {
+			return nil, fmt.Errorf("unknown field %q of %s", name, r.typ.Elem())
+		}
+
+		// fields must appear in order, and only once unless repeated
+		if f.index < lastIndex || (f.index == lastIndex && !f.repeated) {
+			return nil, fmt.Errorf("unexpected field %q of %s", name, r.typ.Elem())
+		}
+
+		if f.index != lastIndex {
+			if err := checkComplete(); err != nil {
+				return nil, err
+			}
+			lastIndex, lastCount = f.index, count
+		}
+
+		v, err := f.renderer.Parse(ctx, fieldScreens)
+		if err != nil {
+			return nil, err
+		}
+
+		fv := rv.Elem().Field(f.index)
+		if !f.repeated {
+			fv.Set(reflect.ValueOf(v))
+			continue
+		}
+
+		if count != lastCount || index != fv.Len()+1 {
+			return nil, fmt.Errorf("unexpected element %d/%d of repeated field %q of %s", index, count, name, r.typ.Elem())
+		}
+
+		fv.Set(reflect.Append(fv, reflect.ValueOf(v)))
+	}
+
+	if err := checkComplete(); err != nil {
+		return nil, err
+	}
+
+	return rv.Interface(), nil
+}
+
+// structRenderer renders nested messages by the name of their type, followed
+// by their fields rendered by their message renderer.
+type structRenderer struct {
+	t   *Textual
+	typ reflect.Type
+}
+
+func (r structRenderer) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	ptr := reflect.New(r.typ)
+	ptr.Elem().Set(reflect.ValueOf(v))
+
+	body, err := r.t.messageRenderer(ptr.Type()).Format(ctx, ptr.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	return nest(messageName(ptr.Type()), body), nil
+}
+
+func (r structRenderer) Parse(ctx context.Context, screens []Screen) (interface{}, error) {
+	ptrType := reflect.PtrTo(r.typ)
+	if len(screens) == 0 || screens[0].Text != messageName(ptrType) {
+		return nil, fmt.Errorf("expected %s", messageName(ptrType))
+	}
+
+	v, err := r.t.messageRenderer(ptrType).Parse(ctx, unnest(screens))
+	if err != nil {
+		return nil, err
+	}
+
+	return reflect.ValueOf(v).Elem().Interface(), nil
+}
+
+// pointerRenderer renders pointers like the values they point to.
+type pointerRenderer struct {
+	typ  reflect.Type
+	elem ValueRenderer
+}
+
+func (r pointerRenderer) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return nil, fmt.Errorf("nil %s", r.typ)
+	}
+
+	return r.elem.Format(ctx, rv.Elem().Interface())
+}
+
+func (r pointerRenderer) Parse(ctx context.Context, screens []Screen) (interface{}, error) {
+	v, err := r.elem.Parse(ctx, screens)
+	if err != nil {
+		return nil, err
+	}
+
+	ptr := reflect.New(r.typ.Elem())
+	ptr.Elem().Set(reflect.ValueOf(v))
+	return ptr.Interface(), nil
+}
+
+// anyRenderer renders the messages packed in an Any by their type URL,
+// followed by their fields rendered by their message renderer.
+type anyRenderer struct {
+	t *Textual
+}
+
+func (r anyRenderer) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	any := v.(*codectypes.Any)
+	if any == nil {
+		return nil, fmt.Errorf("nil Any")
+	}
+
+	typ, err := resolveTypeURL(any.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(any.Value, msg); err != nil {
+		return nil, err
+	}
+
+	body, err := r.t.messageRenderer(typ).Format(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return nest(any.TypeUrl, body), nil
+}
+
+func (r anyRenderer) Parse(ctx context.Context, screens []Screen) (interface{}, error) {
+	if len(screens) == 0 {
+		return nil, fmt.Errorf("expected a type URL")
+	}
+
+	typ, err := resolveTypeURL(screens[0].Text)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := r.t.messageRenderer(typ).Parse(ctx, unnest(screens))
+	if err != nil {
+		return nil, err
+	}
+
+	return codectypes.NewAnyWithValue(msg.(proto.Message))
+}
+
+// resolveTypeURL returns the pointer type of the message with the given type
+// URL.
+func resolveTypeURL(typeURL string) (reflect.Type, error) {
+	if !strings.HasPrefix(typeURL, "/") {
+		return nil, fmt.Errorf("invalid type URL %q", typeURL)
+	}
+
+	typ := proto.MessageType(typeURL[1:])
+	if typ == nil {
+		return nil, fmt.Errorf("unknown type URL %q", typeURL)
+	}
+
+	return typ, nil
+}
+
+// messageName returns the protobuf name of the message of the given pointer
+// type, or its Go name if it is not a registered protobuf message.
+func messageName(typ reflect.Type) string {
+	if msg, ok := reflect.Zero(typ).Interface().(proto.Message); ok {
+		if name := proto.MessageName(msg); name != "" {
+			return name
+		}
+	}
+
+	return typ.Elem().Name()
+}
+
+// enumValueMap returns the values of the protobuf enum with the given name.
+func enumValueMap(name string) map[string]int32 {
+	return proto.EnumValueMap(name)
+}
+
+// parseFieldTags returns the display name of a struct field, whether it is an
+// expert field, and the name of its protobuf enum type if any. The name is
+// read from the textual tag of the field, e.g. `textual:"gas_limit,expert"`,
+// or else from its protobuf tag. It returns an empty name for fields which
+// aren't rendered.
+func parseFieldTags(sf reflect.StructField) (name string, expert bool, enum string) {
+	if tag, ok := sf.Tag.Lookup("textual"); ok {
+		parts := strings.Split(tag, ",")
+		name = parts[0]
+		for _, opt := range parts[1:] {
+			if opt == "expert" {
+				expert = true
+			}
+		}
+	} else if tag, ok := sf.Tag.Lookup("protobuf"); ok {
+		for _, opt := range strings.Split(tag, ",") {
+			switch {
+			case strings.HasPrefix(opt, "name="):
+				name = strings.TrimPrefix(opt, "name=")
+			case strings.HasPrefix(opt, "enum="):
+				enum = strings.TrimPrefix(opt, "enum=")
+			}
+		}
+	}
+
+	if name == "" {
+		return "", false, ""
+	}
+
+	// from_address is displayed as "From address"
+	name = strings.ReplaceAll(name, "_", " ")
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:], expert, enum
+}
+
+// isDefault reports whether v holds the default value of its type.
+func isDefault(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	default:
+		return v.IsZero()
+	}
+}
+
+// label prefixes the headline of a value with the given label, e.g. the name
+// of the field holding it.
+func label(name string, screens []Screen, expert bool) ([]Screen, error) {
+	if len(screens) == 0 {
+		return nil, fmt.Errorf("no screens for %s", name)
+	}
+
+	labelled := make([]Screen, len(screens))
+	copy(labelled, screens)
+
+	labelled[0].Text = name + ": " + labelled[0].Text
+	if expert {
+		for i := range labelled {
+			labelled[i].Expert = true
+		}
+	}
+
+	return labelled, nil
+}
+
+// nest returns a headline followed by the given screens, indented by one
+// level.
+func nest(headline string, screens []Screen) []Screen {
+	nested := make([]Screen, 0, len(screens)+1)
+	nested = append(nested, Screen{Text: headline})
+	for _, s := range screens {
+		s.Indent++
+		nested = append(nested, s)
+	}
+
+	return nested
+}
+
+// unnest is the inverse of nest, and drops the headline.
+func unnest(screens []Screen) []Screen {
+	unnested := make([]Screen, 0, len(screens)-1)
+	for _, s := range screens[1:] {
+		s.Indent--
+		unnested = append(unnested, s)
+	}
+
+	return unnested
+}
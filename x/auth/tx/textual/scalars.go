@@ -0,0 +1,339 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// headline returns the text of the single screen of a scalar value.
+func headline(screens []Screen) (string, error) {
+	if len(screens) != 1 {
+		return "", fmt.Errorf("expected 1 screen, got %d", len(screens))
+	}
+
+	return screens[0].Text, nil
+}
+
+// stringRenderer renders strings as they are.
+type stringRenderer struct {
+	typ reflect.Type
+}
+
+func (r stringRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	return []Screen{{Text: reflect.ValueOf(v).String()}}, nil
+}
+
+func (r stringRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	return reflect.ValueOf(text).Convert(r.typ).Interface(), nil
+}
+
+// boolRenderer renders booleans as True or False.
+type boolRenderer struct{}
+
+func (r boolRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	if v.(bool) {
+		return []Screen{{Text: "True"}}, nil
+	}
+
+	return []Screen{{Text: "False"}}, nil
+}
+
+func (r boolRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	switch text {
+	case "True":
+		return true, nil
+	case "False":
+		return false, nil
+	default:
+		return nil, fmt.Errorf("invalid boolean %q", text)
+	}
+}
+
+// intRenderer renders the native integer types, with thousands separated by
+// an apostrophe.
+type intRenderer struct {
+	typ reflect.Type
+}
+
+func (r intRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	rv := reflect.ValueOf(v)
+
+	var s string
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s = strconv.FormatUint(rv.Uint(), 10)
+	default:
+		s = strconv.FormatInt(rv.Int(), 10)
+	}
+
+	return []Screen{{Text: formatInteger(s)}}, nil
+}
+
+func (r intRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := parseInteger(text)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.New(r.typ).Elem()
+	switch r.typ.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(s, 10, r.typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		rv.SetUint(i)
+
+	default:
+		i, err := strconv.ParseInt(s, 10, r.typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		rv.SetInt(i)
+	}
+
+	return rv.Interface(), nil
+}
+
+// sdkIntRenderer renders sdk.Int values like the native integer types.
+type sdkIntRenderer struct{}
+
+func (r sdkIntRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	i := v.(sdk.Int)
+	if i.IsNil() {
+		return nil, fmt.Errorf("nil integer")
+	}
+
+	return []Screen{{Text: formatInteger(i.String())}}, nil
+}
+
+func (r sdkIntRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := parseInteger(text)
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := sdk.NewIntFromString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", text)
+	}
+
+	return i, nil
+}
+
+// decRenderer renders sdk.Dec values without trailing zeros, with the
+// thousands of their integer part separated by an apostrophe.
+type decRenderer struct{}
+
+func (r decRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	d := v.(sdk.Dec)
+	if d.IsNil() {
+		return nil, fmt.Errorf("nil decimal")
+	}
+
+	return []Screen{{Text: formatDecimal(d)}}, nil
+}
+
+func (r decRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDecimal(text)
+}
+
+// bytesRenderer renders byte slices in upper case hexadecimal.
+type bytesRenderer struct {
+	typ reflect.Type
+}
+
+func (r bytesRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	return []Screen{{Text: strings.ToUpper(hex.EncodeToString(reflect.ValueOf(v).Bytes()))}}, nil
+}
+
+func (r bytesRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	if text != strings.ToUpper(text) {
+		return nil, fmt.Errorf("expected upper case hexadecimal, got %q", text)
+	}
+
+	bz, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return reflect.ValueOf(bz).Convert(r.typ).Interface(), nil
+}
+
+// addressRenderer renders account, validator and consensus addresses in their
+// bech32 form.
+type addressRenderer struct {
+	typ reflect.Type
+}
+
+func (r addressRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	return []Screen{{Text: v.(fmt.Stringer).String()}}, nil
+}
+
+func (r addressRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.typ {
+	case valAddressType:
+		return sdk.ValAddressFromBech32(text)
+	case consAddressType:
+		return sdk.ConsAddressFromBech32(text)
+	default:
+		return sdk.AccAddressFromBech32(text)
+	}
+}
+
+// timeRenderer renders timestamps in UTC, in the RFC 3339 format.
+type timeRenderer struct{}
+
+func (r timeRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	return []Screen{{Text: v.(time.Time).UTC().Format(time.RFC3339Nano)}}, nil
+}
+
+func (r timeRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.Parse(time.RFC3339Nano, text)
+}
+
+// durationRenderer renders durations in the format of time.Duration.String.
+type durationRenderer struct{}
+
+func (r durationRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	return []Screen{{Text: v.(time.Duration).String()}}, nil
+}
+
+func (r durationRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.ParseDuration(text)
+}
+
+// enumRenderer renders protobuf enums by the name of their value.
+type enumRenderer struct {
+	typ  reflect.Type
+	name string
+}
+
+func (r enumRenderer) Format(_ context.Context, v interface{}) ([]Screen, error) {
+	return []Screen{{Text: v.(fmt.Stringer).String()}}, nil
+}
+
+func (r enumRenderer) Parse(_ context.Context, screens []Screen) (interface{}, error) {
+	text, err := headline(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := enumValueMap(r.name)[text]
+	if !ok {
+		return nil, fmt.Errorf("invalid %s value %q", r.name, text)
+	}
+
+	return reflect.ValueOf(value).Convert(r.typ).Interface(), nil
+}
+
+// formatInteger separates the thousands of the given decimal integer with an
+// apostrophe.
+func formatInteger(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	var b strings.Builder
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte('\'')
+		}
+		b.WriteRune(c)
+	}
+
+	return sign + b.String()
+}
+
+// parseInteger is the inverse of formatInteger.
+func parseInteger(text string) (string, error) {
+	s := strings.ReplaceAll(text, "'", "")
+	if formatInteger(s) != text {
+		return "", fmt.Errorf("invalid integer %q", text)
+	}
+
+	return s, nil
+}
+
+// formatDecimal formats d without trailing zeros in its fractional part, and
+// with the thousands of its integer part separated by an apostrophe.
+func formatDecimal(d sdk.Dec) string {
+	s := d.String()
+	parts := strings.SplitN(s, ".", 2)
+
+	s = formatInteger(parts[0])
+	if len(parts) == 2 {
+		if fraction := strings.TrimRight(parts[1], "0"); fraction != "" {
+			s += "." + fraction
+		}
+	}
+
+	return s
+}
+
+// parseDecimal is the inverse of formatDecimal.
+func parseDecimal(text string) (sdk.Dec, error) {
+	d, err := sdk.NewDecFromStr(strings.ReplaceAll(text, "'", ""))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if formatDecimal(d) != text {
+		return sdk.Dec{}, fmt.Errorf("invalid decimal %q", text)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,163 @@
+/*
+Package textual implements the rendering of transactions used by
+SIGN_MODE_TEXTUAL.
+
+A transaction is rendered into a deterministic sequence of screens, each holding
+a short line of human-readable text meant to be displayed on a hardware wallet.
+Every value of the transaction is rendered by a ValueRenderer chosen from its
+type: coins are shown in their display denomination using the x/bank denom
+metadata, addresses in bech32 form, and messages field by field, unless a
+custom renderer is defined for their type with Textual.DefineMessageRenderer.
+
+Every ValueRenderer can also parse the screens it produces back into the
+original value, so that the rendering of a transaction can be checked to be
+unambiguous.
+*/
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Screen is a single line of the textual representation of a value.
+type Screen struct {
+	// Text is the content of the screen.
+	Text string
+
+	// Indent is the indentation level of the screen, used to display nested
+	// values.
+	Indent int
+
+	// Expert indicates that the screen is only displayed to users who opt in
+	// for all the details of their transactions.
+	Expert bool
+}
+
+// ValueRenderer renders values of a given type into screens, and parses them
+// back. The first screen returned by Format is the headline of the value, at
+// indentation 0, and any following screen must have an indentation of at
+// least 1.
+type ValueRenderer interface {
+	// Format renders the value into screens.
+	Format(ctx context.Context, v interface{}) ([]Screen, error)
+
+	// Parse is the inverse of Format.
+	Parse(ctx context.Context, screens []Screen) (interface{}, error)
+}
+
+// CoinMetadataQueryFn returns the bank metadata of the given denom, or nil if
+// the denom has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// Textual holds the value renderers used to render transactions.
+type Textual struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	messages            map[string]ValueRenderer
+}
+
+// NewTextual returns a new Textual which uses the given function to look up
+// the metadata of coin denoms.
+func NewTextual(coinMetadataQuerier CoinMetadataQueryFn) *Textual {
+	if coinMetadataQuerier == nil {
+		panic("coinMetadataQuerier cannot be nil")
+	}
+
+	return &Textual{
+		coinMetadataQuerier: coinMetadataQuerier,
+		messages:            make(map[string]ValueRenderer),
+	}
+}
+
+// DefineMessageRenderer sets the renderer used for the fields of the message
+// with the given type URL, in place of the default renderer which renders each
+// field on its own screen. The renderer's Format function receives a pointer
+// to the message, and its Parse function must return one. The screens it
+// returns are displayed below the message type.
+func (t *Textual) DefineMessageRenderer(typeURL string, vr ValueRenderer) {
+	t.messages[typeURL] = vr
+}
+
+var (
+	anyType         = reflect.TypeOf((*codectypes.Any)(nil))
+	accAddressType  = reflect.TypeOf(sdk.AccAddress{})
+	valAddressType  = reflect.TypeOf(sdk.ValAddress{})
+	consAddressType = reflect.TypeOf(sdk.ConsAddress{})
+	coinType        = reflect.TypeOf(sdk.Coin{})
+	coinsType       = reflect.TypeOf(sdk.Coins{})
+	intType         = reflect.TypeOf(sdk.Int{})
+	decType         = reflect.TypeOf(sdk.Dec{})
+	timeType        = reflect.TypeOf(time.Time{})
+	durationType    = reflect.TypeOf(time.Duration(0))
+)
+
+// GetValueRenderer returns the ValueRenderer for values of the given type.
+func (t *Textual) GetValueRenderer(typ reflect.Type) (ValueRenderer, error) {
+	switch typ {
+	case anyType:
+		return anyRenderer{t}, nil
+	case accAddressType, valAddressType, consAddressType:
+		return addressRenderer{typ}, nil
+	case coinType:
+		return coinRenderer{t}, nil
+	case coinsType:
+		return coinsRenderer{t}, nil
+	case intType:
+		return sdkIntRenderer{}, nil
+	case decType:
+		return decRenderer{}, nil
+	case timeType:
+		return timeRenderer{}, nil
+	case durationType:
+		return durationRenderer{}, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return stringRenderer{typ}, nil
+
+	case reflect.Bool:
+		return boolRenderer{}, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return intRenderer{typ}, nil
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return bytesRenderer{typ}, nil
+		}
+
+	case reflect.Ptr:
+		elem, err := t.GetValueRenderer(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return pointerRenderer{typ, elem}, nil
+
+	case reflect.Struct:
+		return structRenderer{t, typ}, nil
+	}
+
+	return nil, fmt.Errorf("no value renderer for type %s", typ)
+}
+
+// messageRenderer returns the renderer of the fields of messages of the given
+// pointer type.
+func (t *Textual) messageRenderer(typ reflect.Type) ValueRenderer {
+	if msg, ok := reflect.Zero(typ).Interface().(proto.Message); ok {
+		if vr, found := t.messages["/"+proto.MessageName(msg)]; found {
+			return vr
+		}
+	}
+
+	return fieldsRenderer{t, typ}
+}
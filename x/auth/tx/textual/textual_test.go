@@ -0,0 +1,352 @@
+package textual_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	addr1    = sdk.AccAddress("addr1_______________")
+	addr2    = sdk.AccAddress("addr2_______________")
+	valAddr1 = sdk.ValAddress("val1________________")
+
+	atomMetadata = banktypes.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+)
+
+// coinMetadataQuerier resolves the metadata of the uatom base denom, and of
+// its atom display denom.
+func coinMetadataQuerier(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom == atomMetadata.Base || denom == atomMetadata.Display {
+		return &atomMetadata, nil
+	}
+
+	return nil, nil
+}
+
+func TestValueRenderers(t *testing.T) {
+	txt := textual.NewTextual(coinMetadataQuerier)
+	timestamp := time.Date(2020, 12, 1, 10, 30, 5, 120000000, time.UTC)
+
+	testCases := []struct {
+		name  string
+		value interface{}
+		text  string
+	}{
+		{"string", "hello world", "hello world"},
+		{"true", true, "True"},
+		{"false", false, "False"},
+		{"uint64", uint64(1234567), "1'234'567"},
+		{"small uint64", uint64(123), "123"},
+		{"int64", int64(-1234), "-1'234"},
+		{"int32", int32(1000), "1'000"},
+		{"sdk.Int", sdk.NewInt(1000000), "1'000'000"},
+		{"negative sdk.Int", sdk.NewInt(-100000), "-100'000"},
+		{"sdk.Dec", sdk.MustNewDecFromStr("1234.5"), "1'234.5"},
+		{"integral sdk.Dec", sdk.NewDec(12), "12"},
+		{"small sdk.Dec", sdk.MustNewDecFromStr("0.000001"), "0.000001"},
+		{"bytes", []byte{0x01, 0xab, 0xff}, "01ABFF"},
+		{"account address", addr1, addr1.String()},
+		{"validator address", valAddr1, valAddr1.String()},
+		{"time", timestamp, "2020-12-01T10:30:05.12Z"},
+		{"duration", 90 * time.Minute, "1h30m0s"},
+		{"coin with metadata", sdk.NewInt64Coin("uatom", 1500000), "1.5 atom"},
+		{"small coin with metadata", sdk.NewInt64Coin("uatom", 1), "0.000001 atom"},
+		{"coin without metadata", sdk.NewInt64Coin("stake", 10000), "10'000 stake"},
+		{"coins", sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 2000000)), "10 stake, 2 atom"},
+		{"no coins", sdk.Coins{}, "zero"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			vr, err := txt.GetValueRenderer(reflect.TypeOf(tc.value))
+			require.NoError(t, err)
+
+			screens, err := vr.Format(ctx, tc.value)
+			require.NoError(t, err)
+			require.Equal(t, []textual.Screen{{Text: tc.text}}, screens)
+
+			parsed, err := vr.Parse(ctx, screens)
+			require.NoError(t, err)
+			require.Equal(t, tc.value, parsed)
+		})
+	}
+}
+
+func TestParseInvalidValues(t *testing.T) {
+	txt := textual.NewTextual(coinMetadataQuerier)
+
+	testCases := []struct {
+		name  string
+		value interface{}
+		text  string
+	}{
+		{"misplaced separator", uint64(0), "12'34"},
+		{"missing separator", uint64(0), "1234"},
+		{"overflow", uint32(0), "5'000'000'000"},
+		{"trailing zeros", sdk.Dec{}, "1.50"},
+		{"lower case bytes", []byte{}, "01abff"},
+		{"invalid address", sdk.AccAddress{}, "cosmos1invalid"},
+		{"invalid boolean", false, "true"},
+		{"base denom with metadata", sdk.Coin{}, "1'500'000 uatom"},
+		{"fractional base amount", sdk.Coin{}, "0.0000001 atom"},
+		{"fractional amount without metadata", sdk.Coin{}, "1.5 stake"},
+		{"coins without space", sdk.Coins{}, "10stake"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			vr, err := txt.GetValueRenderer(reflect.TypeOf(tc.value))
+			require.NoError(t, err)
+
+			_, err = vr.Parse(context.Background(), []textual.Screen{{Text: tc.text}})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMessageRenderers(t *testing.T) {
+	txt := textual.NewTextual(coinMetadataQuerier)
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		screens []textual.Screen
+	}{
+		{
+			"MsgSend",
+			banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2500000))),
+			[]textual.Screen{
+				{Text: "/cosmos.bank.v1beta1.MsgSend"},
+				{Text: "From address: " + addr1.String(), Indent: 1},
+				{Text: "To address: " + addr2.String(), Indent: 1},
+				{Text: "Amount: 2.5 atom", Indent: 1},
+			},
+		},
+		{
+			"MsgDelegate",
+			stakingtypes.NewMsgDelegate(addr1, valAddr1, sdk.NewInt64Coin("stake", 1000)),
+			[]textual.Screen{
+				{Text: "/cosmos.staking.v1beta1.MsgDelegate"},
+				{Text: "Delegator address: " + addr1.String(), Indent: 1},
+				{Text: "Validator address: " + valAddr1.String(), Indent: 1},
+				{Text: "Amount: 1'000 stake", Indent: 1},
+			},
+		},
+		{
+			"MsgVote",
+			govtypes.NewMsgVote(addr1, 12, govtypes.OptionNoWithVeto),
+			[]textual.Screen{
+				{Text: "/cosmos.gov.v1beta1.MsgVote"},
+				{Text: "Proposal id: 12", Indent: 1},
+				{Text: "Voter: " + addr1.String(), Indent: 1},
+				{Text: "Option: VOTE_OPTION_NO_WITH_VETO", Indent: 1},
+			},
+		},
+		{
+			"MsgMultiSend",
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))},
+				[]banktypes.Output{
+					banktypes.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))),
+					banktypes.NewOutput(addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 15))),
+				},
+			),
+			[]textual.Screen{
+				{Text: "/cosmos.bank.v1beta1.MsgMultiSend"},
+				{Text: "Inputs (1/1): cosmos.bank.v1beta1.Input", Indent: 1},
+				{Text: "Address: " + addr1.String(), Indent: 2},
+				{Text: "Coins: 20 stake", Indent: 2},
+				{Text: "Outputs (1/2): cosmos.bank.v1beta1.Output", Indent: 1},
+				{Text: "Address: " + addr2.String(), Indent: 2},
+				{Text: "Coins: 5 stake", Indent: 2},
+				{Text: "Outputs (2/2): cosmos.bank.v1beta1.Output", Indent: 1},
+				{Text: "Address: " + addr1.String(), Indent: 2},
+				{Text: "Coins: 15 stake", Indent: 2},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			any, err := codectypes.NewAnyWithValue(tc.msg)
+			require.NoError(t, err)
+
+			vr, err := txt.GetValueRenderer(reflect.TypeOf(any))
+			require.NoError(t, err)
+
+			screens, err := vr.Format(ctx, any)
+			require.NoError(t, err)
+			require.Equal(t, tc.screens, screens)
+
+			parsed, err := vr.Parse(ctx, screens)
+			require.NoError(t, err)
+			require.Equal(t, any.TypeUrl, parsed.(*codectypes.Any).TypeUrl)
+			require.Equal(t, any.Value, parsed.(*codectypes.Any).Value)
+			require.Equal(t, tc.msg, parsed.(*codectypes.Any).GetCachedValue())
+		})
+	}
+}
+
+// sendRenderer renders a MsgSend on a single screen.
+type sendRenderer struct {
+	coins textual.ValueRenderer
+}
+
+func (r sendRenderer) Format(ctx context.Context, v interface{}) ([]textual.Screen, error) {
+	msg := v.(*banktypes.MsgSend)
+	screens, err := r.coins.Format(ctx, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return []textual.Screen{{Text: "Send " + screens[0].Text + " from " + msg.FromAddress.String() + " to " + msg.ToAddress.String()}}, nil
+}
+
+func (r sendRenderer) Parse(ctx context.Context, screens []textual.Screen) (interface{}, error) {
+	text := strings.TrimPrefix(screens[0].Text, "Send ")
+	i, j := strings.LastIndex(text, " from "), strings.LastIndex(text, " to ")
+	if i < 0 || j < i {
+		return nil, fmt.Errorf("invalid send screen %q", screens[0].Text)
+	}
+	amount, from, to := text[:i], text[i+len(" from "):j], text[j+len(" to "):]
+
+	coins, err := r.coins.Parse(ctx, []textual.Screen{{Text: amount}})
+	if err != nil {
+		return nil, err
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return banktypes.NewMsgSend(fromAddr, toAddr, coins.(sdk.Coins)), nil
+}
+
+func TestDefineMessageRenderer(t *testing.T) {
+	ctx := context.Background()
+	txt := textual.NewTextual(coinMetadataQuerier)
+
+	coinsRenderer, err := txt.GetValueRenderer(reflect.TypeOf(sdk.Coins{}))
+	require.NoError(t, err)
+	txt.DefineMessageRenderer("/cosmos.bank.v1beta1.MsgSend", sendRenderer{coinsRenderer})
+
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 3000000)))
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+
+	envelope := &textual.Envelope{ChainID: "test-chain", Messages: []*codectypes.Any{any}}
+	screens, err := txt.FormatEnvelope(ctx, envelope)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Message (1/1): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: "Send 3 atom from " + addr1.String() + " to " + addr2.String(), Indent: 1},
+	}, screens)
+
+	parsed, err := txt.ParseEnvelope(ctx, screens)
+	require.NoError(t, err)
+	require.Equal(t, msg, parsed.Messages[0].GetCachedValue())
+}
+
+func TestEnvelope(t *testing.T) {
+	ctx := context.Background()
+	txt := textual.NewTextual(coinMetadataQuerier)
+
+	msg1, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))))
+	require.NoError(t, err)
+	msg2, err := codectypes.NewAnyWithValue(govtypes.NewMsgVote(addr1, 1, govtypes.OptionYes))
+	require.NoError(t, err)
+
+	envelope := &textual.Envelope{
+		ChainID:        "test-chain",
+		AccountNumber:  12,
+		Sequence:       3,
+		Messages:       []*codectypes.Any{msg1, msg2},
+		Memo:           "> a memo\nwith two lines",
+		Fees:           sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)),
+		FeeGranter:     addr2,
+		GasLimit:       200000,
+		TimeoutHeight:  1000,
+		HashOfRawBytes: []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	screens, err := txt.FormatEnvelope(ctx, envelope)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Account number: 12"},
+		{Text: "Sequence: 3"},
+		{Text: "Message (1/2): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: "From address: " + addr1.String(), Indent: 1},
+		{Text: "To address: " + addr2.String(), Indent: 1},
+		{Text: "Amount: 0.000001 atom", Indent: 1},
+		{Text: "Message (2/2): /cosmos.gov.v1beta1.MsgVote"},
+		{Text: "Proposal id: 1", Indent: 1},
+		{Text: "Voter: " + addr1.String(), Indent: 1},
+		{Text: "Option: VOTE_OPTION_YES", Indent: 1},
+		{Text: "Memo: > a memo\nwith two lines"},
+		{Text: "Fees: 0.002 atom"},
+		{Text: "Fee granter: " + addr2.String()},
+		{Text: "Gas limit: 200'000", Expert: true},
+		{Text: "Timeout height: 1'000"},
+		{Text: "Hash of raw bytes: DEADBEEF", Expert: true},
+	}, screens)
+
+	// the encoded screens decode to the same screens
+	decoded, err := textual.DecodeScreens(textual.EncodeScreens(screens))
+	require.NoError(t, err)
+	require.Equal(t, screens, decoded)
+
+	parsed, err := txt.ParseEnvelope(ctx, decoded)
+	require.NoError(t, err)
+	require.Equal(t, envelope.ChainID, parsed.ChainID)
+	require.Equal(t, envelope.Memo, parsed.Memo)
+	require.Equal(t, envelope.Fees, parsed.Fees)
+	require.Equal(t, envelope.HashOfRawBytes, parsed.HashOfRawBytes)
+	require.Len(t, parsed.Messages, 2)
+	for i, msg := range envelope.Messages {
+		require.Equal(t, msg.TypeUrl, parsed.Messages[i].TypeUrl)
+		require.Equal(t, msg.Value, parsed.Messages[i].Value)
+	}
+
+	// fields must be in order, and repeated fields complete
+	_, err = txt.ParseEnvelope(ctx, []textual.Screen{{Text: "Sequence: 3"}, {Text: "Chain id: test-chain"}})
+	require.Error(t, err)
+	_, err = txt.ParseEnvelope(ctx, screens[:7])
+	require.Error(t, err)
+	_, err = txt.ParseEnvelope(ctx, []textual.Screen{{Text: "Unknown: 3"}})
+	require.Error(t, err)
+}
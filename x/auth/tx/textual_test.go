@@ -0,0 +1,123 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	coinMetadataQuerier := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if denom != "uatom" && denom != "atom" {
+			return nil, nil
+		}
+
+		return &banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+			Base:    "uatom",
+			Display: "atom",
+		}, nil
+	}
+
+	txConfig := NewTxConfigWithTextual(marshaler, std.DefaultPublicKeyCodec{}, []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+	}, coinMetadataQuerier)
+	txBuilder := txConfig.NewTxBuilder()
+
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)))
+	txBuilder.SetGasLimit(20000)
+	txBuilder.SetTimeoutHeight(10)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{PubKey: pubkey, Data: sigData, Sequence: 2}))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Len(t, modeHandler.Modes(), 2)
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	directBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	hash := sha256.Sum256(directBytes)
+
+	screens, err := textual.DecodeScreens(signBytes)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Account number: 1"},
+		{Text: "Sequence: 2"},
+		{Text: "Message (1/1): /testdata.TestMsg"},
+		{Text: "Signers (1/1): " + addr.String(), Indent: 1},
+		{Text: "Memo: sometestmemo"},
+		{Text: "Fees: 0.0015 atom"},
+		{Text: "Gas limit: 20'000", Expert: true},
+		{Text: "Timeout height: 10"},
+		{Text: "Hash of raw bytes: " + textualHex(hash[:]), Expert: true},
+	}, screens)
+
+	t.Log("verify the sign bytes parse back into the tx")
+	envelope, err := textual.NewTextual(coinMetadataQuerier).ParseEnvelope(context.Background(), screens)
+	require.NoError(t, err)
+	require.Equal(t, signingData.ChainID, envelope.ChainID)
+	require.Equal(t, signingData.AccountNumber, envelope.AccountNumber)
+	require.Equal(t, signingData.Sequence, envelope.Sequence)
+	require.Equal(t, "sometestmemo", envelope.Memo)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)), envelope.Fees)
+	require.Equal(t, uint64(20000), envelope.GasLimit)
+	require.Equal(t, uint64(10), envelope.TimeoutHeight)
+	require.Equal(t, hash[:], envelope.HashOfRawBytes)
+	require.Len(t, envelope.Messages, 1)
+	require.Equal(t, msgs[0], envelope.Messages[0].GetCachedValue())
+
+	t.Log("verify that a change of memo changes the sign bytes")
+	txBuilder.SetMemo("othermemo")
+	newSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, newSignBytes)
+
+	t.Log("verify GetSignBytes with a wrong sign mode")
+	_, err = signModeTextualHandler{textual.NewTextual(coinMetadataQuerier)}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify that SIGN_MODE_TEXTUAL requires a coin metadata querier")
+	require.Panics(t, func() {
+		NewTxConfig(marshaler, std.DefaultPublicKeyCodec{}, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	})
+}
+
+func textualHex(bz []byte) string {
+	return strings.ToUpper(hex.EncodeToString(bz))
+}
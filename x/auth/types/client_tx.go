@@ -63,6 +63,10 @@ func (s *StdTxBuilder) SetGasLimit(limit uint64) {
 	s.StdTx.Fee.Gas = limit
 }
 
+// SetFeeGranter implements TxBuilder.SetFeeGranter. It is a no-op, as fee
+// allowances are not supported by StdTx.
+func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
+
 // SetMemo implements TxBuilder.SetMemo
 func (s *StdTxBuilder) SetMemo(memo string) {
 	s.Memo = memo
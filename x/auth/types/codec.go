@@ -27,6 +27,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ModuleAccount{},
 	)
 
+	registry.RegisterInterface(
+		"cosmos.auth.v1beta1.ModuleAccountI",
+		(*ModuleAccountI)(nil),
+		&ModuleAccount{},
+	)
+
 	registry.RegisterInterface(
 		"cosmos.auth.GenesisAccount",
 		(*GenesisAccount)(nil),
@@ -70,6 +70,13 @@ func DefaultParams() Params {
 	}
 }
 
+// SigVerifyCostSecp256r1 returns the cost of verifying a secp256r1 signature,
+// which is derived from the secp256k1 one as it isn't a parameter of its own.
+func (p Params) SigVerifyCostSecp256r1() uint64 {
+	// verification on the NIST P-256 curve is about twice as slow
+	return p.SigVerifyCostSecp256k1 * 2
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
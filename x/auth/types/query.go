@@ -8,3 +8,36 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryAccountsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Accounts {
+		var account AccountI
+		if err := unpacker.UnpackAny(any, &account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryAccountsResponse{}
+
+func (m *QueryModuleAccountsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Accounts {
+		var account ModuleAccountI
+		if err := unpacker.UnpackAny(any, &account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryModuleAccountsResponse{}
+
+func (m *QueryModuleAccountByNameResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var account ModuleAccountI
+	return unpacker.UnpackAny(m.Account, &account)
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryModuleAccountByNameResponse{}
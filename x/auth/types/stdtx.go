@@ -276,6 +276,12 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter always returns nil for StdTx, as fee allowances are not supported
+// by the legacy amino transaction format.
+func (tx StdTx) FeeGranter() sdk.AccAddress {
+	return nil
+}
+
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines the vesting schedule read from a periods JSON file.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a single vesting period as read from a periods JSON file.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// ParseVestingData reads and parses a VestingData from a file.
+func ParseVestingData(path string) (VestingData, error) {
+	vestingData := VestingData{}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return vestingData, err
+	}
+
+	if err = json.Unmarshal(contents, &vestingData); err != nil {
+		return vestingData, err
+	}
+
+	return vestingData, nil
+}
+
+// ReadVestingSchedule reads a VestingData from a file and returns its start
+// time and parsed vesting periods.
+func ReadVestingSchedule(path string) (int64, []types.Period, error) {
+	vestingData, err := ParseVestingData(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	periods := make([]types.Period, 0, len(vestingData.Periods))
+	for i, p := range vestingData.Periods {
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid coins in period %d: %w", i, err)
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return vestingData.StartTime, periods, nil
+}
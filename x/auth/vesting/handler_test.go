@@ -0,0 +1,417 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	handler sdk.Handler
+	app     *simapp.SimApp
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	suite.app = app
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create continuous vesting account",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: false,
+		},
+		{
+			name:      "create delayed vesting account",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, true),
+			expectErr: false,
+		},
+		{
+			name:      "create continuous vesting account for an existing account",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+		{
+			name:      "create vesting account with insufficient funds",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr4, sdk.NewCoins(sdk.NewInt64Coin("test", 10000)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+		{
+			name:      "create vesting account for a blocked address",
+			msg:       types.NewMsgCreateVestingAccount(addr1, suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr := tc.msg.ToAddress
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+
+				if tc.msg.Delayed {
+					acc, ok := accI.(*types.DelayedVestingAccount)
+					suite.Require().True(ok)
+					suite.Require().Equal(tc.msg.Amount, acc.GetVestingCoins(ctx.BlockTime()))
+				} else {
+					acc, ok := accI.(*types.ContinuousVestingAccount)
+					suite.Require().True(ok)
+					suite.Require().Equal(tc.msg.Amount, acc.GetVestingCoins(ctx.BlockTime()))
+				}
+
+				suite.Require().Equal(tc.msg.Amount, suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create periodic vesting account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+100, periods),
+			expectErr: false,
+		},
+		{
+			name:      "create periodic vesting account for an existing account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+100, periods),
+			expectErr: true,
+		},
+		{
+			name: "create periodic vesting account with insufficient funds",
+			msg: types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, ctx.BlockTime().Unix()+100, []types.Period{
+				{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 10000))},
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				accI := suite.app.AccountKeeper.GetAccount(ctx, tc.msg.ToAddress)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.PeriodicVestingAccount)
+				suite.Require().True(ok)
+
+				expected := sdk.NewCoins(sdk.NewInt64Coin("test", 300))
+				suite.Require().Equal(expected, acc.GetOriginalVesting())
+				suite.Require().Equal(tc.msg.StartTime+3000, acc.GetEndTime())
+				suite.Require().Equal(expected, suite.app.BankKeeper.GetAllBalances(ctx, tc.msg.ToAddress))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	res, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+100, periods))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	accI := suite.app.AccountKeeper.GetAccount(ctx, addr2)
+	suite.Require().NotNil(accI)
+
+	acc, ok := accI.(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+
+	expected := sdk.NewCoins(sdk.NewInt64Coin("test", 300))
+	suite.Require().Equal(addr1, acc.GetFunderAddress())
+	suite.Require().Equal(expected, acc.GetOriginalVesting())
+	suite.Require().Equal(ctx.BlockTime().Unix()+3100, acc.GetEndTime())
+	suite.Require().Equal(expected, suite.app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// an existing account cannot be turned into a clawback vesting account
+	_, err = suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+100, periods))
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Unix(1000, 0)})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	addr5 := sdk.AccAddress([]byte("addr5_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+	}
+
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods))
+	suite.Require().NoError(err)
+	_, err = suite.handler(ctx, types.NewMsgCreateVestingAccount(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+1000, false))
+	suite.Require().NoError(err)
+
+	// half of the schedule has vested
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1500 * time.Second))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgClawback
+		expectErr bool
+	}{
+		{
+			name:      "clawback from an unknown account",
+			msg:       types.NewMsgClawback(addr1, addr5, nil),
+			expectErr: true,
+		},
+		{
+			name:      "clawback from a non-clawback vesting account",
+			msg:       types.NewMsgClawback(addr1, addr3, nil),
+			expectErr: true,
+		},
+		{
+			name:      "clawback requested by another account than the funder",
+			msg:       types.NewMsgClawback(addr3, addr2, nil),
+			expectErr: true,
+		},
+		{
+			name:      "clawback to a blocked address",
+			msg:       types.NewMsgClawback(addr1, addr2, suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)),
+			expectErr: true,
+		},
+		{
+			name:      "clawback to a destination address",
+			msg:       types.NewMsgClawback(addr1, addr2, addr4),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				vested := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+				suite.Require().Equal(vested, suite.app.BankKeeper.GetAllBalances(ctx, addr2))
+				suite.Require().Equal(vested, suite.app.BankKeeper.GetAllBalances(ctx, addr4))
+
+				acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(vested, acc.GetOriginalVesting())
+				suite.Require().Len(acc.GetVestingPeriods(), 1)
+				suite.Require().True(acc.GetVestingCoins(ctx.BlockTime()).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackDelegated() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Unix(1000, 0)})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+	}
+
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods))
+	suite.Require().NoError(err)
+
+	// delegate most of the vesting coins
+	validator := stakingtypes.NewValidator(valAddr, simapp.CreateTestPubKeys(1)[0], stakingtypes.Description{})
+	suite.app.StakingKeeper.SetValidator(ctx, validator)
+	suite.app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+	_, err = suite.app.StakingKeeper.Delegate(ctx, addr2, sdk.NewInt(150), sdk.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	// half of the schedule has vested
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1500 * time.Second))
+
+	res, err := suite.handler(ctx, types.NewMsgClawback(addr1, addr2, nil))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// the free balance is sent to the funder right away
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 850)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+
+	// the remainder is unbonded to the funder
+	ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(ctx, addr1, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().Equal(sdk.NewInt(50), ubd.Entries[0].Balance)
+
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, addr2, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(100), delegation.Shares)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetOriginalVesting())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetDelegatedFree())
+	suite.Require().True(acc.GetDelegatedVesting().IsZero())
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackMaxEntries() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Unix(1000, 0)})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+	}
+
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods))
+	suite.Require().NoError(err)
+
+	params := suite.app.StakingKeeper.GetParams(ctx)
+	params.MaxEntries = 1
+	suite.app.StakingKeeper.SetParams(ctx, params)
+
+	validator := stakingtypes.NewValidator(valAddr, simapp.CreateTestPubKeys(1)[0], stakingtypes.Description{})
+	suite.app.StakingKeeper.SetValidator(ctx, validator)
+	suite.app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+
+	_, err = suite.app.StakingKeeper.Delegate(ctx, addr1, sdk.NewInt(10), sdk.Unbonded, validator, true)
+	suite.Require().NoError(err)
+	validator, _ = suite.app.StakingKeeper.GetValidator(ctx, valAddr)
+	_, err = suite.app.StakingKeeper.Delegate(ctx, addr2, sdk.NewInt(200), sdk.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	// the funder already has the maximum number of unbonding entries
+	_, err = suite.app.StakingKeeper.Undelegate(ctx, addr1, valAddr, sdk.NewDec(5))
+	suite.Require().NoError(err)
+
+	// all the vesting coins of the grantee are unbonding
+	_, err = suite.app.StakingKeeper.Undelegate(ctx, addr2, valAddr, sdk.NewDec(200))
+	suite.Require().NoError(err)
+
+	// half of the schedule has vested
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1500 * time.Second))
+
+	// the unbonding coins cannot be transferred to the funder, so the clawback
+	// fails and is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = suite.handler(cacheCtx, types.NewMsgClawback(addr1, addr2, nil))
+	suite.Require().Error(err)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200)), acc.GetOriginalVesting())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetVestingCoins(ctx.BlockTime()))
+
+	ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(ctx, addr2, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(200), ubd.Entries[0].Balance)
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
@@ -0,0 +1,324 @@
+package vesting
+
+import (
+	"context"
+	"math"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type msgServer struct {
+	keeper.AccountKeeper
+	types.BankKeeper
+	types.StakingKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateVestingAccount implements the Msg/CreateVestingAccount gRPC method
+func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.checkRecipient(ctx, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	baseAccount := s.AccountKeeper.NewAccountWithAddress(ctx, msg.ToAddress)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount.(*authtypes.BaseAccount), msg.Amount.Sort(), msg.EndTime)
+
+	var acc authtypes.AccountI
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
+	}
+
+	s.AccountKeeper.SetAccount(ctx, acc)
+
+	if err := s.fundAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+// CreatePeriodicVestingAccount implements the Msg/CreatePeriodicVestingAccount
+// gRPC method
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var totalCoins sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	if err := s.checkRecipient(ctx, msg.ToAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	baseAccount := s.AccountKeeper.NewAccountWithAddress(ctx, msg.ToAddress)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	acc := types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	s.AccountKeeper.SetAccount(ctx, acc)
+
+	if err := s.fundAccount(ctx, msg.FromAddress, msg.ToAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// CreateClawbackVestingAccount implements the Msg/CreateClawbackVestingAccount
+// gRPC method
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var totalCoins sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	if err := s.checkRecipient(ctx, msg.ToAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	baseAccount := s.AccountKeeper.NewAccountWithAddress(ctx, msg.ToAddress)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount.(*authtypes.BaseAccount), msg.FromAddress, msg.StartTime, msg.VestingPeriods)
+	s.AccountKeeper.SetAccount(ctx, acc)
+
+	if err := s.fundAccount(ctx, msg.FromAddress, msg.ToAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback implements the Msg/Clawback gRPC method
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acc := s.AccountKeeper.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if !va.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	dest := msg.DestAddress
+	if dest.Empty() {
+		dest = msg.FunderAddress
+	}
+
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	if err := s.clawback(ctx, va, dest); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback removes the unvested coins of the account from its vesting schedule
+// and transfers them to dest. The coins are taken from the account's balance
+// first. Any remainder of the bond denom is taken from its unbonding
+// delegations and then unbonded from its delegations, with dest receiving the
+// tokens once the unbonding completes. An error is returned if unvested coins
+// are left staked by the account, so that the message is reverted.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) error {
+	addr := va.GetAddress()
+
+	toClawBack := va.ComputeClawback(ctx.BlockTime())
+	s.AccountKeeper.SetAccount(ctx, va)
+
+	if toClawBack.IsZero() {
+		return nil
+	}
+
+	fromBalance := minCoins(toClawBack, s.BankKeeper.SpendableCoins(ctx, addr))
+	if !fromBalance.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+			return err
+		}
+	}
+
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+	want := toClawBack.Sub(fromBalance).AmountOf(bondDenom)
+	unbonded := sdk.ZeroInt()
+
+	for _, ubd := range s.StakingKeeper.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		amt := s.StakingKeeper.TransferUnbonding(ctx, addr, dest, ubd.ValidatorAddress, want)
+		want = want.Sub(amt)
+		unbonded = unbonded.Add(amt)
+	}
+
+	for _, delegation := range s.StakingKeeper.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		validator, found := s.StakingKeeper.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		shares := delegation.Shares
+		amt := validator.TokensFromShares(shares).TruncateInt()
+		if amt.GT(want) {
+			var err error
+			if shares, err = validator.SharesFromTokens(want); err != nil {
+				return err
+			}
+
+			if shares.GT(delegation.Shares) {
+				shares = delegation.Shares
+			}
+
+			amt = want
+		}
+
+		if !shares.IsPositive() {
+			continue
+		}
+
+		if _, err := s.StakingKeeper.UndelegateTo(ctx, addr, delegation.ValidatorAddress, dest, shares); err != nil {
+			return err
+		}
+
+		want = want.Sub(amt)
+		unbonded = unbonded.Add(amt)
+	}
+
+	// The unvested coins have been removed from the schedule, so the clawback
+	// fails if some of them are still staked but could not be recovered, e.g.
+	// when the unbonding delegations of dest reach the maximum number of
+	// entries. A shortfall caused by slashing is not recovered.
+	if want.IsPositive() && s.hasStake(ctx, addr) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "could not recover %s%s of unvested coins from account %s", want, bondDenom, addr,
+		)
+	}
+
+	// The tokens moved out of staking will never be undelegated back to the
+	// account, so they are no longer tracked as delegated.
+	if unbonded.IsPositive() {
+		delegatedFree := va.DelegatedFree.AmountOf(bondDenom)
+		untracked := sdk.NewCoin(bondDenom, sdk.MinInt(unbonded, delegatedFree))
+		va.DelegatedFree = va.DelegatedFree.Sub(sdk.NewCoins(untracked))
+		s.AccountKeeper.SetAccount(ctx, va)
+	}
+
+	return nil
+}
+
+// hasStake returns whether the account has any delegation or unbonding
+// delegation left.
+func (s msgServer) hasStake(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return len(s.StakingKeeper.GetDelegatorDelegations(ctx, addr, 1)) > 0 ||
+		len(s.StakingKeeper.GetUnbondingDelegations(ctx, addr, 1)) > 0
+}
+
+// checkRecipient ensures the coins may be sent and that the recipient is
+// neither a blocked address nor an existing account.
+func (s msgServer) checkRecipient(ctx sdk.Context, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := s.BankKeeper.SendEnabledCoins(ctx, amount...); err != nil {
+		return err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", to)
+	}
+
+	return nil
+}
+
+// fundAccount transfers the vesting amount from the sender to the newly
+// created vesting account.
+func (s msgServer) fundAccount(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return nil
+}
+
+// minCoins returns the minimum amount of each denom found in both a and b.
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		amt := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return min
+}
@@ -0,0 +1,295 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount         = "op_weight_msg_create_vesting_account"
+	OpWeightMsgCreatePeriodicVestingAccount = "op_weight_msg_create_periodic_vesting_account"
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account"
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak keeper.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateVestingAccount         int
+		weightMsgCreatePeriodicVestingAccount int
+		weightMsgCreateClawbackVestingAccount int
+		weightMsgClawback                     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePeriodicVestingAccount, &weightMsgCreatePeriodicVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePeriodicVestingAccount = simappparams.DefaultWeightMsgCreatePeriodicVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = simappparams.DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgClawback
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePeriodicVestingAccount,
+			SimulateMsgCreatePeriodicVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount that
+// funds a new continuous or delayed vesting account from a random sender.
+func SimulateMsgCreateVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to := simtypes.RandomAccounts(r, 1)[0]
+
+		if ak.GetAccount(ctx, to.Address) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "account already exists"), nil, nil
+		}
+
+		if bk.BlockedAddr(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "recipient address is blocked"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, from.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		amount := simtypes.RandSubsetCoins(r, spendable)
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "empty vesting amount"), nil, nil
+		}
+
+		if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "send is disabled"), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(amount))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "unable to generate fees"), nil, err
+		}
+
+		endTime := ctx.BlockTime().Unix() + int64(simtypes.RandIntBetween(r, 1, 60*60*24*365))
+		msg := types.NewMsgCreateVestingAccount(from.Address, to.Address, amount, endTime, r.Intn(2) == 0)
+
+		return deliver(app, msg, fees, chainID, account, from)
+	}
+}
+
+// SimulateMsgCreatePeriodicVestingAccount generates a
+// MsgCreatePeriodicVestingAccount that funds a new periodic vesting account
+// from a random sender.
+func SimulateMsgCreatePeriodicVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to := simtypes.RandomAccounts(r, 1)[0]
+
+		if ak.GetAccount(ctx, to.Address) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, "account already exists"), nil, nil
+		}
+
+		if bk.BlockedAddr(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, "recipient address is blocked"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, from.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		periods, total, remaining := randomVestingPeriods(r, spendable)
+		if len(periods) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, "empty vesting periods"), nil, nil
+		}
+
+		if err := bk.SendEnabledCoins(ctx, total...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, "send is disabled"), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, remaining)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(from.Address, to.Address, ctx.BlockTime().Unix(), periods)
+
+		return deliver(app, msg, fees, chainID, account, from)
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a
+// MsgCreateClawbackVestingAccount that funds a new clawback vesting account
+// from a random sender.
+func SimulateMsgCreateClawbackVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to := simtypes.RandomAccounts(r, 1)[0]
+
+		if ak.GetAccount(ctx, to.Address) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "account already exists"), nil, nil
+		}
+
+		if bk.BlockedAddr(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "recipient address is blocked"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, from.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		periods, total, remaining := randomVestingPeriods(r, spendable)
+		if len(periods) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "empty vesting periods"), nil, nil
+		}
+
+		if err := bk.SendEnabledCoins(ctx, total...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "send is disabled"), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, remaining)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(from.Address, to.Address, ctx.BlockTime().Unix(), periods)
+
+		return deliver(app, msg, fees, chainID, account, from)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback that reclaims the unvested coins
+// of a random clawback vesting account funded by one of the simulation
+// accounts.
+func SimulateMsgClawback(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var vestingAccs []*types.ClawbackVestingAccount
+		ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+			va, ok := acc.(*types.ClawbackVestingAccount)
+			if ok {
+				if _, found := simtypes.FindAccount(accs, va.FunderAddress); found {
+					vestingAccs = append(vestingAccs, va)
+				}
+			}
+
+			return false
+		})
+
+		if len(vestingAccs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "no clawback vesting accounts"), nil, nil
+		}
+
+		va := vestingAccs[r.Intn(len(vestingAccs))]
+		funder, _ := simtypes.FindAccount(accs, va.FunderAddress)
+
+		account := ak.GetAccount(ctx, funder.Address)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, account.GetAddress()))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "unable to generate fees"), nil, err
+		}
+
+		var dest sdk.AccAddress
+		if r.Intn(2) == 0 {
+			destAcc, _ := simtypes.RandomAcc(r, accs)
+			dest = destAcc.Address
+		}
+
+		msg := types.NewMsgClawback(funder.Address, va.GetAddress(), dest)
+
+		return deliver(app, msg, fees, chainID, account, funder)
+	}
+}
+
+// randomVestingPeriods returns up to five vesting periods whose amounts are
+// taken from spendable, along with their total and the coins left over.
+func randomVestingPeriods(r *rand.Rand, spendable sdk.Coins) (periods []types.Period, total, remaining sdk.Coins) {
+	remaining = spendable
+	numPeriods := simtypes.RandIntBetween(r, 1, 5)
+	for i := 0; i < numPeriods; i++ {
+		amount := simtypes.RandSubsetCoins(r, remaining)
+		if amount.Empty() {
+			break
+		}
+
+		remaining = remaining.Sub(amount)
+		total = total.Add(amount...)
+		periods = append(periods, types.Period{
+			Length: int64(simtypes.RandIntBetween(r, 1, 60*60*24*30)),
+			Amount: amount,
+		})
+	}
+
+	return periods, total, remaining
+}
+
+func deliver(
+	app *baseapp.BaseApp, msg sdk.Msg, fees sdk.Coins, chainID string, account authtypes.AccountI, from simtypes.Account,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txGen := simappparams.MakeEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		from.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
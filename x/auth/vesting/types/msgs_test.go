@@ -0,0 +1,176 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgCreateVestingAccountRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	msg := NewMsgCreateVestingAccount(addr1, addr2, coins, 100, false)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgCreateVestingAccount, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addr2 := sdk.AccAddress([]byte("to__________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom123eth0 := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 0)}
+
+	cases := []struct {
+		name      string
+		msg       *MsgCreateVestingAccount
+		expectErr bool
+	}{
+		{"valid continuous", NewMsgCreateVestingAccount(addr1, addr2, atom123, 100, false), false},
+		{"valid delayed", NewMsgCreateVestingAccount(addr1, addr2, atom123, 100, true), false},
+		{"empty sender", NewMsgCreateVestingAccount(addrEmpty, addr2, atom123, 100, false), true},
+		{"empty recipient", NewMsgCreateVestingAccount(addr1, addrEmpty, atom123, 100, false), true},
+		{"empty amount", NewMsgCreateVestingAccount(addr1, addr2, sdk.Coins{}, 100, false), true},
+		{"non positive coin", NewMsgCreateVestingAccount(addr1, addr2, atom123eth0, 100, false), true},
+		{"zero end time", NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, false), true},
+		{"negative end time", NewMsgCreateVestingAccount(addr1, addr2, atom123, -1, false), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	periods := []Period{{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}}
+	msg := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, periods)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgCreatePeriodicVestingAccount, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgCreatePeriodicVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addr2 := sdk.AccAddress([]byte("to__________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom10 := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	validPeriods := []Period{{Length: 10, Amount: atom10}, {Length: 20, Amount: atom10}}
+
+	cases := []struct {
+		name      string
+		msg       *MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{"valid", NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, validPeriods), false},
+		{"empty sender", NewMsgCreatePeriodicVestingAccount(addrEmpty, addr2, 100, validPeriods), true},
+		{"empty recipient", NewMsgCreatePeriodicVestingAccount(addr1, addrEmpty, 100, validPeriods), true},
+		{"zero start time", NewMsgCreatePeriodicVestingAccount(addr1, addr2, 0, validPeriods), true},
+		{"no periods", NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, nil), true},
+		{"zero period length", NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, []Period{{Length: 0, Amount: atom10}}), true},
+		{"empty period amount", NewMsgCreatePeriodicVestingAccount(addr1, addr2, 100, []Period{{Length: 10, Amount: sdk.Coins{}}}), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreateClawbackVestingAccountRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	periods := []Period{{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}}
+	msg := NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, periods)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgCreateClawbackVestingAccount, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgCreateClawbackVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addr2 := sdk.AccAddress([]byte("to__________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom10 := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	validPeriods := []Period{{Length: 10, Amount: atom10}, {Length: 20, Amount: atom10}}
+
+	cases := []struct {
+		name      string
+		msg       *MsgCreateClawbackVestingAccount
+		expectErr bool
+	}{
+		{"valid", NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, validPeriods), false},
+		{"empty sender", NewMsgCreateClawbackVestingAccount(addrEmpty, addr2, 100, validPeriods), true},
+		{"empty recipient", NewMsgCreateClawbackVestingAccount(addr1, addrEmpty, 100, validPeriods), true},
+		{"zero start time", NewMsgCreateClawbackVestingAccount(addr1, addr2, 0, validPeriods), true},
+		{"no periods", NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, nil), true},
+		{"zero period length", NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, []Period{{Length: 0, Amount: atom10}}), true},
+		{"empty period amount", NewMsgCreateClawbackVestingAccount(addr1, addr2, 100, []Period{{Length: 10, Amount: sdk.Coins{}}}), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgClawbackRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("funder"))
+	addr2 := sdk.AccAddress([]byte("account"))
+	msg := NewMsgClawback(addr1, addr2, nil)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgClawback, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgClawbackValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("funder______________"))
+	addr2 := sdk.AccAddress([]byte("account_____________"))
+	addr3 := sdk.AccAddress([]byte("dest________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	cases := []struct {
+		name      string
+		msg       *MsgClawback
+		expectErr bool
+	}{
+		{"valid", NewMsgClawback(addr1, addr2, addr3), false},
+		{"valid without destination", NewMsgClawback(addr1, addr2, addrEmpty), false},
+		{"empty funder", NewMsgClawback(addrEmpty, addr2, addr3), true},
+		{"empty account", NewMsgClawback(addr1, addrEmpty, addr3), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
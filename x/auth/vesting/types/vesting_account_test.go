@@ -578,6 +578,108 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	cva := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), periods)
+
+	require.Equal(t, origCoins, cva.GetOriginalVesting())
+	require.Equal(t, endTime.Unix(), cva.GetEndTime())
+	require.Equal(t, funder, cva.GetFunderAddress())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+
+	// require 50% of coins vested after period 1
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.LockedCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, cva.GetVestedCoins(endTime))
+	require.Nil(t, cva.GetVestingCoins(endTime))
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+
+	testCases := []struct {
+		name          string
+		clawbackTime  time.Time
+		expClawback   sdk.Coins
+		expPeriods    int
+		expEndTime    int64
+		expOrigVested sdk.Coins
+	}{
+		{
+			"before the start of the schedule",
+			now.Add(-time.Hour),
+			origCoins,
+			0,
+			now.Unix(),
+			sdk.NewCoins(),
+		},
+		{
+			"during the second period",
+			now.Add(15 * time.Hour),
+			sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)},
+			1,
+			now.Add(12 * time.Hour).Unix(),
+			sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)},
+		},
+		{
+			"after the end of the schedule",
+			now.Add(48 * time.Hour),
+			nil,
+			3,
+			now.Add(24 * time.Hour).Unix(),
+			origCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			cva := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), periods)
+
+			// delegate 100stake of which 50stake are vesting at the clawback time
+			cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+			delegated := cva.DelegatedFree.Add(cva.DelegatedVesting...)
+
+			clawback := cva.ComputeClawback(tc.clawbackTime)
+			require.True(t, tc.expClawback.IsEqual(clawback))
+			require.Len(t, cva.VestingPeriods, tc.expPeriods)
+			require.Equal(t, tc.expEndTime, cva.EndTime)
+			require.True(t, tc.expOrigVested.IsEqual(cva.OriginalVesting))
+			require.Equal(t, delegated, cva.DelegatedFree)
+			require.True(t, cva.DelegatedVesting.IsZero())
+			require.True(t, cva.GetVestingCoins(tc.clawbackTime).IsZero())
+			require.NoError(t, cva.Validate())
+		})
+	}
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -626,6 +728,16 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			types.NewClawbackVestingAccount(baseAcc, nil, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			true,
+		},
 		{
 			"invalid vesting period amounts",
 			types.NewPeriodicVestingAccountRaw(
@@ -707,3 +819,25 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	_, _, funder := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
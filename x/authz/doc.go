@@ -0,0 +1,18 @@
+/*
+Package authz provides functionality for granting arbitrary privileges from one
+account (the granter) to another account (the grantee).
+
+A granter authorizes a grantee to execute a specific sdk.Msg type on their
+behalf with MsgGrant, and removes that authorization with MsgRevoke. Every
+grant has an expiration time, after which it can no longer be used. A grantee
+executes messages signed by a granter by wrapping them in a MsgExec; each
+message is checked against the corresponding authorization and then dispatched
+through the application's message routers, as if it had been sent directly by
+the granter.
+
+An authorization is specified by an implementation of the Authorization
+interface. Two implementations are provided in this package: GenericAuthorization,
+which allows unlimited execution of a single sdk.Msg type, and SendAuthorization,
+which allows bank MsgSend messages up to a spend limit.
+*/
+package authz
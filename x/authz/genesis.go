@@ -0,0 +1,37 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// InitGenesis new authz genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	for _, entry := range data.Authorization {
+		authorization, ok := entry.Authorization.GetCachedValue().(types.Authorization)
+		if !ok {
+			panic("expected authorization")
+		}
+
+		if err := keeper.Grant(ctx, entry.Granter, entry.Grantee, authorization, entry.Expiration); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	keeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter,
+			Grantee:       grantee,
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
@@ -0,0 +1,66 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1})
+	suite.keeper = app.AuthzKeeper
+}
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+	now := suite.ctx.BlockHeader().Time
+	expiration := now.Add(time.Hour)
+
+	authorization := types.NewSendAuthorization(coins)
+	err := suite.keeper.Grant(suite.ctx, granterAddr, granteeAddr, authorization, expiration)
+	suite.Require().NoError(err)
+
+	genesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.Authorization, 1)
+	suite.Require().NoError(genesis.Validate())
+
+	// revoke the authorization
+	err = suite.keeper.Revoke(suite.ctx, granterAddr, granteeAddr, authorization.MsgTypeURL())
+	suite.Require().NoError(err)
+
+	authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	newGenesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+
+	grant, exp := suite.keeper.GetAuthorization(suite.ctx, granterAddr, granteeAddr, authorization.MsgTypeURL())
+	suite.Require().Equal(authorization, grant)
+	suite.Require().True(expiration.Equal(exp))
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
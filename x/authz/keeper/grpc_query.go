@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Authorization implements the Query/Authorization gRPC method.
+func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRequest) (*types.QueryAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid granter addr")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid grantee addr")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type URL")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authorization, expiration := k.GetAuthorization(ctx, req.Granter, req.Grantee, req.MsgTypeUrl)
+	if authorization == nil {
+		return nil, status.Errorf(codes.NotFound, "no authorization found for %s type", req.MsgTypeUrl)
+	}
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationResponse{
+		Authorization: &grant,
+	}, nil
+}
+
+// Authorizations implements the Query/Authorizations gRPC method.
+func (k Keeper) Authorizations(c context.Context, req *types.QueryAuthorizationsRequest) (*types.QueryAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid granter addr")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid grantee addr")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var authorizations []*types.AuthorizationGrant
+
+	store := ctx.KVStore(k.storeKey)
+	authStore := prefix.NewStore(store, types.GetAuthorizationsPrefix(req.Granter, req.Grantee))
+
+	pageRes, err := query.Paginate(authStore, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.AuthorizationGrant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+
+		authorizations = append(authorizations, &grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationsResponse{
+		Authorizations: authorizations,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryAuthorization() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 10)))
+	expiration := suite.ctx.BlockHeader().Time.Add(time.Hour)
+
+	_, err := suite.queryClient.Authorization(gocontext.Background(), &types.QueryAuthorizationRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryAuthorizationRequest{Granter: granter, Grantee: grantee, MsgTypeUrl: bankSendMsgType}
+	_, err = suite.queryClient.Authorization(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.app.AuthzKeeper.Grant(suite.ctx, granter, grantee, authorization, expiration))
+
+	res, err := suite.queryClient.Authorization(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(authorization, res.Authorization.GetAuthorizationGrant())
+	suite.Require().True(expiration.Equal(res.Authorization.Expiration))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAuthorizations() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	expiration := suite.ctx.BlockHeader().Time.Add(time.Hour)
+
+	_, err := suite.queryClient.Authorizations(gocontext.Background(), &types.QueryAuthorizationsRequest{})
+	suite.Require().Error(err)
+
+	k := suite.app.AuthzKeeper
+	suite.Require().NoError(k.Grant(suite.ctx, granter, grantee, types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote"), expiration))
+	suite.Require().NoError(k.Grant(suite.ctx, granter, grantee, types.NewGenericAuthorization(bankSendMsgType), expiration))
+
+	req := &types.QueryAuthorizationsRequest{Granter: granter, Grantee: grantee}
+	res, err := suite.queryClient.Authorizations(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 2)
+
+	req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = suite.queryClient.Authorizations(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
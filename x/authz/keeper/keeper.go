@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations granted between accounts, and dispatches
+// the messages executed through them.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          codec.BinaryMarshaler
+	router       *baseapp.MsgServiceRouter
+	legacyRouter sdk.Router
+}
+
+// NewKeeper constructs a message authorization Keeper. Executed messages are
+// routed through the given MsgServiceRouter, or through the legacy router if
+// they don't have a Msg service.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter, legacyRouter sdk.Router) Keeper {
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		router:       router,
+		legacyRouter: legacyRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getAuthorizationGrant returns the grant stored under the given key.
+func (k Keeper) getAuthorizationGrant(ctx sdk.Context, grantStoreKey []byte) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(grantStoreKey)
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// update replaces the authorization of an existing grant, keeping its
+// expiration.
+func (k Keeper) update(ctx sdk.Context, granter, grantee sdk.AccAddress, updated types.Authorization) error {
+	grantStoreKey := types.GetAuthorizationStoreKey(granter, grantee, updated.MsgTypeURL())
+	grant, found := k.getAuthorizationGrant(ctx, grantStoreKey)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, updated.MsgTypeURL())
+	}
+
+	newGrant, err := types.NewAuthorizationGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(grantStoreKey, k.cdc.MustMarshalBinaryBare(&newGrant))
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages on behalf of their
+// signers. A message signed by an account other than the grantee is only
+// executed if that account granted an authorization accepting it to the grantee.
+// It returns the result data of each executed message.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization can be given to msg with only one signer")
+		}
+
+		granter := signers[0]
+
+		// if the granter is the grantee, no authorization is required
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetAuthorization(ctx, granter, grantee, sdk.MsgTypeURL(msg))
+			if authorization == nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization not found")
+			}
+
+			updated, del, err := authorization.Accept(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+
+			if del {
+				err = k.Revoke(ctx, granter, grantee, sdk.MsgTypeURL(msg))
+			} else if updated != nil {
+				err = k.update(ctx, granter, grantee, updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventExecuteAuthorization,
+					sdk.NewAttribute(types.AttributeKeyGrantType, sdk.MsgTypeURL(msg)),
+					sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+					sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+				),
+			)
+		}
+
+		msgResult, err := k.route(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		results[i] = msgResult.Data
+
+		// propagate the events of the executed message
+		ctx.EventManager().EmitEvents(msgResult.GetEvents())
+	}
+
+	return results, nil
+}
+
+// route executes msg with its Msg service handler, or with its legacy handler
+// if it has no Msg service.
+func (k Keeper) route(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	if handler := k.router.Handler(msg); handler != nil {
+		return handler(ctx, msg)
+	}
+
+	if k.legacyRouter != nil {
+		if handler := k.legacyRouter.Route(ctx, msg.Route()); handler != nil {
+			return handler(ctx, msg)
+		}
+	}
+
+	return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+}
+
+// Grant method grants the provided authorization to the grantee on the granter's account
+// with the provided expiration time. If there is an existing authorization grant for the
+// same `sdk.Msg` type, this grant overwrites that.
+func (k Keeper) Grant(ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	grantStoreKey := types.GetAuthorizationStoreKey(granter, grantee, authorization.MsgTypeURL())
+	store.Set(grantStoreKey, bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGrantType, authorization.MsgTypeURL()),
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// Revoke method revokes any authorization for the provided message type granted to the grantee
+// by the granter.
+func (k Keeper) Revoke(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	grantStoreKey := types.GetAuthorizationStoreKey(granter, grantee, msgType)
+	if _, found := k.getAuthorizationGrant(ctx, grantStoreKey); !found {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, msgType)
+	}
+
+	store.Delete(grantStoreKey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGrantType, msgType),
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorizations returns all the authorizations granted to grantee by granter.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) []types.Authorization {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAuthorizationsPrefix(granter, grantee))
+	defer iter.Close()
+
+	var authorizations []types.Authorization
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		authorizations = append(authorizations, grant.GetAuthorizationGrant())
+	}
+
+	return authorizations
+}
+
+// GetAuthorization returns the authorization granted to grantee by granter
+// for the given sdk.Msg type, along with its expiration time. It returns a nil
+// Authorization if there is no such grant or if it has expired.
+func (k Keeper) GetAuthorization(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) (types.Authorization, time.Time) {
+	grant, found := k.getAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(granter, grantee, msgType))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorizationGrant(), grant.Expiration
+}
+
+// IterateGrants iterates over all the authorization grants in the store,
+// including expired ones. The handler is called with the granter, the grantee
+// and the grant, and iteration stops when it returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context,
+	handler func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		granter, grantee := types.ParseAuthorizationStoreKey(iter.Key())
+		if handler(granter, grantee, grant) {
+			break
+		}
+	}
+}
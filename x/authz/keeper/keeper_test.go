@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var bankSendMsgType = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+	msgSrvr     types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.AuthzKeeper)
+}
+
+func (suite *KeeperTestSuite) TestKeeper() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	now := ctx.BlockHeader().Time
+
+	authorization, _ := k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().Nil(authorization)
+
+	// grant an authorization
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), now.Add(time.Hour)))
+
+	authorization, expiration := k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().NotNil(authorization)
+	suite.Require().Equal(bankSendMsgType, authorization.MsgTypeURL())
+	suite.Require().True(expiration.Equal(now.Add(time.Hour)))
+
+	// the authorization is directional
+	authorization, _ = k.GetAuthorization(ctx, grantee, granter, bankSendMsgType)
+	suite.Require().Nil(authorization)
+
+	suite.Require().Len(k.GetAuthorizations(ctx, granter, grantee), 1)
+
+	// revoke it
+	suite.Require().NoError(k.Revoke(ctx, granter, grantee, bankSendMsgType))
+	authorization, _ = k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().Nil(authorization)
+
+	// revoking a missing authorization fails
+	suite.Require().Error(k.Revoke(ctx, granter, grantee, bankSendMsgType))
+}
+
+func (suite *KeeperTestSuite) TestKeeperExpiration() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	now := ctx.BlockHeader().Time
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), now.Add(time.Hour)))
+
+	authorization, _ := k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().NotNil(authorization)
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	authorization, _ = k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().Nil(authorization)
+}
+
+func (suite *KeeperTestSuite) TestKeeperDispatchActions() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	now := ctx.BlockHeader().Time
+	denom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	sendMsg := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, amount)))}
+	}
+
+	// no authorization
+	_, err := k.DispatchActions(ctx, grantee, sendMsg(10))
+	suite.Require().Error(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), now.Add(time.Hour)))
+
+	// over the spend limit
+	_, err = k.DispatchActions(ctx, grantee, sendMsg(101))
+	suite.Require().Error(err)
+
+	// within the spend limit, the authorization is updated
+	_, err = k.DispatchActions(ctx, grantee, sendMsg(40))
+	suite.Require().NoError(err)
+
+	recipientBalance := suite.app.BankKeeper.GetBalance(ctx, recipient, denom)
+	suite.Require().Equal(sdk.NewInt(30000040), recipientBalance.Amount)
+
+	authorization, _ := k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().NotNil(authorization)
+	sendAuth, ok := authorization.(*types.SendAuthorization)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 60)), sendAuth.SpendLimit)
+
+	// spending the whole remaining limit deletes the authorization
+	_, err = k.DispatchActions(ctx, grantee, sendMsg(60))
+	suite.Require().NoError(err)
+
+	authorization, _ = k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().Nil(authorization)
+
+	// the grantee can always execute its own messages
+	ownMsgs := []sdk.Msg{banktypes.NewMsgSend(grantee, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))}
+	_, err = k.DispatchActions(ctx, grantee, ownMsgs)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeperDispatchGenericAuthorization() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	now := ctx.BlockHeader().Time
+	denom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	delegateMsgType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewGenericAuthorization(delegateMsgType), now.Add(time.Hour)))
+
+	// a generic authorization only accepts its own message type
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))}
+	_, err := k.DispatchActions(ctx, grantee, msgs)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewGenericAuthorization(bankSendMsgType), now.Add(time.Hour)))
+	_, err = k.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+
+	// generic authorizations are not consumed
+	authorization, _ := k.GetAuthorization(ctx, granter, grantee, bankSendMsgType)
+	suite.Require().NotNil(authorization)
+	suite.Require().Len(k.GetAuthorizations(ctx, granter, grantee), 2)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	ctx := suite.ctx
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	now := ctx.BlockHeader().Time
+	denom := suite.app.StakingKeeper.BondDenom(ctx)
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+
+	// expiration must be in the future
+	msgGrant, err := types.NewMsgGrant(granter, grantee, authorization, now.Add(-time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.Grant(sdk.WrapSDKContext(ctx), msgGrant)
+	suite.Require().Error(err)
+
+	msgGrant, err = types.NewMsgGrant(granter, grantee, authorization, now.Add(time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.Grant(sdk.WrapSDKContext(ctx), msgGrant)
+	suite.Require().NoError(err)
+
+	msgExec, err := types.NewMsgExec(grantee, []sdk.Msg{
+		banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+	})
+	suite.Require().NoError(err)
+	res, err := suite.msgSrvr.Exec(sdk.WrapSDKContext(ctx), msgExec)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 1)
+
+	msgRevoke := types.NewMsgRevoke(granter, grantee, bankSendMsgType)
+	_, err = suite.msgSrvr.Revoke(sdk.WrapSDKContext(ctx), &msgRevoke)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.Exec(sdk.WrapSDKContext(ctx), msgExec)
+	suite.Require().Error(err)
+}
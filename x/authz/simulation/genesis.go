@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Simulation parameter constants
+const authz = "authz"
+
+// genAuthorizationGrants returns a slice of randomly generated send
+// authorizations, each granted from an account to the next one.
+func genAuthorizationGrants(r *rand.Rand, genesisTime time.Time, accounts []simtypes.Account) []types.GrantAuthorization {
+	var authorizations []types.GrantAuthorization
+
+	for i := 0; i < len(accounts)-1; i++ {
+		if r.Intn(2) == 0 {
+			continue
+		}
+
+		spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1000))))
+		any, err := codectypes.NewAnyWithValue(types.NewSendAuthorization(spendLimit))
+		if err != nil {
+			panic(err)
+		}
+
+		authorizations = append(authorizations, types.GrantAuthorization{
+			Granter:       accounts[i].Address,
+			Grantee:       accounts[i+1].Address,
+			Authorization: any,
+			Expiration:    genesisTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 1000)) * time.Hour),
+		})
+	}
+
+	return authorizations
+}
+
+// RandomizedGenState generates a random GenesisState for authz
+func RandomizedGenState(simState *module.SimulationState) {
+	var authorizations []types.GrantAuthorization
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, authz, &authorizations, simState.Rand,
+		func(r *rand.Rand) {
+			authorizations = genAuthorizationGrants(r, simState.GenTimestamp, simState.Accounts)
+		},
+	)
+
+	authzGenesis := types.NewGenesisState(authorizations)
+
+	bz, err := simState.Cdc.MarshalJSON(authzGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
@@ -0,0 +1,268 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrant  = "op_weight_msg_grant"
+	OpWeightMsgRevoke = "op_weight_msg_revoke"
+	OpWeightMsgExec   = "op_weight_msg_exec"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrant  int
+		weightMsgRevoke int
+		weightMsgExec   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrant, &weightMsgGrant, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrant = simappparams.DefaultWeightMsgGrant
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevoke, &weightMsgRevoke, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevoke = simappparams.DefaultWeightMsgRevoke
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = simappparams.DefaultWeightMsgExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrant,
+			SimulateMsgGrant(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevoke,
+			SimulateMsgRevoke(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrant generates a MsgGrant granting a SendAuthorization with
+// random values.
+func SimulateMsgGrant(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if grantee.Address.Equals(granter.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "grantee and granter cannot be same"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "unable to generate fees"), nil, err
+		}
+
+		spendableCoins = spendableCoins.Sub(fees)
+		spendLimit := simtypes.RandSubsetCoins(r, spendableCoins)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "spend limit is empty"), nil, nil
+		}
+
+		expiration := ctx.BlockHeader().Time.Add(time.Duration(simtypes.RandIntBetween(r, 1, 1000)) * time.Hour)
+		msg, err := types.NewMsgGrant(granter.Address, grantee.Address, types.NewSendAuthorization(spendLimit), expiration)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevoke generates a MsgRevoke revoking an existing grant.
+func SimulateMsgRevoke(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			hasGrant      bool
+			granterAddr   sdk.AccAddress
+			granteeAddr   sdk.AccAddress
+			authorization types.Authorization
+		)
+
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+			granterAddr = granter
+			granteeAddr = grantee
+			authorization = grant.GetAuthorizationGrant()
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "no grants"), nil, nil
+		}
+
+		granter, ok := simtypes.FindAccount(accs, granterAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgRevoke(granterAddr, granteeAddr, authorization.MsgTypeURL())
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExec generates a MsgExec executing a MsgSend from the granter to
+// the grantee of an existing SendAuthorization.
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			hasGrant    bool
+			granterAddr sdk.AccAddress
+			granteeAddr sdk.AccAddress
+			spendLimit  sdk.Coins
+		)
+
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+			sendAuth, ok := grant.GetAuthorizationGrant().(*types.SendAuthorization)
+			if !ok || grant.Expiration.Before(ctx.BlockHeader().Time) {
+				return false
+			}
+
+			granterAddr = granter
+			granteeAddr = grantee
+			spendLimit = sendAuth.SpendLimit
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "no send grants"), nil, nil
+		}
+
+		grantee, ok := simtypes.FindAccount(accs, granteeAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "account not found"), nil, nil
+		}
+
+		coins := simtypes.RandSubsetCoins(r, spendLimit)
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "send amount is empty"), nil, nil
+		}
+
+		if !bk.SpendableCoins(ctx, granterAddr).IsAllGTE(coins) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "insufficient granter funds"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, grantee.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "unable to generate fees"), nil, err
+		}
+
+		msg, err := types.NewMsgExec(grantee.Address, []sdk.Msg{banktypes.NewMsgSend(granterAddr, granteeAddr, coins)})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			grantee.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of various Authorization types
+// which allow a grantee to execute sdk.Msgs on behalf of a granter.
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type URL of the sdk.Msg the authorization
+	// applies to, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeURL() string
+
+	// Accept determines whether this grant permits the provided sdk.Msg to be
+	// performed, and if so provides an updated authorization instance to be
+	// saved in place of the current one. If delete is true, the authorization
+	// is removed from state, e.g. because it is used up.
+	Accept(ctx sdk.Context, msg sdk.Msg) (updated Authorization, delete bool, err error)
+
+	// ValidateBasic does a simple validation check that doesn't require access
+	// to any other information.
+	ValidateBasic() error
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgTypeURL,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. A GenericAuthorization accepts any
+// sdk.Msg of its type and is never updated.
+func (a GenericAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (Authorization, bool, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %s", a.Msg, sdk.MsgTypeURL(msg))
+	}
+
+	return &a, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GenericAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "msg type URL cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	authorization := types.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend")
+
+	require.NoError(t, authorization.ValidateBasic())
+
+	updated, del, err := authorization.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, authorization, updated)
+
+	_, _, err = authorization.Accept(ctx, banktypes.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	require.Error(t, types.NewGenericAuthorization("").ValidateBasic())
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns a default authz module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (data GenesisState) Validate() error {
+	for _, a := range data.Authorization {
+		if a.Granter.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+		}
+		if a.Grantee.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+		}
+
+		authorization, ok := a.Authorization.GetCachedValue().(Authorization)
+		if !ok {
+			return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization")
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range data.Authorization {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = &AuthorizationGrant{}
+
+// NewAuthorizationGrant returns a new AuthorizationGrant.
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	auth := AuthorizationGrant{
+		Expiration: expiration,
+	}
+
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+	auth.Authorization = any
+
+	return auth, nil
+}
+
+// GetAuthorizationGrant returns the cached value from the AuthorizationGrant.Authorization
+// if present, or nil otherwise.
+func (g AuthorizationGrant) GetAuthorizationGrant() Authorization {
+	authorization, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GetAuthorizationStoreKey returns the store key for the authorization of the
+// given sdk.Msg type granted by granter to grantee.
+func GetAuthorizationStoreKey(granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetAuthorizationsPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GetAuthorizationsPrefix returns a prefix to scan for all the authorizations
+// granted by granter to grantee.
+func GetAuthorizationsPrefix(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append(GrantKeyPrefix, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseAuthorizationStoreKey returns the granter and grantee addresses of an
+// authorization store key.
+func ParseAuthorizationStoreKey(key []byte) (granter sdk.AccAddress, grantee sdk.AccAddress) {
+	addrs := key[len(GrantKeyPrefix):]
+	return sdk.AccAddress(addrs[:sdk.AddrLen]), sdk.AccAddress(addrs[sdk.AddrLen : 2*sdk.AddrLen])
+}
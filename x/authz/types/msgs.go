@@ -0,0 +1,233 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// authz message types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_, _, _ sdk.Msg                       = &MsgGrant{}, &MsgRevoke{}, &MsgExec{}
+	_, _    types.UnpackInterfacesMessage = &MsgGrant{}, &MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant.
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) (*MsgGrant, error) {
+	m := &MsgGrant{
+		Granter:    granter,
+		Grantee:    grantee,
+		Expiration: expiration,
+	}
+	if err := m.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrant) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetGrantAuthorization returns the cached value from the MsgGrant.Authorization
+// if present, or nil otherwise.
+func (msg MsgGrant) GetGrantAuthorization() Authorization {
+	authorization, ok := msg.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
+
+// SetAuthorization converts Authorization to any and adds it to MsgGrant.Authorization.
+func (msg *MsgGrant) SetAuthorization(authorization Authorization) error {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return err
+	}
+
+	msg.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevoke creates a new MsgRevoke.
+func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevoke) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing msg type URL")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// NewMsgExec creates a new MsgExec.
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		protoMsg, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", msg)
+		}
+
+		any, err := types.NewAnyWithValue(protoMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return &MsgExec{
+		Grantee: grantee,
+		Msgs:    msgsAny,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// GetMessages returns the cached sdk.Msgs from the MsgExec.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		m, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %d contains %T which is not a sdk.Msg", i, msgAny.GetCachedValue())
+		}
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, m := range msg.Msgs {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(m, &sdkMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgGrant(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	expiration := time.Now().AddDate(1, 0, 0)
+
+	cases := map[string]struct {
+		granter       sdk.AccAddress
+		grantee       sdk.AccAddress
+		authorization types.Authorization
+		valid         bool
+	}{
+		"valid":               {granter, grantee, types.NewSendAuthorization(coins1000), true},
+		"no granter":          {nil, grantee, types.NewSendAuthorization(coins1000), false},
+		"no grantee":          {granter, nil, types.NewSendAuthorization(coins1000), false},
+		"granter == grantee":  {granter, granter, types.NewSendAuthorization(coins1000), false},
+		"invalid spend limit": {granter, grantee, types.NewSendAuthorization(nil), false},
+		"invalid generic":     {granter, grantee, types.NewGenericAuthorization(""), false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, expiration)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, types.TypeMsgGrant, msg.Type())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevoke(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+
+	cases := map[string]struct {
+		granter sdk.AccAddress
+		grantee sdk.AccAddress
+		msgType string
+		valid   bool
+	}{
+		"valid":              {granter, grantee, msgType, true},
+		"no granter":         {nil, grantee, msgType, false},
+		"no grantee":         {granter, nil, msgType, false},
+		"granter == grantee": {granter, granter, msgType, false},
+		"no msg type":        {granter, grantee, "", false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.msgType)
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, types.TypeMsgRevoke, msg.Type())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgExec(t *testing.T) {
+	grantee := sdk.AccAddress("grantee_____________")
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		msgs    []sdk.Msg
+		valid   bool
+	}{
+		"valid":       {grantee, []sdk.Msg{banktypes.NewMsgSend(fromAddr, toAddr, coins1000)}, true},
+		"no grantee":  {nil, []sdk.Msg{banktypes.NewMsgSend(fromAddr, toAddr, coins1000)}, false},
+		"no messages": {grantee, nil, false},
+		"invalid msg": {grantee, []sdk.Msg{banktypes.NewMsgSend(fromAddr, toAddr, nil)}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgExec(tc.grantee, tc.msgs)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.grantee}, msg.GetSigners())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, types.TypeMsgExec, msg.Type())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthorizationResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Authorization != nil {
+		return m.Authorization.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthorizationsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range m.Authorizations {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization.Accept. It accepts a MsgSend whose amount
+// is within the remaining spend limit, and deducts that amount from it.
+func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (Authorization, bool, error) {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %T", a.MsgTypeURL(), msg)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return nil, true, nil
+	}
+
+	return &SendAuthorization{SpendLimit: limitLeft}, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", a.SpendLimit)
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+
+	return nil
+}
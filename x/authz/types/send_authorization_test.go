@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coins1000 = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	coins500  = sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	fromAddr  = sdk.AccAddress("from________________")
+	toAddr    = sdk.AccAddress("to__________________")
+)
+
+func TestSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	authorization := types.NewSendAuthorization(coins1000)
+
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	// sending half of the limit updates the authorization
+	updated, del, err := authorization.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, types.NewSendAuthorization(coins500), updated)
+
+	// sending the whole limit deletes it
+	updated, del, err = authorization.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.NoError(t, err)
+	require.True(t, del)
+	require.Nil(t, updated)
+
+	// sending more than the limit fails
+	_, _, err = types.NewSendAuthorization(coins500).Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.Error(t, err)
+
+	// other messages are rejected
+	_, _, err = authorization.Accept(ctx, banktypes.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
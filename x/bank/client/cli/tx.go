@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -64,3 +69,74 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewCmdSubmitSetDenomMetadataProposal implements the command to submit a
+// set-denom-metadata proposal.
+func NewCmdSubmitSetDenomMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register or update the metadata of a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to register or update the client metadata of a coin
+denomination along with an initial deposit. The proposal details must be
+supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Register ATOM voucher metadata",
+  "description": "Display the IBC ATOM voucher in atom units",
+  "metadata": {
+    "description": "The native staking token of the Cosmos Hub.",
+    "denom_units": [
+      {"denom": "uatom", "exponent": 0, "aliases": ["microatom"]},
+      {"denom": "atom", "exponent": 6}
+    ],
+    "base": "uatom",
+    "display": "atom"
+  },
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSetDenomMetadataProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
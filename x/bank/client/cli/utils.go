@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ParseSetDenomMetadataProposalWithDeposit reads and parses a SetDenomMetadataProposalWithDeposit from a file.
+func ParseSetDenomMetadataProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.SetDenomMetadataProposalWithDeposit, error) {
+	proposal := types.SetDenomMetadataProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
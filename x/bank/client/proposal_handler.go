@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// SetDenomMetadataProposalHandler is the set denom metadata proposal handler.
+var SetDenomMetadataProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetDenomMetadataProposal, rest.ProposalRESTHandler)
@@ -30,15 +30,23 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		genState.Supply = totalSupply
 	}
 
-	k.SetSupply(ctx, types.NewSupply(genState.Supply))
+	for _, supply := range genState.Supply {
+		k.setSupply(ctx, supply)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	totalSupply := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		totalSupply = totalSupply.Add(supply)
+		return false
+	})
+
 	return types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
-		k.GetSupply(ctx).GetTotal(),
+		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
 }
@@ -10,21 +10,22 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 
 	expectedMetadata := suite.getTestMetadata()
 	expectedBalances := suite.getTestBalances()
+	totalSupply := sdk.NewCoins()
 	for i := range []int{1, 2} {
 		app.BankKeeper.SetDenomMetaData(ctx, expectedMetadata[i])
 		err := app.BankKeeper.SetBalances(ctx, expectedBalances[i].Address, expectedBalances[i].Coins)
 		suite.Require().NoError(err)
+		totalSupply = totalSupply.Add(expectedBalances[i].Coins...)
 	}
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, totalSupply)
-	app.BankKeeper.SetParams(ctx, types.DefaultParams())
+	// the total supply is only writable through genesis, minting and burning
+	app.BankKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), nil, totalSupply, nil))
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
 	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
 	suite.Require().Equal(types.DefaultParams().DefaultSendEnabled, exportGenesis.Params.DefaultSendEnabled)
-	suite.Require().Equal(totalSupply.GetTotal(), exportGenesis.Supply)
+	suite.Require().Equal(totalSupply, exportGenesis.Supply)
 	suite.Require().Equal(expectedBalances, exportGenesis.Balances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
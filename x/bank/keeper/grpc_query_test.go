@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func (suite *IntegrationTestSuite) TestQueryBalance() {
@@ -83,14 +84,26 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000), sdk.NewInt64Coin("test2", 700000000))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, expectedTotalSupply))
 
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
+	suite.Require().Equal(expectedTotalSupply, res.Supply)
 
-	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(expectedTotalSupply[0]), res.Supply)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(expectedTotalSupply[1]), res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -98,8 +111,8 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	test1Supply := sdk.NewInt64Coin("test1", 4000000)
 	test2Supply := sdk.NewInt64Coin("test2", 700000000)
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(test1Supply, test2Supply))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	expectedTotalSupply := sdk.NewCoins(test1Supply, test2Supply)
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, expectedTotalSupply))
 
 	_, err := queryClient.SupplyOf(gocontext.Background(), &types.QuerySupplyOfRequest{})
 	suite.Require().Error(err)
@@ -117,3 +130,44 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().NotNil(res)
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+
+	expectedMetadata := suite.getTestMetadata()
+	for _, metadata := range expectedMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMetadata, res.Metadatas)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMetadata[:1], res.Metadatas)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().Error(err)
+
+	expectedMetadata := suite.getTestMetadata()[0]
+	app.BankKeeper.SetDenomMetaData(ctx, expectedMetadata)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMetadata, res.Metadata)
+}
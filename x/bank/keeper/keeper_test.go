@@ -10,13 +10,18 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -88,11 +93,28 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	initialPower := int64(100)
 	initTokens := sdk.TokensFromConsensusPower(initialPower)
 
-	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
-	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
+	totalSupply := sdk.NewCoins(sdk.NewCoin("foocoin", initTokens), sdk.NewCoin("barcoin", initTokens))
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, "foocoin"))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, totalSupply))
 
-	total := app.BankKeeper.GetSupply(ctx).GetTotal()
-	suite.Require().Equal(totalSupply, total)
+	suite.Require().True(app.BankKeeper.HasSupply(ctx, "foocoin"))
+	suite.Require().Equal(sdk.NewCoin("foocoin", initTokens), app.BankKeeper.GetSupply(ctx, "foocoin"))
+	suite.Require().Equal(sdk.NewCoin("barcoin", initTokens), app.BankKeeper.GetSupply(ctx, "barcoin"))
+	suite.Require().Equal(sdk.NewCoin("bazcoin", sdk.ZeroInt()), app.BankKeeper.GetSupply(ctx, "bazcoin"))
+
+	// burning the whole supply of a denomination removes it from the store
+	barCoins := sdk.NewCoins(sdk.NewCoin("barcoin", initTokens))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, barCoins))
+	suite.Require().NoError(app.BankKeeper.BurnCoins(ctx, stakingtypes.BondedPoolName, barCoins))
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, "barcoin"))
+
+	var denoms []string
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		denoms = append(denoms, coin.Denom)
+		return false
+	})
+	suite.Require().Contains(denoms, "foocoin")
+	suite.Require().NotContains(denoms, "barcoin")
 }
 
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
@@ -120,7 +142,6 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
 	suite.Require().NoError(keeper.SetBalances(ctx, holderAcc.GetAddress(), initCoins))
 
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
 	authKeeper.SetModuleAccount(ctx, holderAcc)
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 	authKeeper.SetAccount(ctx, baseAcc)
@@ -185,7 +206,7 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
 	authKeeper.SetModuleAccount(ctx, randomPermAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
+	initialSupply := keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }, "invalid permission") // nolint:errcheck
@@ -199,16 +220,16 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Minter))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(initialSupply.Amount.Add(initTokens).Equal(keeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
+	initialSupply = keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	err = keeper.MintCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(initialSupply.Amount.Add(initTokens).Equal(keeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount))
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }) // nolint:errcheck
 }
 
@@ -234,37 +255,35 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 		app.GetSubspace(types.ModuleName), make(map[string]bool),
 	)
 
-	suite.Require().NoError(keeper.SetBalances(ctx, burnerAcc.GetAddress(), initCoins))
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	authKeeper.SetModuleAccount(ctx, minterAcc)
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, authtypes.Burner, initCoins))
 
-	initialSupply := keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
-	keeper.SetSupply(ctx, initialSupply)
+	initialSupply := keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                        // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")         // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initialSupply.GetTotal()) }, "random permission") // nolint:errcheck
-	err := keeper.BurnCoins(ctx, authtypes.Burner, initialSupply.GetTotal())
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission") // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initCoins) }, "random permission")        // nolint:errcheck
+	err := keeper.BurnCoins(ctx, authtypes.Burner, initCoins.Add(initCoins...))
 	suite.Require().Error(err, "insufficient coins")
 
 	err = keeper.BurnCoins(ctx, authtypes.Burner, initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(initialSupply.Amount.Sub(initTokens).Equal(keeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
-	keeper.SetSupply(ctx, initialSupply)
-
-	suite.Require().NoError(keeper.SetBalances(ctx, multiPermAcc.GetAddress(), initCoins))
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, multiPermAcc.GetName(), initCoins))
+
+	initialSupply = keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(initialSupply.Amount.Sub(initTokens).Equal(keeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount))
 }
 
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
@@ -530,7 +549,7 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events := ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(2, len(events))
+	suite.Require().Equal(3, len(events))
 
 	event1 := sdk.Event{
 		Type:       types.EventTypeTransfer,
@@ -560,15 +579,20 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().Equal(abci.Event(event1), events[0])
 	suite.Require().Equal(abci.Event(event2), events[1])
 
+	typedEvent, err := sdk.ParseTypedEvent(events[2])
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.EventTransfer{Sender: addr.String(), Recipient: addr2.String(), Amount: newCoins}, typedEvent)
+
 	app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50)))
 	newCoins = sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))
 
 	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(4, len(events))
-	suite.Require().Equal(abci.Event(event1), events[2])
-	suite.Require().Equal(abci.Event(event2), events[3])
+	suite.Require().Equal(6, len(events))
+	suite.Require().Equal(abci.Event(event1), events[3])
+	suite.Require().Equal(abci.Event(event2), events[4])
+	suite.Require().Equal("cosmos.bank.v1beta1.EventTransfer", events[5].Type)
 }
 
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
@@ -871,6 +895,10 @@ func (suite *IntegrationTestSuite) TestDelegateCoins() {
 	// require the ability for a vesting account to delegate
 	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, delCoins))
 	suite.Require().Equal(delCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// require the delegation to be tracked by the stored vesting account
+	vacc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().Equal(delCoins, vacc.GetDelegatedVesting())
 }
 
 func (suite *IntegrationTestSuite) TestDelegateCoins_Invalid() {
@@ -950,6 +978,68 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins() {
 	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addrModule).Empty())
 }
 
+func (suite *IntegrationTestSuite) TestDelegationTrackedByVestingAccounts() {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 80))
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+
+	testCases := []struct {
+		name         string
+		vacc         func(bacc *authtypes.BaseAccount) vestexported.VestingAccount
+		expDelVested sdk.Coins
+		expDelFree   sdk.Coins
+	}{
+		{
+			"continuous vesting account",
+			func(bacc *authtypes.BaseAccount) vestexported.VestingAccount {
+				return vesting.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+			},
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+		},
+		{
+			"delayed vesting account",
+			func(bacc *authtypes.BaseAccount) vestexported.VestingAccount {
+				return vesting.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
+			},
+			delCoins,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			app, ctx := suite.app, suite.ctx.WithBlockHeader(tmproto.Header{Time: now.Add(12 * time.Hour)})
+
+			macc := app.AccountKeeper.NewAccountWithAddress(ctx, addrModule) // we don't need to define an actual module account bc we just need the address for testing
+			app.AccountKeeper.SetAccount(ctx, tc.vacc(authtypes.NewBaseAccountWithAddress(addr)))
+			app.AccountKeeper.SetAccount(ctx, macc)
+			suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, origCoins))
+
+			// the delegation is tracked by the stored account
+			suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr, addrModule, delCoins))
+			vacc := app.AccountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+			suite.Require().Equal(tc.expDelVested, vacc.GetDelegatedVesting())
+			suite.Require().Equal(tc.expDelFree, vacc.GetDelegatedFree())
+
+			// the undelegation is tracked by the stored account
+			suite.Require().NoError(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr, delCoins))
+			vacc = app.AccountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+			suite.Require().True(vacc.GetDelegatedVesting().IsZero())
+			suite.Require().True(vacc.GetDelegatedFree().IsZero())
+			suite.Require().Equal(origCoins, app.BankKeeper.GetAllBalances(ctx, addr))
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	app, ctx := suite.app, suite.ctx
 
@@ -1040,6 +1130,46 @@ func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestMigrate1to2() {
+	app, ctx := suite.app, suite.ctx
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	supply := sdk.NewCoins(newBarCoin(20), newFooCoin(10))
+
+	// version 1 stores the whole supply as a single Supply object wrapped in an
+	// Any, whose only field has the same encoding as the supply of a
+	// QueryTotalSupplyResponse
+	legacySupply, err := (&types.QueryTotalSupplyResponse{Supply: supply}).Marshal()
+	suite.Require().NoError(err)
+	legacyBz, err := (&codectypes.Any{TypeUrl: "/cosmos.bank.v1beta1.Supply", Value: legacySupply}).Marshal()
+	suite.Require().NoError(err)
+
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		store.Delete(append(types.SupplyKey, coin.Denom...))
+		return false
+	})
+	store.Set(types.SupplyKey, legacyBz)
+
+	migrator := keeper.NewMigrator(app.BankKeeper.(keeper.BaseKeeper))
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+
+	suite.Require().False(store.Has(types.SupplyKey))
+	suite.Require().Equal(newBarCoin(20), app.BankKeeper.GetSupply(ctx, barDenom))
+	suite.Require().Equal(newFooCoin(10), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	totalSupply, _, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, totalSupply)
+
+	// a store without the legacy supply is left untouched
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+	suite.Require().Equal(newFooCoin(10), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	// an unexpected legacy supply object aborts the migration
+	store.Set(types.SupplyKey, []byte{0xff})
+	suite.Require().Error(migrator.Migrate1to2(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// legacySupplyTypeURL is the type URL of the Supply object stored by version 1
+// of the x/bank store.
+const legacySupplyTypeURL = "/cosmos.bank.v1beta1.Supply"
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper BaseKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper BaseKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/bank store from version 1 to 2. The total supply,
+// stored as a single Supply object under the supply key in version 1, is split
+// into one entry per denomination under the supply key prefix.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	bz := store.Get(types.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	supply, err := unmarshalLegacySupply(bz)
+	if err != nil {
+		return err
+	}
+
+	store.Delete(types.SupplyKey)
+	for _, coin := range supply {
+		m.keeper.setSupply(ctx, coin)
+	}
+
+	return nil
+}
+
+// unmarshalLegacySupply decodes the total supply from the Any-wrapped Supply
+// object of version 1, whose only field is the repeated total supply coins.
+func unmarshalLegacySupply(bz []byte) (sdk.Coins, error) {
+	var any codectypes.Any
+	if err := any.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if any.TypeUrl != legacySupplyTypeURL {
+		return nil, fmt.Errorf("expected stored supply of type %s, got %s", legacySupplyTypeURL, any.TypeUrl)
+	}
+
+	var supply sdk.Coins
+	for value := any.Value; len(value) > 0; {
+		num, typ, n := protowire.ConsumeTag(value)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		value = value[n:]
+
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, value)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			value = value[n:]
+			continue
+		}
+
+		coinBz, n := protowire.ConsumeBytes(value)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		value = value[n:]
+
+		var coin sdk.Coin
+		if err := coin.Unmarshal(coinBz); err != nil {
+			return nil, err
+		}
+		supply = append(supply, coin)
+	}
+
+	return supply, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetDenomMetadataProposal is a handler for executing a passed set denom
+// metadata proposal. It registers the metadata of a new denomination or
+// overwrites the metadata of an existing one.
+func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p *types.SetDenomMetadataProposal) error {
+	if err := p.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, p.Metadata)
+	return nil
+}
@@ -142,7 +142,16 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	_, err := k.SubtractCoins(ctx, fromAddr, amt)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:    fromAddr.String(),
+		Recipient: toAddr.String(),
+		Amount:    amt,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
 	v039auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_39"
+	v036supply "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_36"
 	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
 	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
 
@@ -37,8 +38,11 @@ func TestMigrate(t *testing.T) {
 	authGenState := v039auth.GenesisState{
 		Accounts: v038auth.GenesisAccounts{acc1, vaac},
 	}
+	supplyGenState := v036supply.GenesisState{
+		Supply: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
 
-	migrated := v040bank.Migrate(bankGenState, authGenState)
+	migrated := v040bank.Migrate(bankGenState, authGenState, supplyGenState)
 	expected := `{
   "send_enabled": true,
   "balances": [
@@ -60,6 +64,12 @@ func TestMigrate(t *testing.T) {
         }
       ]
     }
+  ],
+  "supply": [
+    {
+      "denom": "stake",
+      "amount": "100"
+    }
   ]
 }`
 
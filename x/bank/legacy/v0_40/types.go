@@ -22,6 +22,7 @@ type (
 	GenesisState struct {
 		SendEnabled bool      `json:"send_enabled" yaml:"send_enabled"`
 		Balances    []Balance `json:"balances" yaml:"balances"`
+		Supply      sdk.Coins `json:"supply" yaml:"supply"`
 	}
 
 	Balance struct {
@@ -30,8 +31,8 @@ type (
 	}
 )
 
-func NewGenesisState(sendEnabled bool, balances []Balance) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled, Balances: balances}
+func NewGenesisState(sendEnabled bool, balances []Balance, supply sdk.Coins) GenesisState {
+	return GenesisState{SendEnabled: sendEnabled, Balances: balances, Supply: supply}
 }
 
 func (b Balance) GetAddress() sdk.AccAddress {
@@ -0,0 +1,48 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestSetDenomMetadataProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	handler := bank.NewSetDenomMetadataProposalHandler(app.BankKeeper)
+
+	metadata := types.Metadata{
+		Description: "An IBC voucher of the Cosmos Hub staking token.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "ibc/atom", Exponent: 0, Aliases: nil},
+			{Denom: "atom", Exponent: 6, Aliases: nil},
+		},
+		Base:    "ibc/atom",
+		Display: "atom",
+	}
+
+	require.False(t, app.BankKeeper.HasDenomMetaData(ctx, metadata.Base))
+	require.NoError(t, handler(ctx, types.NewSetDenomMetadataProposal("title", "description", metadata)))
+	require.True(t, app.BankKeeper.HasDenomMetaData(ctx, metadata.Base))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, metadata.Base))
+
+	// updating the metadata of an existing denomination overwrites it
+	metadata.Description = "The Cosmos Hub staking token."
+	require.NoError(t, handler(ctx, types.NewSetDenomMetadataProposal("title", "description", metadata)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, metadata.Base))
+
+	// invalid metadata is rejected
+	invalidMetadata := metadata
+	invalidMetadata.Base = "ibc/uatom"
+	require.Error(t, handler(ctx, types.NewSetDenomMetadataProposal("title", "description", invalidMetadata)))
+	require.False(t, app.BankKeeper.HasDenomMetaData(ctx, invalidMetadata.Base))
+
+	// other proposal types are rejected
+	require.Error(t, handler(ctx, govtypes.NewTextProposal("title", "description")))
+}
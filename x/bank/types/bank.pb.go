@@ -10,7 +10,6 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -237,44 +236,6 @@ func (m *Output) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
-// Supply represents a struct that passively keeps track of the total supply
-// amounts in the network.
-type Supply struct {
-	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
-}
-
-func (m *Supply) Reset()      { *m = Supply{} }
-func (*Supply) ProtoMessage() {}
-func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
-}
-func (m *Supply) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Supply.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Supply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Supply.Merge(m, src)
-}
-func (m *Supply) XXX_Size() int {
-	return m.Size()
-}
-func (m *Supply) XXX_DiscardUnknown() {
-	xxx_messageInfo_Supply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Supply proto.InternalMessageInfo
-
 // DenomUnit represents a struct that describes a given
 // denomination unit of the basic token.
 type DenomUnit struct {
@@ -294,7 +255,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +322,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,57 +379,173 @@ func (m *Metadata) GetDisplay() string {
 	return ""
 }
 
+// SetDenomMetadataProposal is a gov Content type to register or update the
+// client metadata of a coin denomination.
+type SetDenomMetadataProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata    Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *SetDenomMetadataProposal) Reset()      { *m = SetDenomMetadataProposal{} }
+func (*SetDenomMetadataProposal) ProtoMessage() {}
+func (*SetDenomMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{6}
+}
+func (m *SetDenomMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDenomMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDenomMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDenomMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDenomMetadataProposal.Merge(m, src)
+}
+func (m *SetDenomMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDenomMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDenomMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDenomMetadataProposal proto.InternalMessageInfo
+
+// SetDenomMetadataProposalWithDeposit defines a SetDenomMetadataProposal with a
+// deposit.
+type SetDenomMetadataProposalWithDeposit struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Metadata    Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
+	Deposit     string   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) Reset()         { *m = SetDenomMetadataProposalWithDeposit{} }
+func (m *SetDenomMetadataProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*SetDenomMetadataProposalWithDeposit) ProtoMessage()    {}
+func (*SetDenomMetadataProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SetDenomMetadataProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDenomMetadataProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDenomMetadataProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDenomMetadataProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDenomMetadataProposalWithDeposit.Merge(m, src)
+}
+func (m *SetDenomMetadataProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDenomMetadataProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDenomMetadataProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDenomMetadataProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetDenomMetadataProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) GetMetadata() Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return Metadata{}
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
-	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SetDenomMetadataProposal)(nil), "cosmos.bank.v1beta1.SetDenomMetadataProposal")
+	proto.RegisterType((*SetDenomMetadataProposalWithDeposit)(nil), "cosmos.bank.v1beta1.SetDenomMetadataProposalWithDeposit")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xf7, 0x35, 0x69, 0x9a, 0x5e, 0xfa, 0x5d, 0xee, 0xdb, 0xc1, 0xad, 0x84, 0x6d, 0x2c, 0x21,
-	0xa5, 0x88, 0x38, 0x04, 0xc4, 0x92, 0x05, 0x35, 0xa5, 0x42, 0x15, 0x42, 0x20, 0x57, 0x08, 0x09,
-	0x86, 0xe8, 0xe2, 0xbb, 0x06, 0xab, 0xf6, 0x9d, 0x95, 0x3b, 0xa3, 0xe6, 0x3f, 0x60, 0x64, 0x44,
-	0x62, 0xc9, 0xcc, 0x88, 0x58, 0x61, 0xee, 0x58, 0xc1, 0xc2, 0x14, 0x50, 0xb2, 0x30, 0x77, 0x64,
-	0x42, 0xbe, 0xb3, 0x93, 0x54, 0x0a, 0xa8, 0x03, 0x0b, 0x53, 0xde, 0x8f, 0xcf, 0xfb, 0x7c, 0x5e,
-	0xde, 0x7b, 0x67, 0x68, 0x05, 0x5c, 0xc4, 0x5c, 0x34, 0x7b, 0x98, 0x1d, 0x37, 0x5f, 0xb6, 0x7a,
-	0x54, 0xe2, 0x96, 0x72, 0xbc, 0x64, 0xc0, 0x25, 0x47, 0xff, 0xeb, 0xbc, 0xa7, 0x42, 0x79, 0x7e,
-	0x7b, 0xb3, 0xcf, 0xfb, 0x5c, 0xe5, 0x9b, 0x99, 0xa5, 0xa1, 0xdb, 0x5b, 0x1a, 0xda, 0xd5, 0x89,
-	0xbc, 0x4e, 0xa7, 0xe6, 0x2a, 0x82, 0xce, 0x54, 0x02, 0x1e, 0x32, 0x9d, 0x77, 0xbf, 0x00, 0x58,
-	0x79, 0x8c, 0x07, 0x38, 0x16, 0xe8, 0x08, 0x6e, 0x08, 0xca, 0x48, 0x97, 0x32, 0xdc, 0x8b, 0x28,
-	0x31, 0x81, 0x53, 0xaa, 0xd7, 0x6e, 0x39, 0xde, 0x92, 0x3e, 0xbc, 0x43, 0xca, 0xc8, 0xbe, 0xc6,
-	0x75, 0xae, 0x9e, 0x8f, 0xed, 0x2b, 0x43, 0x1c, 0x47, 0x6d, 0x77, 0xb1, 0xfe, 0x06, 0x8f, 0x43,
-	0x49, 0xe3, 0x44, 0x0e, 0x5d, 0xbf, 0x26, 0xe6, 0x78, 0xf4, 0x1c, 0x6e, 0x12, 0x7a, 0x84, 0xd3,
-	0x48, 0x76, 0x2f, 0xe8, 0xad, 0x38, 0xa0, 0x5e, 0xed, 0xec, 0x9c, 0x8f, 0xed, 0x6b, 0x9a, 0x6d,
-	0x19, 0x6a, 0x91, 0x15, 0xe5, 0x80, 0x85, 0x66, 0xda, 0xe5, 0x37, 0x23, 0xdb, 0x70, 0xef, 0xc3,
-	0xda, 0x42, 0x10, 0x6d, 0xc2, 0x55, 0x42, 0x19, 0x8f, 0x4d, 0xe0, 0x80, 0xfa, 0xba, 0xaf, 0x1d,
-	0x64, 0xc2, 0xb5, 0x0b, 0xd2, 0x7e, 0xe1, 0xb6, 0xab, 0x19, 0xc9, 0x8f, 0x91, 0x0d, 0xdc, 0x8f,
-	0x00, 0xae, 0x1e, 0xb0, 0x24, 0x95, 0xe8, 0x01, 0x5c, 0xc3, 0x84, 0x0c, 0xa8, 0x10, 0x8a, 0x65,
-	0xa3, 0xd3, 0xfa, 0x39, 0xb6, 0x1b, 0xfd, 0x50, 0xbe, 0x48, 0x7b, 0x5e, 0xc0, 0xe3, 0x7c, 0xec,
-	0xf9, 0x4f, 0x43, 0x90, 0xe3, 0xa6, 0x1c, 0x26, 0x54, 0x78, 0xbb, 0x41, 0xb0, 0xab, 0x0b, 0xfd,
-	0x82, 0x01, 0x61, 0xb8, 0x9a, 0xed, 0x40, 0x98, 0x2b, 0x6a, 0xc6, 0x5b, 0xf3, 0x19, 0x0b, 0x3a,
-	0x9b, 0xf1, 0x1e, 0x0f, 0x59, 0xe7, 0xe6, 0xe9, 0xd8, 0x36, 0xde, 0x7d, 0xb3, 0xeb, 0x97, 0x50,
-	0xca, 0x0a, 0x84, 0xaf, 0x99, 0xdb, 0x65, 0xd5, 0xff, 0x27, 0x00, 0x2b, 0x8f, 0x52, 0xf9, 0xef,
-	0xfe, 0x81, 0xf7, 0x00, 0x56, 0x0e, 0xd3, 0x24, 0x89, 0x86, 0x99, 0xa6, 0xe4, 0x12, 0x47, 0xf9,
-	0x61, 0xfe, 0x5d, 0x4d, 0xc5, 0xdc, 0xde, 0x7f, 0x35, 0xb2, 0x8d, 0x62, 0xf9, 0x9f, 0x3f, 0x34,
-	0xee, 0x5c, 0xff, 0x23, 0xc3, 0x89, 0x7e, 0xbc, 0xf4, 0x24, 0xe1, 0x03, 0x49, 0x89, 0xa7, 0x1b,
-	0x3d, 0x70, 0x9f, 0xc2, 0xf5, 0x7b, 0xd9, 0x89, 0x3d, 0x61, 0xa1, 0xfc, 0xcd, 0xf1, 0x6d, 0xc3,
-	0x6a, 0x56, 0xc6, 0x28, 0x93, 0xea, 0xfa, 0xfe, 0xf3, 0x67, 0x7e, 0x76, 0x98, 0x38, 0x0a, 0xb1,
-	0xa0, 0xc2, 0x2c, 0x39, 0xa5, 0xfa, 0xba, 0x5f, 0xb8, 0xee, 0x5b, 0x00, 0xab, 0x0f, 0xa9, 0xc4,
-	0x04, 0x4b, 0x8c, 0x1c, 0x58, 0x23, 0x54, 0x04, 0x83, 0x30, 0x91, 0x21, 0x67, 0x39, 0xfd, 0x62,
-	0x08, 0xdd, 0xcd, 0x10, 0x8c, 0xc7, 0xdd, 0x94, 0x85, 0xb2, 0xd8, 0x95, 0xb5, 0xf4, 0x41, 0xcf,
-	0xfa, 0xf5, 0x21, 0x29, 0x4c, 0x81, 0x10, 0x2c, 0x67, 0xd3, 0x35, 0x4b, 0x8a, 0x5b, 0xd9, 0x59,
-	0x77, 0x24, 0x14, 0x49, 0x84, 0x87, 0x66, 0x59, 0x85, 0x0b, 0xb7, 0xb3, 0x77, 0x3a, 0xb1, 0xc0,
-	0xd9, 0xc4, 0x02, 0xdf, 0x27, 0x16, 0x78, 0x3d, 0xb5, 0x8c, 0xb3, 0xa9, 0x65, 0x7c, 0x9d, 0x5a,
-	0xc6, 0xb3, 0x9d, 0xcb, 0x8c, 0x51, 0xed, 0xa3, 0x57, 0x51, 0xdf, 0xa5, 0xdb, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xeb, 0x92, 0xb2, 0x14, 0x1f, 0x05, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0x24, 0x69, 0x9b, 0x4e, 0xfa, 0xfb, 0x29, 0x63, 0xd1, 0xb5, 0xd0, 0xdd, 0xb8, 0xa2,
+	0xa4, 0xd0, 0x26, 0xb6, 0x5e, 0x24, 0x17, 0xe9, 0xb6, 0x22, 0x22, 0x62, 0xd9, 0x22, 0x05, 0x3d,
+	0x94, 0x49, 0x66, 0xda, 0x0e, 0xdd, 0x9d, 0x59, 0x32, 0x13, 0x69, 0xbe, 0x81, 0xde, 0x3c, 0x0a,
+	0x22, 0xf4, 0xec, 0x77, 0xd0, 0x73, 0x8f, 0x05, 0x2f, 0x9e, 0x56, 0x69, 0x2f, 0x9e, 0x73, 0xf4,
+	0x24, 0x3b, 0x93, 0xc9, 0x1f, 0x49, 0x45, 0x8f, 0x9e, 0x76, 0xde, 0x7f, 0xcf, 0xfb, 0x3c, 0xef,
+	0xbb, 0x33, 0xd0, 0x6d, 0x09, 0x19, 0x0b, 0x59, 0x6f, 0x62, 0x7e, 0x58, 0x7f, 0xb9, 0xda, 0xa4,
+	0x0a, 0xaf, 0x6a, 0xa3, 0x96, 0xb4, 0x85, 0x12, 0xe8, 0x8a, 0x89, 0xd7, 0xb4, 0xab, 0x1f, 0x5f,
+	0x98, 0xdf, 0x17, 0xfb, 0x42, 0xc7, 0xeb, 0xd9, 0xc9, 0xa4, 0x2e, 0x0c, 0xa1, 0x24, 0x1d, 0x40,
+	0xb5, 0x04, 0xe3, 0x26, 0xee, 0x7f, 0x06, 0x70, 0x7a, 0x0b, 0xb7, 0x71, 0x2c, 0xd1, 0x1e, 0x9c,
+	0x93, 0x94, 0x93, 0x5d, 0xca, 0x71, 0x33, 0xa2, 0xc4, 0x01, 0x95, 0x42, 0xb5, 0xbc, 0x56, 0xa9,
+	0x4d, 0x68, 0x56, 0xdb, 0xa6, 0x9c, 0x3c, 0x30, 0x79, 0xc1, 0x8d, 0x5e, 0xea, 0x2d, 0x76, 0x71,
+	0x1c, 0x35, 0xfc, 0xd1, 0xfa, 0x65, 0x11, 0x33, 0x45, 0xe3, 0x44, 0x75, 0xfd, 0xb0, 0x2c, 0x87,
+	0xf9, 0xe8, 0x05, 0x9c, 0x27, 0x74, 0x0f, 0x77, 0x22, 0xb5, 0x3b, 0xd6, 0x2f, 0x5f, 0x01, 0xd5,
+	0x52, 0xb0, 0xd4, 0x4b, 0xbd, 0x5b, 0x06, 0x6d, 0x52, 0xd6, 0x28, 0x2a, 0xea, 0x27, 0x8c, 0x90,
+	0x69, 0x14, 0xdf, 0x1e, 0x7b, 0x39, 0xff, 0x21, 0x2c, 0x8f, 0x38, 0xd1, 0x3c, 0x9c, 0x22, 0x94,
+	0x8b, 0xd8, 0x01, 0x15, 0x50, 0x9d, 0x0d, 0x8d, 0x81, 0x1c, 0x38, 0x33, 0xd6, 0x3a, 0xb4, 0x66,
+	0xa3, 0x94, 0x81, 0x7c, 0x3f, 0xf6, 0x80, 0xff, 0x11, 0xc0, 0xa9, 0x47, 0x3c, 0xe9, 0x28, 0xf4,
+	0x18, 0xce, 0x60, 0x42, 0xda, 0x54, 0x4a, 0x8d, 0x32, 0x17, 0xac, 0xfe, 0x48, 0xbd, 0x95, 0x7d,
+	0xa6, 0x0e, 0x3a, 0xcd, 0x5a, 0x4b, 0xc4, 0xf5, 0xfe, 0xa0, 0xcd, 0x67, 0x45, 0x92, 0xc3, 0xba,
+	0xea, 0x26, 0x54, 0xd6, 0xd6, 0x5b, 0xad, 0x75, 0x53, 0x18, 0x5a, 0x04, 0x84, 0xe1, 0x54, 0xb6,
+	0x03, 0xe9, 0xe4, 0xf5, 0x8c, 0xaf, 0x0f, 0x67, 0x2c, 0xe9, 0x60, 0xc6, 0x1b, 0x82, 0xf1, 0xe0,
+	0xce, 0x49, 0xea, 0xe5, 0x3e, 0x7c, 0xf5, 0xaa, 0x7f, 0xd0, 0x29, 0x2b, 0x90, 0xa1, 0x41, 0x6e,
+	0x14, 0x35, 0xff, 0x4f, 0x00, 0x4e, 0x3f, 0xed, 0xa8, 0x7f, 0x57, 0xc0, 0x0e, 0x9c, 0xdd, 0xcc,
+	0xb6, 0xf5, 0x8c, 0x33, 0x75, 0xc1, 0x1e, 0x17, 0x60, 0x89, 0x1e, 0x25, 0x82, 0x53, 0xae, 0xf4,
+	0x22, 0xff, 0x0b, 0x07, 0x76, 0xb6, 0x63, 0x1c, 0x31, 0x2c, 0xa9, 0x74, 0x0a, 0x95, 0x42, 0x75,
+	0x36, 0xb4, 0xa6, 0xff, 0x0e, 0xc0, 0xd2, 0x13, 0xaa, 0x30, 0xc1, 0x0a, 0xa3, 0x0a, 0x2c, 0x13,
+	0x2a, 0x5b, 0x6d, 0x96, 0x28, 0x26, 0x78, 0x1f, 0x7e, 0xd4, 0x85, 0xee, 0x67, 0x19, 0x5c, 0xc4,
+	0xbb, 0x1d, 0xce, 0x94, 0x95, 0xed, 0x4e, 0xbc, 0x1b, 0x03, 0xbe, 0x21, 0x24, 0xf6, 0x28, 0x11,
+	0x82, 0xc5, 0x6c, 0x38, 0x4e, 0x41, 0x63, 0xeb, 0x73, 0xc6, 0x8e, 0x30, 0x99, 0x44, 0xb8, 0xeb,
+	0x14, 0xb5, 0xdb, 0x9a, 0xfe, 0x7b, 0x00, 0x9d, 0x6d, 0xaa, 0x34, 0x94, 0x65, 0xb9, 0xd5, 0x16,
+	0x89, 0x90, 0x38, 0xca, 0xc6, 0xa0, 0x98, 0x8a, 0xa8, 0x1d, 0x83, 0x36, 0x7e, 0xd5, 0x90, 0x9f,
+	0xa4, 0xa1, 0x14, 0xf7, 0xb1, 0x34, 0x8d, 0xf2, 0xda, 0xe2, 0x44, 0x01, 0xb6, 0x61, 0x50, 0xcc,
+	0x76, 0x17, 0x0e, 0x8a, 0x1a, 0xa5, 0x57, 0xc7, 0x5e, 0x4e, 0x5f, 0xb0, 0xd7, 0x79, 0x78, 0xf3,
+	0x22, 0x7e, 0x3b, 0x4c, 0x1d, 0x6c, 0xd2, 0x44, 0x48, 0xa6, 0xd0, 0xed, 0x31, 0xaa, 0xc1, 0xe5,
+	0x5e, 0xea, 0xcd, 0x99, 0xcb, 0xad, 0xdd, 0xbe, 0x25, 0x7f, 0x6f, 0x02, 0xf9, 0xe0, 0x6a, 0x2f,
+	0xf5, 0x90, 0x7d, 0x0a, 0x06, 0x41, 0x7f, 0x5c, 0x54, 0xf8, 0xb7, 0xa2, 0xae, 0x65, 0xa2, 0x7a,
+	0xa9, 0x77, 0xc9, 0x20, 0xdb, 0x62, 0x7f, 0xa8, 0x13, 0x2d, 0xc3, 0x19, 0x62, 0x04, 0x98, 0xbd,
+	0x04, 0xa8, 0x97, 0x7a, 0xff, 0x5b, 0x26, 0x3a, 0xe0, 0x87, 0x36, 0x25, 0xd8, 0x38, 0x39, 0x73,
+	0xc1, 0xe9, 0x99, 0x0b, 0xbe, 0x9d, 0xb9, 0xe0, 0xcd, 0xb9, 0x9b, 0x3b, 0x3d, 0x77, 0x73, 0x5f,
+	0xce, 0xdd, 0xdc, 0xf3, 0xa5, 0xdf, 0xfe, 0xf3, 0x47, 0xe6, 0x7d, 0xd7, 0xbf, 0x7e, 0x73, 0x5a,
+	0x3f, 0xc7, 0x77, 0x7f, 0x0e, 0x00, 0xe5, 0xad, 0xfb, 0xc1, 0xfb, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -562,35 +639,6 @@ func (this *Output) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Supply) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Supply)
-	if !ok {
-		that2, ok := that.(Supply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Total) != len(that1.Total) {
-		return false
-	}
-	for i := range this.Total {
-		if !this.Total[i].Equal(&that1.Total[i]) {
-			return false
-		}
-	}
-	return true
-}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -766,43 +814,6 @@ func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Supply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
 func (m *DenomUnit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -905,6 +916,107 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -988,21 +1100,6 @@ func (m *Output) Size() (n int) {
 	return n
 }
 
-func (m *Supply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
-		}
-	}
-	return n
-}
-
 func (m *DenomUnit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1052,6 +1149,48 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SetDenomMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *SetDenomMetadataProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1512,7 +1651,7 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Supply) Unmarshal(dAtA []byte) error {
+func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1535,17 +1674,17 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1555,25 +1694,74 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1599,7 +1787,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,15 +1810,15 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1658,13 +1846,13 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
 			}
-			m.Exponent = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1674,14 +1862,29 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,7 +1912,39 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1735,7 +1970,7 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1758,13 +1993,45 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -1796,9 +2063,9 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1825,14 +2092,66 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
-			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDenomMetadataProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDenomMetadataProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDenomMetadataProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1860,11 +2179,76 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1892,7 +2276,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
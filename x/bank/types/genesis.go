@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,7 +43,11 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return NewSupply(data.Supply).ValidateBasic()
+	if err := data.Supply.Validate(); err != nil {
+		return fmt.Errorf("invalid total supply: %w", err)
+	}
+
+	return nil
 }
 
 // NewGenesisState creates a new genesis state.
@@ -57,7 +62,7 @@ func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomM
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, DefaultSupply().GetTotal(), []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.NewCoins(), []Metadata{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
@@ -23,7 +23,7 @@ const (
 // KVStore keys
 var (
 	BalancesPrefix      = []byte("balances")
-	SupplyKey           = []byte{0x00}
+	SupplyKey           = []byte{0x00} // prefix for each denom's total supply
 	DenomMetadataPrefix = []byte{0x1}
 )
 
@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   bool
+	}{
+		{
+			"non-empty coins",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), []string{"milliatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			false,
+		},
+		{"empty metadata", types.Metadata{}, true},
+		{
+			"invalid base denom",
+			types.Metadata{Base: "", Display: "atom"},
+			true,
+		},
+		{
+			"invalid display denom",
+			types.Metadata{Base: "uatom", Display: ""},
+			true,
+		},
+		{
+			"no denom units",
+			types.Metadata{Base: "uatom", Display: "atom"},
+			true,
+		},
+		{
+			"base denom not first unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"matom", uint32(0), nil},
+					{"uatom", uint32(3), nil},
+				},
+				Base:    "uatom",
+				Display: "matom",
+			},
+			true,
+		},
+		{
+			"non-zero base exponent",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(1), nil},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"unsorted exponents",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), nil},
+					{"atom", uint32(6), nil},
+					{"matom", uint32(3), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), nil},
+					{"uatom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"duplicate alias",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"atom", uint32(6), []string{"microatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"blank alias",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{" "}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"display denom not a unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), nil},
+					{"matom", uint32(3), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetDenomMetadata defines the type for a SetDenomMetadataProposal
+	ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+)
+
+// Assert SetDenomMetadataProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetDenomMetadataProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(&SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal")
+}
+
+// NewSetDenomMetadataProposal creates a new proposal registering or updating
+// the metadata of a coin denomination.
+func NewSetDenomMetadataProposal(title, description string, metadata Metadata) *SetDenomMetadataProposal {
+	return &SetDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) GetTitle() string { return sdmp.Title }
+
+// GetDescription returns the description of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) GetDescription() string { return sdmp.Description }
+
+// ProposalRoute returns the routing key of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (sdmp *SetDenomMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sdmp); err != nil {
+		return err
+	}
+
+	if err := sdmp.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sdmp SetDenomMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  Metadata:
+    Base:        %s
+    Display:     %s
+    Description: %s
+    Denom Units: %v
+`, sdmp.Title, sdmp.Description, sdmp.Metadata.Base, sdmp.Metadata.Display, sdmp.Metadata.Description, sdmp.Metadata.DenomUnits))
+	return b.String()
+}
@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestSetDenomMetadataProposal(t *testing.T) {
+	metadata := types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{"uatom", uint32(0), []string{"microatom"}},
+			{"atom", uint32(6), nil},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	p := types.NewSetDenomMetadataProposal("title", "description", metadata)
+	require.Equal(t, "title", p.GetTitle())
+	require.Equal(t, "description", p.GetDescription())
+	require.Equal(t, types.RouterKey, p.ProposalRoute())
+	require.Equal(t, types.ProposalTypeSetDenomMetadata, p.ProposalType())
+	require.True(t, govtypes.IsValidProposalType(p.ProposalType()))
+	require.NoError(t, p.ValidateBasic())
+	require.True(t, strings.Contains(p.String(), "uatom"))
+
+	// invalid title
+	p = types.NewSetDenomMetadataProposal("", "description", metadata)
+	require.Error(t, p.ValidateBasic())
+
+	// invalid metadata
+	invalidMetadata := metadata
+	invalidMetadata.Display = "matom"
+	p = types.NewSetDenomMetadataProposal("title", "description", invalidMetadata)
+	require.Error(t, p.ValidateBasic())
+}
@@ -5,11 +5,16 @@ package types
 
 import (
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -87,6 +92,43 @@ func (m *MsgSend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// MsgSendResponse defines the Msg/Send response type.
+type MsgSendResponse struct {
+}
+
+func (m *MsgSendResponse) Reset()         { *m = MsgSendResponse{} }
+func (m *MsgSendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendResponse) ProtoMessage()    {}
+func (*MsgSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{1}
+}
+func (m *MsgSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendResponse.Merge(m, src)
+}
+func (m *MsgSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendResponse proto.InternalMessageInfo
+
 // MsgMultiSend represents an arbitrary multi-in, multi-out send message.
 type MsgMultiSend struct {
 	Inputs  []Input  `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
@@ -97,7 +139,7 @@ func (m *MsgMultiSend) Reset()         { *m = MsgMultiSend{} }
 func (m *MsgMultiSend) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiSend) ProtoMessage()    {}
 func (*MsgMultiSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d8cb1613481f5b7, []int{1}
+	return fileDescriptor_1d8cb1613481f5b7, []int{2}
 }
 func (m *MsgMultiSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,39 +182,82 @@ func (m *MsgMultiSend) GetOutputs() []Output {
 	return nil
 }
 
+// MsgMultiSendResponse defines the Msg/MultiSend response type.
+type MsgMultiSendResponse struct {
+}
+
+func (m *MsgMultiSendResponse) Reset()         { *m = MsgMultiSendResponse{} }
+func (m *MsgMultiSendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendResponse) ProtoMessage()    {}
+func (*MsgMultiSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{3}
+}
+func (m *MsgMultiSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendResponse.Merge(m, src)
+}
+func (m *MsgMultiSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
+	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
 	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.v1beta1.MsgMultiSend")
+	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x0e, 0x6e, 0x50, 0x71, 0x2a, 0xdc, 0xa0, 0xe4, 0xfc, 0xcc, 0x3c, 0x0c, 0x79, 0x24, 0x8b, 0xc0,
-	0xe6, 0x82, 0xe5, 0x95, 0x4e, 0x30, 0x71, 0xb1, 0xfb, 0x16, 0xa7, 0x07, 0xa7, 0xe6, 0xa5, 0x08,
-	0x65, 0x73, 0xf1, 0xa4, 0x15, 0xe5, 0xe7, 0xc6, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b,
-	0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x38, 0x79, 0x7c, 0xba, 0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63,
-	0xa5, 0x84, 0x2c, 0xab, 0xf4, 0xeb, 0x9e, 0xbc, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x1e, 0x08, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90,
-	0x5a, 0xac, 0xe7, 0x98, 0x9c, 0xec, 0x08, 0xd1, 0x11, 0xc4, 0x0d, 0xd2, 0x0f, 0xe5, 0x08, 0xa5,
-	0x72, 0x71, 0x95, 0xe4, 0xc3, 0xad, 0x62, 0x02, 0x5b, 0xe5, 0xf6, 0xe9, 0x9e, 0xbc, 0x20, 0xc4,
-	0x2a, 0x84, 0x1c, 0x19, 0x16, 0x71, 0x96, 0xe4, 0xc3, 0xac, 0x49, 0xe6, 0x62, 0x4b, 0xcc, 0xcd,
-	0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0x87, 0x6d, 0x71,
-	0x2a, 0x2c, 0x6c, 0xf5, 0x9c, 0xf3, 0x33, 0xf3, 0x9c, 0x0c, 0x4e, 0xdc, 0x93, 0x67, 0x58, 0x75,
-	0x5f, 0x5e, 0x83, 0x08, 0xcb, 0x40, 0x1a, 0x8a, 0x83, 0xa0, 0x46, 0x5b, 0xb1, 0xbc, 0x58, 0x20,
-	0xcf, 0xa8, 0xd4, 0xcd, 0xc8, 0xc5, 0xe3, 0x5b, 0x9c, 0xee, 0x5b, 0x9a, 0x53, 0x92, 0x09, 0x0e,
-	0x4f, 0x0b, 0x2e, 0xb6, 0xcc, 0xbc, 0x82, 0xd2, 0x12, 0x50, 0x48, 0x82, 0xec, 0x96, 0xd2, 0xc3,
-	0x12, 0xaf, 0x7a, 0x9e, 0x20, 0x25, 0x4e, 0x2c, 0x20, 0xcb, 0x83, 0xa0, 0xea, 0x85, 0xac, 0xb9,
-	0xd8, 0xf3, 0x4b, 0x4b, 0xc0, 0x5a, 0x99, 0xc0, 0x5a, 0xa5, 0xb1, 0x6a, 0xf5, 0x07, 0xab, 0x81,
-	0xea, 0x85, 0xe9, 0x80, 0xb8, 0xc6, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0x34, 0xf1, 0xfa, 0xaf, 0x02, 0x92, 0x54, 0xc0, 0xde, 0x4c, 0x62, 0x03, 0x27, 0x12,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x04, 0xdf, 0x97, 0xaf, 0x02, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xf6, 0x25, 0x51, 0xaa, 0x5e, 0x23, 0xa1, 0xba, 0x15, 0x2a, 0xa6, 0xb2, 0x8b, 0xc5, 0x90,
+	0x0e, 0x3d, 0xd3, 0xb2, 0xa0, 0x32, 0xd5, 0x95, 0x10, 0x20, 0x59, 0x48, 0x66, 0x82, 0x05, 0xf9,
+	0xe3, 0x30, 0x56, 0x6a, 0xbf, 0x56, 0xee, 0x8c, 0xda, 0xdf, 0xc0, 0xc2, 0x4f, 0x60, 0x46, 0xfc,
+	0x90, 0x8c, 0x19, 0x99, 0x0c, 0x4a, 0x16, 0xe6, 0x8c, 0x4c, 0xe8, 0xce, 0x67, 0x27, 0x12, 0x21,
+	0x20, 0x31, 0xd9, 0xd6, 0xf3, 0x3e, 0x1f, 0xf7, 0xbc, 0x3e, 0x7c, 0x18, 0x01, 0xcb, 0x80, 0x39,
+	0x61, 0x90, 0x8f, 0x9c, 0xf7, 0xa7, 0x21, 0xe5, 0xc1, 0xa9, 0xc3, 0xaf, 0x49, 0x31, 0x06, 0x0e,
+	0xfa, 0x5e, 0x8d, 0x12, 0x81, 0x12, 0x85, 0x1a, 0xfb, 0x09, 0x24, 0x20, 0x71, 0x47, 0xbc, 0xd5,
+	0xa3, 0x86, 0xd9, 0x0a, 0x31, 0xda, 0x0a, 0x45, 0x90, 0xe6, 0xbf, 0xe1, 0x2b, 0x46, 0x52, 0x57,
+	0xe2, 0xf6, 0xa4, 0x83, 0xb7, 0x3c, 0x96, 0xbc, 0xa4, 0x79, 0xac, 0x8f, 0xf0, 0xe0, 0xed, 0x18,
+	0xb2, 0x37, 0x41, 0x1c, 0x8f, 0x29, 0x63, 0x07, 0xe8, 0x08, 0x0d, 0x07, 0xee, 0xd3, 0x45, 0x65,
+	0xed, 0xdd, 0x04, 0xd9, 0xd5, 0xb9, 0xbd, 0x8a, 0xda, 0x3f, 0x2b, 0xeb, 0x24, 0x49, 0xf9, 0xbb,
+	0x32, 0x24, 0x11, 0x64, 0x8e, 0xf2, 0xa9, 0x1f, 0x27, 0x2c, 0x1e, 0x39, 0xfc, 0xa6, 0xa0, 0x8c,
+	0x5c, 0x44, 0xd1, 0x45, 0xcd, 0xf0, 0x77, 0x04, 0x5f, 0x7d, 0xe8, 0x14, 0x63, 0x0e, 0xad, 0x55,
+	0x47, 0x5a, 0x3d, 0x59, 0x54, 0xd6, 0x6e, 0x6d, 0xc5, 0xe1, 0x3f, 0x8c, 0xb6, 0x39, 0x34, 0x36,
+	0x11, 0xee, 0x07, 0x19, 0x94, 0x39, 0x3f, 0xe8, 0x1e, 0x75, 0x87, 0x3b, 0x67, 0x77, 0x48, 0xdb,
+	0x2d, 0xa3, 0x4d, 0xb7, 0xe4, 0x12, 0xd2, 0xdc, 0x7d, 0x30, 0xa9, 0x2c, 0xed, 0xf3, 0x37, 0x6b,
+	0xf8, 0x0f, 0x66, 0x82, 0xc0, 0x7c, 0x25, 0x7d, 0xde, 0xfb, 0xf1, 0xc9, 0x42, 0xf6, 0x2e, 0xbe,
+	0xa5, 0x9a, 0xf4, 0x29, 0x2b, 0x20, 0x67, 0xd4, 0xfe, 0x80, 0xf0, 0xc0, 0x63, 0x89, 0x57, 0x5e,
+	0xf1, 0x54, 0x56, 0xfc, 0x08, 0xf7, 0xd3, 0xbc, 0x28, 0xb9, 0x28, 0x57, 0xc4, 0x31, 0xc8, 0x9a,
+	0x55, 0x93, 0x67, 0x62, 0xc4, 0xed, 0x89, 0x3c, 0xbe, 0x9a, 0xd7, 0x1f, 0xe3, 0x2d, 0x28, 0xb9,
+	0xa4, 0x76, 0x24, 0xf5, 0xee, 0x5a, 0xea, 0x8b, 0x92, 0x2f, 0xb9, 0x0d, 0x43, 0x05, 0xbc, 0x8d,
+	0xf7, 0x57, 0xc3, 0x34, 0x29, 0xcf, 0xbe, 0x20, 0xdc, 0xf5, 0x58, 0xa2, 0x3f, 0xc7, 0x3d, 0x19,
+	0xf2, 0x70, 0xad, 0xb2, 0x3a, 0x9b, 0x71, 0x7f, 0x13, 0xda, 0x68, 0xea, 0xaf, 0xf0, 0xf6, 0xf2,
+	0xd4, 0xf7, 0xfe, 0x44, 0x69, 0x47, 0x8c, 0xe3, 0xbf, 0x8e, 0x34, 0xd2, 0xee, 0xe5, 0x64, 0x66,
+	0xa2, 0xe9, 0xcc, 0x44, 0xdf, 0x67, 0x26, 0xfa, 0x38, 0x37, 0xb5, 0xe9, 0xdc, 0xd4, 0xbe, 0xce,
+	0x4d, 0xed, 0xf5, 0xf1, 0xc6, 0xcd, 0x5d, 0xd7, 0x97, 0x40, 0x2e, 0x30, 0xec, 0xcb, 0xdf, 0xff,
+	0xe1, 0xaf, 0x01, 0x00, 0x63, 0x91, 0x85, 0x9e, 0x89, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgSend) Equal(that interface{}) bool {
@@ -247,6 +332,127 @@ func (this *MsgMultiSend) Equal(that interface{}) bool {
 	}
 	return true
 }
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Send defines a method for sending coins from one account to another account.
+	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other accounts.
+	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
+	out := new(MsgSendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
+	out := new(MsgMultiSendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/MultiSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Send defines a method for sending coins from one account to another account.
+	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other accounts.
+	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/MultiSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _Msg_Send_Handler,
+		},
+		{
+			MethodName: "MultiSend",
+			Handler:    _Msg_MultiSend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/bank/v1beta1/tx.proto",
+}
+
 func (m *MsgSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -298,6 +504,29 @@ func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -349,6 +578,29 @@ func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -383,6 +635,15 @@ func (m *MsgSend) Size() (n int) {
 	return n
 }
 
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgMultiSend) Size() (n int) {
 	if m == nil {
 		return 0
@@ -404,6 +665,15 @@ func (m *MsgMultiSend) Size() (n int) {
 	return n
 }
 
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -565,6 +835,59 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -686,6 +1009,59 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
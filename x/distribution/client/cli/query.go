@@ -32,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryFundingStream(),
+		GetCmdQueryFundingStreams(),
 	)
 
 	return distQueryCmd
@@ -325,3 +327,92 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryFundingStream implements the query community pool funding stream command.
+func GetCmdQueryFundingStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool funding stream by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a community pool funding stream created by governance.
+
+Example:
+$ %s query distribution funding-stream 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := queryClient.FundingStream(
+				context.Background(),
+				&types.QueryFundingStreamRequest{StreamId: streamID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Stream)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFundingStreams implements the query all community pool funding streams command.
+func GetCmdQueryFundingStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all active community pool funding streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all active community pool funding streams created by governance.
+
+Example:
+$ %s query distribution funding-streams
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FundingStreams(
+				context.Background(),
+				&types.QueryFundingStreamsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funding streams")
+	return cmd
+}
@@ -22,3 +22,19 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONMarshaler, proposa
 
 	return proposal, nil
 }
+
+// ParseCreateFundingStreamProposalWithDeposit reads and parses a CreateFundingStreamProposalWithDeposit from a file.
+func ParseCreateFundingStreamProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.CreateFundingStreamProposalWithDeposit, error) {
+	proposal := types.CreateFundingStreamProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
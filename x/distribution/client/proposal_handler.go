@@ -6,7 +6,11 @@ import (
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 )
 
-// ProposalHandler is the community spend proposal handler.
 var (
+	// ProposalHandler is the community spend proposal handler.
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	// CreateFundingStreamProposalHandler is the create community pool funding stream proposal handler.
+	CreateFundingStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCreateFundingStreamProposal, rest.CreateFundingStreamProposalRESTHandler)
+	// CancelFundingStreamProposalHandler is the cancel community pool funding stream proposal handler.
+	CancelFundingStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelFundingStreamProposal, rest.CancelFundingStreamProposalRESTHandler)
 )
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
@@ -17,4 +19,29 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CreateFundingStreamProposalReq defines a create funding stream proposal request body.
+	CreateFundingStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title          string         `json:"title" yaml:"title"`
+		Description    string         `json:"description" yaml:"description"`
+		Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount         sdk.Coins      `json:"amount" yaml:"amount"`
+		PayoutInterval uint64         `json:"payout_interval" yaml:"payout_interval"`
+		Expiry         time.Time      `json:"expiry" yaml:"expiry"`
+		Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelFundingStreamProposalReq defines a cancel funding stream proposal request body.
+	CancelFundingStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		StreamID    uint64         `json:"stream_id" yaml:"stream_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
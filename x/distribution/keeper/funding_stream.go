@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// CreateFundingStream creates a funding stream paying out the given amount
+// from the community pool to the recipient every payout interval until the
+// expiry. The first payout happens one payout interval after the creation.
+func (k Keeper) CreateFundingStream(
+	ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, payoutInterval uint64, expiry time.Time,
+) (types.FundingStream, error) {
+	if err := types.ValidateFundingStreamTerms(recipient, amount, payoutInterval); err != nil {
+		return types.FundingStream{}, err
+	}
+	if k.blockedAddrs[recipient.String()] {
+		return types.FundingStream{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return types.FundingStream{}, sdkerrors.Wrapf(types.ErrInvalidFundingStream, "expiry %s is not after the block time", expiry)
+	}
+
+	id := k.GetNextFundingStreamID(ctx)
+	stream := types.NewFundingStream(id, recipient, amount, payoutInterval, ctx.BlockHeight()+int64(payoutInterval), expiry)
+
+	k.SetFundingStream(ctx, stream)
+	k.SetNextFundingStreamID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateFundingStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return stream, nil
+}
+
+// CancelFundingStream removes a funding stream, stopping its payouts.
+func (k Keeper) CancelFundingStream(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetFundingStream(ctx, id); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFundingStream, "%d", id)
+	}
+
+	k.DeleteFundingStream(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelFundingStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+		),
+	)
+
+	return nil
+}
+
+// PayFundingStreams removes the expired funding streams and pays out the
+// funding streams which are due from the community pool. A payout which the
+// community pool cannot cover is skipped, emitting a skip event.
+func (k Keeper) PayFundingStreams(ctx sdk.Context) {
+	var expired, due []types.FundingStream
+
+	k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+		switch {
+		case stream.IsExpired(ctx.BlockTime()):
+			expired = append(expired, stream)
+		case ctx.BlockHeight() >= stream.NextPayoutHeight:
+			due = append(due, stream)
+		}
+		return false
+	})
+
+	for _, stream := range expired {
+		k.DeleteFundingStream(ctx, stream.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundingStreamExpiry,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			),
+		)
+	}
+
+	for _, stream := range due {
+		// payouts missed while the chain was halted are not caught up
+		stream.NextPayoutHeight = ctx.BlockHeight() + int64(stream.PayoutInterval)
+
+		if err := k.DistributeFromFeePool(ctx, stream.Amount, stream.Recipient); err != nil {
+			k.Logger(ctx).Info(
+				fmt.Sprintf("skipped payout of %s to recipient %s from funding stream %d: %s", stream.Amount, stream.Recipient, stream.Id, err),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeFundingStreamSkip,
+					sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+				),
+			)
+		} else {
+			stream.Paid = stream.Paid.Add(stream.Amount...)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeFundingStreamPayout,
+					sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+				),
+			)
+		}
+
+		k.SetFundingStream(ctx, stream)
+	}
+}
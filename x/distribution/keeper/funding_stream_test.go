@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestCreateFundingStream(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	expiry := now.Add(time.Hour)
+
+	_, err := app.DistrKeeper.CreateFundingStream(ctx, addr[0], sdk.NewCoins(), 5, expiry)
+	require.Error(t, err)
+
+	_, err = app.DistrKeeper.CreateFundingStream(ctx, addr[0], amount, 0, expiry)
+	require.Error(t, err)
+
+	_, err = app.DistrKeeper.CreateFundingStream(ctx, addr[0], amount, 5, now)
+	require.Error(t, err)
+
+	_, err = app.DistrKeeper.CreateFundingStream(ctx, distrAcc.GetAddress(), amount, 5, expiry)
+	require.Error(t, err)
+
+	require.Equal(t, uint64(1), app.DistrKeeper.GetNextFundingStreamID(ctx))
+
+	stream, err := app.DistrKeeper.CreateFundingStream(ctx, addr[0], amount, 5, expiry)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stream.Id)
+	require.Equal(t, int64(15), stream.NextPayoutHeight)
+	require.Equal(t, uint64(2), app.DistrKeeper.GetNextFundingStreamID(ctx))
+
+	stored, found := app.DistrKeeper.GetFundingStream(ctx, stream.Id)
+	require.True(t, found)
+	require.Equal(t, stream.Recipient, stored.Recipient)
+	require.Equal(t, stream.Amount, stored.Amount)
+	require.True(t, stream.Expiry.Equal(stored.Expiry))
+}
+
+func TestPayFundingStreams(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	recipient := sdk.AccAddress([]byte("funding-stream-recipient"))
+
+	poolAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, poolAmount, addr[0]))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	stream, err := app.DistrKeeper.CreateFundingStream(ctx, recipient, amount, 2, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// an unaffordable stream is skipped without affecting the others
+	unaffordable, err := app.DistrKeeper.CreateFundingStream(ctx, addr[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), 2, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// nothing is due before the first payout interval elapses
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+	ctx = ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("stake", 90)), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+
+	stream, found := app.DistrKeeper.GetFundingStream(ctx, stream.Id)
+	require.True(t, found)
+	require.Equal(t, amount, stream.Paid)
+	require.Equal(t, int64(14), stream.NextPayoutHeight)
+
+	unaffordable, found = app.DistrKeeper.GetFundingStream(ctx, unaffordable.Id)
+	require.True(t, found)
+	require.True(t, unaffordable.Paid.IsZero())
+	require.Equal(t, int64(14), unaffordable.NextPayoutHeight)
+
+	var skipped []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFundingStreamSkip {
+			skipped = append(skipped, event)
+		}
+	}
+	require.Len(t, skipped, 1)
+	require.Equal(t, []byte(fmt.Sprintf("%d", unaffordable.Id)), skipped[0].Attributes[0].Value)
+
+	invariant := keeper.FundingStreamsInvariant(app.DistrKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// expired streams are removed without a final payout
+	ctx = ctx.WithBlockHeight(14).WithBlockTime(now.Add(time.Hour))
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Empty(t, app.DistrKeeper.GetAllFundingStreams(ctx))
+}
+
+func TestCancelFundingStream(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	require.True(t, types.ErrUnknownFundingStream.Is(app.DistrKeeper.CancelFundingStream(ctx, 1)))
+
+	stream, err := app.DistrKeeper.CreateFundingStream(ctx, addr[0], amount, 5, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	require.NoError(t, app.DistrKeeper.CancelFundingStream(ctx, stream.Id))
+	_, found := app.DistrKeeper.GetFundingStream(ctx, stream.Id)
+	require.False(t, found)
+
+	// ids of cancelled streams are not reused
+	require.Equal(t, uint64(2), app.DistrKeeper.GetNextFundingStreamID(ctx))
+}
+
+func TestFundingStreamsGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	stream, err := app.DistrKeeper.CreateFundingStream(ctx, addr[0], amount, 5, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(15), stream.NextPayoutHeight)
+
+	// exported at height 12, the next payout is 2 blocks after the first
+	// block of the restarted chain
+	ctx = ctx.WithBlockHeight(12)
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.FundingStreams, 1)
+	require.Equal(t, int64(2), genState.FundingStreams[0].NextPayoutHeight)
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	testCases := []struct {
+		name       string
+		initHeight int64
+		expHeight  int64
+	}{
+		{"zero height genesis", 0, 3},
+		{"genesis at the next height", 13, 15},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: tc.initHeight, Time: now})
+
+			app.DistrKeeper.InitGenesis(ctx, *genState)
+
+			stored, found := app.DistrKeeper.GetFundingStream(ctx, stream.Id)
+			require.True(t, found)
+			require.Equal(t, tc.expHeight, stored.NextPayoutHeight)
+			require.Equal(t, uint64(2), app.DistrKeeper.GetNextFundingStreamID(ctx))
+		})
+	}
+}
@@ -39,6 +39,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, evt := range data.ValidatorSlashEvents {
 		k.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, stream := range data.FundingStreams {
+		// genesis payout heights are relative to the first block of the chain
+		stream.NextPayoutHeight += firstBlockHeight(ctx)
+		k.SetFundingStream(ctx, stream)
+	}
+	if data.NextFundingStreamId != 0 {
+		k.SetNextFundingStreamID(ctx, data.NextFundingStreamId)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -145,5 +153,29 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	streams := make([]types.FundingStream, 0)
+	k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+		// the exported chain resumes at the block after the current one, or
+		// at the initial height of the chain for a zero height genesis
+		stream.NextPayoutHeight -= ctx.BlockHeight() + 1
+		if stream.NextPayoutHeight < 0 {
+			stream.NextPayoutHeight = 0
+		}
+		streams = append(streams, stream)
+		return false
+	})
+
+	return types.NewGenesisState(
+		params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, streams, k.GetNextFundingStreamID(ctx),
+	)
+}
+
+// firstBlockHeight returns the height of the first block run after InitGenesis.
+// InitChain runs at the initial height of the chain, or at height zero for a
+// chain starting at height one.
+func firstBlockHeight(ctx sdk.Context) int64 {
+	if ctx.BlockHeight() < 1 {
+		return 1
+	}
+	return ctx.BlockHeight()
 }
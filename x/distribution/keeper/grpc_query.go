@@ -213,3 +213,50 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// FundingStream queries a community pool funding stream by id
+func (k Keeper) FundingStream(c context.Context, req *types.QueryFundingStreamRequest) (*types.QueryFundingStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.StreamId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "stream id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, found := k.GetFundingStream(ctx, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "funding stream %d doesn't exist", req.StreamId)
+	}
+
+	return &types.QueryFundingStreamResponse{Stream: stream}, nil
+}
+
+// FundingStreams queries all the community pool funding streams
+func (k Keeper) FundingStreams(c context.Context, req *types.QueryFundingStreamsRequest) (*types.QueryFundingStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	streams := make([]types.FundingStream, 0)
+	store := ctx.KVStore(k.storeKey)
+	streamsStore := prefix.NewStore(store, types.FundingStreamPrefix)
+
+	pageRes, err := query.Paginate(streamsStore, req.Pagination, func(key []byte, value []byte) error {
+		var stream types.FundingStream
+		if err := k.cdc.UnmarshalBinaryBare(value, &stream); err != nil {
+			return err
+		}
+
+		streams = append(streams, stream)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFundingStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
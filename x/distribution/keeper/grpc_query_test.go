@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -657,6 +658,41 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCFundingStreams() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.FundingStream(gocontext.Background(), &types.QueryFundingStreamRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.FundingStream(gocontext.Background(), &types.QueryFundingStreamRequest{StreamId: 1})
+	suite.Require().Error(err)
+
+	streams, err := queryClient.FundingStreams(gocontext.Background(), &types.QueryFundingStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(streams.Streams)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	expiry := ctx.BlockTime().Add(time.Hour)
+	for _, addr := range addrs {
+		_, err := app.DistrKeeper.CreateFundingStream(ctx, addr, amount, 5, expiry)
+		suite.Require().NoError(err)
+	}
+
+	stream, err := queryClient.FundingStream(gocontext.Background(), &types.QueryFundingStreamRequest{StreamId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), stream.Stream.Id)
+	suite.Require().Equal(addrs[1], stream.Stream.Recipient)
+	suite.Require().Equal(amount, stream.Stream.Amount)
+
+	streams, err = queryClient.FundingStreams(gocontext.Background(), &types.QueryFundingStreamsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(streams.Streams, 1)
+	suite.Require().Equal(uint64(1), streams.Streams[0].Id)
+	suite.Require().Equal(uint64(len(addrs)), streams.Pagination.Total)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
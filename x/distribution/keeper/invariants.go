@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "funding-streams",
+		FundingStreamsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,7 +37,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return FundingStreamsInvariant(k)(ctx)
 	}
 }
 
@@ -160,3 +166,29 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// FundingStreamsInvariant checks that all the community pool funding streams
+// are valid and that their ids are lower than the id of the next funding stream
+func FundingStreamsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		nextID := k.GetNextFundingStreamID(ctx)
+		k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+			if err := stream.Validate(); err != nil {
+				msg += fmt.Sprintf("\tfunding stream %d is invalid: %s\n", stream.Id, err)
+				broken = true
+			}
+			if stream.Id >= nextID {
+				msg += fmt.Sprintf("\tfunding stream %d has an id greater than or equal to the next funding stream id %d\n", stream.Id, nextID)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "funding streams", msg), broken
+	}
+}
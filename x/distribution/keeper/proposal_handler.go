@@ -23,3 +23,26 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleCreateFundingStreamProposal is a handler for executing a passed create funding stream proposal
+func HandleCreateFundingStreamProposal(ctx sdk.Context, k Keeper, p *types.CreateFundingStreamProposal) error {
+	stream, err := k.CreateFundingStream(ctx, p.Recipient, p.Amount, p.PayoutInterval, p.Expiry)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("created funding stream %d paying %s every %d blocks to recipient %s", stream.Id, p.Amount, p.PayoutInterval, p.Recipient))
+	return nil
+}
+
+// HandleCancelFundingStreamProposal is a handler for executing a passed cancel funding stream proposal
+func HandleCancelFundingStreamProposal(ctx sdk.Context, k Keeper, p *types.CancelFundingStreamProposal) error {
+	if err := k.CancelFundingStream(ctx, p.StreamId); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled funding stream %d", p.StreamId))
+	return nil
+}
@@ -382,3 +382,66 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get a community pool funding stream
+func (k Keeper) GetFundingStream(ctx sdk.Context, id uint64) (stream types.FundingStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetFundingStreamKey(id))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &stream)
+	return stream, true
+}
+
+// set a community pool funding stream
+func (k Keeper) SetFundingStream(ctx sdk.Context, stream types.FundingStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&stream)
+	store.Set(types.GetFundingStreamKey(stream.Id), b)
+}
+
+// delete a community pool funding stream
+func (k Keeper) DeleteFundingStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFundingStreamKey(id))
+}
+
+// iterate over community pool funding streams, ordered by id
+func (k Keeper) IterateFundingStreams(ctx sdk.Context, handler func(stream types.FundingStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FundingStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.FundingStream
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// get all community pool funding streams, ordered by id
+func (k Keeper) GetAllFundingStreams(ctx sdk.Context) (streams []types.FundingStream) {
+	k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// get the id of the next funding stream, defaulting to 1
+func (k Keeper) GetNextFundingStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.FundingStreamIDKey)
+	if b == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// set the id of the next funding stream
+func (k Keeper) SetNextFundingStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FundingStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
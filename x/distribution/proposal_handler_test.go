@@ -2,6 +2,7 @@ package distribution_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -71,3 +72,28 @@ func TestProposalHandlerFailed(t *testing.T) {
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
 }
+
+func TestFundingStreamProposalHandlers(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
+
+	create := types.NewCreateFundingStreamProposal("Test", "description", delAddr1, amount, 10, now.Add(time.Hour))
+	require.NoError(t, hdlr(ctx, create))
+
+	stream, found := app.DistrKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, delAddr1, stream.Recipient)
+	require.Equal(t, amount, stream.Amount)
+
+	expired := types.NewCreateFundingStreamProposal("Test", "description", delAddr1, amount, 10, now)
+	require.Error(t, hdlr(ctx, expired))
+
+	require.NoError(t, hdlr(ctx, types.NewCancelFundingStreamProposal("Test", "description", stream.Id)))
+	_, found = app.DistrKeeper.GetFundingStream(ctx, stream.Id)
+	require.False(t, found)
+
+	require.Error(t, hdlr(ctx, types.NewCancelFundingStreamProposal("Test", "description", stream.Id)))
+}
@@ -63,6 +63,15 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.FundingStreamPrefix):
+			var streamA, streamB types.FundingStream
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &streamA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &streamB)
+			return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+		case bytes.Equal(kvA.Key[:1], types.FundingStreamIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
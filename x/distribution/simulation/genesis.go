@@ -74,6 +74,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
 		},
+		FundingStreams:      []types.FundingStream{},
+		NextFundingStreamId: types.DefaultNextFundingStreamID,
 	}
 
 	bz, err := json.MarshalIndent(&distrGenesis, "", " ")
@@ -22,6 +22,7 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgWithdrawTokenizeShareReward = "op_weight_msg_withdraw_tokenize_share_reward"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -58,6 +59,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgWithdrawTokenizeShareReward int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawTokenizeShareReward, &weightMsgWithdrawTokenizeShareReward, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawTokenizeShareReward = simappparams.DefaultWeightMsgWithdrawTokenizeShareReward
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -75,6 +83,10 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawTokenizeShareReward,
+			SimulateMsgWithdrawTokenizeShareRecordReward(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -231,6 +243,53 @@ func SimulateMsgWithdrawValidatorCommission(ak types.AccountKeeper, bk types.Ban
 	}
 }
 
+// SimulateMsgWithdrawTokenizeShareRecordReward generates a
+// MsgWithdrawTokenizeShareRecordReward for an account owning tokenize share
+// records.
+func SimulateMsgWithdrawTokenizeShareRecordReward(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		if len(sk.GetTokenizeShareRecordsByOwner(ctx, simAccount.Address)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "account does not own tokenize share records"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(simAccount.Address)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgFundCommunityPool simulates MsgFundCommunityPool execution where
 // a random account sends a random amount of its funds to the community pool.
 func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
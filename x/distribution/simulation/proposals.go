@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,8 +12,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
-const OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+// Simulation operation weights constants
+const (
+	OpWeightSubmitCommunitySpendProposal      = "op_weight_submit_community_spend_proposal"
+	OpWeightSubmitCreateFundingStreamProposal = "op_weight_submit_create_funding_stream_proposal"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
@@ -22,6 +26,11 @@ func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
 			simappparams.DefaultWeightCommunitySpendProposal,
 			SimulateCommunityPoolSpendProposalContent(k),
 		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitCreateFundingStreamProposal,
+			simappparams.DefaultWeightCreateFundingStreamProposal,
+			SimulateCreateFundingStreamProposalContent(k),
+		),
 	}
 }
 
@@ -49,3 +58,34 @@ func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) simtypes.Content
 		)
 	}
 }
+
+// SimulateCreateFundingStreamProposalContent generates random create-funding-stream proposal content
+func SimulateCreateFundingStreamProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		balance := k.GetFeePool(ctx).CommunityPool
+		if balance.Empty() {
+			return nil
+		}
+
+		// pay out at most a hundredth of the community pool balance per payout
+		denomIndex := r.Intn(len(balance))
+		amount, err := simtypes.RandPositiveInt(r, balance[denomIndex].Amount.QuoInt64(100).TruncateInt())
+		if err != nil {
+			return nil
+		}
+
+		payoutInterval := uint64(simtypes.RandIntBetween(r, 1, 100))
+		expiry := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 7*24)) * time.Hour)
+
+		return types.NewCreateFundingStreamProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			simAccount.Address,
+			sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount)),
+			payoutInterval,
+			expiry,
+		)
+	}
+}
@@ -26,7 +26,7 @@ func TestProposalContents(t *testing.T) {
 
 	// execute ProposalContents function
 	weightedProposalContent := simulation.ProposalContents(app.DistrKeeper)
-	require.Len(t, weightedProposalContent, 1)
+	require.Len(t, weightedProposalContent, 2)
 
 	w0 := weightedProposalContent[0]
 
@@ -46,4 +46,19 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, "xKGLwQvuyN", content.GetTitle())
 	require.Equal(t, "distribution", content.ProposalRoute())
 	require.Equal(t, "CommunityPoolSpend", content.ProposalType())
+
+	w1 := weightedProposalContent[1]
+
+	// tests w1 interface:
+	require.Equal(t, simulation.OpWeightSubmitCreateFundingStreamProposal, w1.AppParamsKey())
+	require.Equal(t, simappparams.DefaultWeightCreateFundingStreamProposal, w1.DefaultWeight())
+
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	content = w1.ContentSimulatorFn()(r, ctx, accounts)
+	require.NotNil(t, content)
+	require.NoError(t, content.ValidateBasic())
+	require.Equal(t, "distribution", content.ProposalRoute())
+	require.Equal(t, "CreateFundingStream", content.ProposalType())
 }
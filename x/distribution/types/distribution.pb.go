@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -463,6 +467,187 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// FundingStream defines a continuous payout from the community pool to a
+// recipient account, created and cancelled by governance.
+type FundingStream struct {
+	Id        uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// payout_interval is the number of blocks between two payouts, 1 to pay out every block.
+	PayoutInterval uint64 `protobuf:"varint,4,opt,name=payout_interval,json=payoutInterval,proto3" json:"payout_interval,omitempty" yaml:"payout_interval"`
+	// next_payout_height is the height of the next payout. In genesis, it is the
+	// number of blocks between the first block of the chain and the next payout.
+	NextPayoutHeight int64 `protobuf:"varint,5,opt,name=next_payout_height,json=nextPayoutHeight,proto3" json:"next_payout_height,omitempty" yaml:"next_payout_height"`
+	// expiry is the time after which the stream is removed without paying out.
+	Expiry time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// paid is the total amount paid out by the stream so far.
+	Paid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+}
+
+func (m *FundingStream) Reset()      { *m = FundingStream{} }
+func (*FundingStream) ProtoMessage() {}
+func (*FundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{9}
+}
+func (m *FundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStream.Merge(m, src)
+}
+func (m *FundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStream proto.InternalMessageInfo
+
+func (m *FundingStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FundingStream) GetRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *FundingStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *FundingStream) GetPayoutInterval() uint64 {
+	if m != nil {
+		return m.PayoutInterval
+	}
+	return 0
+}
+
+func (m *FundingStream) GetNextPayoutHeight() int64 {
+	if m != nil {
+		return m.NextPayoutHeight
+	}
+	return 0
+}
+
+func (m *FundingStream) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *FundingStream) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+// CreateFundingStreamProposal details a proposal to create a funding stream
+// paying out the given amount from the community pool to the recipient every
+// payout interval until the expiry.
+type CreateFundingStreamProposal struct {
+	Title          string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	PayoutInterval uint64                                        `protobuf:"varint,5,opt,name=payout_interval,json=payoutInterval,proto3" json:"payout_interval,omitempty" yaml:"payout_interval"`
+	Expiry         time.Time                                     `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *CreateFundingStreamProposal) Reset()      { *m = CreateFundingStreamProposal{} }
+func (*CreateFundingStreamProposal) ProtoMessage() {}
+func (*CreateFundingStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{10}
+}
+func (m *CreateFundingStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateFundingStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateFundingStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateFundingStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFundingStreamProposal.Merge(m, src)
+}
+func (m *CreateFundingStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateFundingStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFundingStreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFundingStreamProposal proto.InternalMessageInfo
+
+// CancelFundingStreamProposal details a proposal to cancel a funding stream.
+type CancelFundingStreamProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StreamId    uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty" yaml:"stream_id"`
+}
+
+func (m *CancelFundingStreamProposal) Reset()      { *m = CancelFundingStreamProposal{} }
+func (*CancelFundingStreamProposal) ProtoMessage() {}
+func (*CancelFundingStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
+}
+func (m *CancelFundingStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelFundingStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelFundingStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelFundingStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelFundingStreamProposal.Merge(m, src)
+}
+func (m *CancelFundingStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelFundingStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelFundingStreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelFundingStreamProposal proto.InternalMessageInfo
+
 // DelegatorStartingInfo represents the starting info for a delegator reward period.
 // It tracks the previous validator period, the delegation's amount
 // of staking token, and the creation height (to check later on
@@ -480,7 +665,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +719,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +775,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,6 +839,101 @@ func (m *CommunityPoolSpendProposalWithDeposit) GetDeposit() string {
 	return ""
 }
 
+// CreateFundingStreamProposalWithDeposit defines a CreateFundingStreamProposal with a deposit
+type CreateFundingStreamProposalWithDeposit struct {
+	Title          string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description    string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Recipient      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty" yaml:"recipient"`
+	Amount         string                                        `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
+	PayoutInterval uint64                                        `protobuf:"varint,5,opt,name=payout_interval,json=payoutInterval,proto3" json:"payout_interval,omitempty" yaml:"payout_interval"`
+	Expiry         time.Time                                     `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	Deposit        string                                        `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) Reset() {
+	*m = CreateFundingStreamProposalWithDeposit{}
+}
+func (m *CreateFundingStreamProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*CreateFundingStreamProposalWithDeposit) ProtoMessage()    {}
+func (*CreateFundingStreamProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{15}
+}
+func (m *CreateFundingStreamProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateFundingStreamProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateFundingStreamProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateFundingStreamProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFundingStreamProposalWithDeposit.Merge(m, src)
+}
+func (m *CreateFundingStreamProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateFundingStreamProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFundingStreamProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFundingStreamProposalWithDeposit proto.InternalMessageInfo
+
+func (m *CreateFundingStreamProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) GetRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) GetPayoutInterval() uint64 {
+	if m != nil {
+		return m.PayoutInterval
+	}
+	return 0
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -664,9 +944,13 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*FundingStream)(nil), "cosmos.distribution.v1beta1.FundingStream")
+	proto.RegisterType((*CreateFundingStreamProposal)(nil), "cosmos.distribution.v1beta1.CreateFundingStreamProposal")
+	proto.RegisterType((*CancelFundingStreamProposal)(nil), "cosmos.distribution.v1beta1.CancelFundingStreamProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*CreateFundingStreamProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CreateFundingStreamProposalWithDeposit")
 }
 
 func init() {
@@ -674,77 +958,93 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa6, 0xae, 0xdb, 0x4e, 0xd3, 0xb4, 0x9d, 0x38, 0x89, 0x9b, 0x14, 0x6f, 0x34, 0x52,
-	0xab, 0x20, 0x88, 0xd3, 0xd0, 0x0b, 0xca, 0x01, 0x29, 0xeb, 0x24, 0x02, 0x44, 0x49, 0xb4, 0x0d,
-	0x45, 0xe2, 0x62, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xeb, 0x1d, 0x33, 0x33, 0x76, 0x12, 0x24, 0x84,
-	0xd4, 0x13, 0x17, 0x04, 0x08, 0x0e, 0x1c, 0x00, 0xf5, 0xc8, 0xaf, 0xff, 0x83, 0x1e, 0x7b, 0x03,
-	0x71, 0x58, 0x50, 0x72, 0xe3, 0xe8, 0x1b, 0x9c, 0xd0, 0xce, 0xcc, 0xee, 0xda, 0xae, 0xa9, 0x6c,
-	0x50, 0x4f, 0xf6, 0x7e, 0xf3, 0xf6, 0x7b, 0xdf, 0x7c, 0x6f, 0xde, 0x9b, 0x05, 0x15, 0x8f, 0x89,
-	0x16, 0x13, 0x6b, 0x3e, 0x15, 0x92, 0xd3, 0x7a, 0x47, 0x52, 0x16, 0xae, 0x75, 0xd7, 0xeb, 0x44,
-	0xe2, 0xf5, 0x01, 0xb0, 0xd2, 0xe6, 0x4c, 0x32, 0xb8, 0xa4, 0xe3, 0x2b, 0x03, 0x4b, 0x26, 0x7e,
-	0xb1, 0xd8, 0x60, 0x0d, 0xa6, 0xe2, 0xd6, 0xe2, 0x7f, 0xfa, 0x95, 0xc5, 0xb2, 0x49, 0x51, 0xc7,
-	0x82, 0xa4, 0xd4, 0x1e, 0xa3, 0x86, 0x12, 0xfd, 0x72, 0x0e, 0x14, 0xf6, 0x30, 0xc7, 0x2d, 0x01,
-	0x0f, 0xc1, 0x15, 0x8f, 0xb5, 0x5a, 0x9d, 0x90, 0xca, 0x93, 0x9a, 0xc4, 0xc7, 0x25, 0x6b, 0xd9,
-	0x5a, 0xb9, 0xe4, 0xec, 0x3c, 0x8e, 0xec, 0xdc, 0x6f, 0x91, 0x7d, 0xbb, 0x41, 0x65, 0xb3, 0x53,
-	0xaf, 0x78, 0xac, 0xb5, 0x66, 0x48, 0xf5, 0xcf, 0xaa, 0xf0, 0x0f, 0xd7, 0xe4, 0x49, 0x9b, 0x88,
-	0xca, 0x16, 0xf1, 0x7a, 0x91, 0x5d, 0x3c, 0xc1, 0xad, 0x60, 0x03, 0x0d, 0x90, 0x21, 0x77, 0x3a,
-	0x7d, 0xde, 0xc7, 0xc7, 0xf0, 0x23, 0x50, 0x8c, 0x25, 0xd5, 0xda, 0x9c, 0xb5, 0x99, 0x20, 0xbc,
-	0xc6, 0xc9, 0x11, 0xe6, 0x7e, 0x69, 0x4a, 0xe5, 0xbc, 0x37, 0x71, 0xce, 0x25, 0x9d, 0x73, 0x14,
-	0x27, 0x72, 0x61, 0x0c, 0xef, 0x19, 0xd4, 0x55, 0x20, 0x7c, 0x68, 0x81, 0xb9, 0x3a, 0x0b, 0x3b,
-	0xe2, 0x29, 0x09, 0xe7, 0x94, 0x84, 0xb7, 0x27, 0x96, 0x70, 0xd3, 0x48, 0x18, 0x45, 0x8a, 0xdc,
-	0x59, 0x85, 0x0f, 0x89, 0xd8, 0x07, 0x73, 0x47, 0x54, 0x36, 0x7d, 0x8e, 0x8f, 0x6a, 0xd8, 0xf7,
-	0x79, 0x8d, 0x84, 0xb8, 0x1e, 0x10, 0xbf, 0x94, 0x5f, 0xb6, 0x56, 0x2e, 0x3a, 0xcb, 0x19, 0xeb,
-	0xc8, 0x30, 0xe4, 0xce, 0x26, 0xf8, 0xa6, 0xef, 0xf3, 0x6d, 0x8d, 0x6e, 0xe4, 0xbf, 0x7a, 0x64,
-	0xe7, 0xd0, 0xa7, 0x53, 0x60, 0xf1, 0x01, 0x0e, 0xa8, 0x8f, 0x25, 0xe3, 0xaf, 0x53, 0x21, 0x19,
-	0xa7, 0x1e, 0x0e, 0x74, 0x66, 0x01, 0x7f, 0xb4, 0xc0, 0x82, 0xd7, 0x69, 0x75, 0x02, 0x2c, 0x69,
-	0x97, 0x18, 0x99, 0x35, 0x8e, 0x25, 0x65, 0x25, 0x6b, 0xf9, 0xdc, 0xca, 0xe5, 0x57, 0x6e, 0x9a,
-	0xe3, 0x59, 0x89, 0xdd, 0x4b, 0x8e, 0x59, 0xbc, 0xd7, 0x2a, 0xa3, 0xa1, 0xf3, 0x4e, 0xec, 0x4f,
-	0x2f, 0xb2, 0xcb, 0xa6, 0xd8, 0xa3, 0xa9, 0xd0, 0x0f, 0xbf, 0xdb, 0x2f, 0x8d, 0xe7, 0x60, 0xcc,
-	0x2a, 0xdc, 0xb9, 0x8c, 0x48, 0x2b, 0x75, 0x63, 0x1a, 0x58, 0x05, 0x57, 0x39, 0x39, 0x20, 0x9c,
-	0x84, 0x1e, 0xa9, 0x79, 0xac, 0x13, 0x4a, 0x75, 0x52, 0xae, 0x38, 0x8b, 0xbd, 0xc8, 0x9e, 0xd7,
-	0x12, 0x86, 0x02, 0x90, 0x3b, 0x93, 0x22, 0x55, 0x05, 0x7c, 0x6b, 0x81, 0x85, 0xd4, 0x91, 0x6a,
-	0x87, 0x73, 0x12, 0xca, 0xc4, 0x8e, 0x43, 0x70, 0x41, 0xeb, 0x16, 0x63, 0xed, 0xfe, 0x6e, 0xbc,
-	0xfb, 0x49, 0xf7, 0x96, 0x64, 0x80, 0xf3, 0xa0, 0xd0, 0x26, 0x9c, 0x32, 0x7d, 0xdc, 0xf3, 0xae,
-	0x79, 0x42, 0x5f, 0x58, 0xa0, 0x9c, 0x0a, 0xdc, 0xf4, 0x8c, 0x15, 0xc4, 0xaf, 0xb2, 0x56, 0x8b,
-	0x0a, 0x41, 0x59, 0x08, 0xdf, 0x07, 0xc0, 0x4b, 0x9f, 0x9e, 0x9f, 0xd4, 0xbe, 0x24, 0xe8, 0x6b,
-	0x0b, 0x2c, 0xa5, 0xaa, 0x76, 0x3b, 0x52, 0x48, 0x1c, 0xfa, 0x34, 0x6c, 0x24, 0xd6, 0x7d, 0x38,
-	0x99, 0x75, 0xdb, 0xe6, 0xe0, 0xcc, 0x24, 0x55, 0x53, 0xaf, 0xa2, 0xff, 0x6a, 0x26, 0xfa, 0xde,
-	0x02, 0xb3, 0xa9, 0xbc, 0xfb, 0x01, 0x16, 0xcd, 0xed, 0x2e, 0x09, 0x25, 0xdc, 0x01, 0xd7, 0xba,
-	0x09, 0x5c, 0x33, 0x76, 0xc7, 0x13, 0x2d, 0xef, 0x2c, 0xf5, 0x22, 0x7b, 0x41, 0x67, 0x1f, 0x8e,
-	0x40, 0xee, 0xd5, 0x14, 0xda, 0x53, 0x08, 0x7c, 0x13, 0x5c, 0x3c, 0xe0, 0xd8, 0x8b, 0x67, 0xad,
-	0x99, 0x4e, 0x95, 0xc9, 0x46, 0x83, 0x9b, 0xbe, 0x8f, 0x7e, 0xb2, 0x40, 0x71, 0x84, 0x56, 0x01,
-	0x3f, 0xb1, 0xc0, 0x7c, 0xa6, 0x45, 0xc4, 0x2b, 0x35, 0xa2, 0x96, 0x8c, 0xa7, 0x77, 0x2a, 0xcf,
-	0x98, 0xfd, 0x95, 0x11, 0x9c, 0xce, 0x2d, 0xe3, 0xf3, 0x0b, 0xc3, 0x3b, 0xed, 0x67, 0x47, 0x6e,
-	0xb1, 0x3b, 0x42, 0x8f, 0x19, 0x21, 0xdf, 0x58, 0xe0, 0xc2, 0x0e, 0x21, 0x7b, 0x8c, 0x05, 0xf0,
-	0x73, 0x0b, 0xcc, 0x64, 0x13, 0xbd, 0xcd, 0x58, 0x30, 0x56, 0xb5, 0xdf, 0x32, 0x2a, 0xe6, 0x86,
-	0xef, 0x84, 0x98, 0x61, 0xe2, 0xa2, 0x67, 0x17, 0x54, 0xac, 0x09, 0x7d, 0x39, 0x05, 0x16, 0xab,
-	0xfd, 0xc8, 0xfd, 0x36, 0x09, 0x7d, 0x3d, 0x63, 0x71, 0x00, 0x8b, 0xe0, 0xbc, 0xa4, 0x32, 0x20,
-	0xfa, 0x22, 0x73, 0xf5, 0x03, 0x5c, 0x06, 0x97, 0x7d, 0x22, 0x3c, 0x4e, 0xdb, 0x59, 0x49, 0xdd,
-	0x7e, 0x08, 0xee, 0x82, 0x4b, 0x9c, 0x78, 0xb4, 0x4d, 0x49, 0x28, 0xd5, 0x6d, 0x30, 0xed, 0xac,
-	0xff, 0x1d, 0xd9, 0xab, 0x63, 0x28, 0xdd, 0xf4, 0xbc, 0x78, 0x12, 0x13, 0x21, 0xdc, 0x8c, 0x03,
-	0x7a, 0xa0, 0x80, 0x5b, 0x6a, 0x68, 0xe5, 0x95, 0x65, 0x37, 0x46, 0x5a, 0xa6, 0xfc, 0xba, 0x63,
-	0xba, 0x75, 0x65, 0x8c, 0x64, 0xda, 0x13, 0x43, 0xbd, 0x71, 0xf1, 0xe3, 0x47, 0x76, 0x4e, 0x95,
-	0xed, 0x2f, 0x0b, 0xcc, 0x6d, 0x91, 0x80, 0x34, 0x54, 0x55, 0x25, 0xe6, 0x92, 0x86, 0x8d, 0x37,
-	0xc2, 0x03, 0x35, 0x46, 0xdb, 0x9c, 0x74, 0x29, 0x8b, 0x6f, 0xa8, 0xfe, 0x96, 0xe8, 0x1b, 0xa3,
-	0x43, 0x01, 0xc8, 0x9d, 0x49, 0x10, 0xd3, 0x10, 0xfb, 0xe0, 0xbc, 0x90, 0xf8, 0x90, 0x98, 0x6e,
-	0x78, 0x6d, 0xe2, 0x8b, 0x72, 0x5a, 0x27, 0x52, 0x24, 0xc8, 0xd5, 0x64, 0x70, 0x1b, 0x14, 0x9a,
-	0x84, 0x36, 0x9a, 0xda, 0xf1, 0xbc, 0xb3, 0xfa, 0x67, 0x64, 0x5f, 0xf5, 0x38, 0x89, 0xc7, 0x7f,
-	0x58, 0xd3, 0x4b, 0x99, 0xc8, 0xa1, 0x05, 0xe4, 0x9a, 0x97, 0xd1, 0xc3, 0x29, 0x70, 0xc3, 0xec,
-	0x9d, 0xb2, 0x30, 0x75, 0xc1, 0xdc, 0xb7, 0x1f, 0x80, 0xeb, 0x59, 0x1f, 0x60, 0x5d, 0x28, 0xe5,
-	0xc0, 0xb4, 0x73, 0xaf, 0x17, 0xd9, 0xa5, 0xe1, 0x56, 0x31, 0x21, 0x68, 0xcc, 0xea, 0x3f, 0xc0,
-	0x41, 0x52, 0xfd, 0x6c, 0xf6, 0x18, 0x04, 0x52, 0x50, 0x48, 0xbf, 0x71, 0x9e, 0xd3, 0xd4, 0x36,
-	0x09, 0xd0, 0xcf, 0x53, 0xe0, 0xd6, 0xbf, 0xf7, 0xc5, 0xbb, 0x54, 0x36, 0xb7, 0x48, 0x9b, 0x09,
-	0x2a, 0xe1, 0xed, 0x81, 0x16, 0x71, 0xae, 0x65, 0xd5, 0x51, 0x30, 0x4a, 0x9a, 0xe6, 0xd5, 0x11,
-	0x4d, 0xe3, 0xcc, 0xf7, 0x22, 0x1b, 0xea, 0xe8, 0xbe, 0x45, 0x34, 0xd8, 0x4c, 0xde, 0xd3, 0xcd,
-	0xb4, 0xdd, 0x8b, 0xec, 0x6b, 0xc9, 0xf4, 0x37, 0x4b, 0xe8, 0x7f, 0x35, 0xd8, 0x8b, 0x7d, 0x0d,
-	0x16, 0x2b, 0xbb, 0xde, 0x8b, 0xec, 0x2b, 0x3a, 0x83, 0xc6, 0x51, 0xd2, 0x26, 0xf0, 0x65, 0x70,
-	0xc1, 0xd7, 0x9b, 0x2f, 0x9d, 0x57, 0xb1, 0x30, 0xbb, 0x8b, 0xcc, 0x02, 0x72, 0x93, 0x10, 0x67,
-	0xf7, 0xbb, 0xd3, 0xb2, 0xf5, 0xf8, 0xb4, 0x6c, 0x3d, 0x39, 0x2d, 0x5b, 0x7f, 0x9c, 0x96, 0xad,
-	0xcf, 0xce, 0xca, 0xb9, 0x27, 0x67, 0xe5, 0xdc, 0xaf, 0x67, 0xe5, 0xdc, 0x7b, 0xeb, 0xcf, 0x14,
-	0x7c, 0x3c, 0xf8, 0x5d, 0xaf, 0xf4, 0xd7, 0x0b, 0xea, 0xb3, 0xfb, 0xee, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xb8, 0x54, 0xa7, 0x44, 0xfb, 0x0b, 0x00, 0x00,
+	// 1368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xda, 0x8e, 0x93, 0x0c, 0x49, 0x08, 0x83, 0x13, 0x8c, 0x03, 0x5e, 0x6b, 0x24, 0x50,
+	0xbe, 0xfa, 0x16, 0x9b, 0xc0, 0xa5, 0x42, 0x55, 0x25, 0xd6, 0x24, 0x82, 0xb6, 0x94, 0x68, 0x49,
+	0xa9, 0xd4, 0x8b, 0x35, 0xde, 0x9d, 0x38, 0xa3, 0xac, 0x77, 0xb6, 0xb3, 0x63, 0x93, 0x54, 0xaa,
+	0x2a, 0x71, 0xea, 0xa1, 0x55, 0xe9, 0x8f, 0x03, 0x87, 0xb6, 0xe2, 0xd8, 0x5f, 0xff, 0x47, 0x91,
+	0x7a, 0xe1, 0xd6, 0xaa, 0x07, 0x53, 0x85, 0x5b, 0x8f, 0xbe, 0xb5, 0xa7, 0x6a, 0x67, 0xc6, 0xbb,
+	0xb6, 0x71, 0x69, 0x5c, 0x88, 0x7a, 0xe0, 0x84, 0xf7, 0x33, 0x6f, 0xde, 0xfb, 0xbc, 0xcf, 0xbc,
+	0x79, 0x6f, 0x08, 0x28, 0x3b, 0x2c, 0x6c, 0xb2, 0xb0, 0xe2, 0xd2, 0x50, 0x70, 0x5a, 0x6f, 0x09,
+	0xca, 0xfc, 0x4a, 0x7b, 0xb5, 0x4e, 0x04, 0x5e, 0x1d, 0x00, 0xcb, 0x01, 0x67, 0x82, 0xc1, 0x65,
+	0x65, 0x5f, 0x1e, 0x58, 0xd2, 0xf6, 0x85, 0x5c, 0x83, 0x35, 0x98, 0xb4, 0xab, 0x44, 0xbf, 0xd4,
+	0x96, 0x42, 0x51, 0x87, 0xa8, 0xe3, 0x90, 0xc4, 0xae, 0x1d, 0x46, 0xb5, 0xcb, 0x82, 0xd9, 0x60,
+	0xac, 0xe1, 0x91, 0x8a, 0xfc, 0xaa, 0xb7, 0xb6, 0x2a, 0x82, 0x36, 0x49, 0x28, 0x70, 0x33, 0x50,
+	0x06, 0xe8, 0xe7, 0x34, 0xc8, 0x6e, 0x60, 0x8e, 0x9b, 0x21, 0xdc, 0x01, 0x73, 0x0e, 0x6b, 0x36,
+	0x5b, 0x3e, 0x15, 0x7b, 0x35, 0x81, 0x77, 0xf3, 0x46, 0xc9, 0x58, 0x99, 0xb1, 0xd6, 0x1f, 0x74,
+	0xcc, 0x89, 0x5f, 0x3b, 0xe6, 0xd9, 0x06, 0x15, 0xdb, 0xad, 0x7a, 0xd9, 0x61, 0xcd, 0x8a, 0x8e,
+	0xaa, 0xfe, 0x39, 0x17, 0xba, 0x3b, 0x15, 0xb1, 0x17, 0x90, 0xb0, 0x7c, 0x85, 0x38, 0xdd, 0x8e,
+	0x99, 0xdb, 0xc3, 0x4d, 0xef, 0x12, 0x1a, 0x70, 0x86, 0xec, 0xd9, 0xf8, 0x7b, 0x13, 0xef, 0xc2,
+	0x0f, 0x40, 0x2e, 0xe2, 0x5c, 0x0b, 0x38, 0x0b, 0x58, 0x48, 0x78, 0x8d, 0x93, 0xdb, 0x98, 0xbb,
+	0xf9, 0x94, 0x8c, 0x79, 0x7d, 0xec, 0x98, 0xcb, 0x2a, 0xe6, 0x28, 0x9f, 0xc8, 0x86, 0x11, 0xbc,
+	0xa1, 0x51, 0x5b, 0x82, 0xf0, 0x8e, 0x01, 0x16, 0xeb, 0xcc, 0x6f, 0x85, 0x4f, 0x50, 0x48, 0x4b,
+	0x0a, 0x6f, 0x8e, 0x4d, 0xe1, 0x94, 0xa6, 0x30, 0xca, 0x29, 0xb2, 0x8f, 0x4b, 0x7c, 0x88, 0xc4,
+	0x26, 0x58, 0xbc, 0x4d, 0xc5, 0xb6, 0xcb, 0xf1, 0xed, 0x1a, 0x76, 0x5d, 0x5e, 0x23, 0x3e, 0xae,
+	0x7b, 0xc4, 0xcd, 0x67, 0x4a, 0xc6, 0xca, 0xb4, 0x55, 0x4a, 0xbc, 0x8e, 0x34, 0x43, 0xf6, 0xf1,
+	0x1e, 0x7e, 0xd9, 0x75, 0xf9, 0x9a, 0x42, 0x2f, 0x65, 0xee, 0xdd, 0x37, 0x27, 0xd0, 0x27, 0x29,
+	0x50, 0xb8, 0x85, 0x3d, 0xea, 0x62, 0xc1, 0xf8, 0x55, 0x1a, 0x0a, 0xc6, 0xa9, 0x83, 0x3d, 0x15,
+	0x39, 0x84, 0xdf, 0x1b, 0xe0, 0x84, 0xd3, 0x6a, 0xb6, 0x3c, 0x2c, 0x68, 0x9b, 0x68, 0x9a, 0x35,
+	0x8e, 0x05, 0x65, 0x79, 0xa3, 0x94, 0x5e, 0x39, 0x72, 0xe1, 0x94, 0xae, 0xdf, 0x72, 0xa4, 0x5e,
+	0xaf, 0x0e, 0xa3, 0x5c, 0xab, 0x8c, 0xfa, 0xd6, 0x5b, 0x91, 0x3e, 0xdd, 0x8e, 0x59, 0xd4, 0x87,
+	0x3d, 0xda, 0x15, 0xfa, 0xee, 0x91, 0xf9, 0xff, 0x83, 0x29, 0x18, 0x79, 0x0d, 0xed, 0xc5, 0xc4,
+	0x91, 0x62, 0x6a, 0x47, 0x6e, 0x60, 0x15, 0x1c, 0xe5, 0x64, 0x8b, 0x70, 0xe2, 0x3b, 0xa4, 0xe6,
+	0xb0, 0x96, 0x2f, 0x64, 0xa5, 0xcc, 0x59, 0x85, 0x6e, 0xc7, 0x5c, 0x52, 0x14, 0x86, 0x0c, 0x90,
+	0x3d, 0x1f, 0x23, 0x55, 0x09, 0x7c, 0x6d, 0x80, 0x13, 0xb1, 0x22, 0xd5, 0x16, 0xe7, 0xc4, 0x17,
+	0x3d, 0x39, 0x76, 0xc0, 0x94, 0xe2, 0x1d, 0x1e, 0x28, 0xfb, 0x8b, 0x51, 0xf6, 0xe3, 0xe6, 0xd6,
+	0x8b, 0x00, 0x97, 0x40, 0x36, 0x20, 0x9c, 0x32, 0x55, 0xee, 0x19, 0x5b, 0x7f, 0xa1, 0xcf, 0x0d,
+	0x50, 0x8c, 0x09, 0x5e, 0x76, 0xb4, 0x14, 0xc4, 0xad, 0xb2, 0x66, 0x93, 0x86, 0x21, 0x65, 0x3e,
+	0x7c, 0x17, 0x00, 0x27, 0xfe, 0x3a, 0x3c, 0xaa, 0x7d, 0x41, 0xd0, 0x97, 0x06, 0x58, 0x8e, 0x59,
+	0xdd, 0x68, 0x89, 0x50, 0x60, 0xdf, 0xa5, 0x7e, 0xa3, 0x27, 0xdd, 0xfb, 0xe3, 0x49, 0xb7, 0xa6,
+	0x0b, 0x67, 0xbe, 0x77, 0x6a, 0x72, 0x2b, 0xfa, 0xb7, 0x62, 0xa2, 0x6f, 0x0d, 0x70, 0x3c, 0xa6,
+	0x77, 0xd3, 0xc3, 0xe1, 0xf6, 0x5a, 0x9b, 0xf8, 0x02, 0xae, 0x83, 0x85, 0x76, 0x0f, 0xae, 0x69,
+	0xb9, 0xa3, 0x8e, 0x96, 0xb1, 0x96, 0xbb, 0x1d, 0xf3, 0x84, 0x8a, 0x3e, 0x6c, 0x81, 0xec, 0xa3,
+	0x31, 0xb4, 0x21, 0x11, 0xf8, 0x1a, 0x98, 0xde, 0xe2, 0xd8, 0x89, 0x9a, 0xb1, 0xee, 0x4e, 0xe5,
+	0xf1, 0x5a, 0x83, 0x1d, 0xef, 0x47, 0x3f, 0x18, 0x20, 0x37, 0x82, 0x6b, 0x08, 0x3f, 0x36, 0xc0,
+	0x52, 0xc2, 0x25, 0x8c, 0x56, 0x6a, 0x44, 0x2e, 0x69, 0x4d, 0xcf, 0x97, 0x9f, 0x32, 0x1c, 0xca,
+	0x23, 0x7c, 0x5a, 0x67, 0xb4, 0xce, 0xa7, 0x87, 0x33, 0xed, 0xf7, 0x8e, 0xec, 0x5c, 0x7b, 0x04,
+	0x1f, 0xdd, 0x42, 0xbe, 0x32, 0xc0, 0xd4, 0x3a, 0x21, 0x1b, 0x8c, 0x79, 0xf0, 0x53, 0x03, 0xcc,
+	0x27, 0x1d, 0x3d, 0x60, 0xcc, 0x3b, 0xd0, 0x69, 0xbf, 0xa1, 0x59, 0x2c, 0x0e, 0xcf, 0x84, 0xc8,
+	0xc3, 0xd8, 0x87, 0x9e, 0x0c, 0xa8, 0x88, 0x13, 0xfa, 0x22, 0x05, 0x0a, 0xd5, 0x7e, 0xe4, 0x66,
+	0x40, 0x7c, 0x57, 0xf5, 0x58, 0xec, 0xc1, 0x1c, 0x98, 0x14, 0x54, 0x78, 0x44, 0x0d, 0x32, 0x5b,
+	0x7d, 0xc0, 0x12, 0x38, 0xe2, 0x92, 0xd0, 0xe1, 0x34, 0x48, 0x8e, 0xd4, 0xee, 0x87, 0xe0, 0x0d,
+	0x30, 0xc3, 0x89, 0x43, 0x03, 0x4a, 0x7c, 0x21, 0xa7, 0xc1, 0xac, 0xb5, 0xfa, 0x67, 0xc7, 0x3c,
+	0x77, 0x00, 0xa6, 0x97, 0x1d, 0x27, 0xea, 0xc4, 0x24, 0x0c, 0xed, 0xc4, 0x07, 0x74, 0x40, 0x16,
+	0x37, 0x65, 0xd3, 0xca, 0x48, 0xc9, 0x4e, 0x8e, 0x94, 0x4c, 0xea, 0x75, 0x5e, 0xdf, 0xd6, 0x95,
+	0x03, 0x04, 0x53, 0x9a, 0x68, 0xd7, 0x97, 0xa6, 0x3f, 0xbc, 0x6f, 0x4e, 0xc8, 0x63, 0xfb, 0x28,
+	0x03, 0xe6, 0xd6, 0x5b, 0xf2, 0x8e, 0xde, 0x14, 0x9c, 0xe0, 0x26, 0x9c, 0x07, 0x29, 0xaa, 0xab,
+	0xdf, 0x4e, 0x51, 0x77, 0x30, 0xc3, 0xd4, 0x73, 0xcd, 0x30, 0x7d, 0x68, 0x19, 0x46, 0x43, 0x20,
+	0xc0, 0x7b, 0xac, 0x25, 0x6a, 0xd4, 0x17, 0x84, 0xb7, 0xb1, 0x27, 0xe7, 0x64, 0xa6, 0x7f, 0x08,
+	0x0c, 0x19, 0x20, 0x7b, 0x5e, 0x21, 0xd7, 0x34, 0x00, 0x5f, 0x07, 0xd0, 0x27, 0xbb, 0xa2, 0xa6,
+	0x0d, 0xb7, 0x09, 0x6d, 0x6c, 0x8b, 0xfc, 0x64, 0xc9, 0x58, 0x49, 0x5b, 0xa7, 0xbb, 0x1d, 0xf3,
+	0xa4, 0xf2, 0xf3, 0xa4, 0x0d, 0xb2, 0x17, 0x22, 0x70, 0x43, 0x62, 0x57, 0x25, 0x04, 0x5f, 0x01,
+	0x59, 0xb2, 0x1b, 0x50, 0xbe, 0x97, 0xcf, 0x96, 0x8c, 0x95, 0x23, 0x17, 0x0a, 0x65, 0xf5, 0xde,
+	0x2a, 0xf7, 0xde, 0x5b, 0xe5, 0xcd, 0xde, 0x7b, 0xcb, 0x9a, 0x8e, 0xf2, 0xbe, 0xfb, 0xc8, 0x34,
+	0x6c, 0xbd, 0x07, 0xd6, 0x40, 0x26, 0xc0, 0xd4, 0xcd, 0x4f, 0x3d, 0x7f, 0xc9, 0xa4, 0x63, 0x7d,
+	0x8b, 0xef, 0xa5, 0xc1, 0x72, 0x95, 0x13, 0x2c, 0xc8, 0x40, 0x51, 0xbc, 0x98, 0xd7, 0x64, 0x54,
+	0x11, 0x4d, 0x8e, 0x5d, 0x44, 0xcf, 0x74, 0xee, 0x7d, 0x37, 0xf5, 0x33, 0x03, 0x2c, 0x57, 0xb1,
+	0xef, 0x10, 0xef, 0xf9, 0x1e, 0xcd, 0x2a, 0x98, 0x09, 0xa5, 0xa7, 0x1a, 0x55, 0xef, 0xd9, 0x8c,
+	0x95, 0xeb, 0x76, 0xcc, 0x05, 0x95, 0x5e, 0xbc, 0x84, 0xec, 0x69, 0xf5, 0xfb, 0x9a, 0xdb, 0x47,
+	0xea, 0x0f, 0x03, 0x2c, 0x5e, 0x21, 0x1e, 0x69, 0xc8, 0xa1, 0x20, 0x30, 0x17, 0xd4, 0x6f, 0x5c,
+	0xf3, 0xb7, 0xe4, 0x2b, 0x2c, 0xe0, 0xa4, 0x4d, 0x59, 0xf4, 0xc0, 0xed, 0x9f, 0xa8, 0xfd, 0xda,
+	0x0d, 0x1a, 0x44, 0xda, 0x69, 0x44, 0xcf, 0xd3, 0x4d, 0x30, 0x19, 0x0a, 0xbc, 0x43, 0xf4, 0x30,
+	0x7d, 0x75, 0xec, 0x77, 0xf6, 0x6c, 0x2f, 0x0b, 0xbc, 0x43, 0x90, 0xad, 0x9c, 0xc1, 0x35, 0x90,
+	0xd5, 0x57, 0x59, 0xa5, 0x7b, 0xee, 0xf7, 0x8e, 0x79, 0xd4, 0x89, 0xaa, 0x9e, 0x32, 0x5f, 0xdf,
+	0xe0, 0x84, 0xe4, 0xd0, 0x02, 0xb2, 0xf5, 0x66, 0x74, 0x27, 0x05, 0x4e, 0xea, 0xdc, 0x29, 0xf3,
+	0x63, 0x15, 0xf4, 0x73, 0xfd, 0x3d, 0x70, 0x2c, 0x19, 0xa3, 0x58, 0x15, 0xb0, 0x54, 0x60, 0xd6,
+	0xba, 0xde, 0xed, 0x98, 0xf9, 0xe1, 0x49, 0xab, 0x4d, 0xd0, 0x01, 0x6f, 0xc5, 0x2d, 0xec, 0xf5,
+	0x6e, 0x45, 0xf2, 0x74, 0xd1, 0x08, 0xa4, 0x20, 0x1b, 0xff, 0x17, 0xe9, 0x90, 0x1e, 0x7d, 0x3a,
+	0x00, 0xfa, 0x31, 0x05, 0xce, 0xfc, 0xfd, 0x58, 0x7d, 0x9b, 0x8a, 0xed, 0x2b, 0x24, 0x60, 0x21,
+	0x15, 0xf0, 0xec, 0x40, 0x7d, 0x5a, 0x0b, 0xc9, 0xe9, 0x48, 0x18, 0xf5, 0x2a, 0xf6, 0xe5, 0x11,
+	0x15, 0x6b, 0x2d, 0x75, 0x3b, 0x26, 0x54, 0xd6, 0x7d, 0x8b, 0x68, 0xb0, 0x92, 0x9d, 0x27, 0x9b,
+	0xcc, 0x5a, 0x52, 0xc9, 0xf1, 0x12, 0x7a, 0xa6, 0xc6, 0xf3, 0xbf, 0xbe, 0xc6, 0x13, 0x31, 0x3b,
+	0xd6, 0xed, 0x98, 0x73, 0x2a, 0x82, 0xc2, 0x51, 0xdc, 0x3e, 0x5e, 0x02, 0x53, 0xae, 0x4a, 0x5e,
+	0xb6, 0x8d, 0x19, 0x0b, 0x26, 0x4f, 0x59, 0xbd, 0x80, 0xec, 0x9e, 0x09, 0xfa, 0x29, 0x0d, 0xce,
+	0x3e, 0xa5, 0xf5, 0xbe, 0xd8, 0x52, 0xfe, 0xf7, 0x9d, 0xb8, 0xff, 0x34, 0xa7, 0xfe, 0xf1, 0x34,
+	0xad, 0x1b, 0xdf, 0xec, 0x17, 0x8d, 0x07, 0xfb, 0x45, 0xe3, 0xe1, 0x7e, 0xd1, 0xf8, 0x6d, 0xbf,
+	0x68, 0xdc, 0x7d, 0x5c, 0x9c, 0x78, 0xf8, 0xb8, 0x38, 0xf1, 0xcb, 0xe3, 0xe2, 0xc4, 0x3b, 0xab,
+	0x4f, 0xd5, 0x6c, 0x77, 0xf0, 0xaf, 0x40, 0x52, 0xc2, 0x7a, 0x56, 0x92, 0xbc, 0xf8, 0xd7, 0x00,
+	0xbb, 0x24, 0xe9, 0xf3, 0x29, 0x12, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1025,14 +1325,14 @@ func (this *CommunityPoolSpendProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+func (this *FundingStream) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegatorStartingInfo)
+	that1, ok := that.(*FundingStream)
 	if !ok {
-		that2, ok := that.(DelegatorStartingInfo)
+		that2, ok := that.(FundingStream)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1044,25 +1344,47 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PreviousPeriod != that1.PreviousPeriod {
+	if this.Id != that1.Id {
 		return false
 	}
-	if !this.Stake.Equal(that1.Stake) {
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
 		return false
 	}
-	if this.Height != that1.Height {
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.PayoutInterval != that1.PayoutInterval {
+		return false
+	}
+	if this.NextPayoutHeight != that1.NextPayoutHeight {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	if len(this.Paid) != len(that1.Paid) {
 		return false
 	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+func (this *CreateFundingStreamProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegationDelegatorReward)
+	that1, ok := that.(*CreateFundingStreamProposal)
 	if !ok {
-		that2, ok := that.(DelegationDelegatorReward)
+		that2, ok := that.(CreateFundingStreamProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1074,27 +1396,39 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+	if this.Title != that1.Title {
 		return false
 	}
-	if len(this.Reward) != len(that1.Reward) {
+	if this.Description != that1.Description {
 		return false
 	}
-	for i := range this.Reward {
-		if !this.Reward[i].Equal(&that1.Reward[i]) {
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
 			return false
 		}
 	}
+	if this.PayoutInterval != that1.PayoutInterval {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
 	return true
 }
-func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+func (this *CancelFundingStreamProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	that1, ok := that.(*CancelFundingStreamProposal)
 	if !ok {
-		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		that2, ok := that.(CancelFundingStreamProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1112,31 +1446,165 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	if this.Description != that1.Description {
 		return false
 	}
-	if !bytes.Equal(this.Recipient, that1.Recipient) {
+	if this.StreamId != that1.StreamId {
 		return false
 	}
-	if this.Amount != that1.Amount {
+	return true
+}
+func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorStartingInfo)
+	if !ok {
+		that2, ok := that.(DelegatorStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Deposit != that1.Deposit {
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if this.Height != that1.Height {
 		return false
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	that1, ok := that.(*DelegationDelegatorReward)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (this *CreateFundingStreamProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateFundingStreamProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CreateFundingStreamProposalWithDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.PayoutInterval != that1.PayoutInterval {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
@@ -1514,7 +1982,7 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *FundingStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1524,40 +1992,78 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *FundingStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.NextPayoutHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.NextPayoutHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
 	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.PayoutInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PayoutInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+func (m *CreateFundingStreamProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1567,20 +2073,33 @@ func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateFundingStreamProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateFundingStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.PayoutInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PayoutInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1588,20 +2107,34 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintDistribution(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *CancelFundingStreamProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1611,36 +2144,20 @@ func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelFundingStreamProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelFundingStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+	if m.StreamId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StreamId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -1659,27 +2176,243 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDistribution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.PayoutInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PayoutInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	l = m.BonusProposerReward.Size()
 	n += 1 + l + sovDistribution(uint64(l))
 	if m.WithdrawAddrEnabled {
@@ -1825,43 +2558,75 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
-func (m *DelegatorStartingInfo) Size() (n int) {
+func (m *FundingStream) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PreviousPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
 	}
-	l = m.Stake.Size()
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.PayoutInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.PayoutInterval))
+	}
+	if m.NextPayoutHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.NextPayoutHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
 	n += 1 + l + sovDistribution(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovDistribution(uint64(m.Height))
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *DelegationDelegatorReward) Size() (n int) {
+func (m *CreateFundingStreamProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
+	l = len(m.Title)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	if m.PayoutInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.PayoutInterval))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+func (m *CancelFundingStreamProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1875,23 +2640,113 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if m.StreamId != 0 {
+		n += 1 + sovDistribution(uint64(m.StreamId))
 	}
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DelegatorStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateFundingStreamProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.PayoutInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.PayoutInterval))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozDistribution(x uint64) (n int) {
 	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -2401,7 +3256,717 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			}
+			m.ValidatorPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutInterval", wireType)
+			}
+			m.PayoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutHeight", wireType)
+			}
+			m.NextPayoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPayoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2428,8 +3993,8 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2457,7 +4022,7 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+func (m *CreateFundingStreamProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2480,17 +4045,17 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateFundingStreamProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateFundingStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.ValidatorPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2500,14 +4065,27 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2535,66 +4113,98 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutInterval", wireType)
+			}
+			m.PayoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2621,8 +4231,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2650,7 +4259,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePool) Unmarshal(dAtA []byte) error {
+func (m *CancelFundingStreamProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2673,17 +4282,17 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelFundingStreamProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelFundingStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2693,26 +4302,75 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2737,7 +4395,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2760,15 +4418,34 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2796,13 +4473,15 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2812,27 +4491,67 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2859,14 +4578,14 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipient == nil {
-				m.Recipient = []byte{}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2893,8 +4612,8 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2922,7 +4641,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2945,17 +4664,17 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.PreviousPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2965,14 +4684,27 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3000,15 +4732,13 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3018,69 +4748,31 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3090,31 +4782,29 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3124,25 +4814,23 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3168,7 +4856,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *CreateFundingStreamProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,10 +4879,10 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateFundingStreamProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateFundingStreamProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3328,6 +5016,58 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutInterval", wireType)
+			}
+			m.PayoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
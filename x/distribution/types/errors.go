@@ -18,4 +18,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrNoTokenizeShareRecords  = sdkerrors.Register(ModuleName, 14, "no tokenize share records owned")
+	ErrUnknownFundingStream    = sdkerrors.Register(ModuleName, 15, "unknown funding stream")
+	ErrInvalidFundingStream    = sdkerrors.Register(ModuleName, 16, "invalid funding stream")
 )
@@ -2,15 +2,22 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress  = "set_withdraw_address"
+	EventTypeRewards             = "rewards"
+	EventTypeCommission          = "commission"
+	EventTypeWithdrawRewards     = "withdraw_rewards"
+	EventTypeWithdrawCommission  = "withdraw_commission"
+	EventTypeProposerReward      = "proposer_reward"
+	EventTypeCreateFundingStream = "create_funding_stream"
+	EventTypeCancelFundingStream = "cancel_funding_stream"
+	EventTypeFundingStreamPayout = "funding_stream_payout"
+	EventTypeFundingStreamExpiry = "funding_stream_expiry"
+	EventTypeFundingStreamSkip   = "funding_stream_payout_skipped"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
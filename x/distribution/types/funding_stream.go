@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFundingStream creates a new funding stream paying out the given amount to
+// the recipient every payout interval, starting at the given height, until the
+// expiry.
+func NewFundingStream(
+	id uint64, recipient sdk.AccAddress, amount sdk.Coins, payoutInterval uint64, nextPayoutHeight int64, expiry time.Time,
+) FundingStream {
+	return FundingStream{
+		Id:               id,
+		Recipient:        recipient,
+		Amount:           amount,
+		PayoutInterval:   payoutInterval,
+		NextPayoutHeight: nextPayoutHeight,
+		Expiry:           expiry,
+		Paid:             sdk.NewCoins(),
+	}
+}
+
+// String implements the Stringer interface.
+func (fs FundingStream) String() string {
+	out, _ := yaml.Marshal(fs)
+	return string(out)
+}
+
+// IsExpired returns true if the funding stream is expired at the given time.
+func (fs FundingStream) IsExpired(t time.Time) bool {
+	return !t.Before(fs.Expiry)
+}
+
+// ValidateFundingStreamTerms validates the recipient, amount and payout
+// interval of a funding stream.
+func ValidateFundingStreamTerms(recipient sdk.AccAddress, amount sdk.Coins, payoutInterval uint64) error {
+	if recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidFundingStream, "invalid amount: %s", amount)
+	}
+	if payoutInterval == 0 {
+		return sdkerrors.Wrap(ErrInvalidFundingStream, "payout interval must be positive")
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the funding stream.
+func (fs FundingStream) Validate() error {
+	if fs.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidFundingStream, "id must be positive")
+	}
+	if err := ValidateFundingStreamTerms(fs.Recipient, fs.Amount, fs.PayoutInterval); err != nil {
+		return err
+	}
+	if fs.NextPayoutHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidFundingStream, "next payout height cannot be negative")
+	}
+	if !fs.Paid.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidFundingStream, "invalid paid amount: %s", fs.Paid)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFundingStreamValidate(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	expiry := time.Now().UTC()
+
+	tests := []struct {
+		name    string
+		stream  FundingStream
+		expPass bool
+	}{
+		{"valid", NewFundingStream(1, delAddr1, amount, 5, 10, expiry), true},
+		{"zero id", NewFundingStream(0, delAddr1, amount, 5, 10, expiry), false},
+		{"empty recipient", NewFundingStream(1, emptyDelAddr, amount, 5, 10, expiry), false},
+		{"empty amount", NewFundingStream(1, delAddr1, sdk.NewCoins(), 5, 10, expiry), false},
+		{"zero payout interval", NewFundingStream(1, delAddr1, amount, 0, 10, expiry), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.stream.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestFundingStreamIsExpired(t *testing.T) {
+	expiry := time.Now().UTC()
+	stream := NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 5, 10, expiry)
+
+	require.False(t, stream.IsExpired(expiry.Add(-time.Second)))
+	require.True(t, stream.IsExpired(expiry))
+	require.True(t, stream.IsExpired(expiry.Add(time.Second)))
+}
+
+func TestValidateGenesisFundingStreams(t *testing.T) {
+	stream := NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 5, 10, time.Now().UTC())
+
+	gs := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.FundingStreams = []FundingStream{stream}
+	require.Error(t, ValidateGenesis(gs), "stream id must be lower than the next stream id")
+
+	gs.NextFundingStreamId = 2
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.FundingStreams = []FundingStream{stream, stream}
+	require.Error(t, ValidateGenesis(gs), "duplicate stream ids")
+}
@@ -1,13 +1,19 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultNextFundingStreamID is the id of the first funding stream
+const DefaultNextFundingStreamID uint64 = 1
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	streams []FundingStream, nextStreamID uint64,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -21,6 +27,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		FundingStreams:                  streams,
+		NextFundingStreamId:             nextStreamID,
 	}
 }
 
@@ -37,6 +45,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FundingStreams:                  []FundingStream{},
+		NextFundingStreamId:             DefaultNextFundingStreamID,
 	}
 }
 
@@ -45,5 +55,23 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
-	return gs.FeePool.ValidateGenesis()
+	if err := gs.FeePool.ValidateGenesis(); err != nil {
+		return err
+	}
+
+	seenStreams := make(map[uint64]bool)
+	for _, stream := range gs.FundingStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if seenStreams[stream.Id] {
+			return fmt.Errorf("duplicate funding stream id %d", stream.Id)
+		}
+		if stream.Id >= gs.NextFundingStreamId {
+			return fmt.Errorf("funding stream id %d must be lower than the next funding stream id %d", stream.Id, gs.NextFundingStreamId)
+		}
+		seenStreams[stream.Id] = true
+	}
+
+	return nil
 }
@@ -470,6 +470,10 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// funding_streams defines the community pool funding streams at genesis.
+	FundingStreams []FundingStream `protobuf:"bytes,11,rep,name=funding_streams,json=fundingStreams,proto3" json:"funding_streams" yaml:"funding_streams"`
+	// next_funding_stream_id defines the id of the next funding stream at genesis.
+	NextFundingStreamId uint64 `protobuf:"varint,12,opt,name=next_funding_stream_id,json=nextFundingStreamId,proto3" json:"next_funding_stream_id,omitempty" yaml:"next_funding_stream_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -575,6 +579,20 @@ func (m *GenesisState) GetValidatorSlashEvents() []ValidatorSlashEventRecord {
 	return nil
 }
 
+func (m *GenesisState) GetFundingStreams() []FundingStream {
+	if m != nil {
+		return m.FundingStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFundingStreamId() uint64 {
+	if m != nil {
+		return m.NextFundingStreamId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
@@ -591,73 +609,77 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xae, 0xdb, 0xb4, 0x8c, 0x5d, 0xda, 0x2e, 0x69, 0xe2, 0xba, 0xa9, 0x9d, 0x4e, 0x8b,
-	0x08, 0xaa, 0xba, 0x6e, 0x02, 0x02, 0x54, 0x04, 0x52, 0x36, 0xe5, 0x97, 0x04, 0x34, 0x6c, 0xa4,
-	0x82, 0x10, 0x92, 0x35, 0xde, 0x1d, 0xdb, 0x23, 0xd6, 0x3b, 0xd6, 0xcc, 0xd8, 0x21, 0x70, 0xe4,
-	0xda, 0x03, 0x12, 0xe2, 0x84, 0x90, 0xe0, 0x80, 0x84, 0x10, 0x12, 0x7f, 0x02, 0x12, 0xa7, 0x1e,
-	0x7b, 0xe4, 0x64, 0x50, 0xf2, 0x1f, 0xe4, 0xc8, 0x09, 0xed, 0xcc, 0xec, 0x2f, 0xff, 0xaa, 0x1b,
-	0x24, 0xc8, 0x29, 0xf1, 0xee, 0x9b, 0xef, 0xfb, 0xe6, 0x9b, 0xf7, 0xde, 0xbc, 0x05, 0xcf, 0x7b,
-	0x94, 0x77, 0x29, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x9a, 0x7d, 0x41, 0x68, 0x58, 0x1f, 0x6c, 0x34,
-	0xb1, 0x40, 0x1b, 0xf5, 0x36, 0x0e, 0x31, 0x27, 0xdc, 0xee, 0x31, 0x2a, 0xa8, 0x75, 0x45, 0x85,
-	0xda, 0xd9, 0x50, 0x5b, 0x87, 0x56, 0x96, 0xda, 0xb4, 0x4d, 0x65, 0x5c, 0x3d, 0xfa, 0x4f, 0x2d,
-	0xa9, 0x54, 0x35, 0x7a, 0x13, 0x71, 0x9c, 0xa0, 0x7a, 0x94, 0x84, 0xfa, 0xbd, 0x3d, 0x8b, 0x3d,
-	0xc7, 0x23, 0xe3, 0xe1, 0x03, 0x13, 0x5c, 0xba, 0x8b, 0x03, 0xdc, 0x46, 0x82, 0xb2, 0x0f, 0x89,
-	0xe8, 0xf8, 0x0c, 0xed, 0xbd, 0x13, 0xb6, 0xa8, 0xf5, 0x39, 0xb8, 0xe8, 0xc7, 0x2f, 0x1a, 0xc8,
-	0xf7, 0x19, 0xe6, 0xbc, 0x6c, 0xac, 0x19, 0xeb, 0x25, 0xe7, 0xbd, 0xa3, 0x61, 0xad, 0xbc, 0x8f,
-	0xba, 0xc1, 0x1d, 0x38, 0x16, 0x02, 0xff, 0x1e, 0xd6, 0x6e, 0xb5, 0x89, 0xe8, 0xf4, 0x9b, 0xb6,
-	0x47, 0xbb, 0x75, 0xad, 0x47, 0xfd, 0xb9, 0xc5, 0xfd, 0x4f, 0xeb, 0x62, 0xbf, 0x87, 0xb9, 0xbd,
-	0xe5, 0x79, 0x5b, 0x6a, 0x85, 0x7b, 0x21, 0x01, 0xd1, 0x4f, 0xac, 0x3d, 0x70, 0x61, 0x4f, 0x6b,
-	0x49, 0xa8, 0x4d, 0x49, 0xfd, 0xee, 0xd1, 0xb0, 0xb6, 0xa2, 0xa8, 0x47, 0x23, 0x8e, 0xc1, 0x7c,
-	0x3e, 0xc6, 0xd0, 0x0f, 0xe0, 0xef, 0x26, 0xb8, 0x76, 0x1f, 0x05, 0xc4, 0x8f, 0xd4, 0xdc, 0xeb,
-	0x0b, 0x2e, 0x50, 0xe8, 0x93, 0xb0, 0xed, 0xe2, 0x3d, 0xc4, 0x7c, 0xee, 0x62, 0x8f, 0x32, 0x3f,
-	0xb2, 0x66, 0x10, 0x07, 0x4d, 0xb7, 0x66, 0x2c, 0x64, 0x5e, 0x81, 0xf7, 0x51, 0x90, 0x58, 0x93,
-	0x80, 0xc4, 0xd6, 0x7c, 0x6f, 0x80, 0x67, 0x68, 0x2a, 0xac, 0xc1, 0x94, 0xb2, 0xb2, 0xb9, 0x56,
-	0x58, 0x2f, 0x6e, 0xae, 0xea, 0xf3, 0xb7, 0xa3, 0xfc, 0x88, 0x53, 0xc9, 0xbe, 0x8b, 0xbd, 0x6d,
-	0x4a, 0x42, 0xe7, 0x83, 0x87, 0xc3, 0xda, 0xc2, 0xd1, 0xb0, 0x56, 0x51, 0x02, 0x27, 0xc0, 0xc0,
-	0x9f, 0xff, 0xac, 0xdd, 0x9c, 0x43, 0xa2, 0x46, 0xe4, 0xae, 0x45, 0xc7, 0x4c, 0x82, 0xdf, 0x99,
-	0xe0, 0x46, 0x62, 0xe2, 0x96, 0xe7, 0xf5, 0xbb, 0xfd, 0x00, 0x09, 0xec, 0x6f, 0xd3, 0x6e, 0x97,
-	0x70, 0x4e, 0x68, 0x78, 0x02, 0x7c, 0xdc, 0x07, 0x45, 0x94, 0x4a, 0x93, 0xd9, 0x55, 0xdc, 0x7c,
-	0xd5, 0x9e, 0x51, 0x91, 0xf6, 0xec, 0x3d, 0x39, 0x15, 0xed, 0xae, 0xa5, 0x64, 0x67, 0xd0, 0xa1,
-	0x9b, 0xe5, 0x82, 0x3f, 0x98, 0x60, 0x2d, 0xc1, 0x7a, 0x9b, 0x70, 0x41, 0x19, 0xf1, 0x50, 0x70,
-	0x72, 0x72, 0x6c, 0x19, 0x2c, 0xf6, 0x30, 0x23, 0x54, 0xd9, 0x72, 0xca, 0xd5, 0xbf, 0x2c, 0x02,
-	0xce, 0xc4, 0xe9, 0x56, 0x90, 0x7e, 0xbd, 0x3c, 0x9f, 0x5f, 0x63, 0x7b, 0x74, 0x96, 0xb5, 0x57,
-	0x4f, 0xab, 0x6d, 0xc4, 0xd9, 0xe7, 0xc6, 0xf8, 0xf0, 0x4b, 0x13, 0x5c, 0x4d, 0xd6, 0x6f, 0xf7,
-	0x19, 0xc3, 0xa1, 0x38, 0x39, 0x06, 0xb5, 0x52, 0x23, 0x54, 0xe2, 0xbc, 0x38, 0x9f, 0x11, 0xf9,
-	0x8d, 0x3c, 0xde, 0x85, 0x07, 0x05, 0x70, 0x25, 0xe9, 0xce, 0xbb, 0x02, 0x31, 0x41, 0xc2, 0x76,
-	0xd4, 0x9d, 0x53, 0x0f, 0xfe, 0xb7, 0x1e, 0x3d, 0xd1, 0x7f, 0xf3, 0xbf, 0xf1, 0xbf, 0x0f, 0xce,
-	0x71, 0xed, 0x46, 0x83, 0x84, 0x2d, 0xaa, 0xd3, 0x71, 0x73, 0xe6, 0x29, 0x4c, 0x34, 0xd2, 0x59,
-	0xd5, 0x67, 0xb0, 0xa4, 0xf4, 0xe6, 0x60, 0xa1, 0x5b, 0xe2, 0x99, 0x58, 0xf8, 0xa3, 0x09, 0x2e,
-	0x27, 0x67, 0xb9, 0x1b, 0x20, 0xde, 0x79, 0x63, 0x20, 0x8f, 0xf3, 0x24, 0x54, 0x6c, 0x07, 0x93,
-	0x76, 0x47, 0xc4, 0x15, 0xab, 0x7e, 0x65, 0x2a, 0xb9, 0x90, 0xab, 0xe4, 0x4f, 0xc0, 0x69, 0x1c,
-	0x49, 0x2f, 0x9f, 0x92, 0xc6, 0xdd, 0x9e, 0x2f, 0x7d, 0xd3, 0x2d, 0x3b, 0x4b, 0xda, 0xb6, 0x92,
-	0xda, 0x95, 0x04, 0x83, 0xae, 0x02, 0x85, 0xbf, 0x16, 0x41, 0xe9, 0x2d, 0x35, 0xe9, 0xec, 0x0a,
-	0x24, 0xb0, 0xe5, 0x82, 0xc5, 0x1e, 0x62, 0xa8, 0xab, 0xfc, 0x28, 0x6e, 0x5e, 0x9f, 0xc9, 0xb7,
-	0x23, 0x43, 0x9d, 0x4b, 0x9a, 0xe2, 0x9c, 0xa2, 0x50, 0x00, 0xd0, 0xd5, 0x48, 0xd6, 0x47, 0xe0,
-	0x6c, 0x0b, 0xe3, 0x46, 0x8f, 0xd2, 0x40, 0x17, 0xe1, 0x8d, 0x99, 0xa8, 0x6f, 0x62, 0xbc, 0x43,
-	0x69, 0xe0, 0xac, 0x68, 0xd8, 0xf3, 0x0a, 0x36, 0xc6, 0x80, 0xee, 0x99, 0x96, 0x8a, 0xb0, 0xbe,
-	0x31, 0x40, 0x39, 0xad, 0x99, 0x64, 0xcc, 0x88, 0x32, 0x22, 0x6a, 0x7c, 0x85, 0xf9, 0x33, 0x2d,
-	0x3b, 0x50, 0x39, 0xcf, 0x69, 0xe2, 0xda, 0x68, 0x55, 0xe6, 0x19, 0xa0, 0xbb, 0xec, 0x4f, 0x5a,
-	0xcf, 0xad, 0x2f, 0xc0, 0xc5, 0x1e, 0xc3, 0x03, 0x42, 0xfb, 0xbc, 0xd1, 0x63, 0xb4, 0x47, 0x39,
-	0x66, 0xf2, 0x00, 0x4b, 0xce, 0xfb, 0x69, 0x82, 0x8d, 0x85, 0x44, 0x09, 0x66, 0xcf, 0x91, 0x60,
-	0xdb, 0x34, 0xe4, 0x49, 0x86, 0xc5, 0x28, 0x3b, 0x1a, 0xc4, 0xfa, 0x7a, 0xca, 0xdc, 0x71, 0x5a,
-	0xfa, 0xf1, 0xfa, 0x7c, 0x09, 0x34, 0x6d, 0xa2, 0x72, 0xe0, 0xe3, 0x27, 0x93, 0x49, 0xa3, 0x86,
-	0xf5, 0x9b, 0x01, 0xae, 0x65, 0x2a, 0x2a, 0xbd, 0x64, 0x1b, 0x5e, 0x72, 0x31, 0xf3, 0xf2, 0xa2,
-	0xd4, 0xb8, 0xf5, 0x2f, 0x2e, 0x77, 0x2d, 0xf3, 0xb6, 0x96, 0xb9, 0x3e, 0x56, 0xcb, 0x93, 0x99,
-	0xa1, 0x5b, 0x1b, 0xcc, 0xc4, 0xe5, 0xd6, 0x2f, 0x06, 0x58, 0x4d, 0x71, 0x3a, 0xc9, 0x4d, 0x99,
-	0x18, 0x7c, 0x46, 0x8a, 0x7f, 0xed, 0x98, 0x37, 0xad, 0x16, 0x7e, 0x53, 0x0b, 0xbf, 0x3e, 0x2a,
-	0x7c, 0x9c, 0x10, 0xba, 0x95, 0xc1, 0x54, 0xb8, 0x68, 0xfc, 0xbc, 0x9c, 0xae, 0xf6, 0xd4, 0x7d,
-	0x96, 0x68, 0x3d, 0x2b, 0xb5, 0xde, 0x39, 0xce, 0x65, 0xa8, 0x85, 0xae, 0x6b, 0xa1, 0x6b, 0xa3,
-	0x42, 0x47, 0xa8, 0xa0, 0xbb, 0x32, 0x98, 0x0c, 0x64, 0x7d, 0x9b, 0x2b, 0xdf, 0x5c, 0x43, 0xe7,
-	0xe5, 0xa7, 0xa4, 0xc2, 0x57, 0x9e, 0xfc, 0xa2, 0xd0, 0xfa, 0xa6, 0x16, 0x71, 0x9e, 0x27, 0x5b,
-	0xc4, 0x59, 0x14, 0x1e, 0xd5, 0xd1, 0x72, 0xba, 0x2b, 0x1e, 0x75, 0xd4, 0x86, 0xec, 0x9a, 0xbc,
-	0x0c, 0xa4, 0xb6, 0x97, 0x9e, 0xb4, 0x17, 0x6b, 0x65, 0xcf, 0x6a, 0x65, 0x57, 0x47, 0x9d, 0xcb,
-	0x72, 0x40, 0x77, 0x69, 0x30, 0x8e, 0xc0, 0x9d, 0x7b, 0x3f, 0x1d, 0x54, 0x8d, 0x87, 0x07, 0x55,
-	0xe3, 0xd1, 0x41, 0xd5, 0xf8, 0xeb, 0xa0, 0x6a, 0x7c, 0x75, 0x58, 0x5d, 0x78, 0x74, 0x58, 0x5d,
-	0xf8, 0xe3, 0xb0, 0xba, 0xf0, 0xf1, 0xc6, 0xcc, 0xee, 0xf1, 0x59, 0xfe, 0x63, 0x53, 0x36, 0x93,
-	0xe6, 0xa2, 0xfc, 0xbc, 0x7c, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0x32, 0x1e, 0xb6,
-	0x0e, 0x0f, 0x00, 0x00,
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xce, 0xda, 0x6d, 0xd2, 0xff, 0xd8, 0x6d, 0xda, 0x6d, 0xea, 0xb8, 0x6e, 0x6a, 0x27, 0xd3,
+	0xfe, 0x45, 0xa0, 0xea, 0xba, 0x09, 0x08, 0x50, 0x11, 0x48, 0xd9, 0x94, 0x97, 0x4a, 0x40, 0xc3,
+	0x44, 0x0a, 0x08, 0x21, 0x59, 0xeb, 0xdd, 0xb1, 0x3d, 0xc2, 0xde, 0xb1, 0x66, 0xc6, 0x4e, 0x03,
+	0x47, 0xae, 0x3d, 0x20, 0x21, 0x4e, 0x08, 0x09, 0x0e, 0x48, 0x08, 0xf1, 0x1d, 0x90, 0x38, 0xf5,
+	0xd8, 0x23, 0x27, 0x83, 0x92, 0x6f, 0x90, 0x23, 0xe2, 0x80, 0x76, 0x66, 0xf6, 0xcd, 0x6f, 0x75,
+	0x83, 0x04, 0x39, 0x25, 0xde, 0xfd, 0xcd, 0xf3, 0x3c, 0xf3, 0xcc, 0xef, 0x65, 0x16, 0x3c, 0xef,
+	0x52, 0xde, 0xa1, 0xbc, 0xea, 0x11, 0x2e, 0x18, 0xa9, 0xf7, 0x04, 0xa1, 0x7e, 0xb5, 0xbf, 0x51,
+	0xc7, 0xc2, 0xd9, 0xa8, 0x36, 0xb1, 0x8f, 0x39, 0xe1, 0x56, 0x97, 0x51, 0x41, 0xcd, 0x6b, 0x2a,
+	0xd4, 0x4a, 0x86, 0x5a, 0x3a, 0xb4, 0xb4, 0xd4, 0xa4, 0x4d, 0x2a, 0xe3, 0xaa, 0xc1, 0x7f, 0x6a,
+	0x49, 0xa9, 0xac, 0xd1, 0xeb, 0x0e, 0xc7, 0x11, 0xaa, 0x4b, 0x89, 0xaf, 0xdf, 0x5b, 0xd3, 0xd8,
+	0x53, 0x3c, 0x32, 0x1e, 0x3e, 0xca, 0x80, 0x2b, 0xf7, 0x70, 0x1b, 0x37, 0x1d, 0x41, 0xd9, 0x87,
+	0x44, 0xb4, 0x3c, 0xe6, 0xec, 0xdf, 0xf7, 0x1b, 0xd4, 0xfc, 0x0c, 0x5c, 0xf2, 0xc2, 0x17, 0x35,
+	0xc7, 0xf3, 0x18, 0xe6, 0xbc, 0x68, 0xac, 0x1a, 0xeb, 0x79, 0xfb, 0xbd, 0xe3, 0x41, 0xa5, 0x78,
+	0xe0, 0x74, 0xda, 0x77, 0xe1, 0x48, 0x08, 0xfc, 0x73, 0x50, 0xb9, 0xdd, 0x24, 0xa2, 0xd5, 0xab,
+	0x5b, 0x2e, 0xed, 0x54, 0xb5, 0x1e, 0xf5, 0xe7, 0x36, 0xf7, 0x3e, 0xad, 0x8a, 0x83, 0x2e, 0xe6,
+	0xd6, 0x96, 0xeb, 0x6e, 0xa9, 0x15, 0xe8, 0x62, 0x04, 0xa2, 0x9f, 0x98, 0xfb, 0xe0, 0xe2, 0xbe,
+	0xd6, 0x12, 0x51, 0x67, 0x24, 0xf5, 0xbb, 0xc7, 0x83, 0xca, 0xb2, 0xa2, 0x1e, 0x8e, 0x38, 0x01,
+	0xf3, 0x62, 0x88, 0xa1, 0x1f, 0xc0, 0x5f, 0x33, 0x60, 0x6d, 0xcf, 0x69, 0x13, 0x2f, 0x50, 0xf3,
+	0xa0, 0x27, 0xb8, 0x70, 0x7c, 0x8f, 0xf8, 0x4d, 0x84, 0xf7, 0x1d, 0xe6, 0x71, 0x84, 0x5d, 0xca,
+	0xbc, 0xc0, 0x9a, 0x7e, 0x18, 0x34, 0xd9, 0x9a, 0x91, 0x90, 0x59, 0x05, 0xee, 0x39, 0xed, 0xc8,
+	0x9a, 0x08, 0x24, 0xb4, 0xe6, 0x3b, 0x03, 0x5c, 0xa6, 0xb1, 0xb0, 0x1a, 0x53, 0xca, 0x8a, 0x99,
+	0xd5, 0xec, 0x7a, 0x6e, 0x73, 0x45, 0x9f, 0xbf, 0x15, 0xe4, 0x47, 0x98, 0x4a, 0xd6, 0x3d, 0xec,
+	0x6e, 0x53, 0xe2, 0xdb, 0x1f, 0x3c, 0x1e, 0x54, 0xe6, 0x8e, 0x07, 0x95, 0x92, 0x12, 0x38, 0x06,
+	0x06, 0xfe, 0xf4, 0x7b, 0xe5, 0xd6, 0x0c, 0x12, 0x35, 0x22, 0x47, 0x26, 0x1d, 0x31, 0x09, 0x7e,
+	0x9b, 0x01, 0x37, 0x23, 0x13, 0xb7, 0x5c, 0xb7, 0xd7, 0xe9, 0xb5, 0x1d, 0x81, 0xbd, 0x6d, 0xda,
+	0xe9, 0x10, 0xce, 0x09, 0xf5, 0x4f, 0x81, 0x8f, 0x07, 0x20, 0xe7, 0xc4, 0xd2, 0x64, 0x76, 0xe5,
+	0x36, 0x5f, 0xb3, 0xa6, 0x54, 0xa4, 0x35, 0x7d, 0x4f, 0x76, 0x49, 0xbb, 0x6b, 0x2a, 0xd9, 0x09,
+	0x74, 0x88, 0x92, 0x5c, 0xf0, 0xfb, 0x0c, 0x58, 0x8d, 0xb0, 0xde, 0x21, 0x5c, 0x50, 0x46, 0x5c,
+	0xa7, 0x7d, 0x7a, 0x72, 0xac, 0x00, 0xe6, 0xbb, 0x98, 0x11, 0xaa, 0x6c, 0x39, 0x83, 0xf4, 0x2f,
+	0x93, 0x80, 0x85, 0x30, 0xdd, 0xb2, 0xd2, 0xaf, 0x57, 0x66, 0xf3, 0x6b, 0x64, 0x8f, 0x76, 0x41,
+	0x7b, 0x75, 0x41, 0x6d, 0x23, 0xcc, 0x3e, 0x14, 0xe2, 0xc3, 0x2f, 0x32, 0xe0, 0x7a, 0xb4, 0x7e,
+	0xbb, 0xc7, 0x18, 0xf6, 0xc5, 0xe9, 0x31, 0xa8, 0x11, 0x1b, 0xa1, 0x12, 0xe7, 0xa5, 0xd9, 0x8c,
+	0x48, 0x6f, 0xe4, 0xe9, 0x2e, 0x3c, 0xca, 0x82, 0x6b, 0x51, 0x77, 0xde, 0x15, 0x0e, 0x13, 0xc4,
+	0x6f, 0x06, 0xdd, 0x39, 0xf6, 0xe0, 0x3f, 0xeb, 0xd1, 0x63, 0xfd, 0xcf, 0xfc, 0x3b, 0xfe, 0xf7,
+	0xc0, 0x79, 0xae, 0xdd, 0xa8, 0x11, 0xbf, 0x41, 0x75, 0x3a, 0x6e, 0x4e, 0x3d, 0x85, 0xb1, 0x46,
+	0xda, 0x2b, 0xfa, 0x0c, 0x96, 0x94, 0xde, 0x14, 0x2c, 0x44, 0x79, 0x9e, 0x88, 0x85, 0x3f, 0x64,
+	0xc0, 0xd5, 0xe8, 0x2c, 0x77, 0xdb, 0x0e, 0x6f, 0xbd, 0xd9, 0x97, 0xc7, 0x79, 0x1a, 0x2a, 0xb6,
+	0x85, 0x49, 0xb3, 0x25, 0xc2, 0x8a, 0x55, 0xbf, 0x12, 0x95, 0x9c, 0x4d, 0x55, 0xf2, 0x27, 0xe0,
+	0x2c, 0x0e, 0xa4, 0x17, 0xcf, 0x48, 0xe3, 0xee, 0xcc, 0x96, 0xbe, 0xf1, 0x96, 0xed, 0x25, 0x6d,
+	0x5b, 0x5e, 0xed, 0x4a, 0x82, 0x41, 0xa4, 0x40, 0xe1, 0x5f, 0x79, 0x90, 0x7f, 0x5b, 0xdd, 0x74,
+	0x76, 0x85, 0x23, 0xb0, 0x89, 0xc0, 0x7c, 0xd7, 0x61, 0x4e, 0x47, 0xf9, 0x91, 0xdb, 0xbc, 0x31,
+	0x95, 0x6f, 0x47, 0x86, 0xda, 0x57, 0x34, 0xc5, 0x79, 0x45, 0xa1, 0x00, 0x20, 0xd2, 0x48, 0xe6,
+	0x47, 0xe0, 0x5c, 0x03, 0xe3, 0x5a, 0x97, 0xd2, 0xb6, 0x2e, 0xc2, 0x9b, 0x53, 0x51, 0xdf, 0xc2,
+	0x78, 0x87, 0xd2, 0xb6, 0xbd, 0xac, 0x61, 0x17, 0x15, 0x6c, 0x88, 0x01, 0xd1, 0x42, 0x43, 0x45,
+	0x98, 0x5f, 0x1b, 0xa0, 0x18, 0xd7, 0x4c, 0x74, 0xcd, 0x08, 0x32, 0x22, 0x68, 0x7c, 0xd9, 0xd9,
+	0x33, 0x2d, 0x79, 0xa1, 0xb2, 0x9f, 0xd3, 0xc4, 0x95, 0xe1, 0xaa, 0x4c, 0x33, 0x40, 0x54, 0xf0,
+	0xc6, 0xad, 0xe7, 0xe6, 0xe7, 0xe0, 0x52, 0x97, 0xe1, 0x3e, 0xa1, 0x3d, 0x5e, 0xeb, 0x32, 0xda,
+	0xa5, 0x1c, 0x33, 0x79, 0x80, 0x79, 0xfb, 0xfd, 0x38, 0xc1, 0x46, 0x42, 0x82, 0x04, 0xb3, 0x66,
+	0x48, 0xb0, 0x6d, 0xea, 0xf3, 0x28, 0xc3, 0x42, 0x94, 0x1d, 0x0d, 0x62, 0x7e, 0x35, 0xe1, 0xde,
+	0x71, 0x56, 0xfa, 0xf1, 0xc6, 0x6c, 0x09, 0x34, 0xe9, 0x46, 0x65, 0xc3, 0xa7, 0xdf, 0x4c, 0xc6,
+	0x5d, 0x35, 0xcc, 0x5f, 0x0c, 0xb0, 0x96, 0xa8, 0xa8, 0x78, 0xc8, 0xd6, 0xdc, 0x68, 0x30, 0xf3,
+	0xe2, 0xbc, 0xd4, 0xb8, 0xf5, 0x0f, 0x86, 0xbb, 0x96, 0x79, 0x47, 0xcb, 0x5c, 0x1f, 0xa9, 0xe5,
+	0xf1, 0xcc, 0x10, 0x55, 0xfa, 0x53, 0x71, 0xb9, 0xf9, 0xb3, 0x01, 0x56, 0x62, 0x9c, 0x56, 0x34,
+	0x29, 0x23, 0x83, 0x17, 0xa4, 0xf8, 0xd7, 0x4f, 0x38, 0x69, 0xb5, 0xf0, 0x5b, 0x5a, 0xf8, 0x8d,
+	0x61, 0xe1, 0xa3, 0x84, 0x10, 0x95, 0xfa, 0x13, 0xe1, 0x82, 0xeb, 0xe7, 0xd5, 0x78, 0xb5, 0xab,
+	0xe6, 0x59, 0xa4, 0xf5, 0x9c, 0xd4, 0x7a, 0xf7, 0x24, 0xc3, 0x50, 0x0b, 0x5d, 0xd7, 0x42, 0x57,
+	0x87, 0x85, 0x0e, 0x51, 0x41, 0xb4, 0xdc, 0x1f, 0x0f, 0x64, 0x7e, 0x93, 0x2a, 0xdf, 0x54, 0x43,
+	0xe7, 0xc5, 0xff, 0x49, 0x85, 0xaf, 0x3e, 0xfb, 0xa0, 0xd0, 0xfa, 0x26, 0x16, 0x71, 0x9a, 0x27,
+	0x59, 0xc4, 0x49, 0x14, 0x1e, 0xd4, 0x51, 0x21, 0xde, 0x15, 0x0f, 0x3a, 0x6a, 0x4d, 0x76, 0x4d,
+	0x5e, 0x04, 0x52, 0xdb, 0xcb, 0xcf, 0xda, 0x8b, 0xb5, 0xb2, 0xff, 0x6b, 0x65, 0xd7, 0x87, 0x9d,
+	0x4b, 0x72, 0x40, 0xb4, 0xd4, 0x1f, 0x45, 0xe0, 0x26, 0x07, 0x8b, 0x8d, 0x9e, 0xaa, 0x37, 0x2e,
+	0x18, 0x0e, 0x3a, 0x75, 0x4e, 0xaa, 0x79, 0x61, 0x7a, 0x4f, 0x55, 0x6b, 0x76, 0xe5, 0x12, 0xbb,
+	0xac, 0x15, 0x14, 0x74, 0x67, 0x4d, 0x03, 0x42, 0x74, 0xa1, 0x91, 0x0c, 0xe7, 0xe6, 0x1e, 0x28,
+	0xf8, 0xf8, 0xa1, 0xa8, 0xa5, 0x03, 0x6b, 0xc4, 0x2b, 0xe6, 0x83, 0x61, 0x65, 0xaf, 0xc5, 0xbb,
+	0x19, 0x1f, 0x07, 0xd1, 0xe5, 0xe0, 0x45, 0x4a, 0xc5, 0x7d, 0xcf, 0x7e, 0xf0, 0xe3, 0x61, 0xd9,
+	0x78, 0x7c, 0x58, 0x36, 0x9e, 0x1c, 0x96, 0x8d, 0x3f, 0x0e, 0xcb, 0xc6, 0x97, 0x47, 0xe5, 0xb9,
+	0x27, 0x47, 0xe5, 0xb9, 0xdf, 0x8e, 0xca, 0x73, 0x1f, 0x6f, 0x4c, 0x6d, 0x85, 0x0f, 0xd3, 0x5f,
+	0xce, 0xb2, 0x33, 0xd6, 0xe7, 0xe5, 0xb7, 0xf2, 0x8b, 0x7f, 0x0f, 0x00, 0x59, 0x97, 0x67, 0xe7,
+	0xdb, 0x0f, 0x00, 0x00,
 }
 
 func (this *DelegatorWithdrawInfo) Equal(that interface{}) bool {
@@ -950,6 +972,17 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.FundingStreams) != len(that1.FundingStreams) {
+		return false
+	}
+	for i := range this.FundingStreams {
+		if !this.FundingStreams[i].Equal(&that1.FundingStreams[i]) {
+			return false
+		}
+	}
+	if this.NextFundingStreamId != that1.NextFundingStreamId {
+		return false
+	}
 	return true
 }
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -1275,6 +1308,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextFundingStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFundingStreamId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FundingStreams) > 0 {
+		for iNdEx := len(m.FundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1594,6 +1646,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FundingStreams) > 0 {
+		for _, e := range m.FundingStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFundingStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFundingStreamId))
+	}
 	return n
 }
 
@@ -2903,6 +2964,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreams = append(m.FundingStreams, FundingStream{})
+			if err := m.FundingStreams[len(m.FundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingStreamId", wireType)
+			}
+			m.NextFundingStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFundingStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,10 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<streamID_Bytes>: FundingStream
+//
+// - 0x0a: nextFundingStreamID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +55,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	FundingStreamPrefix = []byte{0x09} // key for community pool funding streams
+	FundingStreamIDKey  = []byte{0x0a} // key for the id of the next funding stream
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -191,3 +198,8 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the key for a community pool funding stream
+func GetFundingStreamKey(id uint64) []byte {
+	return append(FundingStreamPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -3,22 +3,36 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCreateFundingStream defines the type for a CreateFundingStreamProposal
+	ProposalTypeCreateFundingStream = "CreateFundingStream"
+	// ProposalTypeCancelFundingStream defines the type for a CancelFundingStreamProposal
+	ProposalTypeCancelFundingStream = "CancelFundingStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolSpendProposal{}
+// Assert the distribution proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolSpendProposal{}
+	_ govtypes.Content = &CreateFundingStreamProposal{}
+	_ govtypes.Content = &CancelFundingStreamProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreateFundingStream)
+	govtypes.RegisterProposalTypeCodec(&CreateFundingStreamProposal{}, "cosmos-sdk/CreateFundingStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelFundingStream)
+	govtypes.RegisterProposalTypeCodec(&CancelFundingStreamProposal{}, "cosmos-sdk/CancelFundingStreamProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spned proposal.
@@ -65,3 +79,93 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewCreateFundingStreamProposal creates a new create funding stream proposal.
+func NewCreateFundingStreamProposal(
+	title, description string, recipient sdk.AccAddress, amount sdk.Coins, payoutInterval uint64, expiry time.Time,
+) *CreateFundingStreamProposal {
+	return &CreateFundingStreamProposal{title, description, recipient, amount, payoutInterval, expiry}
+}
+
+// GetTitle returns the title of a create funding stream proposal.
+func (cfp *CreateFundingStreamProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a create funding stream proposal.
+func (cfp *CreateFundingStreamProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a create funding stream proposal.
+func (cfp *CreateFundingStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a create funding stream proposal.
+func (cfp *CreateFundingStreamProposal) ProposalType() string { return ProposalTypeCreateFundingStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp *CreateFundingStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cfp)
+	if err != nil {
+		return err
+	}
+	if err := ValidateFundingStreamTerms(cfp.Recipient, cfp.Amount, cfp.PayoutInterval); err != nil {
+		return err
+	}
+	if cfp.Expiry.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidFundingStream, "expiry must be set")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cfp CreateFundingStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Create Funding Stream Proposal:
+  Title:           %s
+  Description:     %s
+  Recipient:       %s
+  Amount:          %s
+  Payout Interval: %d
+  Expiry:          %s
+`, cfp.Title, cfp.Description, cfp.Recipient, cfp.Amount, cfp.PayoutInterval, cfp.Expiry))
+	return b.String()
+}
+
+// NewCancelFundingStreamProposal creates a new cancel funding stream proposal.
+func NewCancelFundingStreamProposal(title, description string, streamID uint64) *CancelFundingStreamProposal {
+	return &CancelFundingStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a cancel funding stream proposal.
+func (cfp *CancelFundingStreamProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a cancel funding stream proposal.
+func (cfp *CancelFundingStreamProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a cancel funding stream proposal.
+func (cfp *CancelFundingStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel funding stream proposal.
+func (cfp *CancelFundingStreamProposal) ProposalType() string { return ProposalTypeCancelFundingStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp *CancelFundingStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cfp)
+	if err != nil {
+		return err
+	}
+	if cfp.StreamId == 0 {
+		return sdkerrors.Wrap(ErrUnknownFundingStream, "stream id must be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cfp CancelFundingStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Funding Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, cfp.Title, cfp.Description, cfp.StreamId))
+	return b.String()
+}
@@ -899,6 +899,199 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryFundingStreamRequest is the request type for the Query/FundingStream RPC method.
+type QueryFundingStreamRequest struct {
+	// stream_id defines the id of the funding stream to query for.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryFundingStreamRequest) Reset()         { *m = QueryFundingStreamRequest{} }
+func (m *QueryFundingStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamRequest) ProtoMessage()    {}
+func (*QueryFundingStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryFundingStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamRequest.Merge(m, src)
+}
+func (m *QueryFundingStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamRequest proto.InternalMessageInfo
+
+func (m *QueryFundingStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryFundingStreamResponse is the response type for the Query/FundingStream RPC method.
+type QueryFundingStreamResponse struct {
+	// stream defines the requested funding stream.
+	Stream FundingStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+}
+
+func (m *QueryFundingStreamResponse) Reset()         { *m = QueryFundingStreamResponse{} }
+func (m *QueryFundingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamResponse) ProtoMessage()    {}
+func (*QueryFundingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryFundingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamResponse.Merge(m, src)
+}
+func (m *QueryFundingStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamResponse proto.InternalMessageInfo
+
+func (m *QueryFundingStreamResponse) GetStream() FundingStream {
+	if m != nil {
+		return m.Stream
+	}
+	return FundingStream{}
+}
+
+// QueryFundingStreamsRequest is the request type for the Query/FundingStreams RPC method.
+type QueryFundingStreamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingStreamsRequest) Reset()         { *m = QueryFundingStreamsRequest{} }
+func (m *QueryFundingStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamsRequest) ProtoMessage()    {}
+func (*QueryFundingStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryFundingStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamsRequest.Merge(m, src)
+}
+func (m *QueryFundingStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryFundingStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFundingStreamsResponse is the response type for the Query/FundingStreams RPC method.
+type QueryFundingStreamsResponse struct {
+	// streams defines the funding streams.
+	Streams []FundingStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingStreamsResponse) Reset()         { *m = QueryFundingStreamsResponse{} }
+func (m *QueryFundingStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamsResponse) ProtoMessage()    {}
+func (*QueryFundingStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryFundingStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamsResponse.Merge(m, src)
+}
+func (m *QueryFundingStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryFundingStreamsResponse) GetStreams() []FundingStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryFundingStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -918,6 +1111,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryFundingStreamRequest)(nil), "cosmos.distribution.v1beta1.QueryFundingStreamRequest")
+	proto.RegisterType((*QueryFundingStreamResponse)(nil), "cosmos.distribution.v1beta1.QueryFundingStreamResponse")
+	proto.RegisterType((*QueryFundingStreamsRequest)(nil), "cosmos.distribution.v1beta1.QueryFundingStreamsRequest")
+	proto.RegisterType((*QueryFundingStreamsResponse)(nil), "cosmos.distribution.v1beta1.QueryFundingStreamsResponse")
 }
 
 func init() {
@@ -925,77 +1122,86 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1111 bytes of a gzipped FileDescriptorProto
+	// 1259 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb8, 0x69, 0x2a, 0xbd, 0xb6, 0x24, 0x9d, 0x56, 0xc8, 0x6c, 0x82, 0x13, 0x6d, 0x28,
-	0x89, 0x88, 0xe2, 0x6d, 0x12, 0xa9, 0xfc, 0x28, 0x08, 0xe2, 0x24, 0xa5, 0x52, 0x51, 0x9b, 0x98,
-	0x2a, 0x29, 0x08, 0x61, 0x8d, 0x77, 0x47, 0xeb, 0x55, 0xed, 0x1d, 0x77, 0x67, 0x9c, 0x10, 0x55,
-	0x3d, 0x40, 0x5b, 0x24, 0x0e, 0x48, 0x48, 0x70, 0xe0, 0x6f, 0xe0, 0xce, 0x85, 0xbf, 0xa0, 0xc7,
-	0x4a, 0x48, 0xa8, 0xa7, 0x16, 0x25, 0x08, 0x71, 0xe1, 0x8c, 0xc4, 0x09, 0x79, 0x76, 0xd6, 0xeb,
-	0xb5, 0xd7, 0xeb, 0x5f, 0xd4, 0xa7, 0x58, 0x6f, 0xe7, 0x7d, 0xf3, 0x7d, 0x6f, 0xe6, 0xbd, 0xf9,
-	0x14, 0x58, 0x30, 0x19, 0xaf, 0x30, 0x6e, 0x58, 0x0e, 0x17, 0x9e, 0x53, 0xac, 0x09, 0x87, 0xb9,
-	0xc6, 0xfe, 0x4a, 0x91, 0x0a, 0xb2, 0x62, 0xdc, 0xad, 0x51, 0xef, 0x30, 0x5b, 0xf5, 0x98, 0x60,
-	0x78, 0xda, 0x5f, 0x98, 0x6d, 0x5e, 0x98, 0x55, 0x0b, 0xb5, 0x37, 0x14, 0x4a, 0x91, 0x70, 0xea,
-	0x67, 0x35, 0x30, 0xaa, 0xc4, 0x76, 0x5c, 0x22, 0x57, 0x4b, 0x20, 0xed, 0x82, 0xcd, 0x6c, 0x26,
-	0x7f, 0x1a, 0xf5, 0x5f, 0x2a, 0x3a, 0x63, 0x33, 0x66, 0x97, 0xa9, 0x41, 0xaa, 0x8e, 0x41, 0x5c,
-	0x97, 0x09, 0x99, 0xc2, 0xd5, 0xd7, 0x4c, 0x33, 0x7e, 0x80, 0x6c, 0x32, 0x27, 0xc0, 0xcc, 0x26,
-	0xa9, 0x88, 0x30, 0x96, 0xeb, 0xf5, 0x0b, 0x80, 0x77, 0xea, 0x2c, 0xb7, 0x89, 0x47, 0x2a, 0x3c,
-	0x4f, 0xef, 0xd6, 0x28, 0x17, 0xfa, 0x6d, 0x38, 0x1f, 0x89, 0xf2, 0x2a, 0x73, 0x39, 0xc5, 0xeb,
-	0x30, 0x51, 0x95, 0x91, 0x34, 0x9a, 0x43, 0x8b, 0xa7, 0x57, 0xe7, 0xb3, 0x09, 0xa5, 0xc8, 0xfa,
-	0xc9, 0xb9, 0xf1, 0xc7, 0xcf, 0x66, 0xc7, 0xf2, 0x2a, 0x51, 0xff, 0x06, 0xc1, 0x82, 0x84, 0xde,
-	0x25, 0x65, 0xc7, 0x22, 0x82, 0x79, 0x37, 0x6b, 0x82, 0x0b, 0xe2, 0x5a, 0x8e, 0x6b, 0xe7, 0xe9,
-	0x01, 0xf1, 0xac, 0x80, 0x05, 0xfe, 0x1c, 0xce, 0xed, 0x07, 0xab, 0x0a, 0xc4, 0xb2, 0x3c, 0xca,
-	0xfd, 0x9d, 0xcf, 0xe4, 0x56, 0xfe, 0x7d, 0x36, 0xbb, 0x6c, 0x3b, 0xa2, 0x54, 0x2b, 0x66, 0x4d,
-	0x56, 0x31, 0x94, 0x6a, 0xff, 0xcf, 0x32, 0xb7, 0xee, 0x18, 0xe2, 0xb0, 0x4a, 0x79, 0x76, 0x97,
-	0x94, 0xd7, 0xfd, 0xc4, 0xfc, 0x54, 0x03, 0x4b, 0x45, 0xf4, 0x87, 0x08, 0x16, 0xbb, 0x73, 0x51,
-	0xda, 0x6f, 0xc3, 0x29, 0xcf, 0x0f, 0x29, 0xf1, 0x6f, 0x25, 0x8a, 0x4f, 0x80, 0x54, 0x15, 0x09,
-	0xe0, 0xf4, 0x2f, 0x11, 0xcc, 0x46, 0x69, 0x6c, 0xb0, 0x4a, 0xc5, 0xe1, 0xdc, 0x61, 0xee, 0xa8,
-	0x4a, 0xf1, 0x08, 0xc1, 0x5c, 0x67, 0x0e, 0xaa, 0x04, 0x04, 0xc0, 0x6c, 0x44, 0x55, 0x15, 0xae,
-	0xf4, 0x56, 0x85, 0x75, 0xd3, 0xac, 0x55, 0x6a, 0x65, 0x22, 0xa8, 0x15, 0x02, 0xab, 0x42, 0x34,
-	0x81, 0xea, 0x3f, 0xa4, 0x60, 0x26, 0xca, 0xe3, 0xe3, 0x32, 0xe1, 0x25, 0x3a, 0xaa, 0x3b, 0x81,
-	0x17, 0x60, 0x92, 0x0b, 0xe2, 0x09, 0xc7, 0xb5, 0x0b, 0x25, 0xea, 0xd8, 0x25, 0x91, 0x4e, 0xcd,
-	0xa1, 0xc5, 0xf1, 0xfc, 0x4b, 0x41, 0xf8, 0x9a, 0x8c, 0xe2, 0x79, 0x38, 0x4b, 0xe5, 0xa9, 0x06,
-	0xcb, 0x4e, 0xc8, 0x65, 0x67, 0xfc, 0xa0, 0x5a, 0x74, 0x15, 0x20, 0xec, 0xfa, 0xf4, 0xb8, 0xac,
-	0xd8, 0xeb, 0x41, 0xc5, 0xea, 0x2d, 0x9c, 0xf5, 0x07, 0x4b, 0xd8, 0x32, 0x36, 0x55, 0x4a, 0xf3,
-	0x4d, 0x99, 0xfa, 0x2f, 0x08, 0x5e, 0xed, 0x50, 0x16, 0x75, 0x36, 0xdb, 0x70, 0x8a, 0xfb, 0xa1,
-	0x34, 0x9a, 0x3b, 0xb1, 0x78, 0x7a, 0xf5, 0x52, 0x6f, 0x07, 0x23, 0x71, 0xb6, 0xf6, 0xa9, 0x2b,
-	0x82, 0x6b, 0xa9, 0x60, 0xf0, 0x87, 0x11, 0xee, 0x29, 0xc9, 0x7d, 0xa1, 0x2b, 0x77, 0x9f, 0x4e,
-	0x84, 0xfc, 0xf3, 0x80, 0xfc, 0x26, 0x2d, 0x53, 0x5b, 0xc6, 0xda, 0x1b, 0xdd, 0xf2, 0xbf, 0x0d,
-	0x7c, 0xa8, 0xeb, 0xa6, 0xd9, 0x38, 0xd4, 0x06, 0x56, 0x70, 0xa8, 0xb1, 0x97, 0x26, 0xf5, 0xff,
-	0x75, 0xcf, 0xb7, 0x08, 0x32, 0x9d, 0x14, 0xaa, 0xf3, 0xb9, 0xd3, 0x3c, 0x3e, 0xea, 0xe7, 0x33,
-	0x13, 0x29, 0x65, 0x50, 0xc4, 0x4d, 0x6a, 0x6e, 0x30, 0xc7, 0xcd, 0xad, 0xd5, 0xcf, 0xe2, 0xa7,
-	0xe7, 0xb3, 0x4b, 0x3d, 0x50, 0x53, 0x39, 0x3c, 0x9c, 0x28, 0x0f, 0x11, 0xe8, 0x2d, 0x7c, 0x6e,
-	0x31, 0x41, 0xca, 0xa3, 0x2d, 0xbb, 0xfe, 0x27, 0x82, 0xf9, 0x44, 0x1a, 0xaa, 0x36, 0xbb, 0xad,
-	0xb5, 0xb9, 0x9c, 0x78, 0x77, 0x43, 0xb4, 0xcd, 0x60, 0x47, 0x1f, 0xb1, 0x65, 0xb0, 0x62, 0x1b,
-	0x4e, 0x8a, 0xfa, 0x7e, 0xe9, 0xd4, 0x8b, 0xaa, 0xb8, 0x8f, 0x1f, 0x4e, 0xf0, 0x06, 0xa1, 0x46,
-	0x7f, 0x8d, 0xac, 0xd8, 0x35, 0x35, 0xc0, 0x63, 0x29, 0xa8, 0x42, 0xef, 0x00, 0x34, 0xee, 0xae,
-	0x5f, 0xeb, 0x81, 0x1a, 0xa0, 0x09, 0x44, 0xff, 0x1a, 0xc1, 0x6b, 0xd1, 0x7d, 0xf7, 0x1c, 0x51,
-	0xb2, 0x3c, 0x72, 0x10, 0xac, 0x1e, 0x91, 0xfe, 0x47, 0x08, 0x2e, 0x76, 0x21, 0xa2, 0xaa, 0xf0,
-	0x19, 0x4c, 0x1d, 0xa8, 0x4f, 0xc3, 0x13, 0x99, 0x3c, 0x88, 0xee, 0xa2, 0x4f, 0xc3, 0x2b, 0x92,
-	0x46, 0xfd, 0x99, 0xab, 0xb9, 0x8e, 0x38, 0xdc, 0x66, 0xac, 0x1c, 0xf8, 0xaa, 0x07, 0x08, 0xb4,
-	0xb8, 0xaf, 0x8a, 0x19, 0x85, 0xf1, 0x2a, 0x63, 0xe5, 0x17, 0x37, 0x21, 0x24, 0xfc, 0xea, 0xd3,
-	0x49, 0x38, 0x29, 0x59, 0xe0, 0x1f, 0x11, 0x4c, 0xf8, 0x36, 0x0d, 0x1b, 0x89, 0x3d, 0xd7, 0xee,
-	0x11, 0xb5, 0x4b, 0xbd, 0x27, 0xf8, 0xf2, 0xf4, 0xa5, 0xaf, 0x7e, 0xfd, 0xe3, 0xfb, 0xd4, 0x45,
-	0x3c, 0x6f, 0x24, 0x99, 0x54, 0xdf, 0x28, 0xe2, 0x07, 0x29, 0x98, 0x4e, 0x30, 0x51, 0x78, 0xb3,
-	0xfb, 0xf6, 0xdd, 0x2d, 0xa6, 0xb6, 0x35, 0x24, 0x8a, 0x52, 0xb6, 0x27, 0x95, 0xed, 0xe0, 0x9b,
-	0x89, 0xca, 0xc2, 0xb6, 0x31, 0xee, 0xb5, 0xbd, 0x47, 0xf7, 0x0d, 0x16, 0xe2, 0x17, 0x82, 0x11,
-	0x76, 0x84, 0xe0, 0x7c, 0x8c, 0x25, 0xc3, 0xef, 0xf6, 0xc1, 0xbb, 0xcd, 0x4d, 0x6a, 0xef, 0x0d,
-	0x98, 0xad, 0xd4, 0xde, 0x90, 0x6a, 0xaf, 0xe1, 0xab, 0xc3, 0xa8, 0x0d, 0x4d, 0x1f, 0xfe, 0x0d,
-	0xc1, 0x54, 0xab, 0xb1, 0xc1, 0x6f, 0xf7, 0xc1, 0x31, 0xea, 0x11, 0xb5, 0x77, 0x06, 0x49, 0x55,
-	0xda, 0xae, 0x4b, 0x6d, 0x5b, 0x78, 0x63, 0x18, 0x6d, 0x81, 0x85, 0xfa, 0x1b, 0xc1, 0xb9, 0x36,
-	0x4b, 0x80, 0x7b, 0xa0, 0xd7, 0xc9, 0x29, 0x69, 0x57, 0x06, 0xca, 0x55, 0xda, 0x0a, 0x52, 0xdb,
-	0x27, 0x78, 0x2f, 0x51, 0x5b, 0x63, 0xb2, 0x72, 0xe3, 0x5e, 0xdb, 0xc4, 0xbe, 0x6f, 0xa8, 0x9b,
-	0x19, 0xa7, 0x1b, 0xff, 0x85, 0xe0, 0xe5, 0xf8, 0xb7, 0x1e, 0xbf, 0xdf, 0x0f, 0xf1, 0x18, 0xb3,
-	0xa2, 0x7d, 0x30, 0x38, 0x40, 0x5f, 0x47, 0xdb, 0x9b, 0x7c, 0xd9, 0x98, 0x31, 0x4f, 0x6d, 0x2f,
-	0x8d, 0xd9, 0xd9, 0x24, 0xf4, 0xd2, 0x98, 0x09, 0xef, 0x7b, 0x8f, 0x8d, 0xd9, 0x45, 0x61, 0x78,
-	0xb7, 0xf1, 0x3f, 0x08, 0xd2, 0x9d, 0x9e, 0x53, 0xbc, 0xde, 0x07, 0xd7, 0x78, 0x4f, 0xa0, 0xe5,
-	0x86, 0x81, 0x50, 0x9a, 0x6f, 0x49, 0xcd, 0x37, 0xf0, 0x47, 0xc3, 0x68, 0x6e, 0xf5, 0x03, 0xf8,
-	0x67, 0x04, 0x67, 0x23, 0x6f, 0x34, 0xbe, 0xdc, 0x9d, 0x6b, 0xdc, 0x93, 0xaf, 0xbd, 0xd9, 0x77,
-	0x9e, 0x12, 0xb6, 0x26, 0x85, 0x2d, 0xe3, 0xa5, 0x44, 0x61, 0x66, 0x90, 0x5b, 0xa8, 0x3f, 0xed,
-	0xb9, 0xeb, 0x8f, 0x8f, 0x32, 0xe8, 0xc9, 0x51, 0x06, 0xfd, 0x7e, 0x94, 0x41, 0xdf, 0x1d, 0x67,
-	0xc6, 0x9e, 0x1c, 0x67, 0xc6, 0x9e, 0x1e, 0x67, 0xc6, 0x3e, 0x5d, 0x49, 0xf4, 0x09, 0x5f, 0x44,
-	0xd1, 0xa5, 0x6d, 0x28, 0x4e, 0xc8, 0x7f, 0x11, 0xad, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x5f,
-	0xcd, 0x9d, 0x50, 0x1a, 0x13, 0x00, 0x00,
+	0x14, 0xce, 0xb8, 0x69, 0x02, 0xaf, 0x4d, 0x9b, 0x4e, 0x2b, 0x64, 0x36, 0xc1, 0x89, 0x36, 0x94,
+	0x44, 0x0d, 0xf1, 0x36, 0x09, 0x6a, 0x43, 0x4b, 0x45, 0xf3, 0x93, 0x40, 0x51, 0x9b, 0xb8, 0x55,
+	0x52, 0x10, 0xc2, 0xda, 0x78, 0xb7, 0x9b, 0x55, 0xed, 0x1d, 0x77, 0x67, 0x9d, 0x10, 0x45, 0x39,
+	0x40, 0x5b, 0x24, 0x0e, 0x48, 0x48, 0x70, 0xe0, 0x6f, 0xe0, 0x88, 0xc4, 0x01, 0x8e, 0x9c, 0x7a,
+	0xac, 0x84, 0x84, 0xe0, 0xd2, 0xa2, 0x04, 0x21, 0x2e, 0x9c, 0x91, 0x38, 0x21, 0xcf, 0x8f, 0xb5,
+	0x37, 0x5e, 0xaf, 0xbd, 0x76, 0xeb, 0x53, 0xa3, 0xb7, 0xef, 0x7d, 0xf3, 0x7d, 0x6f, 0xe6, 0xcd,
+	0x7c, 0x2e, 0x8c, 0xe6, 0x08, 0x2d, 0x10, 0xaa, 0x19, 0x36, 0xf5, 0x5c, 0x7b, 0xa3, 0xe4, 0xd9,
+	0xc4, 0xd1, 0xb6, 0x26, 0x37, 0x4c, 0x4f, 0x9f, 0xd4, 0xee, 0x95, 0x4c, 0x77, 0x27, 0x5d, 0x74,
+	0x89, 0x47, 0xf0, 0x00, 0x4f, 0x4c, 0x57, 0x27, 0xa6, 0x45, 0xa2, 0x72, 0x4e, 0xa0, 0x6c, 0xe8,
+	0xd4, 0xe4, 0x55, 0x3e, 0x46, 0x51, 0xb7, 0x6c, 0x47, 0x67, 0xd9, 0x0c, 0x48, 0x39, 0x63, 0x11,
+	0x8b, 0xb0, 0x3f, 0xb5, 0xf2, 0x5f, 0x22, 0x3a, 0x68, 0x11, 0x62, 0xe5, 0x4d, 0x4d, 0x2f, 0xda,
+	0x9a, 0xee, 0x38, 0xc4, 0x63, 0x25, 0x54, 0x7c, 0x4d, 0x55, 0xe3, 0x4b, 0xe4, 0x1c, 0xb1, 0x25,
+	0x66, 0x3a, 0x4a, 0x45, 0x80, 0x31, 0xcb, 0x57, 0xcf, 0x00, 0x5e, 0x2d, 0xb3, 0x5c, 0xd1, 0x5d,
+	0xbd, 0x40, 0x33, 0xe6, 0xbd, 0x92, 0x49, 0x3d, 0xf5, 0x36, 0x9c, 0x0e, 0x44, 0x69, 0x91, 0x38,
+	0xd4, 0xc4, 0xb3, 0xd0, 0x53, 0x64, 0x91, 0x24, 0x1a, 0x46, 0x63, 0xc7, 0xa6, 0x46, 0xd2, 0x11,
+	0xad, 0x48, 0xf3, 0xe2, 0xb9, 0xee, 0x47, 0x4f, 0x86, 0xba, 0x32, 0xa2, 0x50, 0xfd, 0x02, 0xc1,
+	0x28, 0x83, 0x5e, 0xd3, 0xf3, 0xb6, 0xa1, 0x7b, 0xc4, 0xbd, 0x51, 0xf2, 0xa8, 0xa7, 0x3b, 0x86,
+	0xed, 0x58, 0x19, 0x73, 0x5b, 0x77, 0x0d, 0xc9, 0x02, 0x7f, 0x0c, 0xa7, 0xb6, 0x64, 0x56, 0x56,
+	0x37, 0x0c, 0xd7, 0xa4, 0x7c, 0xe5, 0xe3, 0x73, 0x93, 0xff, 0x3d, 0x19, 0x9a, 0xb0, 0x6c, 0x6f,
+	0xb3, 0xb4, 0x91, 0xce, 0x91, 0x82, 0x26, 0x54, 0xf3, 0x7f, 0x26, 0xa8, 0x71, 0x57, 0xf3, 0x76,
+	0x8a, 0x26, 0x4d, 0xaf, 0xe9, 0xf9, 0x59, 0x5e, 0x98, 0xe9, 0xf7, 0xb1, 0x44, 0x44, 0x7d, 0x80,
+	0x60, 0xac, 0x31, 0x17, 0xa1, 0xfd, 0x36, 0xf4, 0xba, 0x3c, 0x24, 0xc4, 0xcf, 0x44, 0x8a, 0x8f,
+	0x80, 0x14, 0x1d, 0x91, 0x70, 0xea, 0xa7, 0x08, 0x86, 0x82, 0x34, 0xe6, 0x49, 0xa1, 0x60, 0x53,
+	0x6a, 0x13, 0xa7, 0x53, 0xad, 0x78, 0x88, 0x60, 0xb8, 0x3e, 0x07, 0xd1, 0x02, 0x1d, 0x20, 0xe7,
+	0x47, 0x45, 0x17, 0x2e, 0x37, 0xd7, 0x85, 0xd9, 0x5c, 0xae, 0x54, 0x28, 0xe5, 0x75, 0xcf, 0x34,
+	0x2a, 0xc0, 0xa2, 0x11, 0x55, 0xa0, 0xea, 0x37, 0x09, 0x18, 0x0c, 0xf2, 0xb8, 0x99, 0xd7, 0xe9,
+	0xa6, 0xd9, 0xa9, 0x33, 0x81, 0x47, 0xe1, 0x24, 0xf5, 0x74, 0xd7, 0xb3, 0x1d, 0x2b, 0xbb, 0x69,
+	0xda, 0xd6, 0xa6, 0x97, 0x4c, 0x0c, 0xa3, 0xb1, 0xee, 0xcc, 0x09, 0x19, 0x5e, 0x66, 0x51, 0x3c,
+	0x02, 0x7d, 0xa6, 0x63, 0x54, 0xa5, 0x1d, 0x61, 0x69, 0xc7, 0x79, 0x50, 0x24, 0x2d, 0x01, 0x54,
+	0xa6, 0x3e, 0xd9, 0xcd, 0x3a, 0xf6, 0x9a, 0xec, 0x58, 0x79, 0x84, 0xd3, 0xfc, 0x62, 0xa9, 0x8c,
+	0x8c, 0x65, 0x0a, 0xa5, 0x99, 0xaa, 0x4a, 0xf5, 0x27, 0x04, 0xaf, 0xd4, 0x69, 0x8b, 0xd8, 0x9b,
+	0x15, 0xe8, 0xa5, 0x3c, 0x94, 0x44, 0xc3, 0x47, 0xc6, 0x8e, 0x4d, 0x9d, 0x6f, 0x6e, 0x63, 0x18,
+	0xce, 0xe2, 0x96, 0xe9, 0x78, 0xf2, 0x58, 0x0a, 0x18, 0xfc, 0x4e, 0x80, 0x7b, 0x82, 0x71, 0x1f,
+	0x6d, 0xc8, 0x9d, 0xd3, 0x09, 0x90, 0x7f, 0x2a, 0xc9, 0x2f, 0x98, 0x79, 0xd3, 0x62, 0xb1, 0xda,
+	0x41, 0x37, 0xf8, 0xb7, 0x96, 0x37, 0x75, 0x36, 0x97, 0xf3, 0x37, 0xd5, 0xc7, 0x92, 0x9b, 0x1a,
+	0x7a, 0x68, 0x12, 0xcf, 0x6e, 0x7a, 0xbe, 0x44, 0x90, 0xaa, 0xa7, 0x50, 0xec, 0xcf, 0xdd, 0xea,
+	0xeb, 0xa3, 0xbc, 0x3f, 0x83, 0x81, 0x56, 0xca, 0x26, 0x2e, 0x98, 0xb9, 0x79, 0x62, 0x3b, 0x73,
+	0xd3, 0xe5, 0xbd, 0xf8, 0xee, 0xe9, 0xd0, 0x78, 0x13, 0xd4, 0x44, 0x0d, 0xad, 0xdc, 0x28, 0x0f,
+	0x10, 0xa8, 0x87, 0xf8, 0xdc, 0x22, 0x9e, 0x9e, 0xef, 0x6c, 0xdb, 0xd5, 0xbf, 0x10, 0x8c, 0x44,
+	0xd2, 0x10, 0xbd, 0x59, 0x3b, 0xdc, 0x9b, 0x0b, 0x91, 0x67, 0xb7, 0x82, 0xb6, 0x20, 0x57, 0xe4,
+	0x88, 0x87, 0x2e, 0x56, 0x6c, 0xc1, 0x51, 0xaf, 0xbc, 0x5e, 0x32, 0xf1, 0xbc, 0x3a, 0xce, 0xf1,
+	0x2b, 0x37, 0xb8, 0x4f, 0xc8, 0x9f, 0xaf, 0x8e, 0x35, 0xbb, 0x04, 0xc3, 0xf5, 0x29, 0x88, 0x46,
+	0xaf, 0x02, 0xf8, 0x67, 0x97, 0xf7, 0xba, 0xa5, 0x01, 0xa8, 0x02, 0x51, 0x3f, 0x47, 0xf0, 0x6a,
+	0x70, 0xdd, 0x75, 0xdb, 0xdb, 0x34, 0x5c, 0x7d, 0x5b, 0x66, 0x77, 0x48, 0xff, 0x43, 0x04, 0x67,
+	0x1b, 0x10, 0x11, 0x5d, 0xf8, 0x08, 0xfa, 0xb7, 0xc5, 0xa7, 0xf6, 0x89, 0x9c, 0xdc, 0x0e, 0xae,
+	0xa2, 0x0e, 0xc0, 0xcb, 0x8c, 0x46, 0xf9, 0x99, 0x2b, 0x39, 0xb6, 0xb7, 0xb3, 0x42, 0x48, 0x5e,
+	0xfa, 0xaa, 0xfb, 0x08, 0x94, 0xb0, 0xaf, 0x82, 0x99, 0x09, 0xdd, 0x45, 0x42, 0xf2, 0xcf, 0xef,
+	0x86, 0x60, 0xf0, 0xea, 0x8c, 0xa0, 0xb8, 0x54, 0x62, 0x6f, 0xd5, 0x4d, 0xcf, 0x35, 0xf5, 0x82,
+	0xdc, 0xa7, 0x01, 0x78, 0x91, 0xb2, 0x40, 0xd6, 0x36, 0x58, 0x5b, 0xba, 0x33, 0x2f, 0xf0, 0xc0,
+	0xbb, 0x86, 0x7a, 0x07, 0x94, 0xb0, 0x4a, 0x41, 0x7f, 0x19, 0x7a, 0x78, 0xa6, 0xf0, 0x06, 0xe7,
+	0x22, 0xc7, 0x38, 0x80, 0x21, 0x5d, 0x22, 0xaf, 0x57, 0x8d, 0xb0, 0x75, 0xfc, 0xa3, 0x14, 0x7c,
+	0x55, 0x51, 0xcb, 0xaf, 0xea, 0xf7, 0x08, 0x06, 0x42, 0x97, 0x11, 0x7a, 0xde, 0x83, 0x5e, 0xce,
+	0x47, 0xde, 0x4b, 0xf1, 0x05, 0x49, 0x80, 0x67, 0xf6, 0x9a, 0x4e, 0xfd, 0x8e, 0xe1, 0x28, 0x23,
+	0x8d, 0xbf, 0x45, 0xd0, 0xc3, 0x3d, 0x36, 0xd6, 0x22, 0x89, 0xd5, 0x1a, 0x7c, 0xe5, 0x7c, 0xf3,
+	0x05, 0x9c, 0x83, 0x3a, 0xfe, 0xd9, 0x2f, 0x7f, 0x7e, 0x9d, 0x38, 0x8b, 0x47, 0xb4, 0xa8, 0x5f,
+	0x18, 0xdc, 0xe5, 0xe3, 0xfb, 0x09, 0x18, 0x88, 0x70, 0xc0, 0x78, 0xa1, 0xf1, 0xf2, 0x8d, 0x7f,
+	0x1f, 0x28, 0x8b, 0x6d, 0xa2, 0x08, 0x65, 0xeb, 0x4c, 0xd9, 0x2a, 0xbe, 0x11, 0xa9, 0xac, 0x72,
+	0xe7, 0x69, 0xbb, 0x35, 0x66, 0x62, 0x4f, 0x23, 0x15, 0xfc, 0xac, 0x7c, 0x7f, 0xf6, 0x11, 0x9c,
+	0x0e, 0xf1, 0xd3, 0xf8, 0xad, 0x18, 0xbc, 0x6b, 0x7e, 0x0a, 0x28, 0x57, 0x5a, 0xac, 0x16, 0x6a,
+	0xaf, 0x33, 0xb5, 0xcb, 0x78, 0xa9, 0x1d, 0xb5, 0x15, 0xc7, 0x8e, 0x7f, 0x45, 0xd0, 0x7f, 0xd8,
+	0x95, 0xe2, 0x37, 0x63, 0x70, 0x0c, 0x1a, 0x7c, 0xe5, 0x52, 0x2b, 0xa5, 0x42, 0xdb, 0x35, 0xa6,
+	0x6d, 0x11, 0xcf, 0xb7, 0xa3, 0x4d, 0xfa, 0xdf, 0x7f, 0x10, 0x9c, 0xaa, 0xf1, 0x73, 0xb8, 0x09,
+	0x7a, 0xf5, 0x6c, 0xae, 0x72, 0xb9, 0xa5, 0x5a, 0xa1, 0x2d, 0xcb, 0xb4, 0x7d, 0x80, 0xd7, 0x23,
+	0xb5, 0xf9, 0xcf, 0x22, 0xd5, 0x76, 0x6b, 0x9e, 0xdb, 0x3d, 0x4d, 0x9c, 0xcc, 0x30, 0xdd, 0xf8,
+	0x6f, 0x04, 0x2f, 0x85, 0x1b, 0x35, 0xfc, 0x76, 0x1c, 0xe2, 0x21, 0x4e, 0x53, 0xb9, 0xda, 0x3a,
+	0x40, 0xac, 0xad, 0x6d, 0x4e, 0x3e, 0x1b, 0xcc, 0x10, 0x9f, 0xd4, 0xcc, 0x60, 0xd6, 0x77, 0x78,
+	0xca, 0x95, 0x16, 0xab, 0x63, 0x0d, 0x66, 0x03, 0x85, 0x95, 0xb3, 0x8d, 0xff, 0x45, 0x90, 0xac,
+	0xe7, 0x85, 0xf0, 0x6c, 0x0c, 0xae, 0xe1, 0x86, 0x4e, 0x99, 0x6b, 0x07, 0x42, 0x68, 0xbe, 0xc5,
+	0x34, 0x5f, 0xc7, 0xef, 0xb7, 0xa3, 0xf9, 0xb0, 0x99, 0xc3, 0x3f, 0x20, 0xe8, 0x0b, 0x18, 0x2c,
+	0x7c, 0xa1, 0x31, 0xd7, 0x30, 0xbf, 0xa6, 0x5c, 0x8c, 0x5d, 0x27, 0x84, 0x4d, 0x33, 0x61, 0x13,
+	0x78, 0x3c, 0x52, 0x58, 0x4e, 0xd6, 0x66, 0xcb, 0xbe, 0x0c, 0xff, 0x8c, 0xa0, 0x2f, 0x60, 0x22,
+	0x9a, 0xe1, 0x1d, 0x66, 0xe2, 0x94, 0x8b, 0xb1, 0xeb, 0x04, 0xef, 0xab, 0x8c, 0xf7, 0x25, 0x3c,
+	0x13, 0xc9, 0xfb, 0x0e, 0xaf, 0xcd, 0x0a, 0x73, 0xa3, 0xed, 0xfa, 0x8e, 0x71, 0x0f, 0xff, 0x88,
+	0xe0, 0x44, 0x00, 0x9b, 0xe2, 0xb8, 0x6c, 0xfc, 0x23, 0x36, 0x13, 0xbf, 0x50, 0xe8, 0x78, 0x83,
+	0xe9, 0x48, 0xe3, 0xd7, 0xe3, 0xe8, 0x98, 0xbb, 0xf6, 0x68, 0x3f, 0x85, 0x1e, 0xef, 0xa7, 0xd0,
+	0x1f, 0xfb, 0x29, 0xf4, 0xd5, 0x41, 0xaa, 0xeb, 0xf1, 0x41, 0xaa, 0xeb, 0xb7, 0x83, 0x54, 0xd7,
+	0x87, 0x93, 0x91, 0x2e, 0xfb, 0x93, 0x20, 0x3c, 0x33, 0xdd, 0x1b, 0x3d, 0xec, 0x3f, 0x58, 0xa7,
+	0xff, 0x1f, 0x00, 0x98, 0x81, 0x57, 0x3f, 0x58, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1028,6 +1234,10 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// FundingStream queries a community pool funding stream by id.
+	FundingStream(ctx context.Context, in *QueryFundingStreamRequest, opts ...grpc.CallOption) (*QueryFundingStreamResponse, error)
+	// FundingStreams queries all the community pool funding streams.
+	FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -1119,6 +1329,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) FundingStream(ctx context.Context, in *QueryFundingStreamRequest, opts ...grpc.CallOption) (*QueryFundingStreamResponse, error) {
+	out := new(QueryFundingStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/FundingStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error) {
+	out := new(QueryFundingStreamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/FundingStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1139,6 +1367,10 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// FundingStream queries a community pool funding stream by id.
+	FundingStream(context.Context, *QueryFundingStreamRequest) (*QueryFundingStreamResponse, error)
+	// FundingStreams queries all the community pool funding streams.
+	FundingStreams(context.Context, *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1172,6 +1404,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) FundingStream(ctx context.Context, req *QueryFundingStreamRequest) (*QueryFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStream not implemented")
+}
+func (*UnimplementedQueryServer) FundingStreams(ctx context.Context, req *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStreams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1339,6 +1577,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/FundingStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStream(ctx, req.(*QueryFundingStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/FundingStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStreams(ctx, req.(*QueryFundingStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1379,6 +1653,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "FundingStream",
+			Handler:    _Query_FundingStream_Handler,
+		},
+		{
+			MethodName: "FundingStreams",
+			Handler:    _Query_FundingStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2004,87 +2286,232 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFundingStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFundingStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFundingStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorSlashesRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryFundingStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSlashesRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2269,6 +2696,61 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFundingStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryFundingStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundingStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3946,6 +4428,376 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFundingStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, FundingStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -233,11 +233,58 @@ func (m *MsgFundCommunityPool) GetDepositor() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of all the
+// tokenize share records owned by an account to the owner address.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner_address,omitempty" yaml:"owner_address"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{4}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordReward) GetOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
 }
 
 func init() {
@@ -245,35 +292,38 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xb1, 0x6e, 0xd4, 0x30,
-	0x1c, 0xc6, 0xe3, 0x20, 0x55, 0xc2, 0x20, 0xd1, 0x46, 0x45, 0x1c, 0x87, 0xe4, 0x54, 0x11, 0xc3,
-	0x2d, 0x4d, 0x08, 0x6c, 0x6c, 0xbd, 0x22, 0x26, 0xa2, 0xa2, 0x43, 0x2a, 0x12, 0x0b, 0x72, 0x62,
-	0x2b, 0xb5, 0x48, 0xf2, 0x3f, 0xc5, 0x4e, 0xd3, 0xeb, 0x33, 0x30, 0x30, 0x30, 0xf2, 0x00, 0x88,
-	0x27, 0xe9, 0xc0, 0xd0, 0x91, 0x29, 0xa0, 0xdc, 0x1b, 0x74, 0x64, 0x42, 0x49, 0x9c, 0xd0, 0x6b,
-	0x11, 0x2a, 0x0c, 0x30, 0x25, 0x8a, 0x3f, 0x7f, 0xbf, 0x2f, 0x7f, 0xeb, 0x33, 0xbe, 0x1f, 0x81,
-	0x4c, 0x41, 0x7a, 0x4c, 0x48, 0x95, 0x8b, 0xb0, 0x50, 0x02, 0x32, 0xef, 0xd0, 0x0f, 0xb9, 0xa2,
-	0xbe, 0xa7, 0x8e, 0xdc, 0x79, 0x0e, 0x0a, 0xac, 0x7b, 0x9d, 0xca, 0x3d, 0xaf, 0x72, 0xb5, 0x6a,
-	0xbc, 0x19, 0x43, 0x0c, 0xad, 0xce, 0x6b, 0xde, 0xba, 0x2d, 0x63, 0xa2, 0x8d, 0x43, 0x2a, 0xf9,
-	0x60, 0x18, 0x81, 0xc8, 0xba, 0x75, 0xe7, 0xad, 0x89, 0x6f, 0x07, 0x32, 0x7e, 0xc1, 0xd5, 0x4b,
-	0xa1, 0x0e, 0x58, 0x4e, 0xcb, 0x1d, 0xc6, 0x72, 0x2e, 0xa5, 0x75, 0x8c, 0x37, 0x18, 0x4f, 0x78,
-	0x4c, 0x15, 0xe4, 0xaf, 0x69, 0xf7, 0x71, 0x84, 0xb6, 0xd0, 0xe4, 0xe6, 0x34, 0x38, 0xab, 0xec,
-	0xd1, 0x82, 0xa6, 0xc9, 0x63, 0xe7, 0x92, 0xc4, 0xf9, 0x5e, 0xd9, 0xdb, 0xb1, 0x50, 0x07, 0x45,
-	0xe8, 0x46, 0x90, 0x7a, 0x9a, 0xdf, 0x3d, 0xb6, 0x25, 0x7b, 0xe3, 0xa9, 0xc5, 0x9c, 0x4b, 0x77,
-	0x27, 0x8a, 0x34, 0x69, 0xb6, 0x3e, 0x98, 0xf4, 0xec, 0x12, 0xaf, 0x97, 0x3a, 0xce, 0x80, 0x36,
-	0x5b, 0xf4, 0xb3, 0xb3, 0xca, 0xbe, 0xd3, 0xa1, 0x2f, 0x2a, 0xfe, 0x82, 0x7c, 0xab, 0x5c, 0xfd,
-	0x69, 0xe7, 0xbd, 0x89, 0xc7, 0x81, 0x8c, 0xfb, 0x59, 0x3c, 0xe9, 0x83, 0xcd, 0x78, 0x49, 0x73,
-	0xf6, 0x5f, 0x67, 0x72, 0x8c, 0x37, 0x0e, 0x69, 0x22, 0xd8, 0x0a, 0xdb, 0xbc, 0xc8, 0xbe, 0x24,
-	0xb9, 0x2a, 0x7b, 0x9f, 0x26, 0x03, 0x7b, 0x30, 0xe9, 0xc7, 0xf2, 0x01, 0x61, 0x72, 0x6e, 0x2c,
-	0xfb, 0xfd, 0xfa, 0x2e, 0xa4, 0xa9, 0x90, 0x52, 0x40, 0xf6, 0xeb, 0x78, 0xe8, 0xdf, 0xc4, 0xfb,
-	0x8c, 0xf0, 0x66, 0x20, 0xe3, 0xa7, 0x45, 0xc6, 0x9a, 0x44, 0x45, 0x26, 0xd4, 0xe2, 0x39, 0x40,
-	0x62, 0x45, 0x78, 0x8d, 0xa6, 0x50, 0x64, 0x6a, 0x84, 0xb6, 0xae, 0x4d, 0x6e, 0x3c, 0xbc, 0xeb,
-	0xea, 0x06, 0x35, 0x75, 0xe8, 0x9b, 0xe3, 0xee, 0x82, 0xc8, 0xa6, 0x0f, 0x4e, 0x2a, 0xdb, 0xf8,
-	0xf4, 0xd5, 0x9e, 0x5c, 0x21, 0x4c, 0xb3, 0x41, 0xce, 0xb4, 0xb5, 0xb5, 0x87, 0xaf, 0x33, 0x3e,
-	0x07, 0x29, 0x14, 0xe4, 0xfa, 0x40, 0xfc, 0x3f, 0x3f, 0xf0, 0x9f, 0x1e, 0xd3, 0xbd, 0x8f, 0x35,
-	0x41, 0x27, 0x35, 0x41, 0xa7, 0x35, 0x41, 0xdf, 0x6a, 0x82, 0xde, 0x2d, 0x89, 0x71, 0xba, 0x24,
-	0xc6, 0x97, 0x25, 0x31, 0x5e, 0xf9, 0xbf, 0xf5, 0x3d, 0x5a, 0xbd, 0x42, 0x5a, 0x4c, 0xb8, 0xd6,
-	0x76, 0xfd, 0xd1, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x95, 0x71, 0x58, 0x66, 0x04, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xc6, 0x73, 0xf9, 0x49, 0x95, 0x7e, 0x47, 0x11, 0x6d, 0x14, 0x44, 0x08, 0x92, 0x5d, 0x59,
+	0x1d, 0xb2, 0xd4, 0x26, 0xb0, 0xb1, 0x35, 0x45, 0x48, 0x48, 0x44, 0x45, 0x29, 0x2a, 0x12, 0x0b,
+	0x3a, 0xfb, 0x4e, 0xce, 0xa9, 0xf6, 0x7d, 0xa3, 0xbb, 0x73, 0xdd, 0xe4, 0x35, 0x30, 0x30, 0x20,
+	0x26, 0x5e, 0x00, 0xe2, 0x95, 0x74, 0x60, 0xe8, 0xc8, 0x64, 0x50, 0xf2, 0x0e, 0x32, 0x32, 0xa1,
+	0xd8, 0x67, 0x93, 0xa4, 0x08, 0x95, 0x0e, 0x30, 0x25, 0xf6, 0x3d, 0xf7, 0x7c, 0x1e, 0x3f, 0xf7,
+	0x07, 0xef, 0x06, 0xa0, 0x62, 0x50, 0x1e, 0xe5, 0x4a, 0x4b, 0xee, 0x27, 0x9a, 0x83, 0xf0, 0x4e,
+	0xbb, 0x3e, 0xd3, 0xa4, 0xeb, 0xe9, 0x33, 0x77, 0x24, 0x41, 0x43, 0xe3, 0x5e, 0xa1, 0x72, 0x97,
+	0x55, 0xae, 0x51, 0xb5, 0x9b, 0x21, 0x84, 0x90, 0xeb, 0xbc, 0xc5, 0xbf, 0x62, 0x4a, 0xdb, 0x32,
+	0xc6, 0x3e, 0x51, 0xac, 0x32, 0x0c, 0x80, 0x8b, 0x62, 0xdc, 0x79, 0x53, 0xc7, 0xb7, 0xfb, 0x2a,
+	0x3c, 0x62, 0xfa, 0x25, 0xd7, 0x43, 0x2a, 0x49, 0xba, 0x4f, 0xa9, 0x64, 0x4a, 0x35, 0x26, 0x78,
+	0x9b, 0xb2, 0x88, 0x85, 0x44, 0x83, 0x7c, 0x4d, 0x8a, 0x97, 0x2d, 0xb4, 0x83, 0x3a, 0x9b, 0xbd,
+	0xfe, 0x3c, 0xb3, 0x5b, 0x63, 0x12, 0x47, 0x8f, 0x9c, 0x4b, 0x12, 0xe7, 0x7b, 0x66, 0xef, 0x85,
+	0x5c, 0x0f, 0x13, 0xdf, 0x0d, 0x20, 0xf6, 0x0c, 0xbf, 0xf8, 0xd9, 0x53, 0xf4, 0xc4, 0xd3, 0xe3,
+	0x11, 0x53, 0xee, 0x7e, 0x10, 0x18, 0xd2, 0x60, 0xab, 0x32, 0x29, 0xd9, 0x29, 0xde, 0x4a, 0x4d,
+	0x9c, 0x0a, 0x5d, 0xcf, 0xd1, 0xcf, 0xe6, 0x99, 0x7d, 0xa7, 0x40, 0xaf, 0x2b, 0xae, 0x41, 0xbe,
+	0x95, 0xae, 0x7e, 0xb4, 0xf3, 0xae, 0x8e, 0xdb, 0x7d, 0x15, 0x96, 0x5d, 0x3c, 0x2e, 0x83, 0x0d,
+	0x58, 0x4a, 0x24, 0xfd, 0xa7, 0x9d, 0x4c, 0xf0, 0xf6, 0x29, 0x89, 0x38, 0x5d, 0x61, 0xd7, 0xd7,
+	0xd9, 0x97, 0x24, 0x57, 0x65, 0x1f, 0x93, 0xa8, 0x62, 0x57, 0x26, 0x65, 0x2d, 0x1f, 0x10, 0xb6,
+	0x96, 0x6a, 0x39, 0x2e, 0xc7, 0x0f, 0x20, 0x8e, 0xb9, 0x52, 0x1c, 0xc4, 0xaf, 0xe3, 0xa1, 0xbf,
+	0x13, 0xef, 0x33, 0xc2, 0xcd, 0xbe, 0x0a, 0x9f, 0x24, 0x82, 0x2e, 0x12, 0x25, 0x82, 0xeb, 0xf1,
+	0x73, 0x80, 0xa8, 0x11, 0xe0, 0x0d, 0x12, 0x43, 0x22, 0x74, 0x0b, 0xed, 0xfc, 0xd7, 0xb9, 0xf1,
+	0xe0, 0xae, 0x6b, 0x4e, 0xd0, 0xe2, 0x38, 0x94, 0x27, 0xc7, 0x3d, 0x00, 0x2e, 0x7a, 0xf7, 0xcf,
+	0x33, 0xbb, 0xf6, 0xe9, 0xab, 0xdd, 0xb9, 0x42, 0x98, 0xc5, 0x04, 0x35, 0x30, 0xd6, 0x8d, 0x43,
+	0xfc, 0x3f, 0x65, 0x23, 0x50, 0x5c, 0x83, 0x34, 0x0b, 0xd2, 0xfd, 0xf3, 0x05, 0xff, 0xe9, 0xe1,
+	0xbc, 0x47, 0x78, 0x77, 0xa9, 0xed, 0x17, 0x70, 0xc2, 0x04, 0x9f, 0xb0, 0xa3, 0x21, 0x91, 0x6c,
+	0xc0, 0x02, 0x90, 0xd4, 0x6c, 0x47, 0x81, 0x6f, 0x42, 0x2a, 0xd8, 0x7a, 0xdf, 0x4f, 0xe7, 0x99,
+	0xdd, 0x2c, 0xfa, 0x5e, 0x19, 0xbe, 0xc6, 0x36, 0xdc, 0xcc, 0x0d, 0xcc, 0x53, 0xef, 0xf0, 0xe3,
+	0xd4, 0x42, 0xe7, 0x53, 0x0b, 0x5d, 0x4c, 0x2d, 0xf4, 0x6d, 0x6a, 0xa1, 0xb7, 0x33, 0xab, 0x76,
+	0x31, 0xb3, 0x6a, 0x5f, 0x66, 0x56, 0xed, 0x55, 0xf7, 0xb7, 0xd6, 0x67, 0xab, 0x77, 0x5b, 0x4e,
+	0xf2, 0x37, 0xf2, 0x4b, 0xe8, 0xe1, 0x8f, 0x01, 0x00, 0x3c, 0x58, 0xc5, 0x8a, 0xff, 0x04, 0x00,
 	0x00,
 }
 
@@ -387,6 +437,30 @@ func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordReward)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	return true
+}
 func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -535,6 +609,36 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -612,6 +716,19 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1068,6 +1185,93 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
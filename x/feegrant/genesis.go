@@ -0,0 +1,38 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
+	for _, f := range data.FeeAllowances {
+		grant := f.GetFeeGrant()
+		if grant == nil {
+			panic(fmt.Sprintf("invalid fee allowance from %s to %s", f.Granter, f.Grantee))
+		}
+
+		if err := k.GrantFeeAllowance(ctx, f.Granter, f.Grantee, grant); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var grants []types.FeeAllowanceGrant
+
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(grants)
+}
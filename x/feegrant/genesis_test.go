@@ -0,0 +1,62 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1})
+	suite.keeper = app.FeeGrantKeeper
+}
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+	now := suite.ctx.BlockHeader().Time
+	exp := now.AddDate(1, 0, 0)
+
+	allowance := &types.BasicFeeAllowance{SpendLimit: coins, Expiration: &exp}
+	err := suite.keeper.GrantFeeAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
+	suite.Require().NoError(err)
+
+	genesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.FeeAllowances, 1)
+	suite.Require().NoError(genesis.Validate())
+
+	// revoke fee allowance
+	err = suite.keeper.RevokeFeeAllowance(suite.ctx, granterAddr, granteeAddr)
+	suite.Require().NoError(err)
+
+	feegrant.InitGenesis(suite.ctx, suite.keeper, genesis)
+	newGenesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+	suite.Require().Equal(allowance, suite.keeper.GetFeeAllowance(suite.ctx, granterAddr, granteeAddr))
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
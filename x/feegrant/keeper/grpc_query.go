@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeAllowance returns fee granted to the grantee by the granter.
+func (k Keeper) FeeAllowance(c context.Context, req *types.QueryFeeAllowanceRequest) (*types.QueryFeeAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid granter addr")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid grantee addr")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feeGrant, found := k.GetFeeGrant(ctx, req.Granter, req.Grantee)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee allowance found")
+	}
+
+	return &types.QueryFeeAllowanceResponse{
+		FeeAllowance: &feeGrant,
+	}, nil
+}
+
+// FeeAllowances returns all the grants for the grantee.
+func (k Keeper) FeeAllowances(c context.Context, req *types.QueryFeeAllowancesRequest) (*types.QueryFeeAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid grantee addr")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.FeeAllowanceGrant
+
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.FeeAllowancePrefixByGrantee(req.Grantee))
+
+	pageRes, err := query.Paginate(grantsStore, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.FeeAllowanceGrant
+
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeAllowancesResponse{FeeAllowances: grants, Pagination: pageRes}, nil
+}
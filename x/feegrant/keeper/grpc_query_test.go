@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeAllowance() {
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+
+	_, err := suite.queryClient.FeeAllowance(gocontext.Background(), &types.QueryFeeAllowanceRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryFeeAllowanceRequest{Granter: suite.addrs[0], Grantee: suite.addrs[1]}
+	_, err = suite.queryClient.FeeAllowance(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], allowance))
+
+	res, err := suite.queryClient.FeeAllowance(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[0], res.FeeAllowance.Granter)
+	suite.Require().Equal(suite.addrs[1], res.FeeAllowance.Grantee)
+	suite.Require().Equal(allowance, res.FeeAllowance.GetFeeGrant())
+}
+
+func (suite *KeeperTestSuite) TestFeeAllowances() {
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+
+	_, err := suite.queryClient.FeeAllowances(gocontext.Background(), &types.QueryFeeAllowancesRequest{})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], allowance))
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, suite.addrs[1], suite.addrs[2], allowance))
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[3], allowance))
+
+	res, err := suite.queryClient.FeeAllowances(gocontext.Background(), &types.QueryFeeAllowancesRequest{Grantee: suite.addrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FeeAllowances, 2)
+
+	res, err = suite.queryClient.FeeAllowances(gocontext.Background(), &types.QueryFeeAllowancesRequest{
+		Grantee:    suite.addrs[2],
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FeeAllowances, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+	msgSrvr     types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.FeeGrantKeeper)
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+	basic := &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+		Expiration: &exp,
+	}
+	basic2 := &types.BasicFeeAllowance{
+		SpendLimit: eth,
+		Expiration: &exp,
+	}
+
+	// let's set up some initial state here
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], basic2))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[3], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[3], suite.addrs[0], basic2))
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[2]))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], basic2))
+
+	// revoking a missing grant fails
+	suite.Require().Error(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr (basic2)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowanceI
+	}{
+		"addr revoked": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+		},
+		"addr revoked and added": {
+			granter:   suite.addrs[0],
+			grantee:   suite.addrs[2],
+			allowance: basic,
+		},
+		"addr never there": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[3],
+		},
+		"addr modified": {
+			granter:   suite.addrs[1],
+			grantee:   suite.addrs[2],
+			allowance: basic2,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			allow := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				suite.Nil(allow)
+				return
+			}
+			suite.NotNil(allow)
+			suite.Equal(tc.allowance, allow)
+		})
+	}
+
+	grants := map[string]struct {
+		grantee sdk.AccAddress
+		grants  []types.FeeAllowanceGrant
+	}{
+		"addr2 has none": {
+			grantee: suite.addrs[1],
+		},
+		"addr has one": {
+			grantee: suite.addrs[0],
+			grants: []types.FeeAllowanceGrant{
+				mustNewFeeAllowanceGrant(suite.addrs[3], suite.addrs[0], basic2),
+			},
+		},
+		"addr3 has two": {
+			grantee: suite.addrs[2],
+			grants: []types.FeeAllowanceGrant{
+				mustNewFeeAllowanceGrant(suite.addrs[0], suite.addrs[2], basic),
+				mustNewFeeAllowanceGrant(suite.addrs[1], suite.addrs[2], basic2),
+			},
+		},
+	}
+
+	for name, tc := range grants {
+		tc := tc
+		suite.Run(name, func() {
+			var grants []types.FeeAllowanceGrant
+			err := k.IterateAllGranteeFeeAllowances(ctx, tc.grantee, func(grant types.FeeAllowanceGrant) bool {
+				grants = append(grants, grant)
+				return false
+			})
+			suite.NoError(err)
+			suite.Require().Len(grants, len(tc.grants))
+			for i, grant := range tc.grants {
+				suite.Equal(grant.Granter, grants[i].Granter)
+				suite.Equal(grant.Grantee, grants[i].Grantee)
+				suite.Equal(grant.GetFeeGrant(), grants[i].GetFeeGrant())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUseGrantFee() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+
+	future := &types.BasicFeeAllowance{
+		SpendLimit: atom,
+		Expiration: &exp,
+	}
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 554)),
+		Expiration: &exp,
+	}
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     atom,
+			allowed: true,
+			final:   nil,
+		},
+		"wrong denom": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     eth,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"use too much": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], future))
+			expired := ctx.BlockTime().AddDate(-1, 0, 0)
+			suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[3], &types.BasicFeeAllowance{
+				SpendLimit: eth,
+				Expiration: &expired,
+			}))
+
+			err := k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee, []sdk.Msg{})
+			if tc.allowed {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+
+			loaded := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			suite.Equal(tc.final, loaded)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServerGrant() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+
+	msg, err := types.NewMsgGrantFeeAllowance(allowance, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.GrantFeeAllowance(ctx, msg)
+	suite.Require().NoError(err)
+
+	// granting again fails as the allowance already exists
+	_, err = suite.msgSrvr.GrantFeeAllowance(ctx, msg)
+	suite.Require().Error(err)
+
+	revoke := types.NewMsgRevokeFeeAllowance(suite.addrs[0], suite.addrs[1])
+	_, err = suite.msgSrvr.RevokeFeeAllowance(ctx, &revoke)
+	suite.Require().NoError(err)
+	suite.Require().Nil(suite.app.FeeGrantKeeper.GetFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[1]))
+
+	_, err = suite.msgSrvr.RevokeFeeAllowance(ctx, &revoke)
+	suite.Require().Error(err)
+}
+
+func mustNewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance types.FeeAllowanceI) types.FeeAllowanceGrant {
+	grant, err := types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	if err != nil {
+		panic(err)
+	}
+
+	return grant
+}
@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Simulation parameter constants
+const feegrant = "feegrant"
+
+// genFeeGrants returns a slice of randomly generated basic allowances, each
+// granted from an account to the next one.
+func genFeeGrants(r *rand.Rand, genesisTime time.Time, accounts []simtypes.Account) []types.FeeAllowanceGrant {
+	var feeGrants []types.FeeAllowanceGrant
+
+	for i := 0; i < len(accounts)-1; i++ {
+		if r.Intn(2) == 0 {
+			continue
+		}
+
+		allowance := &types.BasicFeeAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1000)))),
+		}
+		if r.Intn(2) == 0 {
+			expiration := genesisTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 1000)) * time.Hour)
+			allowance.Expiration = &expiration
+		}
+
+		grant, err := types.NewFeeAllowanceGrant(accounts[i].Address, accounts[i+1].Address, allowance)
+		if err != nil {
+			panic(err)
+		}
+
+		feeGrants = append(feeGrants, grant)
+	}
+
+	return feeGrants
+}
+
+// RandomizedGenState generates a random GenesisState for feegrant
+func RandomizedGenState(simState *module.SimulationState) {
+	var feeGrants []types.FeeAllowanceGrant
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, feegrant, &feeGrants, simState.Rand,
+		func(r *rand.Rand) { feeGrants = genFeeGrants(r, simState.GenTimestamp, simState.Accounts) },
+	)
+
+	feegrantGenesis := types.NewGenesisState(feeGrants)
+
+	bz, err := simState.Cdc.MarshalJSON(feegrantGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
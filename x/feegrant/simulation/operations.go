@@ -0,0 +1,177 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantFeeAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeFeeAllowance = "op_weight_msg_revoke_fee_allowance"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrantFeeAllowance  int
+		weightMsgRevokeFeeAllowance int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantFeeAllowance, &weightMsgGrantFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantFeeAllowance = simappparams.DefaultWeightGrantFeeAllowance
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeFeeAllowance, &weightMsgRevokeFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeFeeAllowance = simappparams.DefaultWeightRevokeFeeAllowance
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrantFeeAllowance,
+			SimulateMsgGrantFeeAllowance(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeFeeAllowance,
+			SimulateMsgRevokeFeeAllowance(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrantFeeAllowance generates a MsgGrantFeeAllowance with random values.
+func SimulateMsgGrantFeeAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if grantee.Address.Equals(granter.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantFeeAllowance, "grantee and granter cannot be same"), nil, nil
+		}
+
+		if f := k.GetFeeAllowance(ctx, granter.Address, grantee.Address); f != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantFeeAllowance, "fee allowance exists"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantFeeAllowance, "unable to generate fees"), nil, err
+		}
+
+		spendableCoins = spendableCoins.Sub(fees)
+		spendLimit := simtypes.RandSubsetCoins(r, spendableCoins)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantFeeAllowance, "spend limit is empty"), nil, nil
+		}
+
+		msg, err := types.NewMsgGrantFeeAllowance(&types.BasicFeeAllowance{SpendLimit: spendLimit}, granter.Address, grantee.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantFeeAllowance, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevokeFeeAllowance generates a MsgRevokeFeeAllowance with random values.
+func SimulateMsgRevokeFeeAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			hasGrant    bool
+			granterAddr sdk.AccAddress
+			granteeAddr sdk.AccAddress
+		)
+
+		err := k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+			granterAddr = grant.Granter
+			granteeAddr = grant.Grantee
+			hasGrant = true
+			return true
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeFeeAllowance, err.Error()), nil, err
+		}
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeFeeAllowance, "no grants"), nil, nil
+		}
+
+		granter, ok := simtypes.FindAccount(accs, granterAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeFeeAllowance, "account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeFeeAllowance, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granterAddr, granteeAddr)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
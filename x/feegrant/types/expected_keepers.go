@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the expected auth Account Keeper (noalias)
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) auth.ModuleAccountI
+
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
+	SetAccount(ctx sdk.Context, acc auth.AccountI)
+}
+
+// BankKeeper defines the expected bank Keeper (noalias)
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
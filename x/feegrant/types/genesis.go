@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []FeeAllowanceGrant) *GenesisState {
+	return &GenesisState{
+		FeeAllowances: entries,
+	}
+}
+
+// DefaultGenesisState returns a default feegrant module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate ensures all grants in the genesis state are valid
+func (data GenesisState) Validate() error {
+	for _, f := range data.FeeAllowances {
+		if err := f.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, f := range data.FeeAllowances {
+		if err := f.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
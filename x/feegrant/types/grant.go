@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = &FeeAllowanceGrant{}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (FeeAllowanceGrant, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return FeeAllowanceGrant{}, fmt.Errorf("cannot proto marshal %T", feeAllowance)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return FeeAllowanceGrant{}, err
+	}
+
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a FeeAllowanceGrant) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if a.Grantee.Equals(a.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	allowance := a.GetFeeGrant()
+	if allowance == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing fee allowance")
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetFeeGrant unpacks allowance
+func (a FeeAllowanceGrant) GetFeeGrant() FeeAllowanceI {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	return allowance
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a FeeAllowanceGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 2))
+	basic := &types.BasicFeeAllowance{SpendLimit: atom}
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		grant   *types.BasicFeeAllowance
+		valid   bool
+	}{
+		"valid": {
+			grantee: grantee,
+			granter: granter,
+			grant:   basic,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: granter,
+			grant:   basic,
+		},
+		"no granter": {
+			grantee: grantee,
+			grant:   basic,
+		},
+		"grantee == granter": {
+			grantee: grantee,
+			granter: grantee,
+			grant:   basic,
+		},
+		"invalid allowance": {
+			grantee: grantee,
+			granter: granter,
+			grant:   &types.BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantFeeAllowance(tc.grant, tc.granter, tc.grantee)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, types.TypeMsgGrantFeeAllowance, msg.Type())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid":              {grantee: grantee, granter: granter, valid: true},
+		"no grantee":         {granter: granter},
+		"no granter":         {grantee: grantee},
+		"grantee == granter": {grantee: grantee, granter: grantee},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.NewMsgRevokeFeeAllowance(tc.granter, tc.grantee)
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgRevokeFeeAllowance, msg.Type())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
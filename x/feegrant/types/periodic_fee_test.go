@@ -0,0 +1,201 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestPeriodicFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	oneAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	now := time.Now()
+	oneHour := now.Add(time.Hour)
+	twoHours := now.Add(2 * time.Hour)
+
+	cases := map[string]struct {
+		allow types.PeriodicFeeAllowance
+		// all other checks are ignored if valid=false
+		fee           sdk.Coins
+		blockTime     time.Time
+		valid         bool // all other checks are ignored if valid=false
+		accept        bool
+		remove        bool
+		remains       sdk.Coins
+		remainsPeriod sdk.Coins
+		periodReset   time.Time
+	}{
+		"empty": {
+			allow: types.PeriodicFeeAllowance{},
+			valid: false,
+		},
+		"only basic": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: &oneHour,
+				},
+			},
+			valid: false,
+		},
+		"empty basic": {
+			allow: types.PeriodicFeeAllowance{
+				Period:           time.Minute * 10,
+				PeriodSpendLimit: smallAtom,
+				PeriodCanSpend:   smallAtom,
+				PeriodReset:      now.Add(30 * time.Minute),
+			},
+			valid:         true,
+			fee:           oneAtom,
+			blockTime:     now,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: smallAtom.Sub(oneAtom),
+			remains:       nil,
+			periodReset:   now.Add(30 * time.Minute),
+		},
+		"mismatched currencies": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: &oneHour,
+				},
+				Period:           time.Minute * 10,
+				PeriodSpendLimit: eth,
+			},
+			valid: false,
+		},
+		"same period": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: &twoHours,
+				},
+				Period:           time.Hour,
+				PeriodReset:      now.Add(1 * time.Hour),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:         true,
+			fee:           smallAtom,
+			blockTime:     now,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			remains:       leftAtom,
+			periodReset:   now.Add(1 * time.Hour),
+		},
+		"step one period": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: &twoHours,
+				},
+				Period:           time.Hour,
+				PeriodReset:      now,
+				PeriodSpendLimit: leftAtom,
+			},
+			valid:         true,
+			fee:           leftAtom,
+			blockTime:     now.Add(1 * time.Hour),
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			remains:       smallAtom,
+			periodReset:   oneHour, // one step from last reset, not now
+		},
+		"step limited by global allowance": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: smallAtom,
+					Expiration: &twoHours,
+				},
+				Period:           time.Hour,
+				PeriodReset:      now,
+				PeriodSpendLimit: atom,
+			},
+			valid:         true,
+			fee:           oneAtom,
+			blockTime:     oneHour,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: smallAtom.Sub(oneAtom),
+			remains:       smallAtom.Sub(oneAtom),
+			periodReset:   oneHour, // one step from last reset, not now
+		},
+		"expired": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: &now,
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: smallAtom,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+		"over period limit": {
+			allow: types.PeriodicFeeAllowance{
+				Basic: types.BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: &now,
+				},
+				Period:           time.Hour,
+				PeriodReset:      now.Add(1 * time.Hour),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:     true,
+			fee:       leftAtom,
+			blockTime: now,
+			accept:    false,
+			remove:    false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx := ctx.WithBlockTime(tc.blockTime)
+			// now try to deduct
+			remove, err := tc.allow.Accept(ctx, tc.fee, []sdk.Msg{})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				assert := require.New(t)
+				assert.Equal(tc.remains, tc.allow.Basic.SpendLimit)
+				assert.Equal(tc.remainsPeriod, tc.allow.PeriodCanSpend)
+				assert.Equal(tc.periodReset.String(), tc.allow.PeriodReset.String())
+			}
+		})
+	}
+}
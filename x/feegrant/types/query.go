@@ -0,0 +1,25 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryFeeAllowanceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.FeeAllowance != nil {
+		return m.FeeAllowance.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryFeeAllowancesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range m.FeeAllowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
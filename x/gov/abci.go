@@ -19,7 +19,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	// delete inactive proposal from store and its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalId)
-		keeper.DeleteDeposits(ctx, proposal.ProposalId)
+		keeper.SettleDeposits(ctx, proposal.ProposalId, types.OutcomeDepositExpired)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -33,7 +33,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalId,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -44,29 +44,61 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
-
-		if burnDeposits {
-			keeper.DeleteDeposits(ctx, proposal.ProposalId)
-		} else {
-			keeper.RefundDeposits(ctx, proposal.ProposalId)
+		outcome, tallyResults := keeper.Tally(ctx, proposal)
+		passes := outcome == types.OutcomePassed
+
+		// An expedited proposal which fails the expedited tally is converted to
+		// a regular proposal: its voting period is extended to the regular
+		// voting period and its votes and deposits are kept until it is
+		// tallied again.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a regular proposal",
+					proposal.ProposalId, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
 		}
 
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
+		keeper.SettleDeposits(ctx, proposal.ProposalId, outcome)
+
 		if passes {
 			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// The proposal handler and messages may execute state mutating
+			// logic depending on the proposal content and messages. If the
+			// handler or any message fails, no state mutation is written and
+			// the error message is logged.
 			err := handler(cacheCtx, proposal.GetContent())
+			if err == nil {
+				err = keeper.ExecuteProposalMessages(cacheCtx, proposal)
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
 
 				// The cached context is created with a new EventManager. However, since
-				// the proposal handler execution was successful, we want to track/keep
+				// the proposal execution was successful, we want to track/keep
 				// any events emitted, so we re-emit to "merge" the events into the
 				// original Context's EventManager.
 				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -4,12 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -172,7 +174,11 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposalID := proposalData.ProposalId
 
 	inactiveQueue = app.GovKeeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
 	require.False(t, inactiveQueue.Valid())
@@ -224,7 +230,11 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposalID := proposalData.ProposalId
 
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(time.Duration(1) * time.Second)
@@ -261,6 +271,90 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 }
 
+func TestExpeditedProposalConvertedToRegular(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(200))
+
+	SortAddresses(addrs)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	govHandler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{60, 40})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposalCoins := app.GovKeeper.GetDepositParams(ctx).ExpeditedMinDeposit
+	newProposalMsg, err := types.NewMsgSubmitProposal(TestProposal, proposalCoins, addrs[0])
+	require.NoError(t, err)
+	newProposalMsg.SetExpedited(true)
+
+	res, err := govHandler(ctx, newProposalMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalData.ProposalId)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	// 60% of the voting power votes yes, which is below the expedited
+	// threshold, so the expedited tally fails at the end of the expedited
+	// voting period and the proposal becomes a regular proposal
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	require.NoError(t, err)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo))
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalData.ProposalId)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+
+	deposits := app.GovKeeper.GetDeposits(ctx, proposalData.ProposalId)
+	require.Len(t, deposits, 1)
+
+	// the votes cast during the expedited voting period are kept
+	require.Len(t, app.GovKeeper.GetVotes(ctx, proposalData.ProposalId), 2)
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// at the end of the regular voting period the same votes pass the regular
+	// threshold, and the votes are deleted once the proposal is finalized
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalData.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, sdk.TokensFromConsensusPower(60), proposal.FinalTallyResult.Yes)
+	require.Equal(t, sdk.TokensFromConsensusPower(40), proposal.FinalTallyResult.No)
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposalData.ProposalId))
+}
+
 func TestProposalPassedEndblocker(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -283,7 +377,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -300,7 +394,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -335,7 +429,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -345,7 +439,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -359,3 +453,74 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerProposalMessages(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	handler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	govCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, types.ModuleName, govCoins))
+
+	recipientCoins := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+
+	testCases := []struct {
+		name       string
+		msgs       []sdk.Msg
+		expStatus  types.ProposalStatus
+		expBalance sdk.Coins
+	}{
+		{
+			"messages fail atomically",
+			[]sdk.Msg{
+				banktypes.NewMsgSend(govAddr, addrs[1], sendCoins),
+				banktypes.NewMsgSend(govAddr, addrs[1], sendCoins),
+			},
+			types.StatusFailed,
+			recipientCoins,
+		},
+		{
+			"messages are executed",
+			[]sdk.Msg{banktypes.NewMsgSend(govAddr, addrs[1], sendCoins)},
+			types.StatusPassed,
+			recipientCoins.Add(sendCoins...),
+		},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs, "", nil, false)
+		require.NoError(t, err, tc.name)
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+		res, err := handler(ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+		require.NoError(t, err, tc.name)
+		require.NotNil(t, res, tc.name)
+
+		err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+		require.NoError(t, err, tc.name)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, tc.name)
+		require.Equal(t, tc.expStatus, proposal.Status, tc.name)
+		require.Equal(t, tc.expBalance, app.BankKeeper.GetAllBalances(ctx, addrs[1]), tc.name)
+	}
+}
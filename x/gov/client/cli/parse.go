@@ -7,6 +7,9 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -21,6 +24,8 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		proposal.Description, _ = fs.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(proposalType)
 		proposal.Deposit, _ = fs.GetString(FlagDeposit)
+		proposal.Metadata, _ = fs.GetString(FlagMetadata)
+		proposal.Expedited, _ = fs.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -30,6 +35,10 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		}
 	}
 
+	if expedited, _ := fs.GetBool(FlagExpedited); expedited {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
+
 	contents, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
 		return nil, err
@@ -42,3 +51,23 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseProposalMessages decodes the JSON encoded sdk.Msgs of a proposal file.
+func parseProposalMessages(clientCtx client.Context, rawMsgs []json.RawMessage) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var any codectypes.Any
+		if err := clientCtx.JSONMarshaler.UnmarshalJSON(rawMsg, &any); err != nil {
+			return nil, err
+		}
+
+		var msg sdk.Msg
+		if err := clientCtx.InterfaceRegistry.UnpackAny(&any, &msg); err != nil {
+			return nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
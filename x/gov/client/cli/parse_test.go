@@ -1,11 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -14,7 +23,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "metadata": "ipfs://CID",
+  "expedited": true
 }
 `)
 	t.Cleanup(cleanup1)
@@ -42,6 +53,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.Equal(t, "ipfs://CID", proposal1.Metadata)
+	require.True(t, proposal1.Expedited)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -51,12 +64,19 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 		fs.Set(incompatibleFlag, "")
 	}
 
+	// --expedited can't be used with --proposal
+	fs.Set(FlagExpedited, "true")
+	_, err = parseSubmitProposalFlags(fs)
+	require.Error(t, err)
+
 	// no --proposal, only flags
 	fs.Set(FlagProposal, "")
 	fs.Set(FlagTitle, proposal1.Title)
 	fs.Set(FlagDescription, proposal1.Description)
 	fs.Set(FlagProposalType, proposal1.Type)
 	fs.Set(FlagDeposit, proposal1.Deposit)
+	fs.Set(FlagMetadata, proposal1.Metadata)
+	fs.Set(FlagExpedited, "true")
 	proposal2, err := parseSubmitProposalFlags(fs)
 
 	require.Nil(t, err, "unexpected error")
@@ -64,9 +84,57 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.Equal(t, proposal1.Metadata, proposal2.Metadata)
+	require.Equal(t, proposal1.Expedited, proposal2.Expedited)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseProposalMessages(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	clientCtx := client.Context{}.
+		WithJSONMarshaler(codec.NewProtoCodec(interfaceRegistry)).
+		WithInterfaceRegistry(interfaceRegistry)
+
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	toAddr := sdk.AccAddress("addr________________")
+
+	msgsJSON, cleanup := testutil.WriteToNewTempFile(t, fmt.Sprintf(`
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "type": "Text",
+  "deposit": "1000test",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "%s",
+      "to_address": "%s",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ]
+}
+`, govAddr, toAddr))
+	t.Cleanup(cleanup)
+
+	fs := NewCmdSubmitProposal().Flags()
+	fs.Set(FlagProposal, msgsJSON.Name())
+	proposal, err := parseSubmitProposalFlags(fs)
+	require.NoError(t, err)
+
+	msgs, err := parseProposalMessages(clientCtx, proposal.Messages)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	msgSend, ok := msgs[0].(*banktypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, banktypes.NewMsgSend(govAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), msgSend)
+
+	// unknown message type
+	_, err = parseProposalMessages(clientCtx, []json.RawMessage{[]byte(`{"@type": "/cosmos.unknown.MsgUnknown"}`)})
+	require.Error(t, err)
+}
@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQuerySettledDeposits(),
 	)
 
 	return govQueryCmd
@@ -103,15 +104,17 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query gov proposals --proposer cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
 `,
-				version.AppName, version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bechDepositorAddr, _ := cmd.Flags().GetString(flagDepositor)
 			bechVoterAddr, _ := cmd.Flags().GetString(flagVoter)
+			bechProposerAddr, _ := cmd.Flags().GetString(flagProposer)
 			strProposalStatus, _ := cmd.Flags().GetString(flagStatus)
 
 			depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
@@ -124,6 +127,14 @@ $ %s query gov proposals --page=2 --limit=100
 				return err
 			}
 
+			var proposerAddr sdk.AccAddress
+			if bechProposerAddr != "" {
+				proposerAddr, err = sdk.AccAddressFromBech32(bechProposerAddr)
+				if err != nil {
+					return err
+				}
+			}
+
 			proposalStatus, err := types.ProposalStatusFromString(gcutils.NormalizeProposalStatus(strProposalStatus))
 			if err != nil {
 				return err
@@ -147,6 +158,7 @@ $ %s query gov proposals --page=2 --limit=100
 					ProposalStatus: proposalStatus,
 					Voter:          voterAddr,
 					Depositor:      depositorAddr,
+					Proposer:       proposerAddr,
 					Pagination:     pageReq,
 				},
 			)
@@ -164,6 +176,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
+	cmd.Flags().String(flagProposer, "", "(optional) filter by proposals submitted by proposer")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
@@ -536,6 +549,54 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQuerySettledDeposits implements the command to query what happened to
+// the deposits of a finished proposal.
+func GetCmdQuerySettledDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settled-deposits [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query what happened to the deposits of a finished proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the outcome of a finished proposal and whether its deposits
+were refunded, burned or sent to the community pool.
+
+Example:
+$ %s query gov settled-deposits 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.SettledDeposits(
+				context.Background(),
+				&types.QuerySettledDepositsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.SettledDeposits)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -682,6 +743,17 @@ $ %s query gov proposer 1
 				return fmt.Errorf("proposal-id %s is not a valid uint", args[0])
 			}
 
+			// proposals record their proposer, except those submitted before
+			// proposers were tracked, which are looked up in the txs instead
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Proposal(
+				context.Background(),
+				&types.QueryProposalRequest{ProposalId: proposalID},
+			)
+			if err == nil && !res.Proposal.Proposer.Empty() {
+				return clientCtx.PrintOutputLegacy(gcutils.NewProposer(proposalID, res.Proposal.Proposer.String()))
+			}
+
 			prop, err := gcutils.QueryProposerByTxQuery(clientCtx, proposalID)
 			if err != nil {
 				return err
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -22,10 +24,13 @@ const (
 	FlagDescription  = "description"
 	FlagProposalType = "type"
 	FlagDeposit      = "deposit"
+	FlagMetadata     = "metadata"
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
+	flagProposer     = "proposer"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -33,6 +38,9 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Metadata    string
+	Expedited   bool
+	Messages    []json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -43,6 +51,7 @@ var ProposalFlags = []string{
 	FlagDescription,
 	FlagProposalType,
 	FlagDeposit,
+	FlagMetadata,
 }
 
 // NewTxCmd returns the transaction commands for this module
@@ -68,6 +77,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		cmdSubmitProp,
 	)
 
@@ -98,8 +108,34 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+A pointer to off-chain metadata of the proposal, e.g. an IPFS CID or a URL, may
+be given with the --metadata flag or the "metadata" field of the proposal JSON
+file. Its length is limited by the max_metadata_len deposit parameter.
+
+An expedited proposal, which has a shorter voting period but requires a higher
+deposit, quorum and threshold, is submitted with the --expedited flag or by
+setting "expedited": true in the proposal JSON file.
+
+The proposal JSON file may also contain the messages to execute when the
+proposal passes, which must be signed by the gov module account %s:
+
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "type": "Text",
+  "deposit": "10test",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "%s",
+      "to_address": "cosmos1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ]
+}
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, authtypes.NewModuleAddress(types.ModuleName), authtypes.NewModuleAddress(types.ModuleName),
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,6 +162,18 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			messages, err := parseProposalMessages(clientCtx, proposal.Messages)
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal messages: %w", err)
+			}
+
+			if err = msg.SetMessages(messages); err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			msg.SetExpedited(proposal.Expedited)
+			msg.SetMetadata(proposal.Metadata)
+
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -138,7 +186,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagDescription, "", "The proposal description")
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagMetadata, "", "The proposal metadata, e.g. an IPFS CID or a URL")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as an expedited proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -251,3 +301,58 @@ $ %s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+between several options. You can find the proposal-id by running "%s query gov proposals".
+The weights of all options must sum up to 1.
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Get voting address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -387,6 +387,7 @@ func queryProposalsWithParameterFn(clientCtx client.Context) http.HandlerFunc {
 		var (
 			voterAddr      sdk.AccAddress
 			depositorAddr  sdk.AccAddress
+			proposerAddr   sdk.AccAddress
 			proposalStatus types.ProposalStatus
 		)
 
@@ -404,6 +405,13 @@ func queryProposalsWithParameterFn(clientCtx client.Context) http.HandlerFunc {
 			}
 		}
 
+		if v := r.URL.Query().Get(RestProposer); len(v) != 0 {
+			proposerAddr, err = sdk.AccAddressFromBech32(v)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+
 		if v := r.URL.Query().Get(RestProposalStatus); len(v) != 0 {
 			proposalStatus, err = types.ProposalStatusFromString(gcutils.NormalizeProposalStatus(v))
 			if rest.CheckBadRequestError(w, err) {
@@ -411,7 +419,7 @@ func queryProposalsWithParameterFn(clientCtx client.Context) http.HandlerFunc {
 			}
 		}
 
-		params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr)
+		params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr, proposerAddr)
 		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
 			return
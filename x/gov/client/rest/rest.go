@@ -17,6 +17,7 @@ const (
 	RestProposalID     = "proposal-id"
 	RestDepositor      = "depositor"
 	RestVoter          = "voter"
+	RestProposer       = "proposer"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
 )
@@ -41,6 +42,7 @@ type PostProposalReq struct {
 	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal }
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	Metadata       string         `json:"metadata" yaml:"metadata"`               // Pointer to off-chain metadata of the proposal
 }
 
 // DepositReq defines the properties of a deposit request's body.
@@ -43,6 +43,7 @@ func newPostProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
+		msg.SetMetadata(req.Metadata)
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,18 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote options,
+// given as a comma separated list of <option>=<weight> pairs
+func NormalizeWeightedVoteOptions(options string) string {
+	pairs := strings.Split(options, ",")
+	for i, pair := range pairs {
+		fields := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		fields[0] = NormalizeVoteOption(fields[0])
+		pairs[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(pairs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
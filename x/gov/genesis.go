@@ -31,6 +31,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, vote)
 	}
 
+	for _, settled := range data.SettledDeposits {
+		k.SetSettledDeposits(ctx, settled)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
@@ -77,5 +81,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		SettledDeposits:    k.GetAllSettledDeposits(ctx),
 	}
 }
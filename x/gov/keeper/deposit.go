@@ -48,21 +48,6 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 	return
 }
 
-// DeleteDeposits deletes all the deposits on a specific proposal without refunding them
-func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
-	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
-		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
-		if err != nil {
-			panic(err)
-		}
-
-		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
-		return false
-	})
-}
-
 // IterateAllDeposits iterates over the all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -126,7 +111,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -154,17 +139,110 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
-// RefundDeposits refunds and deletes all the deposits on a specific proposal
-func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
+// SettleDeposits refunds, burns or sends to the community pool all the
+// deposits on a specific proposal, depending on the outcome of the proposal,
+// and deletes them. The settled deposits are recorded in the store.
+func (keeper Keeper) SettleDeposits(ctx sdk.Context, proposalID uint64, outcome types.ProposalOutcome) {
 	store := ctx.KVStore(keeper.storeKey)
+	action := keeper.GetDepositParams(ctx).GetDepositAction(outcome)
+	moduleAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+
+	var (
+		deposits types.Deposits
+		total    sdk.Coins
+	)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
-		err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount)
+		var err error
+
+		switch action {
+		case types.DepositActionRefund:
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount)
+		case types.DepositActionBurn:
+			err = keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
+		case types.DepositActionCommunityPool:
+			err = keeper.distrKeeper.FundCommunityPool(ctx, deposit.Amount, moduleAddr)
+		default:
+			err = fmt.Errorf("invalid deposit action for outcome %s: %s", outcome, action)
+		}
 		if err != nil {
 			panic(err)
 		}
 
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSettleDeposit{
+			ProposalId: proposalID,
+			Depositor:  deposit.Depositor.String(),
+			Amount:     deposit.Amount,
+			Outcome:    outcome,
+			Action:     action,
+		}); err != nil {
+			panic(err)
+		}
+
+		deposits = append(deposits, deposit)
+		total = total.Add(deposit.Amount...)
+
 		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
 		return false
 	})
+
+	keeper.SetSettledDeposits(ctx, types.NewSettledDeposits(proposalID, outcome, action, deposits))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleDeposits,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalOutcome, outcome.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositAction, action.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		),
+	)
+}
+
+// GetSettledDeposits gets the settled deposits of a specific proposal
+func (keeper Keeper) GetSettledDeposits(ctx sdk.Context, proposalID uint64) (settled types.SettledDeposits, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.SettledDepositsKey(proposalID))
+	if bz == nil {
+		return settled, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &settled)
+
+	return settled, true
+}
+
+// SetSettledDeposits sets the settled deposits of a proposal to the gov store
+func (keeper Keeper) SetSettledDeposits(ctx sdk.Context, settled types.SettledDeposits) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryBare(&settled)
+	store.Set(types.SettledDepositsKey(settled.ProposalId), bz)
+}
+
+// GetAllSettledDeposits returns the settled deposits of all the proposals from the store
+func (keeper Keeper) GetAllSettledDeposits(ctx sdk.Context) (settled []types.SettledDeposits) {
+	keeper.IterateAllSettledDeposits(ctx, func(sd types.SettledDeposits) bool {
+		settled = append(settled, sd)
+		return false
+	})
+
+	return
+}
+
+// IterateAllSettledDeposits iterates over the settled deposits of all the proposals and performs a callback function
+func (keeper Keeper) IterateAllSettledDeposits(ctx sdk.Context, cb func(settled types.SettledDeposits) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SettledDepositsKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var settled types.SettledDeposits
+
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &settled)
+
+		if cb(settled) {
+			break
+		}
+	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -18,7 +19,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, "", nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
@@ -91,13 +92,69 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, TestAddrs[1], deposits[1].Depositor)
 	require.Equal(t, fourStake, deposits[1].Amount)
 
-	// Test Refund Deposits
+	// Test Settle Deposits
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
 	require.Equal(t, fourStake, deposit.Amount)
-	app.GovKeeper.RefundDeposits(ctx, proposalID)
+	app.GovKeeper.SettleDeposits(ctx, proposalID, types.OutcomePassed)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.False(t, found)
 	require.Equal(t, addr0Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+
+	settled, found := app.GovKeeper.GetSettledDeposits(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, types.OutcomePassed, settled.Outcome)
+	require.Equal(t, types.DepositActionRefund, settled.Action)
+	require.Equal(t, deposits, settled.Deposits)
+}
+
+func TestSettleDeposits(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.VetoedDepositAction = types.DepositActionCommunityPool
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	testCases := []struct {
+		outcome types.ProposalOutcome
+		action  types.DepositAction
+	}{
+		{types.OutcomeRejected, types.DepositActionRefund},
+		{types.OutcomeQuorumNotMet, types.DepositActionBurn},
+		{types.OutcomeVetoed, types.DepositActionCommunityPool},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", nil, false)
+		require.NoError(t, err)
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, TestAddrs[0], fiveStake)
+		require.NoError(t, err)
+
+		balance := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+		supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+		communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+		app.GovKeeper.SettleDeposits(ctx, proposal.ProposalId, tc.outcome)
+		require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+
+		switch tc.action {
+		case types.DepositActionRefund:
+			require.Equal(t, balance.Add(fiveStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+		case types.DepositActionBurn:
+			require.Equal(t, supply.Sub(fiveStake[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+		case types.DepositActionCommunityPool:
+			require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(fiveStake...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+		}
+
+		settled, found := app.GovKeeper.GetSettledDeposits(ctx, proposal.ProposalId)
+		require.True(t, found)
+		require.Equal(t, tc.outcome, settled.Outcome)
+		require.Equal(t, tc.action, settled.Action)
+		require.Equal(t, types.Deposits{types.NewDeposit(proposal.ProposalId, TestAddrs[0], fiveStake)}, settled.Deposits)
+	}
 }
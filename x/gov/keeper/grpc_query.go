@@ -48,7 +48,7 @@ func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (
 			return false, status.Error(codes.Internal, err.Error())
 		}
 
-		matchVoter, matchDepositor, matchStatus := true, true, true
+		matchVoter, matchDepositor, matchProposer, matchStatus := true, true, true, true
 
 		// match status (if supplied/valid)
 		if types.ValidProposalStatus(req.ProposalStatus) {
@@ -65,7 +65,12 @@ func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (
 			_, matchDepositor = q.GetDeposit(ctx, p.ProposalId, req.Depositor)
 		}
 
-		if matchVoter && matchDepositor && matchStatus {
+		// match proposer (if supplied)
+		if len(req.Proposer) > 0 {
+			matchProposer = p.Proposer.Equals(req.Proposer)
+		}
+
+		if matchVoter && matchDepositor && matchProposer && matchStatus {
 			if accumulate {
 				filteredProposals = append(filteredProposals, p)
 			}
@@ -254,8 +259,28 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = q.Tally(ctx, proposal)
+		_, tallyResult = q.Tally(ctx, proposal)
 	}
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// SettledDeposits queries what happened to the deposits of a finished proposal
+func (q Keeper) SettledDeposits(c context.Context, req *types.QuerySettledDepositsRequest) (*types.QuerySettledDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	settled, found := q.GetSettledDeposits(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "deposits of proposal %d have not been settled", req.ProposalId)
+	}
+
+	return &types.QuerySettledDepositsResponse{SettledDeposits: settled}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -17,8 +18,9 @@ type Keeper struct {
 	// The reference to the Paramstore to get and set gov specific params
 	paramSpace types.ParamSubspace
 
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
@@ -31,6 +33,10 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Routers of the messages executed by passed proposals
+	msgRouter    *baseapp.MsgServiceRouter
+	legacyRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -39,10 +45,16 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
+// The messages of passed proposals are routed through the given
+// MsgServiceRouter, or through the legacy router if they don't have a Msg
+// service.
+//
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace types.ParamSubspace,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
+	sk types.StakingKeeper, rtr types.Router,
+	msgRouter *baseapp.MsgServiceRouter, legacyRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -56,13 +68,16 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     rtr,
+		storeKey:     key,
+		paramSpace:   paramSpace,
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
+		sk:           sk,
+		cdc:          cdc,
+		router:       rtr,
+		msgRouter:    msgRouter,
+		legacyRouter: legacyRouter,
 	}
 }
 
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/gov store from version 1 to 2. The expedited
+// proposal params added in version 2 are derived from the existing params so
+// that they are valid with respect to them, while the maximum proposal metadata
+// length and the actions taken on deposits are set to their defaults. The
+// default deposit actions match the behavior of version 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	depositParams := m.keeper.GetDepositParams(ctx)
+	if depositParams.ExpeditedMinDeposit.Empty() {
+		multiplier := types.DefaultExpeditedMinDepositTokens.Quo(types.DefaultMinDepositTokens)
+		expeditedMinDeposit := sdk.NewCoins()
+		for _, coin := range depositParams.MinDeposit {
+			expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(multiplier)))
+		}
+		depositParams.ExpeditedMinDeposit = expeditedMinDeposit
+	}
+	if depositParams.MaxMetadataLen == 0 {
+		depositParams.MaxMetadataLen = types.DefaultMaxMetadataLen
+	}
+	if depositParams.PassedDepositAction == types.DepositActionNil {
+		depositParams.PassedDepositAction = types.DefaultPassedDepositAction
+	}
+	if depositParams.RejectedDepositAction == types.DepositActionNil {
+		depositParams.RejectedDepositAction = types.DefaultRejectedDepositAction
+	}
+	if depositParams.VetoedDepositAction == types.DepositActionNil {
+		depositParams.VetoedDepositAction = types.DefaultVetoedDepositAction
+	}
+	if depositParams.QuorumNotMetDepositAction == types.DepositActionNil {
+		depositParams.QuorumNotMetDepositAction = types.DefaultQuorumNotMetDepositAction
+	}
+	if depositParams.ExpiredDepositAction == types.DepositActionNil {
+		depositParams.ExpiredDepositAction = types.DefaultExpiredDepositAction
+	}
+	m.keeper.SetDepositParams(ctx, depositParams)
+
+	votingParams := m.keeper.GetVotingParams(ctx)
+	if votingParams.ExpeditedVotingPeriod == 0 {
+		votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+		if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+			votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+		}
+		m.keeper.SetVotingParams(ctx, votingParams)
+	}
+
+	tallyParams := m.keeper.GetTallyParams(ctx)
+	if tallyParams.ExpeditedThreshold.IsNil() || tallyParams.ExpeditedThreshold.IsZero() {
+		tallyParams.ExpeditedQuorum = sdk.MaxDec(types.DefaultExpeditedQuorum, tallyParams.Quorum)
+		tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+		if tallyParams.ExpeditedThreshold.LTE(tallyParams.Threshold) {
+			// halfway between the threshold and unanimity
+			tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec()).QuoInt64(2)
+		}
+		m.keeper.SetTallyParams(ctx, tallyParams)
+	}
+
+	return nil
+}
@@ -9,12 +9,37 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content and the messages to
+// execute when it passes. The messages must be signed by the gov module account.
+// Expedited proposals use the expedited deposit, voting and tally parameters.
+func (keeper Keeper) SubmitProposal(
+	ctx sdk.Context, content types.Content, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool,
+) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
+	maxMetadataLen := keeper.GetDepositParams(ctx).MaxMetadataLen
+	if uint64(len(metadata)) > maxMetadataLen {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrMetadataTooLong, "got %d, maximum is %d", len(metadata), maxMetadataLen)
+	}
+
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range messages {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.Proposal{}, sdkerrors.Wrapf(
+				types.ErrInvalidProposalMsg, "message %d must only be signed by the gov module account %s", i, govAddr,
+			)
+		}
+
+		if keeper.msgHandler(ctx, msg) == nil {
+			return types.Proposal{}, sdkerrors.Wrapf(
+				types.ErrInvalidProposalMsg, "unrecognized message route: %s; message index: %d", msg.Route(), i,
+			)
+		}
+	}
+
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
 	// governance process. State is not persisted.
@@ -32,7 +57,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(
+		content, messages, proposalID, submitTime, submitTime.Add(depositPeriod), metadata, proposer, expedited,
+	)
 	if err != nil {
 		return types.Proposal{}, err
 	}
@@ -51,6 +78,49 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecuteProposalMessages executes the messages of a passed proposal in order.
+// It stops at the first message which fails, so it should be called with a
+// cache-wrapped context to execute the messages atomically.
+func (keeper Keeper) ExecuteProposalMessages(ctx sdk.Context, proposal types.Proposal) error {
+	msgs, err := proposal.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := keeper.msgHandler(ctx, msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		// propagate the events of the executed message
+		ctx.EventManager().EmitEvents(msgResult.GetEvents())
+	}
+
+	return nil
+}
+
+// msgHandler returns the Msg service handler of msg, or its legacy handler if
+// it has no Msg service. It returns nil if msg can't be routed.
+func (keeper Keeper) msgHandler(ctx sdk.Context, msg sdk.Msg) sdk.Handler {
+	if keeper.msgRouter != nil {
+		if handler := keeper.msgRouter.Handler(msg); handler != nil {
+			return handler
+		}
+	}
+
+	if keeper.legacyRouter != nil {
+		return keeper.legacyRouter.Route(ctx, msg.Route())
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -117,11 +187,12 @@ func (keeper Keeper) GetProposals(ctx sdk.Context) (proposals types.Proposals) {
 }
 
 // GetProposalsFiltered retrieves proposals filtered by a given set of params which
-// include pagination parameters along with voter and depositor addresses and a
-// proposal status. The voter address will filter proposals by whether or not
+// include pagination parameters along with voter, depositor and proposer addresses
+// and a proposal status. The voter address will filter proposals by whether or not
 // that address has voted on proposals. The depositor address will filter proposals
-// by whether or not that address has deposited to them. Finally, status will filter
-// proposals by status.
+// by whether or not that address has deposited to them. The proposer address will
+// filter proposals by whether or not that address has submitted them. Finally,
+// status will filter proposals by status.
 //
 // NOTE: If no filters are provided, all proposals will be returned in paginated
 // form.
@@ -130,7 +201,7 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryPro
 	filteredProposals := make([]types.Proposal, 0, len(proposals))
 
 	for _, p := range proposals {
-		matchVoter, matchDepositor, matchStatus := true, true, true
+		matchVoter, matchDepositor, matchProposer, matchStatus := true, true, true, true
 
 		// match status (if supplied/valid)
 		if types.ValidProposalStatus(params.ProposalStatus) {
@@ -147,7 +218,12 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryPro
 			_, matchDepositor = keeper.GetDeposit(ctx, p.ProposalId, params.Depositor)
 		}
 
-		if matchVoter && matchDepositor && matchStatus {
+		// match proposer (if supplied)
+		if len(params.Proposer) > 0 {
+			matchProposer = p.Proposer.Equals(params.Proposer)
+		}
+
+		if matchVoter && matchDepositor && matchProposer && matchStatus {
 			filteredProposals = append(filteredProposals, p)
 		}
 	}
@@ -182,7 +258,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.Expedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
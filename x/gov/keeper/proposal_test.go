@@ -11,7 +11,9 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -20,7 +22,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, "", nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -35,7 +37,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, "", nil, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -78,11 +80,80 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, nil, "", nil, false)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func TestSubmitProposalMetadata(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	proposer := sdk.AccAddress("proposer____________")
+	maxMetadataLen := int(app.GovKeeper.GetDepositParams(ctx).MaxMetadataLen)
+
+	testCases := []struct {
+		metadata    string
+		expectedErr error
+	}{
+		{"", nil},
+		{"ipfs://CID", nil},
+		{strings.Repeat("a", maxMetadataLen), nil},
+		{strings.Repeat("a", maxMetadataLen+1), types.ErrMetadataTooLong},
+	}
+
+	for i, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, tc.metadata, proposer, false)
+		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+		if tc.expectedErr != nil {
+			continue
+		}
+
+		gotProposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, "tc #%d", i)
+		require.Equal(t, tc.metadata, gotProposal.Metadata, "tc #%d", i)
+		require.Equal(t, proposer, gotProposal.Proposer, "tc #%d", i)
+	}
+}
+
+func TestSubmitProposalMessages(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	addr := sdk.AccAddress("addr________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	testCases := []struct {
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{[]sdk.Msg{banktypes.NewMsgSend(govAddr, addr, coins)}, nil},
+		{[]sdk.Msg{banktypes.NewMsgSend(govAddr, addr, coins), banktypes.NewMsgSend(govAddr, addr, coins)}, nil},
+		// messages must be signed by the gov module account only
+		{[]sdk.Msg{banktypes.NewMsgSend(addr, govAddr, coins)}, types.ErrInvalidProposalMsg},
+		{[]sdk.Msg{testdata.NewTestMsg(govAddr, addr)}, types.ErrInvalidProposalMsg},
+		// messages must have a route
+		{[]sdk.Msg{testdata.NewTestMsg(govAddr)}, types.ErrInvalidProposalMsg},
+	}
+
+	for i, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs, "", nil, false)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(err, tc.expectedErr), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+			continue
+		}
+
+		require.NoError(t, err, "tc #%d", i)
+
+		gotProposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, "tc #%d", i)
+		msgs, err := gotProposal.GetMessages()
+		require.NoError(t, err, "tc #%d", i)
+		require.Equal(t, tc.msgs, msgs, "tc #%d", i)
+	}
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -91,17 +162,23 @@ func TestGetProposalsFiltered(t *testing.T) {
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
 
 	addr1 := sdk.AccAddress("foo")
+	addr2 := sdk.AccAddress("bar")
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			var proposer sdk.AccAddress
+			if i%2 == 1 {
+				proposer = addr2
+			}
+
+			p, err := types.NewProposal(TestProposal, nil, proposalID, time.Now(), time.Now(), "", proposer, false)
 			require.NoError(t, err)
 
 			p.Status = s
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -115,18 +192,22 @@ func TestGetProposalsFiltered(t *testing.T) {
 		params             types.QueryProposalsParams
 		expectedNumResults int
 	}{
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 25, types.StatusNil, nil, nil), 25},
-		{types.NewQueryProposalsParams(2, 25, types.StatusNil, nil, nil), 25},
-		{types.NewQueryProposalsParams(1, 50, types.StatusRejected, nil, nil), 0},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, addr1), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, addr1), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, addr1, addr1), 25},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 25, types.StatusNil, nil, nil, nil), 25},
+		{types.NewQueryProposalsParams(2, 25, types.StatusNil, nil, nil, nil), 25},
+		{types.NewQueryProposalsParams(1, 50, types.StatusRejected, nil, nil, nil), 0},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, addr1, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, addr1, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, addr1, addr1, nil), 25},
+		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil, nil), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, nil, addr2), 50},
+		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil, addr2), 25},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, nil, addr2), 0},
+		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, nil, addr1), 0},
 	}
 
 	for i, tc := range testCases {
@@ -138,6 +219,9 @@ func TestGetProposalsFiltered(t *testing.T) {
 				if types.ValidProposalStatus(tc.params.ProposalStatus) {
 					require.Equal(t, tc.params.ProposalStatus, p.Status)
 				}
+				if len(tc.params.Proposer) > 0 {
+					require.Equal(t, tc.params.Proposer, p.Proposer)
+				}
 			}
 		})
 	}
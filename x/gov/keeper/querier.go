@@ -174,7 +174,7 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = keeper.Tally(ctx, proposal)
+		_, tallyResult = keeper.Tally(ctx, proposal)
 	}
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, tallyResult)
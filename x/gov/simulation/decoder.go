@@ -41,6 +41,12 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &depositB)
 			return fmt.Sprintf("%v\n%v", depositA, depositB)
 
+		case bytes.Equal(kvA.Key[:1], types.SettledDepositsKeyPrefix):
+			var settledA, settledB types.SettledDeposits
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &settledA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &settledB)
+			return fmt.Sprintf("%v\n%v", settledA, settledB)
+
 		case bytes.Equal(kvA.Key[:1], types.VotesKeyPrefix):
 			var voteA, voteB types.Vote
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &voteA)
@@ -28,13 +28,14 @@ func TestDecodeStore(t *testing.T) {
 	endTime := time.Now().UTC()
 
 	content := types.ContentFromProposalType("test", "test", types.ProposalTypeText)
-	proposal, err := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	proposal, err := types.NewProposal(content, nil, 1, endTime, endTime.Add(24*time.Hour), "", nil, false)
 	require.NoError(t, err)
 
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
-	vote := types.NewVote(1, delAddr1, types.OptionYes)
+	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
+	settled := types.NewSettledDeposits(1, types.OutcomeVetoed, types.DepositActionBurn, types.Deposits{deposit})
 
 	proposalBz, err := cdc.MarshalBinaryBare(&proposal)
 	require.NoError(t, err)
@@ -44,6 +45,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ProposalKey(1), Value: proposalBz},
 			{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
 			{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryBare(&deposit)},
+			{Key: types.SettledDepositsKey(1), Value: cdc.MustMarshalBinaryBare(&settled)},
 			{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryBare(&vote)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
@@ -56,6 +58,7 @@ func TestDecodeStore(t *testing.T) {
 		{"proposals", fmt.Sprintf("%v\n%v", proposal, proposal)},
 		{"proposal IDs", "proposalIDA: 1\nProposalIDB: 1"},
 		{"deposits", fmt.Sprintf("%v\n%v", deposit, deposit)},
+		{"settled deposits", fmt.Sprintf("%v\n%v", settled, settled)},
 		{"votes", fmt.Sprintf("%v\n%v", vote, vote)},
 		{"other", ""},
 	}
@@ -16,12 +16,22 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod        = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	DepositParamsMaxMetadataLen       = "deposit_params_max_metadata_len"
+	DepositParamsPassedAction         = "deposit_params_passed_deposit_action"
+	DepositParamsRejectedAction       = "deposit_params_rejected_deposit_action"
+	DepositParamsVetoedAction         = "deposit_params_vetoed_deposit_action"
+	DepositParamsQuorumNotMetAction   = "deposit_params_quorum_not_met_deposit_action"
+	DepositParamsExpiredAction        = "deposit_params_expired_deposit_action"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	TallyParamsExpeditedQuorum        = "tally_params_expedited_quorum"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -34,11 +44,34 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit,
+// which is always greater than or equal to the given minimum deposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand, minDeposit sdk.Coins) sdk.Coins {
+	return minDeposit.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
+}
+
+// GenDepositParamsMaxMetadataLen randomized DepositParamsMaxMetadataLen
+func GenDepositParamsMaxMetadataLen(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 1000))
+}
+
+// GenDepositParamsDepositAction randomized action taken on the deposits of a
+// proposal for a given outcome
+func GenDepositParamsDepositAction(r *rand.Rand) types.DepositAction {
+	return types.DepositAction(simulation.RandIntBetween(r, int(types.DepositActionRefund), int(types.DepositActionCommunityPool)+1))
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// which is always shorter than the given voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod/time.Millisecond))) * time.Millisecond
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -54,6 +87,16 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsExpeditedQuorum randomized TallyParamsExpeditedQuorum
+func GenTallyParamsExpeditedQuorum(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 500, 600)), 3)
+}
+
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 600, 750)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -94,11 +137,62 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r, minDeposit) },
+	)
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
+	var expeditedQuorum sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedQuorum, &expeditedQuorum, simState.Rand,
+		func(r *rand.Rand) { expeditedQuorum = GenTallyParamsExpeditedQuorum(r) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var maxMetadataLen uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMaxMetadataLen, &maxMetadataLen, simState.Rand,
+		func(r *rand.Rand) { maxMetadataLen = GenDepositParamsMaxMetadataLen(r) },
+	)
+
+	var passedAction, rejectedAction, vetoedAction, quorumNotMetAction, expiredAction types.DepositAction
+	for _, p := range []struct {
+		key    string
+		action *types.DepositAction
+	}{
+		{DepositParamsPassedAction, &passedAction},
+		{DepositParamsRejectedAction, &rejectedAction},
+		{DepositParamsVetoedAction, &vetoedAction},
+		{DepositParamsQuorumNotMetAction, &quorumNotMetAction},
+		{DepositParamsExpiredAction, &expiredAction},
+	} {
+		action := p.action
+		simState.AppParams.GetOrGenerate(
+			simState.Cdc, p.key, action, simState.Rand,
+			func(r *rand.Rand) { *action = GenDepositParamsDepositAction(r) },
+		)
+	}
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(
+			minDeposit, depositPeriod, expeditedMinDeposit, maxMetadataLen,
+			passedAction, rejectedAction, vetoedAction, quorumNotMetAction, expiredAction,
+		),
+		types.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		types.NewTallyParams(quorum, threshold, veto, expeditedQuorum, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
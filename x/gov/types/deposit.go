@@ -51,3 +51,25 @@ func (d Deposits) String() string {
 func (d Deposit) Empty() bool {
 	return d.Equal(Deposit{})
 }
+
+// NewSettledDeposits creates a new SettledDeposits instance
+func NewSettledDeposits(proposalID uint64, outcome ProposalOutcome, action DepositAction, deposits Deposits) SettledDeposits {
+	return SettledDeposits{proposalID, outcome, action, deposits}
+}
+
+// String implements the Stringer interface.
+func (sd SettledDeposits) String() string {
+	out, _ := yaml.Marshal(sd)
+	return string(out)
+}
+
+// ValidDepositAction returns true if the deposit action is valid and false
+// otherwise.
+func ValidDepositAction(action DepositAction) bool {
+	if action == DepositActionRefund ||
+		action == DepositActionBurn ||
+		action == DepositActionCommunityPool {
+		return true
+	}
+	return false
+}
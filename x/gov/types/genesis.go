@@ -34,7 +34,21 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		settledDepositsEqual(data.SettledDeposits, other.SettledDeposits)
+}
+
+func settledDepositsEqual(settled, other []SettledDeposits) bool {
+	if len(settled) != len(other) {
+		return false
+	}
+	for i := range settled {
+		if settled[i].ProposalId != other[i].ProposalId || settled[i].Outcome != other[i].Outcome ||
+			settled[i].Action != other[i].Action || !settled[i].Deposits.Equal(other[i].Deposits) {
+			return false
+		}
+	}
+	return true
 }
 
 // Empty returns true if a GenesisState is empty
@@ -61,7 +75,21 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
-	return nil
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return err
+	}
+
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return err
+	}
+
+	for _, settled := range data.SettledDeposits {
+		if !ValidDepositAction(settled.Action) {
+			return fmt.Errorf("invalid deposit action for the settled deposits of proposal %d: %s", settled.ProposalId, settled.Action)
+		}
+	}
+
+	return validateTallyParams(data.TallyParams)
 }
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
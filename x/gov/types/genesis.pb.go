@@ -39,6 +39,8 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// settled_deposits defines the settlement records of all finished proposals.
+	SettledDeposits []SettledDeposits `protobuf:"bytes,8,rep,name=settled_deposits,json=settledDeposits,proto3" json:"settled_deposits" yaml:"settled_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +125,13 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetSettledDeposits() []SettledDeposits {
+	if m != nil {
+		return m.SettledDeposits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
 }
@@ -130,34 +139,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x9a, 0x94, 0xf6, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x9e, 0xb2,
-	0x60, 0xab, 0x65, 0x43, 0x62, 0xb1, 0x90, 0x50, 0x07, 0xa4, 0x62, 0x10, 0x03, 0x4b, 0x74, 0x89,
-	0x4f, 0x87, 0x85, 0xdd, 0x67, 0xf9, 0x1d, 0x16, 0xf9, 0x16, 0x7c, 0x0e, 0x3e, 0x49, 0xc7, 0x8e,
-	0x4c, 0x01, 0x25, 0x13, 0x6b, 0x3f, 0x01, 0xf2, 0xdd, 0x19, 0x1c, 0x61, 0x3a, 0x25, 0x7e, 0xf7,
-	0xbf, 0xdf, 0xef, 0xdd, 0xd3, 0x23, 0xde, 0x0a, 0x30, 0x07, 0x0c, 0x05, 0x54, 0x61, 0x75, 0xba,
-	0xe4, 0x92, 0x9d, 0x86, 0x82, 0x5f, 0x72, 0x4c, 0x31, 0x28, 0x4a, 0x90, 0x40, 0xa9, 0x4e, 0x04,
-	0x02, 0xaa, 0xc0, 0x24, 0x4e, 0x26, 0x02, 0x04, 0xa8, 0xe3, 0xb0, 0xfe, 0xa7, 0x93, 0x27, 0xb3,
-	0x2e, 0x16, 0x54, 0xfa, 0xd4, 0xff, 0xd5, 0x27, 0xa3, 0x57, 0x9a, 0xfc, 0x56, 0x32, 0xc9, 0xe9,
-	0x1b, 0x32, 0x41, 0xc9, 0x4a, 0x99, 0x5e, 0x8a, 0x45, 0x51, 0x42, 0x01, 0xc8, 0xb2, 0x45, 0x9a,
-	0xd8, 0x96, 0x67, 0xcd, 0xfb, 0x91, 0x7b, 0xb3, 0x71, 0xa7, 0x6b, 0x96, 0x67, 0xcf, 0xfd, 0xae,
-	0x94, 0x1f, 0xd3, 0xa6, 0x7c, 0x61, 0xaa, 0xe7, 0x09, 0x3d, 0x27, 0x47, 0x09, 0x2f, 0x00, 0x53,
-	0x89, 0xf6, 0x1d, 0xef, 0x60, 0x3e, 0x3c, 0x9b, 0x06, 0xff, 0xb6, 0x1f, 0xbc, 0xd4, 0x99, 0xe8,
-	0xfe, 0xd5, 0xc6, 0xed, 0x7d, 0xfb, 0xe1, 0x1e, 0x99, 0x02, 0xc6, 0x7f, 0xae, 0xd3, 0x17, 0x64,
-	0x50, 0x81, 0xe4, 0x68, 0x1f, 0x28, 0x8e, 0xdd, 0xc5, 0x79, 0x0f, 0x92, 0x47, 0x63, 0x03, 0x19,
-	0xd4, 0x5f, 0x18, 0xeb, 0x5b, 0xf4, 0x35, 0x39, 0x6e, 0xba, 0x45, 0xbb, 0xaf, 0x10, 0xb3, 0x2e,
-	0x44, 0xd3, 0x7c, 0xf4, 0xc0, 0x60, 0x8e, 0x9b, 0x0a, 0xc6, 0x7f, 0x09, 0x54, 0x90, 0x7b, 0xa6,
-	0xb3, 0x45, 0xc1, 0x4a, 0x96, 0xa3, 0x3d, 0xf0, 0xac, 0xf9, 0xf0, 0xec, 0xc9, 0x2d, 0xcf, 0xbb,
-	0x50, 0xc1, 0xe8, 0x71, 0x0d, 0xbe, 0xd9, 0xb8, 0x8f, 0xf4, 0x30, 0xf7, 0x31, 0x7e, 0x3c, 0x4e,
-	0xda, 0x69, 0xba, 0x22, 0xe3, 0x0a, 0xf4, 0xb0, 0xb5, 0xe7, 0x50, 0x79, 0xbc, 0xff, 0x3c, 0xbf,
-	0x1e, 0xbf, 0xd6, 0xcc, 0x8c, 0x66, 0xa2, 0x35, 0x7b, 0x10, 0x3f, 0x1e, 0x55, 0xad, 0x2c, 0x5d,
-	0x90, 0x91, 0x64, 0x59, 0xb6, 0x6e, 0x1c, 0x77, 0x95, 0xc3, 0xed, 0x72, 0xbc, 0xab, 0x73, 0x46,
-	0x31, 0x35, 0x8a, 0x87, 0x5a, 0xd1, 0x46, 0xf8, 0xf1, 0x50, 0xb6, 0x92, 0xd1, 0xd5, 0xd6, 0xb1,
-	0xae, 0xb7, 0x8e, 0xf5, 0x73, 0xeb, 0x58, 0x5f, 0x77, 0x4e, 0xef, 0x7a, 0xe7, 0xf4, 0xbe, 0xef,
-	0x9c, 0xde, 0x87, 0xb9, 0x48, 0xe5, 0xc7, 0xcf, 0xcb, 0x60, 0x05, 0x79, 0x68, 0xd6, 0x55, 0xff,
-	0x3c, 0xc5, 0xe4, 0x53, 0xf8, 0x45, 0xed, 0xae, 0x5c, 0x17, 0x1c, 0x97, 0x87, 0x6a, 0x6d, 0x9f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xb6, 0x8e, 0xce, 0x6d, 0x61, 0x98, 0x22, 0xa2, 0xb5, 0x24, 0x21, 0x5c, 0x7a,
+	0x21, 0xd1, 0xc6, 0x0d, 0x89, 0x4b, 0x84, 0x84, 0x76, 0x40, 0x1a, 0x19, 0xe2, 0xc0, 0x25, 0x72,
+	0x1b, 0xcb, 0x44, 0xa4, 0xfb, 0xa2, 0x7e, 0x26, 0xa2, 0x6f, 0xc1, 0x73, 0xf0, 0x24, 0x3b, 0xee,
+	0x88, 0x38, 0x14, 0xd4, 0xbe, 0xc1, 0x9e, 0x00, 0xc5, 0x76, 0xb6, 0xae, 0x04, 0x4e, 0x49, 0x3e,
+	0xff, 0xfd, 0xfb, 0x7d, 0x76, 0x6c, 0xe2, 0x4d, 0x01, 0x67, 0x80, 0xa1, 0x80, 0x32, 0x2c, 0x8f,
+	0x26, 0x5c, 0xb2, 0xa3, 0x50, 0xf0, 0x73, 0x8e, 0x19, 0x06, 0xc5, 0x1c, 0x24, 0x50, 0xaa, 0x13,
+	0x81, 0x80, 0x32, 0x30, 0x89, 0xc3, 0x81, 0x00, 0x01, 0x6a, 0x38, 0xac, 0xde, 0x74, 0xf2, 0x70,
+	0xd4, 0xc4, 0x82, 0x52, 0x8f, 0xfa, 0x3f, 0xdb, 0xa4, 0xf7, 0x46, 0x93, 0xcf, 0x24, 0x93, 0x9c,
+	0xbe, 0x23, 0x03, 0x94, 0x6c, 0x2e, 0xb3, 0x73, 0x91, 0x14, 0x73, 0x28, 0x00, 0x59, 0x9e, 0x64,
+	0xa9, 0x6d, 0x79, 0xd6, 0x78, 0x37, 0x72, 0xaf, 0x96, 0xee, 0x70, 0xc1, 0x66, 0xf9, 0x4b, 0xbf,
+	0x29, 0xe5, 0xc7, 0xb4, 0x2e, 0x9f, 0x9a, 0xea, 0x49, 0x4a, 0x4f, 0x48, 0x27, 0xe5, 0x05, 0x60,
+	0x26, 0xd1, 0xbe, 0xe3, 0xed, 0x8c, 0xbb, 0xc7, 0xc3, 0xe0, 0xef, 0xf6, 0x83, 0xd7, 0x3a, 0x13,
+	0x1d, 0x5c, 0x2c, 0xdd, 0xd6, 0xf7, 0x5f, 0x6e, 0xc7, 0x14, 0x30, 0xbe, 0x9e, 0x4e, 0x5f, 0x91,
+	0x76, 0x09, 0x92, 0xa3, 0xbd, 0xa3, 0x38, 0x76, 0x13, 0xe7, 0x03, 0x48, 0x1e, 0xf5, 0x0d, 0xa4,
+	0x5d, 0x7d, 0x61, 0xac, 0x67, 0xd1, 0xb7, 0x64, 0xbf, 0xee, 0x16, 0xed, 0x5d, 0x85, 0x18, 0x35,
+	0x21, 0xea, 0xe6, 0xa3, 0x07, 0x06, 0xb3, 0x5f, 0x57, 0x30, 0xbe, 0x21, 0x50, 0x41, 0xee, 0x99,
+	0xce, 0x92, 0x82, 0xcd, 0xd9, 0x0c, 0xed, 0xb6, 0x67, 0x8d, 0xbb, 0xc7, 0x4f, 0xff, 0xb3, 0xbc,
+	0x53, 0x15, 0x8c, 0x9e, 0x54, 0xe0, 0xab, 0xa5, 0xfb, 0x48, 0x6f, 0xe6, 0x6d, 0x8c, 0x1f, 0xf7,
+	0xd3, 0xcd, 0x34, 0x9d, 0x92, 0x7e, 0x09, 0x7a, 0xb3, 0xb5, 0x67, 0x4f, 0x79, 0xbc, 0x7f, 0x2c,
+	0xbf, 0xda, 0x7e, 0xad, 0x19, 0x19, 0xcd, 0x40, 0x6b, 0x6e, 0x41, 0xfc, 0xb8, 0x57, 0x6e, 0x64,
+	0x69, 0x42, 0x7a, 0x92, 0xe5, 0xf9, 0xa2, 0x76, 0xdc, 0x55, 0x0e, 0xb7, 0xc9, 0xf1, 0xbe, 0xca,
+	0x19, 0xc5, 0xd0, 0x28, 0x1e, 0x6a, 0xc5, 0x26, 0xc2, 0x8f, 0xbb, 0xf2, 0x26, 0x49, 0x81, 0x1c,
+	0x20, 0x97, 0x32, 0xe7, 0x69, 0x72, 0x7d, 0x1e, 0x3a, 0xea, 0x27, 0x3c, 0x6b, 0x92, 0x9c, 0xe9,
+	0x6c, 0x7d, 0x0a, 0x22, 0xd7, 0x88, 0x1e, 0x9b, 0xf3, 0xb7, 0x85, 0xf2, 0xe3, 0xfb, 0xb8, 0x35,
+	0x23, 0xba, 0x58, 0x39, 0xd6, 0xe5, 0xca, 0xb1, 0x7e, 0xaf, 0x1c, 0xeb, 0xdb, 0xda, 0x69, 0x5d,
+	0xae, 0x9d, 0xd6, 0x8f, 0xb5, 0xd3, 0xfa, 0x38, 0x16, 0x99, 0xfc, 0xf4, 0x65, 0x12, 0x4c, 0x61,
+	0x16, 0x9a, 0xfb, 0xa1, 0x1f, 0xcf, 0x31, 0xfd, 0x1c, 0x7e, 0x55, 0x97, 0x45, 0x2e, 0x0a, 0x8e,
+	0x93, 0x3d, 0x75, 0x4f, 0x5e, 0xfc, 0x19, 0x00, 0x09, 0x32, 0xf1, 0x87, 0x93, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +191,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SettledDeposits) > 0 {
+		for iNdEx := len(m.SettledDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -304,6 +329,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SettledDeposits) > 0 {
+		for _, e := range m.SettledDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -562,6 +593,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDeposits = append(m.SettledDeposits, SettledDeposits{})
+			if err := m.SettledDeposits[len(m.SettledDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
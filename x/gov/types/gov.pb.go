@@ -12,9 +12,9 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -117,6 +117,127 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 
+// ProposalOutcome enumerates the ways a proposal can leave the deposit or
+// voting period.
+type ProposalOutcome int32
+
+const (
+	// PROPOSAL_OUTCOME_UNSPECIFIED defines a no-op outcome.
+	OutcomeNil ProposalOutcome = 0
+	// PROPOSAL_OUTCOME_PASSED defines a proposal that passed its tally.
+	OutcomePassed ProposalOutcome = 1
+	// PROPOSAL_OUTCOME_REJECTED defines a proposal that reached quorum but did not pass.
+	OutcomeRejected ProposalOutcome = 2
+	// PROPOSAL_OUTCOME_VETOED defines a proposal that was vetoed.
+	OutcomeVetoed ProposalOutcome = 3
+	// PROPOSAL_OUTCOME_QUORUM_NOT_MET defines a proposal that did not reach quorum.
+	OutcomeQuorumNotMet ProposalOutcome = 4
+	// PROPOSAL_OUTCOME_DEPOSIT_EXPIRED defines a proposal that did not reach the
+	// minimum deposit before the end of its deposit period.
+	OutcomeDepositExpired ProposalOutcome = 5
+)
+
+var ProposalOutcome_name = map[int32]string{
+	0: "PROPOSAL_OUTCOME_UNSPECIFIED",
+	1: "PROPOSAL_OUTCOME_PASSED",
+	2: "PROPOSAL_OUTCOME_REJECTED",
+	3: "PROPOSAL_OUTCOME_VETOED",
+	4: "PROPOSAL_OUTCOME_QUORUM_NOT_MET",
+	5: "PROPOSAL_OUTCOME_DEPOSIT_EXPIRED",
+}
+
+var ProposalOutcome_value = map[string]int32{
+	"PROPOSAL_OUTCOME_UNSPECIFIED":     0,
+	"PROPOSAL_OUTCOME_PASSED":          1,
+	"PROPOSAL_OUTCOME_REJECTED":        2,
+	"PROPOSAL_OUTCOME_VETOED":          3,
+	"PROPOSAL_OUTCOME_QUORUM_NOT_MET":  4,
+	"PROPOSAL_OUTCOME_DEPOSIT_EXPIRED": 5,
+}
+
+func (x ProposalOutcome) String() string {
+	return proto.EnumName(ProposalOutcome_name, int32(x))
+}
+
+func (ProposalOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+}
+
+// DepositAction enumerates what can happen to the deposits of a proposal once
+// it leaves the deposit or voting period.
+type DepositAction int32
+
+const (
+	// DEPOSIT_ACTION_UNSPECIFIED defines a no-op action.
+	DepositActionNil DepositAction = 0
+	// DEPOSIT_ACTION_REFUND returns the deposits to their depositors.
+	DepositActionRefund DepositAction = 1
+	// DEPOSIT_ACTION_BURN burns the deposits.
+	DepositActionBurn DepositAction = 2
+	// DEPOSIT_ACTION_COMMUNITY_POOL sends the deposits to the community pool.
+	DepositActionCommunityPool DepositAction = 3
+)
+
+var DepositAction_name = map[int32]string{
+	0: "DEPOSIT_ACTION_UNSPECIFIED",
+	1: "DEPOSIT_ACTION_REFUND",
+	2: "DEPOSIT_ACTION_BURN",
+	3: "DEPOSIT_ACTION_COMMUNITY_POOL",
+}
+
+var DepositAction_value = map[string]int32{
+	"DEPOSIT_ACTION_UNSPECIFIED":    0,
+	"DEPOSIT_ACTION_REFUND":         1,
+	"DEPOSIT_ACTION_BURN":           2,
+	"DEPOSIT_ACTION_COMMUNITY_POOL": 3,
+}
+
+func (x DepositAction) String() string {
+	return proto.EnumName(DepositAction_name, int32(x))
+}
+
+func (DepositAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+}
+
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval.
 type TextProposal struct {
@@ -127,7 +248,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +287,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,12 +327,23 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// messages are the sdk.Msgs, signed by the gov module account, which are
+	// executed after the proposal content when the proposal passes.
+	Messages []*types1.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// expedited defines if the proposal is expedited, i.e. voted on with the
+	// expedited voting period and tally params.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the account which submitted the proposal.
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,12,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// metadata is an optional pointer, e.g. an IPFS CID or a URL, to off-chain
+	// metadata of the proposal.
+	Metadata string `protobuf:"bytes,13,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,6 +372,47 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// SettledDeposits records what happened to the deposits of a proposal once it
+// left the deposit or voting period.
+type SettledDeposits struct {
+	ProposalId uint64          `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Outcome    ProposalOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=cosmos.gov.v1beta1.ProposalOutcome" json:"outcome,omitempty"`
+	Action     DepositAction   `protobuf:"varint,3,opt,name=action,proto3,enum=cosmos.gov.v1beta1.DepositAction" json:"action,omitempty"`
+	Deposits   Deposits        `protobuf:"bytes,4,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+}
+
+func (m *SettledDeposits) Reset()      { *m = SettledDeposits{} }
+func (*SettledDeposits) ProtoMessage() {}
+func (*SettledDeposits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+}
+func (m *SettledDeposits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettledDeposits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettledDeposits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettledDeposits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledDeposits.Merge(m, src)
+}
+func (m *SettledDeposits) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettledDeposits) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledDeposits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledDeposits proto.InternalMessageInfo
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	Yes        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"yes"`
@@ -251,7 +424,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,17 +454,21 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
+// A Vote consists of a proposal ID, the voter, and the weighted vote options.
 type Vote struct {
 	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	// Deprecated: Prefer to use `options` instead. This field is set in queries
+	// if and only if `len(options) == 1` and that option has weight 1. In all
+	// other cases, this field will default to VOTE_OPTION_UNSPECIFIED.
+	Option  VoteOption           `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,12 +503,26 @@ type DepositParams struct {
 	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty" yaml:"min_deposit"`
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit"`
+	//  Maximum length of the metadata of a proposal.
+	MaxMetadataLen uint64 `protobuf:"varint,4,opt,name=max_metadata_len,json=maxMetadataLen,proto3" json:"max_metadata_len,omitempty" yaml:"max_metadata_len"`
+	//  Action taken on the deposits of a proposal that passed.
+	PassedDepositAction DepositAction `protobuf:"varint,5,opt,name=passed_deposit_action,json=passedDepositAction,proto3,enum=cosmos.gov.v1beta1.DepositAction" json:"passed_deposit_action,omitempty" yaml:"passed_deposit_action"`
+	//  Action taken on the deposits of a proposal that was rejected.
+	RejectedDepositAction DepositAction `protobuf:"varint,6,opt,name=rejected_deposit_action,json=rejectedDepositAction,proto3,enum=cosmos.gov.v1beta1.DepositAction" json:"rejected_deposit_action,omitempty" yaml:"rejected_deposit_action"`
+	//  Action taken on the deposits of a proposal that was vetoed.
+	VetoedDepositAction DepositAction `protobuf:"varint,7,opt,name=vetoed_deposit_action,json=vetoedDepositAction,proto3,enum=cosmos.gov.v1beta1.DepositAction" json:"vetoed_deposit_action,omitempty" yaml:"vetoed_deposit_action"`
+	//  Action taken on the deposits of a proposal that did not reach quorum.
+	QuorumNotMetDepositAction DepositAction `protobuf:"varint,8,opt,name=quorum_not_met_deposit_action,json=quorumNotMetDepositAction,proto3,enum=cosmos.gov.v1beta1.DepositAction" json:"quorum_not_met_deposit_action,omitempty" yaml:"quorum_not_met_deposit_action"`
+	//  Action taken on the deposits of a proposal whose deposit period expired.
+	ExpiredDepositAction DepositAction `protobuf:"varint,9,opt,name=expired_deposit_action,json=expiredDepositAction,proto3,enum=cosmos.gov.v1beta1.DepositAction" json:"expired_deposit_action,omitempty" yaml:"expired_deposit_action"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,12 +555,14 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of expedited proposals.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,12 +599,16 @@ type TallyParams struct {
 	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+	//  Minimum percentage of total stake needed to vote for the result of an expedited proposal to be considered valid.
+	ExpeditedQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_quorum,json=expeditedQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_quorum,omitempty" yaml:"expedited_quorum"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. Default value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty" yaml:"expedited_threshold"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,9 +640,13 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalOutcome", ProposalOutcome_name, ProposalOutcome_value)
+	proto.RegisterEnum("cosmos.gov.v1beta1.DepositAction", DepositAction_name, DepositAction_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
+	proto.RegisterType((*SettledDeposits)(nil), "cosmos.gov.v1beta1.SettledDeposits")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
@@ -456,96 +657,172 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6c, 0x1b, 0x45,
-	0x17, 0xf7, 0x3a, 0xff, 0xea, 0xb1, 0x93, 0x6c, 0x27, 0x69, 0xe2, 0xec, 0xd7, 0x6f, 0x77, 0xbf,
-	0xfd, 0x10, 0x8a, 0xaa, 0xd6, 0x69, 0x03, 0x02, 0xd1, 0x4a, 0x08, 0x3b, 0xde, 0xb6, 0x46, 0x95,
-	0x6d, 0xad, 0xb7, 0xae, 0x5a, 0x0e, 0xab, 0xb5, 0x77, 0xea, 0x2c, 0x78, 0x77, 0x8c, 0x77, 0x1c,
-	0x62, 0x71, 0xe1, 0x58, 0x19, 0x09, 0xf5, 0x88, 0x84, 0x2c, 0x21, 0x71, 0xe3, 0xcc, 0x99, 0x23,
-	0x8a, 0x10, 0x87, 0x8a, 0x53, 0x05, 0x92, 0x4b, 0x53, 0x09, 0xa1, 0x1c, 0x73, 0xe0, 0xc0, 0x01,
-	0xa1, 0xdd, 0x99, 0x8d, 0xd7, 0x76, 0x44, 0x6a, 0x38, 0x65, 0xf7, 0xcd, 0xfb, 0xfd, 0x7e, 0x6f,
-	0x7e, 0x33, 0xef, 0x6d, 0x0c, 0x2e, 0xd6, 0xb1, 0xe7, 0x60, 0x6f, 0xab, 0x81, 0xf7, 0xb6, 0xf6,
-	0xae, 0xd5, 0x10, 0x31, 0xaf, 0xf9, 0xcf, 0x99, 0x56, 0x1b, 0x13, 0x0c, 0x21, 0x5d, 0xcd, 0xf8,
-	0x11, 0xb6, 0x2a, 0x88, 0x0c, 0x51, 0x33, 0x3d, 0x74, 0x02, 0xa9, 0x63, 0xdb, 0xa5, 0x18, 0x61,
-	0xb5, 0x81, 0x1b, 0x38, 0x78, 0xdc, 0xf2, 0x9f, 0x58, 0x74, 0x83, 0xa2, 0x0c, 0xba, 0xc0, 0x68,
-	0xe9, 0x92, 0xd4, 0xc0, 0xb8, 0xd1, 0x44, 0x5b, 0xc1, 0x5b, 0xad, 0xf3, 0x70, 0x8b, 0xd8, 0x0e,
-	0xf2, 0x88, 0xe9, 0xb4, 0x42, 0xec, 0x78, 0x82, 0xe9, 0x76, 0xd9, 0x92, 0x38, 0xbe, 0x64, 0x75,
-	0xda, 0x26, 0xb1, 0x31, 0x2b, 0x46, 0xb9, 0x07, 0x52, 0x3a, 0xda, 0x27, 0xe5, 0x36, 0x6e, 0x61,
-	0xcf, 0x6c, 0xc2, 0x55, 0x30, 0x47, 0x6c, 0xd2, 0x44, 0x69, 0x4e, 0xe6, 0x36, 0x13, 0x1a, 0x7d,
-	0x81, 0x32, 0x48, 0x5a, 0xc8, 0xab, 0xb7, 0xed, 0x96, 0x0f, 0x4d, 0xc7, 0x83, 0xb5, 0x68, 0xe8,
-	0xfa, 0xf2, 0x6f, 0x5f, 0x4a, 0xdc, 0x8f, 0xdf, 0x5c, 0x59, 0xd8, 0xc1, 0x2e, 0x41, 0x2e, 0x51,
-	0xfe, 0xe4, 0xc0, 0x42, 0x1e, 0xb5, 0xb0, 0x67, 0x13, 0xf8, 0x26, 0x48, 0xb6, 0x98, 0x80, 0x61,
-	0x5b, 0x01, 0xf5, 0x6c, 0x6e, 0xed, 0x78, 0x20, 0xc1, 0xae, 0xe9, 0x34, 0xaf, 0x2b, 0x91, 0x45,
-	0x45, 0x03, 0xe1, 0x5b, 0xc1, 0x82, 0x25, 0x90, 0xb0, 0x28, 0x07, 0x6e, 0x07, 0xaa, 0xa9, 0xdc,
-	0xb5, 0x3f, 0x06, 0xd2, 0x95, 0x86, 0x4d, 0x76, 0x3b, 0xb5, 0x4c, 0x1d, 0x3b, 0xcc, 0x29, 0xf6,
-	0xe7, 0x8a, 0x67, 0x7d, 0xb0, 0x45, 0xba, 0x2d, 0xe4, 0x65, 0xb2, 0xf5, 0x7a, 0xd6, 0xb2, 0xda,
-	0xc8, 0xf3, 0xb4, 0x21, 0x07, 0xac, 0x83, 0x79, 0xd3, 0xc1, 0x1d, 0x97, 0xa4, 0x67, 0xe4, 0x99,
-	0xcd, 0xe4, 0xf6, 0x46, 0x86, 0x39, 0xed, 0x1f, 0x56, 0x78, 0x82, 0x99, 0x1d, 0x6c, 0xbb, 0xb9,
-	0xab, 0x07, 0x03, 0x29, 0xf6, 0xf5, 0x33, 0x69, 0xf3, 0x25, 0xc4, 0x7c, 0x80, 0xa7, 0x31, 0xea,
-	0xeb, 0xb3, 0xbe, 0x17, 0xca, 0xef, 0xf3, 0xe0, 0xdc, 0x89, 0xad, 0xaf, 0x9f, 0xe6, 0xc0, 0xca,
-	0xd1, 0x40, 0x8a, 0xdb, 0xd6, 0xf1, 0x40, 0x4a, 0x50, 0x1f, 0xc6, 0xb7, 0x7f, 0x03, 0x2c, 0xd4,
-	0xa9, 0x9d, 0xc1, 0xe6, 0x93, 0xdb, 0xab, 0x19, 0x7a, 0x9c, 0x99, 0xf0, 0x38, 0x33, 0x59, 0xb7,
-	0x9b, 0x4b, 0x7e, 0x3f, 0xf4, 0x5d, 0x0b, 0x11, 0xb0, 0x0a, 0xe6, 0x3d, 0x62, 0x92, 0x8e, 0x97,
-	0x9e, 0x91, 0xb9, 0xcd, 0xa5, 0x6d, 0x25, 0x33, 0x79, 0x57, 0x33, 0x61, 0x81, 0x95, 0x20, 0x33,
-	0x27, 0x1c, 0x0f, 0xa4, 0xb5, 0xb1, 0x33, 0xa1, 0x24, 0x8a, 0xc6, 0xd8, 0x60, 0x0b, 0xc0, 0x87,
-	0xb6, 0x6b, 0x36, 0x0d, 0x62, 0x36, 0x9b, 0x5d, 0xa3, 0x8d, 0xbc, 0x4e, 0x93, 0xa4, 0x67, 0x83,
-	0xfa, 0xa4, 0xd3, 0x34, 0x74, 0x3f, 0x4f, 0x0b, 0xd2, 0x72, 0xff, 0xf3, 0x4d, 0x3d, 0x1e, 0x48,
-	0x1b, 0x54, 0x64, 0x92, 0x48, 0xd1, 0xf8, 0x20, 0x18, 0x01, 0xc1, 0xf7, 0x40, 0xd2, 0xeb, 0xd4,
-	0x1c, 0x9b, 0x18, 0xfe, 0xc5, 0x4f, 0xcf, 0x05, 0x52, 0xc2, 0x84, 0x15, 0x7a, 0xd8, 0x15, 0x39,
-	0x91, 0xa9, 0xb0, 0xeb, 0x15, 0x01, 0x2b, 0x8f, 0x9f, 0x49, 0x9c, 0x06, 0x68, 0xc4, 0x07, 0x40,
-	0x1b, 0xf0, 0xec, 0x7a, 0x18, 0xc8, 0xb5, 0xa8, 0xc2, 0xfc, 0x99, 0x0a, 0xff, 0x67, 0x0a, 0xeb,
-	0x54, 0x61, 0x9c, 0x81, 0xca, 0x2c, 0xb1, 0xb0, 0xea, 0x5a, 0x81, 0xd4, 0x23, 0x0e, 0x2c, 0x12,
-	0x4c, 0xcc, 0xa6, 0xc1, 0x16, 0xd2, 0x0b, 0x67, 0x5d, 0xc2, 0xdb, 0x4c, 0x67, 0x95, 0xea, 0x8c,
-	0xa0, 0x95, 0xa9, 0x2e, 0x67, 0x2a, 0xc0, 0x86, 0x1d, 0xd9, 0x04, 0xe7, 0xf7, 0x30, 0xb1, 0xdd,
-	0x86, 0x7f, 0xbc, 0x6d, 0x66, 0xec, 0xb9, 0x33, 0xb7, 0xfd, 0x0a, 0x2b, 0x27, 0x4d, 0xcb, 0x99,
-	0xa0, 0xa0, 0xfb, 0x5e, 0xa6, 0xf1, 0x8a, 0x1f, 0x0e, 0x36, 0xfe, 0x10, 0xb0, 0xd0, 0xd0, 0xe2,
-	0xc4, 0x99, 0x5a, 0x0a, 0xd3, 0x5a, 0x1b, 0xd1, 0x1a, 0x75, 0x78, 0x91, 0x46, 0x99, 0xc1, 0xac,
-	0xf1, 0x0e, 0xe2, 0x20, 0x19, 0xbd, 0x3e, 0xef, 0x80, 0x99, 0x2e, 0xf2, 0xe8, 0x40, 0xcb, 0x65,
-	0x7c, 0xd6, 0x9f, 0x06, 0xd2, 0xab, 0x2f, 0x61, 0x5c, 0xc1, 0x25, 0x9a, 0x0f, 0x85, 0xb7, 0xc1,
-	0x82, 0x59, 0xf3, 0x88, 0x69, 0xb3, 0xd1, 0x37, 0x35, 0x4b, 0x08, 0x87, 0x6f, 0x83, 0xb8, 0x8b,
-	0x83, 0x86, 0x9c, 0x9e, 0x24, 0xee, 0x62, 0xd8, 0x00, 0x29, 0x17, 0x1b, 0x1f, 0xd9, 0x64, 0xd7,
-	0xd8, 0x43, 0x04, 0x07, 0x6d, 0x97, 0xc8, 0xa9, 0xd3, 0x31, 0x1d, 0x0f, 0xa4, 0x15, 0x6a, 0x6a,
-	0x94, 0x4b, 0xd1, 0x80, 0x8b, 0xef, 0xd9, 0x64, 0xb7, 0x8a, 0x08, 0x66, 0x56, 0x7e, 0xc7, 0x81,
-	0xd9, 0x2a, 0x26, 0xe8, 0x9f, 0x4f, 0xf0, 0x5b, 0x60, 0x6e, 0x0f, 0x13, 0xf4, 0x2f, 0xa6, 0x37,
-	0xc5, 0xc3, 0x37, 0xc0, 0x3c, 0xa6, 0x5f, 0x1f, 0x3a, 0xce, 0xc4, 0xd3, 0x46, 0x8d, 0x5f, 0x6b,
-	0x29, 0xc8, 0xd2, 0x58, 0x36, 0xdb, 0xc8, 0xb7, 0x71, 0xb0, 0xc8, 0xee, 0x7e, 0xd9, 0x6c, 0x9b,
-	0x8e, 0x07, 0xbf, 0xe0, 0x40, 0xd2, 0xb1, 0xdd, 0x93, 0x56, 0xe4, 0xce, 0x6a, 0x45, 0xc3, 0x37,
-	0xf9, 0x68, 0x20, 0x5d, 0x88, 0xa0, 0x2e, 0x63, 0xc7, 0x26, 0xc8, 0x69, 0x91, 0xee, 0xd0, 0x8a,
-	0xc8, 0xf2, 0x74, 0x1d, 0x0a, 0x1c, 0xdb, 0x0d, 0xfb, 0xf3, 0x33, 0x0e, 0x40, 0xc7, 0xdc, 0x0f,
-	0x89, 0x8c, 0x16, 0x6a, 0xdb, 0xd8, 0x62, 0x5f, 0x81, 0x8d, 0x89, 0xae, 0xc9, 0xb3, 0x8f, 0x3a,
-	0xbd, 0x09, 0x47, 0x03, 0xe9, 0xe2, 0x24, 0x78, 0xa4, 0x56, 0x36, 0x7f, 0x27, 0xb3, 0x94, 0xcf,
-	0xfd, 0xbe, 0xe2, 0x1d, 0x73, 0x3f, 0xb4, 0x8b, 0x86, 0x3f, 0xe5, 0x40, 0xaa, 0x1a, 0x34, 0x1b,
-	0xf3, 0xef, 0x63, 0xc0, 0x9a, 0x2f, 0xac, 0x8d, 0x3b, 0xab, 0xb6, 0x1b, 0xac, 0xb6, 0xf5, 0x11,
-	0xdc, 0x48, 0x59, 0xab, 0x23, 0xbd, 0x1e, 0xad, 0x28, 0x45, 0x63, 0xac, 0x9a, 0x9f, 0xc3, 0x16,
-	0x67, 0xc5, 0x3c, 0x00, 0xf3, 0x1f, 0x76, 0x70, 0xbb, 0xe3, 0x04, 0x55, 0xa4, 0x72, 0xb9, 0x29,
-	0x1a, 0x22, 0x8f, 0xea, 0x47, 0x03, 0x89, 0xa7, 0xf8, 0x61, 0x35, 0x1a, 0x63, 0x84, 0x75, 0x90,
-	0x20, 0xbb, 0x6d, 0xe4, 0xed, 0xe2, 0xa6, 0xc5, 0x6e, 0xb1, 0x3a, 0x35, 0xfd, 0xca, 0x09, 0x45,
-	0x44, 0x61, 0xc8, 0x0b, 0x7b, 0x1c, 0x58, 0xf2, 0x9b, 0xd0, 0x18, 0x4a, 0xcd, 0x04, 0x52, 0xf5,
-	0xa9, 0xa5, 0xd2, 0xa3, 0x3c, 0x23, 0xfe, 0x5e, 0x60, 0xfe, 0x8e, 0x64, 0x28, 0xda, 0xa2, 0x1f,
-	0xd0, 0xc3, 0xf7, 0x4b, 0xbf, 0x72, 0x00, 0x0c, 0x3b, 0x09, 0x5e, 0x06, 0xeb, 0xd5, 0x92, 0xae,
-	0x1a, 0xa5, 0xb2, 0x5e, 0x28, 0x15, 0x8d, 0xbb, 0xc5, 0x4a, 0x59, 0xdd, 0x29, 0xdc, 0x2c, 0xa8,
-	0x79, 0x3e, 0x26, 0x2c, 0xf7, 0xfa, 0x72, 0x92, 0x26, 0xaa, 0xbe, 0x08, 0x54, 0xc0, 0x72, 0x34,
-	0xfb, 0xbe, 0x5a, 0xe1, 0x39, 0x61, 0xb1, 0xd7, 0x97, 0x13, 0x34, 0xeb, 0x3e, 0xf2, 0xe0, 0x25,
-	0xb0, 0x12, 0xcd, 0xc9, 0xe6, 0x2a, 0x7a, 0xb6, 0x50, 0xe4, 0xe3, 0xc2, 0xf9, 0x5e, 0x5f, 0x5e,
-	0xa4, 0x79, 0x59, 0x36, 0x31, 0x65, 0xb0, 0x14, 0xcd, 0x2d, 0x96, 0xf8, 0x19, 0x21, 0xd5, 0xeb,
-	0xcb, 0xe7, 0x68, 0x5a, 0x11, 0xc3, 0x6d, 0x90, 0x1e, 0xcd, 0x30, 0xee, 0x15, 0xf4, 0xdb, 0x46,
-	0x55, 0xd5, 0x4b, 0xfc, 0xac, 0xb0, 0xda, 0xeb, 0xcb, 0x7c, 0x98, 0x1b, 0x8e, 0x37, 0x61, 0xf6,
-	0xd1, 0x57, 0x62, 0xec, 0xd2, 0x0f, 0x71, 0xb0, 0x34, 0xfa, 0x1f, 0x10, 0xcc, 0x80, 0xff, 0x94,
-	0xb5, 0x52, 0xb9, 0x54, 0xc9, 0xde, 0x31, 0x2a, 0x7a, 0x56, 0xbf, 0x5b, 0x19, 0xdb, 0x70, 0xb0,
-	0x15, 0x9a, 0x5c, 0xb4, 0x9b, 0xf0, 0x06, 0x10, 0xc7, 0xf3, 0xf3, 0x6a, 0xb9, 0x54, 0x29, 0xe8,
-	0x46, 0x59, 0xd5, 0x0a, 0xa5, 0x3c, 0xcf, 0x09, 0xeb, 0xbd, 0xbe, 0xbc, 0x42, 0x21, 0x23, 0x4d,
-	0x05, 0xdf, 0x02, 0xff, 0x1d, 0x07, 0x57, 0x4b, 0x7a, 0xa1, 0x78, 0x2b, 0xc4, 0xc6, 0x85, 0xb5,
-	0x5e, 0x5f, 0x86, 0x14, 0x5b, 0x8d, 0x74, 0x00, 0xbc, 0x0c, 0xd6, 0xc6, 0xa1, 0xe5, 0x6c, 0xa5,
-	0xa2, 0xe6, 0xf9, 0x19, 0x81, 0xef, 0xf5, 0xe5, 0x14, 0xc5, 0x94, 0x4d, 0xcf, 0x43, 0x16, 0xbc,
-	0x0a, 0xd2, 0xe3, 0xd9, 0x9a, 0xfa, 0xae, 0xba, 0xa3, 0xab, 0x79, 0x7e, 0x56, 0x80, 0xbd, 0xbe,
-	0xbc, 0x44, 0xf3, 0x35, 0xf4, 0x3e, 0xaa, 0x13, 0x74, 0x2a, 0xff, 0xcd, 0x6c, 0xe1, 0x8e, 0x9a,
-	0xe7, 0xe7, 0xa2, 0xfc, 0x37, 0x4d, 0xbb, 0x89, 0x2c, 0x6a, 0x67, 0xae, 0x78, 0xf0, 0x5c, 0x8c,
-	0x3d, 0x7d, 0x2e, 0xc6, 0x3e, 0x39, 0x14, 0x63, 0x07, 0x87, 0x22, 0xf7, 0xe4, 0x50, 0xe4, 0x7e,
-	0x39, 0x14, 0xb9, 0xc7, 0x2f, 0xc4, 0xd8, 0x93, 0x17, 0x62, 0xec, 0xe9, 0x0b, 0x31, 0xf6, 0xe0,
-	0xef, 0x07, 0xe2, 0x7e, 0xf0, 0x43, 0x2b, 0xb8, 0xcf, 0xb5, 0xf9, 0x60, 0x86, 0xbc, 0xf6, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x92, 0x64, 0xc1, 0x80, 0x83, 0x0d, 0x00, 0x00,
-}
+	// 2167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4d, 0x6c, 0xdb, 0xc8,
+	0xd9, 0x36, 0x25, 0xf9, 0x6f, 0xe4, 0x1f, 0x65, 0xe4, 0x1f, 0x99, 0x9b, 0x88, 0x0c, 0x77, 0xb1,
+	0x5f, 0x10, 0x24, 0x72, 0xe2, 0x6f, 0xd1, 0x22, 0x49, 0xdb, 0xad, 0x64, 0x33, 0x89, 0x8a, 0x58,
+	0x54, 0x28, 0xd9, 0x69, 0xb6, 0x07, 0x82, 0x96, 0x26, 0x32, 0x5b, 0x91, 0xa3, 0x8a, 0xa3, 0xac,
+	0x8d, 0x5e, 0x7a, 0x5c, 0xe8, 0xd0, 0x6e, 0xf7, 0x54, 0xb4, 0xd0, 0xa2, 0xe8, 0xde, 0xda, 0x4b,
+	0x0b, 0xf4, 0xd0, 0x73, 0x4f, 0x41, 0xd1, 0xc3, 0xa2, 0xa7, 0x45, 0x51, 0x68, 0xbb, 0x09, 0x50,
+	0x14, 0x3e, 0xfa, 0xd8, 0x43, 0x51, 0x90, 0x33, 0xa4, 0x48, 0x8a, 0xb1, 0xad, 0xf4, 0x14, 0x69,
+	0xe6, 0x7d, 0x9e, 0xf7, 0x99, 0x67, 0xde, 0x79, 0x67, 0xac, 0x80, 0xcb, 0x0d, 0x6c, 0x9b, 0xd8,
+	0xde, 0x6c, 0xe1, 0xe7, 0x9b, 0xcf, 0x6f, 0x1f, 0x20, 0xa2, 0xdf, 0x76, 0x3e, 0x17, 0x3a, 0x5d,
+	0x4c, 0x30, 0x84, 0x74, 0xb6, 0xe0, 0x8c, 0xb0, 0x59, 0x3e, 0xcf, 0x10, 0x07, 0xba, 0x8d, 0x7c,
+	0x48, 0x03, 0x1b, 0x16, 0xc5, 0xf0, 0x2b, 0x2d, 0xdc, 0xc2, 0xee, 0xc7, 0x4d, 0xe7, 0x13, 0x1b,
+	0xdd, 0xa0, 0x28, 0x8d, 0x4e, 0x30, 0x5a, 0x3a, 0x25, 0xb4, 0x30, 0x6e, 0xb5, 0xd1, 0xa6, 0xfb,
+	0xed, 0xa0, 0xf7, 0x6c, 0x93, 0x18, 0x26, 0xb2, 0x89, 0x6e, 0x76, 0x3c, 0x6c, 0x34, 0x40, 0xb7,
+	0x8e, 0xd9, 0x54, 0x3e, 0x3a, 0xd5, 0xec, 0x75, 0x75, 0x62, 0x60, 0x26, 0x46, 0xfa, 0x2d, 0x07,
+	0xe0, 0x13, 0x64, 0xb4, 0x0e, 0x09, 0x6a, 0xee, 0x63, 0x82, 0x94, 0x8e, 0x33, 0x09, 0xbf, 0x06,
+	0x66, 0xb0, 0xfb, 0x29, 0xc7, 0x89, 0xdc, 0xb5, 0xa5, 0xad, 0x7c, 0x61, 0x7c, 0xa1, 0x85, 0x51,
+	0xbc, 0xca, 0xa2, 0xe1, 0x13, 0x30, 0xf3, 0xa1, 0xcb, 0x96, 0x4b, 0x88, 0xdc, 0xb5, 0xf9, 0xd2,
+	0xfb, 0x2f, 0x86, 0xc2, 0xd4, 0xdf, 0x86, 0xc2, 0xbb, 0x2d, 0x83, 0x1c, 0xf6, 0x0e, 0x0a, 0x0d,
+	0x6c, 0xb2, 0xb5, 0xb1, 0x7f, 0x6e, 0xda, 0xcd, 0x1f, 0x6c, 0x92, 0xe3, 0x0e, 0xb2, 0x0b, 0x3b,
+	0xa8, 0x71, 0x3a, 0x14, 0x16, 0x8f, 0x75, 0xb3, 0x7d, 0x57, 0xa2, 0x2c, 0x92, 0xca, 0xe8, 0xee,
+	0xa6, 0xfe, 0xf5, 0x2b, 0x81, 0x93, 0x9e, 0x80, 0x85, 0x3a, 0x3a, 0x22, 0xd5, 0x2e, 0xee, 0x60,
+	0x5b, 0x6f, 0xc3, 0x15, 0x30, 0x4d, 0x0c, 0xd2, 0x46, 0xae, 0xca, 0x79, 0x95, 0x7e, 0x81, 0x22,
+	0x48, 0x37, 0x91, 0xdd, 0xe8, 0x1a, 0x74, 0x05, 0xae, 0x12, 0x35, 0x38, 0x74, 0x77, 0xd9, 0x61,
+	0xfb, 0xeb, 0x1f, 0x6e, 0xce, 0x6e, 0x63, 0x8b, 0x20, 0x8b, 0x48, 0xff, 0xe1, 0xc0, 0xec, 0x0e,
+	0xea, 0x60, 0xdb, 0x20, 0xf0, 0xeb, 0x20, 0xdd, 0x61, 0x09, 0x34, 0xa3, 0xe9, 0x52, 0xa7, 0x4a,
+	0x6b, 0xa7, 0x43, 0x01, 0x52, 0x69, 0x81, 0x49, 0x49, 0x05, 0xde, 0xb7, 0x72, 0x13, 0x2a, 0x60,
+	0xbe, 0x49, 0x39, 0x70, 0xd7, 0xcd, 0xba, 0x50, 0xba, 0xfd, 0xef, 0xa1, 0x70, 0xf3, 0x02, 0x6b,
+	0x2f, 0x36, 0x1a, 0xc5, 0x66, 0xb3, 0x8b, 0x6c, 0x5b, 0x1d, 0x71, 0xc0, 0x06, 0x98, 0xd1, 0x4d,
+	0xdc, 0xb3, 0x48, 0x2e, 0x29, 0x26, 0xaf, 0xa5, 0xb7, 0x36, 0xbc, 0x5d, 0x70, 0x4a, 0xcb, 0xdf,
+	0x86, 0x6d, 0x6c, 0x58, 0xa5, 0x5b, 0x8e, 0xd1, 0xbf, 0xf9, 0x52, 0xb8, 0x76, 0x81, 0x64, 0x0e,
+	0xc0, 0x56, 0x19, 0x35, 0x73, 0xf6, 0xf7, 0x73, 0x60, 0xce, 0xb7, 0xf5, 0xbd, 0x38, 0x07, 0xb2,
+	0x27, 0x43, 0x21, 0x61, 0x34, 0x4f, 0x87, 0xc2, 0x3c, 0xf5, 0x21, 0xba, 0xfc, 0x7b, 0x60, 0xb6,
+	0x41, 0xed, 0x74, 0x17, 0x9f, 0xde, 0x5a, 0x29, 0xd0, 0xe2, 0x2b, 0x78, 0xc5, 0x57, 0x28, 0x5a,
+	0xc7, 0xa5, 0xf4, 0x9f, 0x47, 0xbe, 0xab, 0x1e, 0x02, 0xee, 0x83, 0x19, 0x9b, 0xe8, 0xa4, 0x67,
+	0xe7, 0x92, 0x6e, 0xc1, 0x49, 0x71, 0x05, 0xe7, 0x09, 0xac, 0xb9, 0x91, 0x25, 0xfe, 0x74, 0x28,
+	0xac, 0x45, 0xf6, 0x84, 0x92, 0x48, 0x2a, 0x63, 0x83, 0x1d, 0x00, 0x9f, 0x19, 0x96, 0xde, 0xd6,
+	0x88, 0xde, 0x6e, 0x1f, 0x6b, 0x5d, 0x64, 0xf7, 0xda, 0x24, 0x97, 0x72, 0xf5, 0x09, 0x71, 0x39,
+	0xea, 0x4e, 0x9c, 0xea, 0x86, 0x95, 0xae, 0x3a, 0xa6, 0x9e, 0x0e, 0x85, 0x0d, 0x9a, 0x64, 0x9c,
+	0x48, 0x52, 0x33, 0xee, 0x60, 0x00, 0x04, 0xbf, 0x07, 0xd2, 0x76, 0xef, 0xc0, 0x34, 0x88, 0xe6,
+	0x1c, 0xd3, 0xdc, 0xb4, 0x9b, 0x8a, 0x1f, 0xb3, 0xa2, 0xee, 0x9d, 0xe1, 0x52, 0x9e, 0x65, 0x61,
+	0xe5, 0x15, 0x00, 0x4b, 0x1f, 0x7f, 0x29, 0x70, 0x2a, 0xa0, 0x23, 0x0e, 0x00, 0x1a, 0x20, 0xc3,
+	0xca, 0x43, 0x43, 0x56, 0x93, 0x66, 0x98, 0x39, 0x37, 0xc3, 0xdb, 0x2c, 0xc3, 0x3a, 0xcd, 0x10,
+	0x65, 0xa0, 0x69, 0x96, 0xd8, 0xb0, 0x6c, 0x35, 0xdd, 0x54, 0x1f, 0x71, 0x60, 0x91, 0x60, 0xa2,
+	0xb7, 0x35, 0x36, 0x91, 0x9b, 0x3d, 0xaf, 0x08, 0x1f, 0xb2, 0x3c, 0x2b, 0x34, 0x4f, 0x08, 0x2d,
+	0x4d, 0x54, 0x9c, 0x0b, 0x2e, 0xd6, 0x3b, 0x91, 0x6d, 0x70, 0xe9, 0x39, 0x26, 0x86, 0xd5, 0x72,
+	0xb6, 0xb7, 0xcb, 0x8c, 0x9d, 0x3b, 0x77, 0xd9, 0xef, 0x30, 0x39, 0x39, 0x2a, 0x67, 0x8c, 0x82,
+	0xae, 0x7b, 0x99, 0x8e, 0xd7, 0x9c, 0x61, 0x77, 0xe1, 0xcf, 0x00, 0x1b, 0x1a, 0x59, 0x3c, 0x7f,
+	0x6e, 0x2e, 0x89, 0xe5, 0x5a, 0x0b, 0xe5, 0x0a, 0x3b, 0xbc, 0x48, 0x47, 0x3d, 0x83, 0x6f, 0x81,
+	0x39, 0x13, 0xd9, 0xb6, 0xde, 0x42, 0x76, 0x0e, 0x88, 0xc9, 0xd7, 0x1d, 0x18, 0xd5, 0x8f, 0x82,
+	0x97, 0xc1, 0x3c, 0x3a, 0xea, 0xa0, 0xa6, 0x41, 0x50, 0x33, 0x97, 0x16, 0xb9, 0x6b, 0x73, 0xea,
+	0x68, 0x00, 0xee, 0x82, 0x39, 0x7a, 0x0c, 0x50, 0x37, 0xb7, 0xf0, 0xa6, 0xdd, 0xc7, 0xa7, 0x80,
+	0xbc, 0x23, 0x8f, 0xe8, 0x4d, 0x9d, 0xe8, 0xb9, 0x45, 0xb7, 0x85, 0xfa, 0xdf, 0x59, 0xcf, 0xf8,
+	0x69, 0x02, 0x2c, 0xd7, 0x10, 0x21, 0x6d, 0xd4, 0x64, 0x3b, 0x65, 0xbf, 0x79, 0xf3, 0xfc, 0x26,
+	0x98, 0xc5, 0x3d, 0xd2, 0xc0, 0x26, 0x72, 0xbb, 0xc7, 0xd2, 0xd6, 0xdb, 0x67, 0x75, 0x00, 0x85,
+	0x86, 0xaa, 0x1e, 0x06, 0xde, 0x01, 0x33, 0x7a, 0xc3, 0x6d, 0xf7, 0xb4, 0x7f, 0x5c, 0x8d, 0x43,
+	0x33, 0x95, 0xc5, 0x06, 0xbd, 0xb3, 0x28, 0x00, 0x96, 0xc1, 0x1c, 0xab, 0x51, 0x3b, 0x97, 0x72,
+	0xf7, 0xe1, 0xad, 0x33, 0xc0, 0xa5, 0x0c, 0xeb, 0xb4, 0x73, 0xde, 0x9a, 0x55, 0x1f, 0x2e, 0xbd,
+	0x48, 0x80, 0x74, 0xb0, 0x17, 0x7c, 0x1b, 0x24, 0x8f, 0x91, 0x4d, 0x6f, 0xa7, 0x52, 0x61, 0x82,
+	0xbb, 0xb0, 0x6c, 0x11, 0xd5, 0x81, 0xc2, 0x87, 0x60, 0x56, 0x3f, 0xb0, 0x89, 0x6e, 0xb0, 0x7b,
+	0x6c, 0x62, 0x16, 0x0f, 0x0e, 0xbf, 0x05, 0x12, 0x16, 0xce, 0x25, 0xdf, 0x88, 0x24, 0x61, 0x61,
+	0xd8, 0x02, 0x0b, 0x16, 0xd6, 0x3e, 0x34, 0xc8, 0xa1, 0xf6, 0x1c, 0x11, 0xec, 0xf6, 0xd0, 0xf9,
+	0x92, 0x3c, 0x19, 0xd3, 0xe9, 0x50, 0xc8, 0xd2, 0x42, 0x08, 0x72, 0x49, 0x2a, 0xb0, 0xf0, 0x13,
+	0x83, 0x1c, 0xee, 0x23, 0x82, 0x59, 0x71, 0x7d, 0x92, 0x00, 0x29, 0xe7, 0x81, 0xf1, 0xe6, 0x15,
+	0xf5, 0x00, 0x4c, 0x3f, 0xc7, 0x04, 0xfd, 0x0f, 0x57, 0x31, 0xc5, 0xc3, 0xbb, 0xfe, 0x63, 0x28,
+	0x79, 0x91, 0xc7, 0x50, 0x29, 0x91, 0xe3, 0xfc, 0x07, 0xd1, 0x7d, 0x30, 0x4b, 0x3f, 0x79, 0xb5,
+	0xf5, 0x6e, 0x1c, 0x78, 0xfc, 0x05, 0x56, 0x4a, 0x39, 0xc6, 0xaa, 0x1e, 0x98, 0x99, 0xf2, 0x59,
+	0x1a, 0x2c, 0xb2, 0xb2, 0xab, 0xea, 0x5d, 0xdd, 0xb4, 0xe1, 0x2f, 0x39, 0x90, 0x36, 0x0d, 0xcb,
+	0xef, 0xd1, 0xdc, 0x79, 0x3d, 0x5a, 0x73, 0x78, 0x4f, 0x86, 0xc2, 0x6a, 0x00, 0x75, 0x03, 0x9b,
+	0x06, 0x41, 0x66, 0x87, 0x1c, 0x8f, 0x6c, 0x0d, 0x4c, 0x4f, 0xd6, 0xba, 0x81, 0x69, 0x58, 0x5e,
+	0xe3, 0xfe, 0x09, 0x07, 0xa0, 0xa9, 0x1f, 0x79, 0x44, 0x5a, 0x07, 0x75, 0x0d, 0xdc, 0x64, 0xcf,
+	0x83, 0x8d, 0xb1, 0x6e, 0xb7, 0xc3, 0xde, 0xa6, 0xb4, 0xaa, 0x4e, 0x86, 0xc2, 0xe5, 0x71, 0x70,
+	0x48, 0x2b, 0xbb, 0x98, 0xc7, 0xa3, 0xa4, 0x9f, 0x3b, 0x0d, 0x37, 0x63, 0xea, 0x47, 0x9e, 0x5d,
+	0xee, 0x30, 0xfc, 0x13, 0x07, 0x56, 0xfd, 0x8e, 0xa9, 0x05, 0x8d, 0x3b, 0xf7, 0x85, 0x65, 0x33,
+	0x4d, 0x42, 0x2c, 0x3e, 0x24, 0xeb, 0x32, 0x95, 0x15, 0x1b, 0x38, 0x99, 0x99, 0x59, 0x9f, 0x63,
+	0x77, 0xe4, 0x6a, 0x03, 0x38, 0x0b, 0xd3, 0xbc, 0x6e, 0xac, 0xb5, 0x91, 0xe5, 0x9e, 0xc6, 0x54,
+	0xe9, 0xce, 0xc9, 0x50, 0xe0, 0xa3, 0x73, 0x21, 0x69, 0xeb, 0x23, 0xc7, 0x82, 0x31, 0x92, 0xba,
+	0x64, 0xea, 0x47, 0xbb, 0x6c, 0xe4, 0x11, 0xb2, 0xe0, 0x2f, 0x38, 0xb0, 0xda, 0xd1, 0x6d, 0x1b,
+	0x35, 0x7d, 0x6b, 0x59, 0x83, 0x9d, 0xbe, 0x60, 0x83, 0x2d, 0x6d, 0x3b, 0x6e, 0xc5, 0x72, 0xc4,
+	0xb9, 0x15, 0x1b, 0x28, 0xa9, 0x59, 0x3a, 0x1e, 0x62, 0x86, 0xbf, 0xe6, 0xc0, 0x7a, 0x17, 0x7d,
+	0x1f, 0x35, 0xc8, 0xb8, 0xbc, 0x99, 0x8b, 0xca, 0x7b, 0x70, 0x32, 0x14, 0xae, 0xbe, 0x86, 0x25,
+	0x24, 0x30, 0x4f, 0x05, 0xbe, 0x26, 0x54, 0x52, 0x57, 0xbd, 0x99, 0xb0, 0x48, 0xc7, 0x41, 0xa7,
+	0xbb, 0x8d, 0x4b, 0x9c, 0x9d, 0xc8, 0xc1, 0x58, 0x8e, 0x38, 0x07, 0x63, 0x03, 0x25, 0x35, 0x4b,
+	0xc7, 0xc3, 0xe2, 0xfe, 0xc8, 0x81, 0x2b, 0x3f, 0xec, 0xe1, 0x6e, 0xcf, 0xd4, 0x2c, 0x4c, 0x9c,
+	0x5a, 0x88, 0x8a, 0x9c, 0xbb, 0xa8, 0xc8, 0xda, 0xc9, 0x50, 0xf8, 0xbf, 0x33, 0xb9, 0x42, 0x62,
+	0xdf, 0xa1, 0x62, 0xcf, 0x04, 0x48, 0xea, 0x06, 0x9d, 0xaf, 0x60, 0xb2, 0x8b, 0x48, 0x58, 0xfa,
+	0xa7, 0x1c, 0x58, 0x43, 0x47, 0x1d, 0xa3, 0x3b, 0x6e, 0xec, 0xfc, 0x45, 0x35, 0xcb, 0x27, 0x43,
+	0x41, 0x8c, 0x27, 0x09, 0x89, 0xbd, 0xe2, 0x9f, 0xe4, 0x98, 0x48, 0x49, 0x5d, 0x61, 0x13, 0x21,
+	0x72, 0xe9, 0x77, 0x09, 0xb0, 0xb0, 0xef, 0x3e, 0xf5, 0x58, 0x93, 0xfe, 0x11, 0x60, 0x4f, 0x3f,
+	0xaf, 0x01, 0x72, 0xe7, 0x35, 0xc0, 0x7b, 0xac, 0xd9, 0xac, 0x87, 0x70, 0x21, 0x69, 0x2b, 0xa1,
+	0x97, 0x66, 0xb0, 0xed, 0x2d, 0xd0, 0x31, 0xd6, 0xf2, 0x9c, 0xb3, 0x32, 0xea, 0x44, 0x61, 0x1d,
+	0xe7, 0x36, 0x62, 0x85, 0xe9, 0xb8, 0xfa, 0x1a, 0x86, 0xb8, 0x73, 0xf2, 0x9a, 0x50, 0xaa, 0x6d,
+	0xd4, 0x7d, 0xf7, 0x03, 0x22, 0xa5, 0x9f, 0x4d, 0xb3, 0x87, 0x13, 0x73, 0xec, 0x03, 0x30, 0x43,
+	0x0b, 0xc0, 0xb5, 0x6a, 0xa1, 0x54, 0x9a, 0xec, 0x77, 0x84, 0x93, 0xa1, 0x90, 0xa1, 0xf8, 0x91,
+	0x40, 0x95, 0x31, 0xc2, 0x06, 0x98, 0x27, 0x87, 0x5d, 0x64, 0x1f, 0xe2, 0x76, 0x93, 0xbd, 0x0d,
+	0xe4, 0x89, 0xe9, 0xb3, 0x3e, 0x45, 0x20, 0xc3, 0x88, 0x17, 0xf6, 0x39, 0xb0, 0xe4, 0x9c, 0x3b,
+	0x6d, 0x94, 0x2a, 0xe9, 0xa6, 0x6a, 0x4c, 0x9c, 0x2a, 0x17, 0xe6, 0x09, 0x59, 0xbe, 0x3a, 0x3a,
+	0xf9, 0xa3, 0x08, 0x49, 0x5d, 0x74, 0x06, 0xea, 0xbe, 0x98, 0x4f, 0x38, 0x90, 0x19, 0xed, 0x0a,
+	0x33, 0x36, 0xe5, 0xca, 0x69, 0x4d, 0x2c, 0x87, 0x8f, 0x32, 0xc5, 0xdd, 0x2f, 0xd1, 0x18, 0x49,
+	0x5d, 0xf6, 0x87, 0x1e, 0xd3, 0x6d, 0xf8, 0x94, 0x03, 0xa3, 0xdb, 0x2d, 0x60, 0xd3, 0xb4, 0xab,
+	0xcb, 0x9c, 0x58, 0xd7, 0x95, 0x18, 0xb2, 0x90, 0x34, 0x3e, 0x2a, 0x2d, 0x60, 0x18, 0xf4, 0x47,
+	0x7d, 0xd7, 0xae, 0xff, 0x93, 0x03, 0x20, 0xf0, 0x93, 0xd8, 0x0d, 0xb0, 0xbe, 0xaf, 0xd4, 0x65,
+	0x4d, 0xa9, 0xd6, 0xcb, 0x4a, 0x45, 0xdb, 0xab, 0xd4, 0xaa, 0xf2, 0x76, 0xf9, 0x7e, 0x59, 0xde,
+	0xc9, 0x4c, 0xf1, 0xcb, 0xfd, 0x81, 0x98, 0xa6, 0x81, 0xb2, 0x93, 0x0e, 0x4a, 0x60, 0x39, 0x18,
+	0xfd, 0x54, 0xae, 0x65, 0x38, 0x7e, 0xb1, 0x3f, 0x10, 0xe7, 0x69, 0xd4, 0x53, 0x64, 0xc3, 0xeb,
+	0x20, 0x1b, 0x8c, 0x29, 0x96, 0x6a, 0xf5, 0x62, 0xb9, 0x92, 0x49, 0xf0, 0x97, 0xfa, 0x03, 0x71,
+	0x91, 0xc6, 0x15, 0xd9, 0xeb, 0x5d, 0x04, 0x4b, 0xc1, 0xd8, 0x8a, 0x92, 0x49, 0xf2, 0x0b, 0xfd,
+	0x81, 0x38, 0x47, 0xc3, 0x2a, 0x18, 0x6e, 0x81, 0x5c, 0x38, 0x42, 0x7b, 0x52, 0xae, 0x3f, 0xd4,
+	0xf6, 0xe5, 0xba, 0x92, 0x49, 0xf1, 0x2b, 0xfd, 0x81, 0x98, 0xf1, 0x62, 0xbd, 0xa7, 0x36, 0x9f,
+	0xfa, 0xe8, 0xb3, 0xfc, 0xd4, 0xf5, 0xbf, 0x24, 0xc0, 0x52, 0xf8, 0xa7, 0x15, 0x58, 0x00, 0x6f,
+	0x55, 0x55, 0xa5, 0xaa, 0xd4, 0x8a, 0x8f, 0xb4, 0x5a, 0xbd, 0x58, 0xdf, 0xab, 0x45, 0x16, 0xec,
+	0x2e, 0x85, 0x06, 0x57, 0x8c, 0x36, 0xbc, 0x07, 0xf2, 0xd1, 0xf8, 0x1d, 0xb9, 0xaa, 0xd4, 0xca,
+	0x75, 0xad, 0x2a, 0xab, 0x65, 0x65, 0x27, 0xc3, 0xf1, 0xeb, 0xfd, 0x81, 0x98, 0xa5, 0x90, 0xf0,
+	0xa3, 0xec, 0x0e, 0xb8, 0x12, 0x05, 0xef, 0x2b, 0xf5, 0x72, 0xe5, 0x81, 0x87, 0x4d, 0xf0, 0x6b,
+	0xfd, 0x81, 0x08, 0x29, 0x36, 0xd8, 0x37, 0xe0, 0x0d, 0xb0, 0x16, 0x85, 0x56, 0x8b, 0xb5, 0x9a,
+	0xbc, 0x93, 0x49, 0xf2, 0x99, 0xfe, 0x40, 0x5c, 0xa0, 0x98, 0xaa, 0xfb, 0x86, 0x80, 0xb7, 0x40,
+	0x2e, 0x1a, 0xad, 0xca, 0xdf, 0x91, 0xb7, 0xeb, 0xf2, 0x4e, 0x26, 0xc5, 0xc3, 0xfe, 0x40, 0x5c,
+	0xa2, 0xf1, 0x2a, 0xbb, 0xd0, 0xe3, 0xf8, 0xef, 0x17, 0xcb, 0x8f, 0xe4, 0x9d, 0xcc, 0x74, 0x90,
+	0xff, 0xbe, 0x6e, 0xb4, 0x51, 0x93, 0xd9, 0xf9, 0xf7, 0x04, 0x58, 0x8e, 0xfc, 0x9d, 0x0a, 0x6f,
+	0x81, 0xcb, 0x3e, 0x8f, 0xb2, 0x57, 0xdf, 0x56, 0x76, 0xe5, 0x88, 0xa1, 0x4b, 0xfd, 0x81, 0x08,
+	0x58, 0xb8, 0xe3, 0x68, 0x01, 0xac, 0x8f, 0x21, 0xd8, 0xd2, 0x38, 0x56, 0x20, 0x34, 0x98, 0xad,
+	0x6d, 0x0b, 0x6c, 0x8c, 0xc5, 0xfb, 0x8b, 0x4b, 0xf0, 0xd9, 0xfe, 0x40, 0x5c, 0x66, 0x08, 0x7f,
+	0x75, 0x71, 0x39, 0x9c, 0x7a, 0x71, 0xed, 0x0b, 0xe6, 0xd8, 0x77, 0x5f, 0x10, 0xf0, 0x1b, 0x40,
+	0x18, 0x8b, 0x7f, 0xbc, 0xa7, 0xa8, 0x7b, 0xbb, 0x5a, 0x45, 0xa9, 0x6b, 0xbb, 0x72, 0x3d, 0x93,
+	0xa2, 0xdb, 0xcc, 0x70, 0x8f, 0x03, 0x97, 0x38, 0x7c, 0x1f, 0x88, 0x63, 0x68, 0xaf, 0x48, 0xe4,
+	0xef, 0x56, 0xcb, 0xaa, 0xeb, 0xea, 0x46, 0x7f, 0x20, 0xae, 0x32, 0x38, 0x2b, 0x13, 0x99, 0x5e,
+	0xb2, 0xcc, 0xde, 0x53, 0x0e, 0x2c, 0x86, 0xee, 0x5b, 0xf8, 0x1e, 0xe0, 0x3d, 0x9e, 0xe2, 0x76,
+	0xcc, 0xe1, 0x74, 0x6b, 0x3f, 0x04, 0x71, 0x0c, 0xde, 0x02, 0xab, 0x11, 0x94, 0x2a, 0xdf, 0xdf,
+	0xab, 0xf8, 0x95, 0x1a, 0x02, 0xa8, 0xe8, 0x59, 0xcf, 0x72, 0x0c, 0xcb, 0x46, 0x30, 0xa5, 0x3d,
+	0xd5, 0x39, 0xb1, 0xab, 0xfd, 0x81, 0x78, 0x29, 0xfc, 0xc4, 0xe8, 0x75, 0x2d, 0x58, 0x04, 0x57,
+	0x22, 0xf1, 0xdb, 0xca, 0xee, 0xee, 0x5e, 0xa5, 0x5c, 0x7f, 0xaa, 0x55, 0x15, 0xe5, 0x51, 0x26,
+	0xc9, 0xe7, 0xfb, 0x03, 0x91, 0x0f, 0x21, 0xb7, 0xb1, 0x69, 0xf6, 0x2c, 0x83, 0x1c, 0x57, 0x31,
+	0x6e, 0xd3, 0x45, 0x97, 0x2a, 0x2f, 0xbe, 0xca, 0x4f, 0x7d, 0xf1, 0x55, 0x7e, 0xea, 0xc7, 0x2f,
+	0xf3, 0x53, 0x2f, 0x5e, 0xe6, 0xb9, 0xcf, 0x5f, 0xe6, 0xb9, 0x7f, 0xbc, 0xcc, 0x73, 0x1f, 0xbf,
+	0xca, 0x4f, 0x7d, 0xfe, 0x2a, 0x3f, 0xf5, 0xc5, 0xab, 0xfc, 0xd4, 0x07, 0x67, 0xff, 0x5d, 0x71,
+	0xe4, 0xfe, 0x1f, 0x86, 0xdb, 0x32, 0x0f, 0x66, 0xdc, 0xab, 0xfe, 0xff, 0xff, 0x3b, 0x00, 0x63,
+	0x25, 0x1d, 0xbc, 0xde, 0x18, 0x00, 0x00,
+}
+
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *TextProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -659,6 +936,23 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
+	if !bytes.Equal(this.Proposer, that1.Proposer) {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -722,8 +1016,54 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -830,6 +1170,44 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -911,6 +1289,58 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SettledDeposits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettledDeposits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettledDeposits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -994,6 +1424,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1034,6 +1478,50 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiredDepositAction != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExpiredDepositAction))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.QuorumNotMetDepositAction != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumNotMetDepositAction))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VetoedDepositAction != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VetoedDepositAction))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RejectedDepositAction != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.RejectedDepositAction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PassedDepositAction != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PassedDepositAction))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMetadataLen != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxMetadataLen))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
 	if err7 != nil {
 		return 0, err7
@@ -1079,13 +1567,21 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err8 != nil {
 		return 0, err8
 	}
 	i -= n8
 	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1111,17 +1607,37 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.VetoThreshold.Size()
+		size := m.ExpeditedThreshold.Size()
 		i -= size
-		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x2a
 	{
-		size := m.Threshold.Size()
+		size := m.ExpeditedQuorum.Size()
+		i -= size
+		if _, err := m.ExpeditedQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Threshold.Size()
 		i -= size
 		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
@@ -1154,6 +1670,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1225,6 +1755,47 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *SettledDeposits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovGov(uint64(m.Outcome))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGov(uint64(m.Action))
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1261,6 +1832,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1278,6 +1855,30 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxMetadataLen != 0 {
+		n += 1 + sovGov(uint64(m.MaxMetadataLen))
+	}
+	if m.PassedDepositAction != 0 {
+		n += 1 + sovGov(uint64(m.PassedDepositAction))
+	}
+	if m.RejectedDepositAction != 0 {
+		n += 1 + sovGov(uint64(m.RejectedDepositAction))
+	}
+	if m.VetoedDepositAction != 0 {
+		n += 1 + sovGov(uint64(m.VetoedDepositAction))
+	}
+	if m.QuorumNotMetDepositAction != 0 {
+		n += 1 + sovGov(uint64(m.QuorumNotMetDepositAction))
+	}
+	if m.ExpiredDepositAction != 0 {
+		n += 1 + sovGov(uint64(m.ExpiredDepositAction))
+	}
 	return n
 }
 
@@ -1289,6 +1890,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1304,6 +1907,10 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedQuorum.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1313,6 +1920,112 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1872,64 +2585,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1939,31 +2599,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1973,18 +2633,335 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettledDeposits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettledDeposits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettledDeposits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ProposalOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -2186,6 +3163,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2306,6 +3317,154 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLen", wireType)
+			}
+			m.MaxMetadataLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassedDepositAction", wireType)
+			}
+			m.PassedDepositAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PassedDepositAction |= DepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedDepositAction", wireType)
+			}
+			m.RejectedDepositAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedDepositAction |= DepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoedDepositAction", wireType)
+			}
+			m.VetoedDepositAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoedDepositAction |= DepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNotMetDepositAction", wireType)
+			}
+			m.QuorumNotMetDepositAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNotMetDepositAction |= DepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredDepositAction", wireType)
+			}
+			m.ExpiredDepositAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredDepositAction |= DepositAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2392,6 +3551,39 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2544,6 +3736,72 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedQuorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
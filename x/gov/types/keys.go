@@ -35,6 +35,8 @@ const (
 //
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
+// - 0x11<proposalID_Bytes>: SettledDeposits
+//
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
@@ -42,7 +44,8 @@ var (
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
 
-	DepositsKeyPrefix = []byte{0x10}
+	DepositsKeyPrefix        = []byte{0x10}
+	SettledDepositsKeyPrefix = []byte{0x11}
 
 	VotesKeyPrefix = []byte{0x20}
 )
@@ -96,6 +99,11 @@ func DepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
 	return append(DepositsKey(proposalID), depositorAddr.Bytes()...)
 }
 
+// SettledDepositsKey gets the key of the settled deposits of a proposal
+func SettledDepositsKey(proposalID uint64) []byte {
+	return append(SettledDepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
 // VotesKey gets the first part of the votes key based on the proposalID
 func VotesKey(proposalID uint64) []byte {
 	return append(VotesKeyPrefix, GetProposalIDBytes(proposalID)...)
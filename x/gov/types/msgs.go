@@ -16,13 +16,14 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
 var (
-	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}
-	_       MsgSubmitProposalI            = &MsgSubmitProposal{}
-	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_          MsgSubmitProposalI            = &MsgSubmitProposal{}
+	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
@@ -66,6 +67,17 @@ func (m *MsgSubmitProposal) GetContent() Content {
 	return content
 }
 
+// GetMessages returns the cached sdk.Msgs executed when the proposal passes.
+func (m *MsgSubmitProposal) GetMessages() ([]sdk.Msg, error) {
+	return unpackMsgs(m.Messages)
+}
+
+// GetExpedited returns whether the proposal is submitted as an expedited proposal.
+func (m *MsgSubmitProposal) GetExpedited() bool { return m.Expedited }
+
+// GetMetadata returns the metadata of the proposal.
+func (m *MsgSubmitProposal) GetMetadata() string { return m.Metadata }
+
 func (m *MsgSubmitProposal) SetInitialDeposit(coins sdk.Coins) {
 	m.InitialDeposit = coins
 }
@@ -87,6 +99,26 @@ func (m *MsgSubmitProposal) SetContent(content Content) error {
 	return nil
 }
 
+// SetExpedited sets whether the proposal is submitted as an expedited proposal.
+func (m *MsgSubmitProposal) SetExpedited(expedited bool) {
+	m.Expedited = expedited
+}
+
+// SetMetadata sets the metadata of the proposal.
+func (m *MsgSubmitProposal) SetMetadata(metadata string) {
+	m.Metadata = metadata
+}
+
+// SetMessages sets the sdk.Msgs executed when the proposal passes.
+func (m *MsgSubmitProposal) SetMessages(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
 // Route implements Msg
 func (m MsgSubmitProposal) Route() string { return RouterKey }
 
@@ -116,6 +148,16 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return err
 	}
 
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d: %s", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -139,7 +181,11 @@ func (m MsgSubmitProposal) String() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+		return err
+	}
+
+	return unpackMsgsInterfaces(unpacker, m.Messages)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -224,3 +270,92 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote on an active proposal,
+// splitting the voting power between the given options
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return WeightedVoteOptions(msg.Options).ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// packMsgs packs the given sdk.Msgs into Anys.
+func packMsgs(msgs []sdk.Msg) ([]*types.Any, error) {
+	// keep no messages as nil, as they are decoded from the store
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		protoMsg, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("can't proto marshal %T", msg)
+		}
+
+		any, err := types.NewAnyWithValue(protoMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// unpackMsgs returns the sdk.Msgs cached in the given Anys.
+func unpackMsgs(anys []*types.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d contains %T which is not a sdk.Msg", i, any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// unpackMsgsInterfaces unpacks the sdk.Msgs of the given Anys.
+func unpackMsgsInterfaces(unpacker types.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -59,6 +59,34 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposalMessages(t *testing.T) {
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{nil, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes)}, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes), NewMsgDeposit(addrs[0], 1, coinsPos)}, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes), NewMsgDeposit(sdk.AccAddress{}, 1, coinsPos)}, false},
+	}
+
+	for i, tc := range tests {
+		msg, err := NewMsgSubmitProposal(NewTextProposal("Test Proposal", "the purpose of this proposal is to test"), coinsPos, addrs[0])
+		require.NoError(t, err)
+		require.NoError(t, msg.SetMessages(tc.msgs))
+
+		msgs, err := msg.GetMessages()
+		require.NoError(t, err)
+		require.Len(t, msgs, len(tc.msgs))
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.True(t, ErrInvalidProposalMsg.Is(msg.ValidateBasic()), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -118,6 +146,52 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(2, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.ZeroDec())}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
@@ -12,15 +12,22 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
+// DefaultMaxMetadataLen is the default maximum length of the metadata of a proposal
+const DefaultMaxMetadataLen uint64 = 255
+
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultExpeditedMinDepositTokens = sdk.TokensFromConsensusPower(50)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedQuorum           = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
 )
 
 // Parameter store key
@@ -39,11 +46,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	)
 }
 
+// Default actions taken on the deposits of a proposal for each outcome
+const (
+	DefaultPassedDepositAction       = DepositActionRefund
+	DefaultRejectedDepositAction     = DepositActionRefund
+	DefaultVetoedDepositAction       = DepositActionBurn
+	DefaultQuorumNotMetDepositAction = DepositActionBurn
+	DefaultExpiredDepositAction      = DepositActionBurn
+)
+
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins, maxMetadataLen uint64,
+	passedAction, rejectedAction, vetoedAction, quorumNotMetAction, expiredAction DepositAction,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:                minDeposit,
+		MaxDepositPeriod:          maxDepositPeriod,
+		ExpeditedMinDeposit:       expeditedMinDeposit,
+		MaxMetadataLen:            maxMetadataLen,
+		PassedDepositAction:       passedAction,
+		RejectedDepositAction:     rejectedAction,
+		VetoedDepositAction:       vetoedAction,
+		QuorumNotMetDepositAction: quorumNotMetAction,
+		ExpiredDepositAction:      expiredAction,
 	}
 }
 
@@ -52,9 +78,44 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
+		DefaultMaxMetadataLen,
+		DefaultPassedDepositAction,
+		DefaultRejectedDepositAction,
+		DefaultVetoedDepositAction,
+		DefaultQuorumNotMetDepositAction,
+		DefaultExpiredDepositAction,
 	)
 }
 
+// GetMinDeposit returns the minimum deposit for a proposal to enter its voting
+// period, which depends on whether the proposal is expedited.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
+// GetDepositAction returns the action taken on the deposits of a proposal
+// with the given outcome.
+func (dp DepositParams) GetDepositAction(outcome ProposalOutcome) DepositAction {
+	switch outcome {
+	case OutcomePassed:
+		return dp.PassedDepositAction
+	case OutcomeRejected:
+		return dp.RejectedDepositAction
+	case OutcomeVetoed:
+		return dp.VetoedDepositAction
+	case OutcomeQuorumNotMet:
+		return dp.QuorumNotMetDepositAction
+	case OutcomeDepositExpired:
+		return dp.ExpiredDepositAction
+	default:
+		return DepositActionNil
+	}
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -63,7 +124,11 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) && dp.MaxMetadataLen == dp2.MaxMetadataLen &&
+		dp.PassedDepositAction == dp2.PassedDepositAction && dp.RejectedDepositAction == dp2.RejectedDepositAction &&
+		dp.VetoedDepositAction == dp2.VetoedDepositAction && dp.QuorumNotMetDepositAction == dp2.QuorumNotMetDepositAction &&
+		dp.ExpiredDepositAction == dp2.ExpiredDepositAction
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +143,59 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !v.ExpeditedMinDeposit.IsAllGTE(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit %s must be greater than or equal to the minimum deposit %s", v.ExpeditedMinDeposit, v.MinDeposit)
+	}
+	for _, outcome := range []ProposalOutcome{OutcomePassed, OutcomeRejected, OutcomeVetoed, OutcomeQuorumNotMet, OutcomeDepositExpired} {
+		if action := v.GetDepositAction(outcome); !ValidDepositAction(action) {
+			return fmt.Errorf("invalid deposit action for outcome %s: %s", outcome, action)
+		}
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedQuorum, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum,
-		Threshold:     threshold,
-		VetoThreshold: vetoThreshold,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		VetoThreshold:      vetoThreshold,
+		ExpeditedQuorum:    expeditedQuorum,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedQuorum, DefaultExpeditedThreshold)
+}
+
+// GetQuorum returns the quorum of a proposal, which depends on whether the
+// proposal is expedited.
+func (tp TallyParams) GetQuorum(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedQuorum
+	}
+	return tp.Quorum
+}
+
+// GetThreshold returns the threshold of a proposal, which depends on whether
+// the proposal is expedited.
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExpeditedQuorum.Equal(other.ExpeditedQuorum) && tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
 }
 
 // String implements stringer insterface
@@ -131,25 +228,47 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedQuorum.LT(v.Quorum) {
+		return fmt.Errorf("expedited quorum cannot be less than the quorum: %s", v)
+	}
+	if v.ExpeditedQuorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited quorum too large: %s", v)
+	}
+	if v.ExpeditedThreshold.LTE(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", v)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
+}
+
+// GetVotingPeriod returns the voting period of a proposal, which depends on
+// whether the proposal is expedited.
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -167,6 +286,12 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
 	return nil
 }
@@ -16,8 +16,12 @@ import (
 // DefaultStartingProposalID is 1
 const DefaultStartingProposalID uint64 = 1
 
-// NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+// NewProposal creates a new Proposal instance. The given messages are executed
+// after the content when the proposal passes.
+func NewProposal(
+	content Content, messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time,
+	metadata string, proposer sdk.AccAddress, expedited bool,
+) (Proposal, error) {
 	p := Proposal{
 		ProposalId:       id,
 		Status:           StatusDepositPeriod,
@@ -25,6 +29,9 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		Expedited:        expedited,
+		Proposer:         proposer,
+		Metadata:         metadata,
 	}
 
 	msg, ok := content.(proto.Message)
@@ -39,6 +46,11 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 
 	p.Content = any
 
+	p.Messages, err = packMsgs(messages)
+	if err != nil {
+		return Proposal{}, err
+	}
+
 	return p, nil
 }
 
@@ -57,6 +69,11 @@ func (p Proposal) GetContent() Content {
 	return content
 }
 
+// GetMessages returns the sdk.Msgs executed when the proposal passes.
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return unpackMsgs(p.Messages)
+}
+
 func (p Proposal) ProposalType() string {
 	content := p.GetContent()
 	if content == nil {
@@ -84,7 +101,11 @@ func (p Proposal) GetTitle() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+		return err
+	}
+
+	return unpackMsgsInterfaces(unpacker, p.Messages)
 }
 
 // Proposals is an array of proposal
@@ -87,16 +87,18 @@ type QueryProposalsParams struct {
 	Limit          int
 	Voter          sdk.AccAddress
 	Depositor      sdk.AccAddress
+	Proposer       sdk.AccAddress
 	ProposalStatus ProposalStatus
 }
 
 // NewQueryProposalsParams creates a new instance of QueryProposalsParams
-func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depositor sdk.AccAddress) QueryProposalsParams {
+func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depositor, proposer sdk.AccAddress) QueryProposalsParams {
 	return QueryProposalsParams{
 		Page:           page,
 		Limit:          limit,
 		Voter:          voter,
 		Depositor:      depositor,
+		Proposer:       proposer,
 		ProposalStatus: status,
 	}
 }
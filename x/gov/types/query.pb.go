@@ -132,6 +132,8 @@ type QueryProposalsRequest struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// proposer defines the proposer address for the proposals.
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 }
 
 func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
@@ -195,6 +197,13 @@ func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryProposalsRequest) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
 // QueryProposalsResponse is the response type for the Query/Proposals RPC method.
 type QueryProposalsResponse struct {
 	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
@@ -872,6 +881,99 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QuerySettledDepositsRequest is the request type for the Query/SettledDeposits RPC method.
+type QuerySettledDepositsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QuerySettledDepositsRequest) Reset()         { *m = QuerySettledDepositsRequest{} }
+func (m *QuerySettledDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledDepositsRequest) ProtoMessage()    {}
+func (*QuerySettledDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{16}
+}
+func (m *QuerySettledDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledDepositsRequest.Merge(m, src)
+}
+func (m *QuerySettledDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledDepositsRequest proto.InternalMessageInfo
+
+func (m *QuerySettledDepositsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QuerySettledDepositsResponse is the response type for the Query/SettledDeposits RPC method.
+type QuerySettledDepositsResponse struct {
+	// settled_deposits records the outcome of the proposal and the action taken
+	// on its deposits.
+	SettledDeposits SettledDeposits `protobuf:"bytes,1,opt,name=settled_deposits,json=settledDeposits,proto3" json:"settled_deposits"`
+}
+
+func (m *QuerySettledDepositsResponse) Reset()         { *m = QuerySettledDepositsResponse{} }
+func (m *QuerySettledDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledDepositsResponse) ProtoMessage()    {}
+func (*QuerySettledDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{17}
+}
+func (m *QuerySettledDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledDepositsResponse.Merge(m, src)
+}
+func (m *QuerySettledDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledDepositsResponse proto.InternalMessageInfo
+
+func (m *QuerySettledDepositsResponse) GetSettledDeposits() SettledDeposits {
+	if m != nil {
+		return m.SettledDeposits
+	}
+	return SettledDeposits{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1beta1.QueryProposalResponse")
@@ -889,73 +991,81 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta1.QueryTallyResultResponse")
+	proto.RegisterType((*QuerySettledDepositsRequest)(nil), "cosmos.gov.v1beta1.QuerySettledDepositsRequest")
+	proto.RegisterType((*QuerySettledDepositsResponse)(nil), "cosmos.gov.v1beta1.QuerySettledDepositsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xce, 0x73, 0x9c, 0x36, 0x9e, 0xa4, 0x01, 0x86, 0x02, 0x96, 0x29, 0x76, 0x58, 0xd1, 0xd6,
-	0xa4, 0xc4, 0x4b, 0x92, 0x02, 0x2a, 0x05, 0xd4, 0x58, 0xa8, 0x29, 0xaa, 0x04, 0xc5, 0xad, 0x40,
-	0xe2, 0x12, 0x6d, 0xe2, 0xd5, 0x62, 0xe1, 0xf8, 0x6d, 0xfd, 0x9e, 0x2d, 0xa2, 0x34, 0x42, 0xe2,
-	0x82, 0x10, 0x17, 0x50, 0x11, 0x37, 0xc4, 0xa1, 0x12, 0x3f, 0x05, 0xf5, 0x58, 0x09, 0x0e, 0x9c,
-	0x2a, 0x94, 0xf0, 0x2b, 0x10, 0x07, 0xb4, 0xb3, 0xf3, 0xd6, 0xbb, 0xc9, 0xda, 0xeb, 0xa4, 0x11,
-	0xa7, 0x38, 0xef, 0xcd, 0x37, 0xf3, 0xcd, 0x37, 0xf3, 0x66, 0x6c, 0x28, 0x6f, 0x4a, 0xb5, 0x25,
-	0x95, 0xed, 0xc9, 0xbe, 0xdd, 0x5f, 0xda, 0x70, 0xb5, 0xb3, 0x64, 0xdf, 0xed, 0xb9, 0xdd, 0xed,
-	0x9a, 0xdf, 0x95, 0x5a, 0x22, 0x86, 0xf7, 0x35, 0x4f, 0xf6, 0x6b, 0x7c, 0x5f, 0x5a, 0x60, 0xcc,
-	0x86, 0xa3, 0xdc, 0xd0, 0x38, 0x82, 0xfa, 0x8e, 0xd7, 0xea, 0x38, 0xba, 0x25, 0x3b, 0x21, 0xbe,
-	0x74, 0xd6, 0x93, 0x9e, 0xa4, 0x8f, 0x76, 0xf0, 0x89, 0x4f, 0xcf, 0x79, 0x52, 0x7a, 0x6d, 0xd7,
-	0x76, 0xfc, 0x96, 0xed, 0x74, 0x3a, 0x52, 0x13, 0x44, 0x99, 0xdb, 0x14, 0x4e, 0x41, 0x7c, 0xba,
-	0xb5, 0xde, 0x82, 0xb3, 0x1f, 0x07, 0x31, 0x6f, 0x75, 0xa5, 0x2f, 0x95, 0xd3, 0x6e, 0xb8, 0x77,
-	0x7b, 0xae, 0xd2, 0x58, 0x81, 0x19, 0x9f, 0x8f, 0xd6, 0x5b, 0xcd, 0xa2, 0x98, 0x17, 0xd5, 0x7c,
-	0x03, 0xcc, 0xd1, 0x07, 0x4d, 0xeb, 0x53, 0x78, 0xee, 0x00, 0x50, 0xf9, 0xb2, 0xa3, 0x5c, 0x7c,
-	0x0f, 0xa6, 0x8d, 0x19, 0xc1, 0x66, 0x96, 0xcf, 0xd5, 0x0e, 0xa7, 0x5d, 0x33, 0xb8, 0x7a, 0xfe,
-	0xe1, 0xe3, 0xca, 0x44, 0x23, 0xc2, 0x58, 0xbf, 0xe5, 0x0e, 0x78, 0x56, 0x86, 0xd3, 0x4d, 0x78,
-	0x2a, 0xe2, 0xa4, 0xb4, 0xa3, 0x7b, 0x8a, 0x02, 0xcc, 0x2d, 0x5b, 0xa3, 0x02, 0xdc, 0x26, 0xcb,
-	0xc6, 0x9c, 0x9f, 0xf8, 0x1f, 0xd7, 0x60, 0xaa, 0x2f, 0xb5, 0xdb, 0x2d, 0xe6, 0xe6, 0x45, 0x75,
-	0xb6, 0xbe, 0xf4, 0xcf, 0xe3, 0xca, 0xa2, 0xd7, 0xd2, 0x9f, 0xf7, 0x36, 0x6a, 0x9b, 0x72, 0xcb,
-	0x66, 0xd1, 0xc2, 0x3f, 0x8b, 0xaa, 0xf9, 0x85, 0xad, 0xb7, 0x7d, 0x57, 0xd5, 0x56, 0x37, 0x37,
-	0x57, 0x9b, 0xcd, 0xae, 0xab, 0x54, 0x23, 0xc4, 0xe3, 0x47, 0x50, 0x68, 0xba, 0xbe, 0x54, 0x2d,
-	0x2d, 0xbb, 0xc5, 0xc9, 0xe3, 0x3a, 0x1b, 0xf8, 0xc0, 0xeb, 0x00, 0x83, 0xc2, 0x17, 0xf3, 0x24,
-	0xe1, 0x05, 0x93, 0x61, 0xd0, 0x25, 0xb5, 0xb0, 0xa5, 0xa2, 0x44, 0x1d, 0xcf, 0x65, 0x89, 0x1a,
-	0x31, 0xa4, 0xf5, 0x40, 0xc0, 0xf3, 0x07, 0x85, 0xe4, 0x1a, 0x5d, 0x83, 0x82, 0x91, 0x23, 0xd0,
-	0x70, 0x72, 0xcc, 0x22, 0x0d, 0x40, 0xb8, 0x96, 0x20, 0x99, 0x23, 0x92, 0x17, 0x33, 0x49, 0x86,
-	0xe1, 0x13, 0x2c, 0xef, 0xc1, 0xd3, 0x44, 0xf2, 0x13, 0xa9, 0xdd, 0x71, 0x9b, 0xef, 0xc4, 0x8a,
-	0x67, 0xad, 0xc1, 0x33, 0xb1, 0xe8, 0xac, 0xce, 0x32, 0xe4, 0x83, 0x5b, 0xee, 0xde, 0x62, 0x9a,
-	0x30, 0x81, 0x3d, 0x8b, 0x42, 0xb6, 0xd6, 0xbd, 0x98, 0x23, 0x35, 0x76, 0x1e, 0xd7, 0x53, 0x54,
-	0x3c, 0x4e, 0xa9, 0xef, 0x0b, 0xc0, 0x78, 0x78, 0x4e, 0xe4, 0x72, 0x28, 0x93, 0x29, 0x71, 0x56,
-	0x26, 0xa1, 0xf1, 0xc9, 0x95, 0xf6, 0x0d, 0x26, 0x75, 0xcb, 0xe9, 0x3a, 0x5b, 0x09, 0x51, 0xe8,
-	0x60, 0x3d, 0x28, 0x0a, 0x89, 0x52, 0x08, 0x60, 0xc1, 0xd1, 0x9d, 0x6d, 0xdf, 0xb5, 0xfe, 0x15,
-	0xf0, 0x6c, 0x02, 0xc7, 0xd9, 0xdc, 0x84, 0x33, 0x7d, 0xa9, 0x5b, 0x1d, 0x6f, 0x3d, 0x34, 0xe6,
-	0xfa, 0xcc, 0x0f, 0xc9, 0xaa, 0xd5, 0xf1, 0x42, 0x07, 0x9c, 0xdd, 0x6c, 0x3f, 0x76, 0x86, 0x1f,
-	0xc2, 0x1c, 0xbf, 0x38, 0xe3, 0x2d, 0x4c, 0xf4, 0xe5, 0x34, 0x6f, 0xef, 0x87, 0x96, 0x09, 0x77,
-	0x67, 0x9a, 0xf1, 0x43, 0xbc, 0x01, 0xb3, 0xda, 0x69, 0xb7, 0xb7, 0x8d, 0xb7, 0x49, 0xf2, 0x56,
-	0x49, 0xf3, 0x76, 0x27, 0xb0, 0x4b, 0xf8, 0x9a, 0xd1, 0x83, 0x23, 0xeb, 0x1b, 0x93, 0x3e, 0x47,
-	0x1d, 0xbb, 0x99, 0x12, 0x83, 0x28, 0xf7, 0xe4, 0x83, 0xc8, 0xba, 0xcd, 0xbb, 0x21, 0x22, 0xc2,
-	0x85, 0xb8, 0x0a, 0xa7, 0xd9, 0x88, 0x4b, 0xf0, 0xe2, 0x08, 0xd1, 0x38, 0x45, 0x83, 0xb0, 0xbe,
-	0x4a, 0x3a, 0xfd, 0xff, 0xdf, 0xca, 0x2f, 0x82, 0xf7, 0xcb, 0x80, 0x01, 0xe7, 0xf5, 0x2e, 0x4c,
-	0x33, 0x4b, 0xf3, 0x62, 0xc6, 0x48, 0x2c, 0x82, 0x9c, 0xdc, 0xbb, 0x79, 0x1b, 0x5e, 0x20, 0x82,
-	0xd4, 0x28, 0x0d, 0x57, 0xf5, 0xda, 0xfa, 0x08, 0x6b, 0xb9, 0x78, 0x18, 0x1b, 0xd5, 0x6d, 0x8a,
-	0x1a, 0x8d, 0xab, 0x36, 0xbc, 0x39, 0x43, 0x9c, 0x99, 0x0a, 0x84, 0x59, 0xfe, 0xa3, 0x00, 0x53,
-	0xe4, 0x19, 0x7f, 0x14, 0x30, 0x6d, 0x16, 0x03, 0x56, 0xd3, 0x9c, 0xa4, 0x7d, 0xa3, 0x28, 0xbd,
-	0x3a, 0x86, 0x65, 0x48, 0xd4, 0x5a, 0xf9, 0xfa, 0xf7, 0xbf, 0xef, 0xe7, 0x16, 0xf1, 0x92, 0x9d,
-	0xf2, 0xdd, 0x25, 0xda, 0x41, 0xf6, 0x4e, 0x4c, 0x8a, 0x5d, 0xfc, 0x56, 0x40, 0x21, 0xda, 0x74,
-	0x98, 0x1d, 0xcd, 0x74, 0x5e, 0x69, 0x61, 0x1c, 0x53, 0x66, 0x76, 0x9e, 0x98, 0x55, 0xf0, 0xa5,
-	0x91, 0xcc, 0xf0, 0x27, 0x01, 0xf9, 0x60, 0xb0, 0xe2, 0x2b, 0x43, 0x7d, 0xc7, 0xf6, 0x5d, 0xe9,
-	0x7c, 0x86, 0x15, 0x07, 0x5f, 0xa5, 0xe0, 0x57, 0xf1, 0xca, 0x11, 0x64, 0xb1, 0x69, 0xa6, 0xdb,
-	0x3b, 0xb4, 0xee, 0x76, 0xf1, 0x07, 0x01, 0x53, 0xb4, 0x23, 0x70, 0x74, 0xcc, 0x48, 0x9c, 0x0b,
-	0x59, 0x66, 0xcc, 0xed, 0x0a, 0x71, 0x5b, 0xc1, 0xa5, 0x23, 0x73, 0xc3, 0xef, 0x04, 0x9c, 0xe2,
-	0x29, 0x3a, 0x3c, 0x5a, 0x62, 0x87, 0x94, 0x2e, 0x66, 0xda, 0x31, 0xad, 0xd7, 0x89, 0xd6, 0x02,
-	0x56, 0x53, 0x69, 0x91, 0xad, 0xbd, 0x13, 0x5b, 0x47, 0xbb, 0xf8, 0xab, 0x80, 0xd3, 0xfc, 0xc2,
-	0x71, 0x78, 0x98, 0xe4, 0x6c, 0x2e, 0x55, 0xb3, 0x0d, 0x99, 0xd0, 0x0d, 0x22, 0x54, 0xc7, 0x6b,
-	0x47, 0xd1, 0xc9, 0x8c, 0x18, 0x7b, 0x27, 0x1a, 0xce, 0xbb, 0xf8, 0xb3, 0x80, 0x69, 0x33, 0xc2,
-	0x30, 0x93, 0x80, 0xca, 0x7e, 0x86, 0x07, 0xe7, 0xa1, 0xf5, 0x0e, 0x71, 0x7d, 0x13, 0x2f, 0x1f,
-	0x87, 0x2b, 0x3e, 0x10, 0x30, 0x13, 0x9b, 0x26, 0x78, 0x69, 0x68, 0xe0, 0xc3, 0x73, 0xae, 0xf4,
-	0xda, 0x78, 0xc6, 0x4f, 0xd2, 0x7c, 0x34, 0xd6, 0xea, 0xf5, 0x87, 0x7b, 0x65, 0xf1, 0x68, 0xaf,
-	0x2c, 0xfe, 0xda, 0x2b, 0x8b, 0xef, 0xf7, 0xcb, 0x13, 0x8f, 0xf6, 0xcb, 0x13, 0x7f, 0xee, 0x97,
-	0x27, 0x3e, 0xab, 0x8e, 0xdc, 0x9b, 0x5f, 0x52, 0x0c, 0xda, 0x9e, 0x1b, 0xa7, 0xe8, 0xa7, 0xd4,
-	0xca, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x87, 0x8d, 0x76, 0xb6, 0xfe, 0x0d, 0x00, 0x00,
+	// 1057 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x71, 0x9b, 0x3c, 0xa7, 0x49, 0x79, 0xa4, 0x60, 0x6d, 0x83, 0x1d, 0x16, 0xda,
+	0x9a, 0x94, 0x78, 0x9b, 0xa4, 0x80, 0x4a, 0x4b, 0xd4, 0x58, 0x55, 0x53, 0x54, 0x01, 0xc5, 0x89,
+	0x40, 0xe2, 0x12, 0x6d, 0xe2, 0xd1, 0x62, 0xe1, 0x78, 0xb6, 0x3b, 0x63, 0x8b, 0x28, 0x8d, 0x90,
+	0xb8, 0x20, 0xc4, 0x85, 0xaa, 0xc0, 0x0d, 0x71, 0xa8, 0xc4, 0x8f, 0xe0, 0x17, 0xf4, 0x58, 0x89,
+	0x0b, 0xa7, 0x0a, 0x25, 0xfc, 0x0a, 0xc4, 0x01, 0xed, 0xec, 0xcc, 0x66, 0xd7, 0x59, 0x7b, 0xd7,
+	0x69, 0xd4, 0x53, 0xdc, 0x99, 0xf7, 0xbe, 0xf7, 0x7d, 0xef, 0xbd, 0x79, 0x6f, 0x0b, 0xc5, 0x2d,
+	0xc6, 0xb7, 0x19, 0xb7, 0x1c, 0xd6, 0xb1, 0x3a, 0x0b, 0x9b, 0x54, 0xd8, 0x0b, 0xd6, 0xfd, 0x36,
+	0xf5, 0x76, 0x2a, 0xae, 0xc7, 0x04, 0x43, 0x0c, 0xee, 0x2b, 0x0e, 0xeb, 0x54, 0xd4, 0xbd, 0x31,
+	0xa7, 0x7c, 0x36, 0x6d, 0x4e, 0x03, 0xe3, 0xd0, 0xd5, 0xb5, 0x9d, 0x46, 0xcb, 0x16, 0x0d, 0xd6,
+	0x0a, 0xfc, 0x8d, 0x69, 0x87, 0x39, 0x4c, 0xfe, 0xb4, 0xfc, 0x5f, 0xea, 0x74, 0xc6, 0x61, 0xcc,
+	0x69, 0x52, 0xcb, 0x76, 0x1b, 0x96, 0xdd, 0x6a, 0x31, 0x21, 0x5d, 0xb8, 0xbe, 0x4d, 0xe0, 0xe4,
+	0xc7, 0x97, 0xb7, 0xe6, 0x7b, 0x30, 0xfd, 0xa9, 0x1f, 0xf3, 0x9e, 0xc7, 0x5c, 0xc6, 0xed, 0x66,
+	0x8d, 0xde, 0x6f, 0x53, 0x2e, 0xb0, 0x04, 0x79, 0x57, 0x1d, 0x6d, 0x34, 0xea, 0x05, 0x32, 0x4b,
+	0xca, 0xa3, 0x35, 0xd0, 0x47, 0x1f, 0xd6, 0xcd, 0xcf, 0xe1, 0x5c, 0x97, 0x23, 0x77, 0x59, 0x8b,
+	0x53, 0x5c, 0x86, 0x31, 0x6d, 0x26, 0xdd, 0xf2, 0x8b, 0x33, 0x95, 0xa3, 0xb2, 0x2b, 0xda, 0xaf,
+	0x3a, 0xfa, 0xe4, 0x59, 0x69, 0xa8, 0x16, 0xfa, 0x98, 0x3f, 0x8f, 0x74, 0x21, 0x73, 0xcd, 0xe9,
+	0x2e, 0x4c, 0x85, 0x9c, 0xb8, 0xb0, 0x45, 0x9b, 0xcb, 0x00, 0x93, 0x8b, 0x66, 0xbf, 0x00, 0x6b,
+	0xd2, 0xb2, 0x36, 0xe9, 0xc6, 0xfe, 0x8d, 0xab, 0x90, 0xeb, 0x30, 0x41, 0xbd, 0xc2, 0xf0, 0x2c,
+	0x29, 0x4f, 0x54, 0x17, 0xfe, 0x7d, 0x56, 0x9a, 0x77, 0x1a, 0xe2, 0xcb, 0xf6, 0x66, 0x65, 0x8b,
+	0x6d, 0x5b, 0x2a, 0x69, 0xc1, 0x9f, 0x79, 0x5e, 0xff, 0xca, 0x12, 0x3b, 0x2e, 0xe5, 0x95, 0x95,
+	0xad, 0xad, 0x95, 0x7a, 0xdd, 0xa3, 0x9c, 0xd7, 0x02, 0x7f, 0xfc, 0x04, 0xc6, 0xeb, 0xd4, 0x65,
+	0xbc, 0x21, 0x98, 0x57, 0x18, 0x39, 0x2e, 0xd8, 0x21, 0x06, 0xde, 0x06, 0x38, 0x2c, 0x7c, 0x61,
+	0x54, 0xa6, 0xf0, 0xa2, 0x56, 0xe8, 0x77, 0x49, 0x25, 0x68, 0xa9, 0x50, 0xa8, 0xed, 0x50, 0x95,
+	0xa2, 0x5a, 0xc4, 0x13, 0x3f, 0xd2, 0x85, 0xa0, 0x5e, 0x21, 0x77, 0x5c, 0x5e, 0x21, 0x84, 0xf9,
+	0x98, 0xc0, 0x2b, 0xdd, 0x75, 0x51, 0x25, 0xbf, 0x09, 0xe3, 0x3a, 0xbb, 0x7e, 0x49, 0x46, 0x32,
+	0xd6, 0xfc, 0xd0, 0x09, 0x57, 0x63, 0x9a, 0x87, 0xa5, 0xe6, 0x4b, 0xa9, 0x9a, 0x83, 0xf0, 0x51,
+	0xd1, 0xe6, 0x03, 0x38, 0x2b, 0x49, 0x7e, 0xc6, 0x04, 0xcd, 0xda, 0xcb, 0x27, 0xd6, 0x0b, 0xe6,
+	0x2a, 0xbc, 0x14, 0x89, 0xae, 0xb2, 0xb3, 0x08, 0xa3, 0xfe, 0xad, 0x7a, 0x0c, 0x85, 0xa4, 0xc4,
+	0xf8, 0xf6, 0x2a, 0x29, 0xd2, 0xd6, 0x7c, 0x10, 0x01, 0xe2, 0x99, 0x75, 0xdc, 0x4e, 0xc8, 0xe2,
+	0x31, 0x3a, 0xc7, 0x7c, 0x44, 0x00, 0xa3, 0xe1, 0x95, 0x90, 0xab, 0x41, 0x9a, 0x74, 0x89, 0xd3,
+	0x94, 0x04, 0xc6, 0x27, 0x57, 0xda, 0x77, 0x14, 0xa9, 0x7b, 0xb6, 0x67, 0x6f, 0xc7, 0x92, 0x22,
+	0x0f, 0x36, 0xfc, 0xa2, 0xc8, 0xa4, 0x8c, 0xd7, 0x20, 0x38, 0x5a, 0xdf, 0x71, 0xa9, 0xf9, 0x1f,
+	0x81, 0x97, 0x63, 0x7e, 0x4a, 0xcd, 0x5d, 0x38, 0xd3, 0x61, 0xa2, 0xd1, 0x72, 0x36, 0x02, 0x63,
+	0x55, 0x9f, 0xd9, 0x1e, 0xaa, 0x1a, 0x2d, 0x27, 0x00, 0x50, 0xea, 0x26, 0x3a, 0x91, 0x33, 0xfc,
+	0x18, 0x26, 0xd5, 0x03, 0xd6, 0x68, 0x81, 0xd0, 0xd7, 0x93, 0xd0, 0x6e, 0x05, 0x96, 0x31, 0xb8,
+	0x33, 0xf5, 0xe8, 0x21, 0xde, 0x81, 0x09, 0x61, 0x37, 0x9b, 0x3b, 0x1a, 0x6d, 0x44, 0xa2, 0x95,
+	0x92, 0xd0, 0xd6, 0x7d, 0xbb, 0x18, 0x56, 0x5e, 0x1c, 0x1e, 0x99, 0xdf, 0x69, 0xf9, 0x2a, 0x6a,
+	0xe6, 0x66, 0x8a, 0xcd, 0xb5, 0xe1, 0xe7, 0x9f, 0x6b, 0xe6, 0x1a, 0x4c, 0xc7, 0x89, 0xa8, 0x42,
+	0x5c, 0x87, 0xd3, 0xca, 0x48, 0x95, 0xe0, 0x7c, 0x9f, 0xa4, 0x29, 0x89, 0xda, 0xc3, 0xfc, 0x26,
+	0x0e, 0xfa, 0xe2, 0xdf, 0xca, 0x6f, 0x04, 0xce, 0x75, 0x31, 0x50, 0xba, 0x3e, 0x80, 0x31, 0xc5,
+	0x52, 0xbf, 0x98, 0x0c, 0xc2, 0x42, 0x97, 0x93, 0x7b, 0x37, 0xef, 0xc3, 0xab, 0x92, 0xa0, 0x6c,
+	0x94, 0x1a, 0xe5, 0xed, 0xa6, 0x18, 0x60, 0xcb, 0x17, 0x8e, 0xfa, 0x86, 0x75, 0xcb, 0xc9, 0x46,
+	0x2b, 0x90, 0x94, 0xe6, 0x0c, 0xfc, 0xf4, 0x54, 0x90, 0x3e, 0xe6, 0x32, 0x9c, 0x97, 0xc0, 0x6b,
+	0x54, 0x88, 0x26, 0xad, 0x0f, 0x5a, 0x3e, 0x53, 0xc0, 0x4c, 0xb2, 0xbf, 0x22, 0xb7, 0x0e, 0x67,
+	0x79, 0x70, 0xb5, 0x11, 0x29, 0x82, 0xcf, 0xf3, 0x8d, 0x24, 0x9e, 0x5d, 0x30, 0x8a, 0xeb, 0x14,
+	0x8f, 0x1f, 0x2f, 0x3e, 0xcc, 0x43, 0x4e, 0x86, 0xc5, 0x9f, 0x08, 0x8c, 0xe9, 0x75, 0x86, 0xe5,
+	0x24, 0xc8, 0xa4, 0xcf, 0x2a, 0xe3, 0xad, 0x0c, 0x96, 0x81, 0x02, 0x73, 0xe9, 0xdb, 0x3f, 0xff,
+	0x79, 0x34, 0x3c, 0x8f, 0x97, 0xad, 0x84, 0x0f, 0xb8, 0x70, 0x73, 0x5a, 0xbb, 0x91, 0x3c, 0xed,
+	0xe1, 0xf7, 0x04, 0xc6, 0x35, 0x12, 0xc7, 0xf4, 0x68, 0x3a, 0xe1, 0xc6, 0x5c, 0x16, 0x53, 0xc5,
+	0xec, 0x82, 0x64, 0x56, 0xc2, 0xd7, 0xfa, 0x32, 0xc3, 0x5f, 0x08, 0x8c, 0xfa, 0xeb, 0x00, 0xdf,
+	0xec, 0x89, 0x1d, 0xd9, 0xd2, 0xc6, 0x85, 0x14, 0x2b, 0x15, 0x7c, 0x45, 0x06, 0xbf, 0x8e, 0xd7,
+	0x06, 0x48, 0x8b, 0x25, 0x37, 0x91, 0xb5, 0xeb, 0xff, 0xf1, 0xf6, 0xf0, 0x21, 0x81, 0x9c, 0x8f,
+	0xc9, 0xb1, 0x7f, 0xcc, 0x30, 0x39, 0x17, 0xd3, 0xcc, 0x14, 0xb7, 0x6b, 0x92, 0xdb, 0x12, 0x2e,
+	0x0c, 0xcc, 0x0d, 0x7f, 0x20, 0x70, 0x4a, 0xcd, 0xfe, 0xde, 0xd1, 0x62, 0x9b, 0xcf, 0xb8, 0x94,
+	0x6a, 0xa7, 0x68, 0x5d, 0x91, 0xb4, 0xe6, 0xb0, 0x9c, 0x48, 0x4b, 0xda, 0x5a, 0xbb, 0x91, 0x25,
+	0xba, 0x87, 0xbf, 0x13, 0x38, 0xad, 0x9a, 0x1e, 0x7b, 0x87, 0x89, 0x6f, 0x14, 0xa3, 0x9c, 0x6e,
+	0xa8, 0x08, 0xdd, 0x91, 0x84, 0xaa, 0x78, 0x73, 0x90, 0x3c, 0xe9, 0x67, 0x6c, 0xed, 0x86, 0x2b,
+	0x65, 0x0f, 0x7f, 0x25, 0x30, 0xa6, 0xd0, 0x39, 0xa6, 0x12, 0xe0, 0xe9, 0xcf, 0xb0, 0x7b, 0x90,
+	0x98, 0x37, 0x24, 0xd7, 0x77, 0xf1, 0xea, 0x71, 0xb8, 0xe2, 0x63, 0x02, 0xf9, 0xc8, 0x0c, 0xc4,
+	0xcb, 0x3d, 0x03, 0x1f, 0x9d, 0xce, 0xc6, 0xdb, 0xd9, 0x8c, 0x9f, 0xa7, 0xf9, 0xe4, 0x30, 0xc6,
+	0x3f, 0x08, 0x4c, 0x75, 0x4d, 0x40, 0xb4, 0x7a, 0x06, 0x4f, 0x1e, 0xd9, 0xc6, 0x95, 0xec, 0x0e,
+	0x8a, 0xf1, 0x2d, 0xc9, 0x78, 0x19, 0x6f, 0x0c, 0xc2, 0xb8, 0x7b, 0xaa, 0x57, 0xab, 0x4f, 0xf6,
+	0x8b, 0xe4, 0xe9, 0x7e, 0x91, 0xfc, 0xbd, 0x5f, 0x24, 0x3f, 0x1e, 0x14, 0x87, 0x9e, 0x1e, 0x14,
+	0x87, 0xfe, 0x3a, 0x28, 0x0e, 0x7d, 0x51, 0xee, 0xfb, 0xa9, 0xf2, 0xb5, 0x0c, 0x27, 0x3f, 0x58,
+	0x36, 0x4f, 0xc9, 0xff, 0x0c, 0x2f, 0xfd, 0x3f, 0x00, 0x26, 0xb5, 0x57, 0x55, 0xc0, 0x0f, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1096,8 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// SettledDeposits queries what happened to the deposits of a finished proposal.
+	SettledDeposits(ctx context.Context, in *QuerySettledDepositsRequest, opts ...grpc.CallOption) (*QuerySettledDepositsResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1180,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) SettledDeposits(ctx context.Context, in *QuerySettledDepositsRequest, opts ...grpc.CallOption) (*QuerySettledDepositsResponse, error) {
+	out := new(QuerySettledDepositsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/SettledDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1207,8 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// SettledDeposits queries what happened to the deposits of a finished proposal.
+	SettledDeposits(context.Context, *QuerySettledDepositsRequest) (*QuerySettledDepositsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1239,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) SettledDeposits(ctx context.Context, req *QuerySettledDepositsRequest) (*QuerySettledDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettledDeposits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1391,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SettledDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettledDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettledDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/SettledDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettledDeposits(ctx, req.(*QuerySettledDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1445,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "SettledDeposits",
+			Handler:    _Query_SettledDeposits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta1/query.proto",
@@ -1387,6 +1535,13 @@ func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1928,6 +2083,67 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySettledDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SettledDeposits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1983,6 +2199,10 @@ func (m *QueryProposalsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2180,6 +2400,29 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySettledDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QuerySettledDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SettledDeposits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2496,6 +2739,40 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3884,6 +4161,164 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySettledDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettledDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettledDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettledDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettledDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettledDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
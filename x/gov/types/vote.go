@@ -2,15 +2,33 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalId: proposalID, Voter: voter, Options: options}
+	vote.PopulateOptions()
+	return vote
+}
+
+// PopulateOptions keeps the deprecated Option field and the weighted Options
+// of a vote consistent. Votes cast before weighted voting only carry a single
+// Option, which is converted to a non-split weighted option, while a vote
+// consisting of a single option with weight 1 also sets the deprecated field.
+func (v *Vote) PopulateOptions() {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		v.Options = NewNonSplitVoteOption(v.Option)
+	}
+
+	if len(v.Options) == 1 && v.Options[0].Weight.Equal(sdk.OneDec()) {
+		v.Option = v.Options[0].Option
+	}
 }
 
 func (v Vote) String() string {
@@ -42,7 +60,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, WeightedVoteOptions(vot.Options))
 	}
 	return out
 }
@@ -62,6 +80,105 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	return VoteOption(option), nil
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption creates WeightedVoteOptions which casts the full
+// voting power on a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// String implements the Stringer interface, formatting the option as
+// <option>=<weight>.
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions describes the options of a vote along with the fraction
+// of the voting power cast on each of them.
+type WeightedVoteOptions []WeightedVoteOption
+
+// String implements the Stringer interface, formatting the options as a comma
+// separated list of <option>=<weight> pairs.
+func (v WeightedVoteOptions) String() string {
+	out := make([]string, len(v))
+	for i, option := range v {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// ValidateBasic checks that all options are valid and distinct, and that their
+// weights sum up to exactly 1.
+func (v WeightedVoteOptions) ValidateBasic() error {
+	if len(v) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range v {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns WeightedVoteOptions from a comma
+// separated list of <option>=<weight> pairs, e.g.
+// "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4". An option without a weight is
+// given weight 1. It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+
+		weight := sdk.OneDec()
+		switch len(fields) {
+		case 1:
+		case 2:
+			weight, err = sdk.NewDecFromStr(fields[1])
+			if err != nil {
+				return options, fmt.Errorf("'%s' is not a valid vote weight: %w", fields[1], err)
+			}
+		default:
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// ValidWeightedVoteOption returns true if the option is valid and its weight
+// is positive and at most 1, and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
 // ValidVoteOption returns true if the vote option is valid and false otherwise.
 func ValidVoteOption(option VoteOption) bool {
 	if option == OptionYes ||
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := []struct {
+		str        string
+		expected   WeightedVoteOptions
+		expectPass bool
+	}{
+		{"VOTE_OPTION_YES", NewNonSplitVoteOption(OptionYes), true},
+		{"VOTE_OPTION_YES=1", NewNonSplitVoteOption(OptionYes), true},
+		{"VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, true},
+		{"VOTE_OPTION_YES=0.6, VOTE_OPTION_NO_WITH_VETO=0.4", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+		}, true},
+		{"yes=1", nil, false},
+		{"VOTE_OPTION_YES=abc", nil, false},
+		{"VOTE_OPTION_YES=0.5=0.5", nil, false},
+		{"", nil, false},
+	}
+
+	for i, tc := range tests {
+		options, err := WeightedVoteOptionsFromString(tc.str)
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+			require.Equal(t, tc.expected.String(), options.String(), "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
+
+func TestVotePopulateOptions(t *testing.T) {
+	// votes cast before weighted voting only carry the deprecated option
+	legacyVote := Vote{ProposalId: 1, Voter: addrs[0], Option: OptionNo}
+	legacyVote.PopulateOptions()
+	require.Equal(t, NewVote(1, addrs[0], NewNonSplitVoteOption(OptionNo)), legacyVote)
+
+	vote := NewVote(1, addrs[0], NewNonSplitVoteOption(OptionYes))
+	require.Equal(t, OptionYes, vote.Option)
+
+	vote = NewVote(1, addrs[0], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Equal(t, OptionEmpty, vote.Option)
+}
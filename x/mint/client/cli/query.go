@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+const flagBlocks = "blocks"
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -25,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryMinter(),
 	)
 
 	return mintingQueryCmd
@@ -125,3 +128,41 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryMinter implements a command to return the current minter along
+// with the minting schedule projected over a number of blocks.
+func GetCmdQueryMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter",
+		Short: "Query the current minter and the projected minting schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blocks, err := cmd.Flags().GetUint64(flagBlocks)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMinterRequest{Blocks: blocks}
+			res, err := queryClient.Minter(context.Background(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagBlocks, 1, fmt.Sprintf("Number of blocks to project the minting schedule over, at most %d", types.MaxMinterProjectionBlocks))
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
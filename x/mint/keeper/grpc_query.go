@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,30 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// Minter returns the current minter of the mint module along with the minting
+// schedule projected over the requested number of blocks.
+func (k Keeper) Minter(c context.Context, req *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = 1
+	}
+	if blocks > types.MaxMinterProjectionBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot project more than %d blocks", types.MaxMinterProjectionBlocks)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule := k.ProjectMinters(ctx, blocks)
+
+	return &types.QueryMinterResponse{
+		Minter:             k.GetMinter(ctx),
+		BondedRatio:        k.BondedRatio(ctx),
+		NextMinter:         schedule[0].Minter,
+		NextBlockProvision: schedule[0].BlockProvision,
+		Schedule:           schedule,
+	}, nil
+}
@@ -49,6 +49,25 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	annualProvisions, err := queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
+
+	minter, err := queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(minter.Minter, app.MintKeeper.GetMinter(ctx))
+	suite.Require().Equal(minter.BondedRatio, app.MintKeeper.BondedRatio(ctx))
+
+	nextMinter := app.MintKeeper.NextMinter(ctx, app.MintKeeper.GetMinter(ctx), app.MintKeeper.GetParams(ctx), app.MintKeeper.BondedRatio(ctx))
+	suite.Require().Equal(minter.NextMinter, nextMinter)
+	suite.Require().Equal(minter.NextBlockProvision, nextMinter.BlockProvision(app.MintKeeper.GetParams(ctx)))
+	suite.Require().Len(minter.Schedule, 1)
+
+	minter, err = queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{Blocks: 10})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.MintKeeper.ProjectMinters(ctx, 10), minter.Schedule)
+	suite.Require().Equal(minter.NextMinter, minter.Schedule[0].Minter)
+	suite.Require().Equal(minter.NextBlockProvision, minter.Schedule[0].BlockProvision)
+
+	_, err = queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{Blocks: types.MaxMinterProjectionBlocks + 1})
+	suite.Require().Error(err)
 }
 
 func TestMintTestSuite(t *testing.T) {
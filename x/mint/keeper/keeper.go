@@ -19,13 +19,17 @@ type Keeper struct {
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
+
+	inflationCalculationFn types.InflationCalculationFn
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The inflation rate of each
+// block is calculated by inflationCalculationFn, which defaults to
+// types.DefaultInflationCalculationFn when nil.
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	feeCollectorName string, inflationCalculationFn types.InflationCalculationFn,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -37,13 +41,18 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	if inflationCalculationFn == nil {
+		inflationCalculationFn = types.DefaultInflationCalculationFn
+	}
+
 	return Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace,
-		stakingKeeper:    sk,
-		bankKeeper:       bk,
-		feeCollectorName: feeCollectorName,
+		cdc:                    cdc,
+		storeKey:               key,
+		paramSpace:             paramSpace,
+		stakingKeeper:          sk,
+		bankKeeper:             bk,
+		feeCollectorName:       feeCollectorName,
+		inflationCalculationFn: inflationCalculationFn,
 	}
 }
 
@@ -73,6 +82,41 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// NextMinter returns the minter of the next block, with its inflation rate
+// recalculated by the InflationCalculationFn of the keeper and its annual
+// provisions recalculated from the staking token supply.
+func (k Keeper) NextMinter(ctx sdk.Context, minter types.Minter, params types.Params, bondedRatio sdk.Dec) types.Minter {
+	minter.Inflation = k.inflationCalculationFn(ctx, minter, params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, k.StakingTokenSupply(ctx))
+
+	return minter
+}
+
+// ProjectMinters returns the minter and block provision projected for each of
+// the given number of blocks following the current block. The projection
+// assumes the bonded ratio stays at its current value, while the provision of
+// each block is added to the staking token supply of the next one.
+func (k Keeper) ProjectMinters(ctx sdk.Context, blocks uint64) []types.MinterProjection {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+	totalStakingSupply := k.StakingTokenSupply(ctx)
+
+	schedule := make([]types.MinterProjection, 0, blocks)
+	for i := uint64(1); i <= blocks; i++ {
+		height := ctx.BlockHeight() + int64(i)
+		minter.Inflation = k.inflationCalculationFn(ctx.WithBlockHeight(height), minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+
+		provision := minter.BlockProvision(params)
+		totalStakingSupply = totalStakingSupply.Add(provision.Amount)
+
+		schedule = append(schedule, types.MinterProjection{Height: height, Minter: minter, BlockProvision: provision})
+	}
+
+	return schedule
+}
+
 //______________________________________________________________________
 
 // GetParams returns the total set of minting parameters.
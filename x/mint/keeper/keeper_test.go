@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestNextMinter(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	params := app.MintKeeper.GetParams(ctx)
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+	totalSupply := app.MintKeeper.StakingTokenSupply(ctx)
+
+	// the default keeper uses the bonded ratio based calculation
+	nextMinter := app.MintKeeper.NextMinter(ctx, minter, params, bondedRatio)
+	expInflation := minter.NextInflationRate(params, bondedRatio)
+	require.Equal(t, expInflation, nextMinter.Inflation)
+	require.Equal(t, expInflation.MulInt(totalSupply), nextMinter.AnnualProvisions)
+
+	// a keeper with a custom inflation calculation uses it instead
+	fixedInflation := sdk.NewDecWithPrec(2, 2)
+	fixedInflationFn := func(_ sdk.Context, _ types.Minter, _ types.Params, _ sdk.Dec) sdk.Dec {
+		return fixedInflation
+	}
+
+	mintKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, fixedInflationFn,
+	)
+
+	nextMinter = mintKeeper.NextMinter(ctx, minter, params, bondedRatio)
+	require.Equal(t, fixedInflation, nextMinter.Inflation)
+	require.Equal(t, fixedInflation.MulInt(totalSupply), nextMinter.AnnualProvisions)
+}
+
+func TestProjectMinters(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(8)
+
+	// halve the inflation rate every 5 blocks
+	halvingInflationFn := func(ctx sdk.Context, _ types.Minter, _ types.Params, _ sdk.Dec) sdk.Dec {
+		inflation := sdk.NewDecWithPrec(8, 2)
+		for i := int64(0); i < ctx.BlockHeight()/5; i++ {
+			inflation = inflation.QuoInt64(2)
+		}
+		return inflation
+	}
+
+	mintKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, halvingInflationFn,
+	)
+
+	params := mintKeeper.GetParams(ctx)
+	totalSupply := mintKeeper.StakingTokenSupply(ctx)
+
+	schedule := mintKeeper.ProjectMinters(ctx, 4)
+	require.Len(t, schedule, 4)
+	require.Equal(t, mintKeeper.NextMinter(ctx.WithBlockHeight(9), mintKeeper.GetMinter(ctx), params, mintKeeper.BondedRatio(ctx)), schedule[0].Minter)
+
+	for i, projection := range schedule {
+		height := int64(9 + i)
+		require.Equal(t, height, projection.Height)
+		require.Equal(t, halvingInflationFn(ctx.WithBlockHeight(height), projection.Minter, params, sdk.Dec{}), projection.Minter.Inflation)
+		require.Equal(t, projection.Minter.Inflation.MulInt(totalSupply), projection.Minter.AnnualProvisions)
+		require.Equal(t, projection.Minter.BlockProvision(params), projection.BlockProvision)
+
+		totalSupply = totalSupply.Add(projection.BlockProvision.Amount)
+	}
+
+	// the inflation rate is halved from height 10
+	require.Equal(t, sdk.NewDecWithPrec(4, 2), schedule[0].Minter.Inflation)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), schedule[1].Minter.Inflation)
+
+	// the state is left untouched
+	require.Equal(t, app.MintKeeper.GetMinter(ctx), mintKeeper.GetMinter(ctx))
+	require.Equal(t, app.MintKeeper.StakingTokenSupply(ctx), mintKeeper.StakingTokenSupply(ctx))
+}
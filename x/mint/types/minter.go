@@ -6,6 +6,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxMinterProjectionBlocks is the maximum number of blocks the Minter query
+// projects the minting schedule over.
+const MaxMinterProjectionBlocks = 1000
+
+// InflationCalculationFn defines the function required to calculate the
+// inflation rate of the next block from the current minter, the params and the
+// bonded ratio.
+type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
+
+// DefaultInflationCalculationFn is the default function used to calculate the
+// inflation rate, which adjusts it towards the GoalBonded bonded ratio.
+func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return minter.NextInflationRate(params, bondedRatio)
+}
+
 // NewMinter returns a new Minter object with the given inflation and annual
 // provisions values.
 func NewMinter(inflation, annualProvisions sdk.Dec) Minter {
@@ -23,5 +23,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// raise the validators to the floors set by a parameter change in this block
+	if k.ValidatorParamFloorsModified(ctx) {
+		k.ApplyValidatorParamFloors(ctx)
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -11,7 +11,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -25,11 +24,9 @@ func bootstrapGenesisTest(t *testing.T, power int64, numAddrs int) (*simapp.SimA
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
-	require.NoError(t, err)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), totalSupply))
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
 
 	return app, ctx, addrDels
 }
@@ -93,6 +90,45 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, abcivals, vals)
 }
 
+func TestInitGenesisTokenizeShareRecords(t *testing.T) {
+	app, ctx, addrs := bootstrapGenesisTest(t, 1000, 10)
+
+	valTokens := sdk.TokensFromConsensusPower(2)
+	valAddr := sdk.ValAddress(addrs[0])
+
+	pk0, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, PKs[0])
+	require.NoError(t, err)
+
+	validator := types.Validator{
+		OperatorAddress: valAddr,
+		ConsensusPubkey: pk0,
+		Status:          sdk.Bonded,
+		Tokens:          valTokens,
+		DelegatorShares: valTokens.ToDec(),
+	}
+
+	record := types.NewTokenizeShareRecord(3, addrs[1], valAddr)
+	delegations := []types.Delegation{
+		types.NewDelegation(addrs[0], valAddr, valTokens.QuoRaw(2).ToDec()),
+		types.NewDelegation(record.GetModuleAddress(), valAddr, valTokens.QuoRaw(2).ToDec()),
+	}
+
+	genesisState := types.NewGenesisState(app.StakingKeeper.GetParams(ctx), []types.Validator{validator}, delegations)
+	genesisState.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+	genesisState.LastTokenizeShareRecordId = 5
+	require.NoError(t, staking.ValidateGenesis(genesisState))
+
+	staking.InitGenesis(ctx, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, genesisState)
+
+	// the liquid shares of the validator are rebuilt from the record delegation
+	require.Equal(t, valTokens.QuoRaw(2).ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+
+	actualGenesis := staking.ExportGenesis(ctx, app.StakingKeeper)
+	require.Equal(t, genesisState.TokenizeShareRecords, actualGenesis.TokenizeShareRecords)
+	require.Equal(t, uint64(5), actualGenesis.LastTokenizeShareRecordId)
+}
+
 func TestInitGenesisLargeValidatorSet(t *testing.T) {
 	size := 200
 	require.True(t, size > 100)
@@ -155,6 +191,24 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"invalid tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(1, sdk.AccAddress{}, sdk.ValAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id greater than last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(2, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
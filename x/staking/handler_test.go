@@ -5,7 +5,7 @@ import (
 	"testing"
 	"time"
 
-	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -16,7 +16,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -31,11 +30,9 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
-	require.NoError(t, err)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), totalSupply))
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
 
 	return app, ctx, addrDels, addrVals
 }
@@ -116,12 +113,12 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -254,12 +251,12 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -378,6 +375,16 @@ func TestIncrementsMsgDelegate(t *testing.T) {
 		require.Equal(t, expDelegatorAcc, gotDelegatorAcc,
 			"i: %v\nexpDelegatorAcc: %v\ngotDelegatorAcc: %v\nvalidator: %v\nbond: %v\n",
 			i, expDelegatorAcc, gotDelegatorAcc, validator, bond)
+
+		// the delegation emits a typed event
+		event, err := sdk.ParseTypedEvent(res.Events[len(res.Events)-1])
+		require.NoError(t, err)
+		require.Equal(t, &types.EventDelegate{
+			Delegator: delegatorAddr.String(),
+			Validator: validatorAddr.String(),
+			Amount:    msgDelegate.Amount,
+			NewShares: bondAmount.ToDec(),
+		}, event)
 	}
 }
 
@@ -450,6 +457,146 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestCreateValidatorBelowParamFloors(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+
+	validatorAddr := valAddrs[0]
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.GlobalMinSelfDelegation = sdk.NewInt(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// commission rate below the min commission rate
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(10)
+	res, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+	require.Nil(t, res)
+
+	// min self delegation below the global min self delegation
+	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), sdk.ZeroDec())
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(9)
+	res, err = handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrMinSelfDelegationBelowGlobalMin.Is(err), err)
+	require.Nil(t, res)
+
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(10)
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestEditValidatorBelowParamFloors(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
+
+	validatorAddr := valAddrs[0]
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1))
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.GlobalMinSelfDelegation = sdk.NewInt(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// commission changes are rate limited, so move past the max change window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+
+	newRate := sdk.NewDecWithPrec(4, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+	require.Nil(t, res)
+
+	newMinSelfDelegation := sdk.NewInt(9)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, nil, &newMinSelfDelegation)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrMinSelfDelegationBelowGlobalMin.Is(err), err)
+	require.Nil(t, res)
+
+	newRate = sdk.NewDecWithPrec(5, 2)
+	newMinSelfDelegation = sdk.NewInt(10)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, &newMinSelfDelegation)
+	res, err = handler(ctx, msgEditValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestEndBlockerAppliesValidatorParamFloors(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, 1000000000)
+	createTime := time.Now().UTC()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: createTime})
+
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	// the first validator is below both floors, the second one is above them
+	msgCreateValidator := NewTestMsgCreateValidator(valAddrs[0], PKs[0], initBond)
+	msgCreateValidator.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgCreateValidator = NewTestMsgCreateValidator(valAddrs[1], PKs[1], initBond)
+	msgCreateValidator.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(20)
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the third validator self delegates less than the global min self delegation
+	msgCreateValidator = NewTestMsgCreateValidator(valAddrs[2], PKs[2], sdk.NewInt(5))
+	msgCreateValidator.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.GlobalMinSelfDelegation = sdk.NewInt(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(createTime.Add(time.Hour))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.MaxRate)
+	require.Equal(t, ctx.BlockTime(), validator.Commission.UpdateTime)
+	require.Equal(t, sdk.NewInt(10), validator.MinSelfDelegation)
+	require.False(t, validator.Jailed)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), validator.Commission.MaxRate)
+	require.Equal(t, createTime, validator.Commission.UpdateTime)
+	require.Equal(t, sdk.NewInt(20), validator.MinSelfDelegation)
+	require.False(t, validator.Jailed)
+
+	// a validator left below its raised min self delegation is jailed
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(t, createTime, validator.Commission.UpdateTime)
+	require.Equal(t, sdk.NewInt(10), validator.MinSelfDelegation)
+	require.True(t, validator.Jailed)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromConsensusPower(initPower)
@@ -499,12 +646,12 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -617,10 +764,8 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		_, err = gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
 		// adds validator into unbonding queue
@@ -675,12 +820,12 @@ func TestMultipleMsgDelegate(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -714,12 +859,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -734,12 +879,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts = &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err = gogotypes.TimestampFromProto(ts)
+	resData = types.MsgUndelegateResponse{}
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime = resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -782,12 +927,12 @@ func TestValidatorQueue(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -888,12 +1033,12 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	require.NotNil(t, res)
 
 	// change the ctx to Block Time one second before the validator would have unbonded
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime.Add(time.Second * -1))
 
 	// unbond the delegator from the validator
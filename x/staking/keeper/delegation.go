@@ -259,6 +259,25 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// Remove an unbonding delegation from the appropriate timeslice in the unbonding queue
+func (k Keeper) RemoveUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -663,13 +682,22 @@ func (k Keeper) getBeginInfo(
 // processed during the staking EndBlocker.
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (time.Time, error) {
+	return k.UndelegateTo(ctx, delAddr, valAddr, delAddr, sharesAmount)
+}
+
+// UndelegateTo unbonds an amount of delegator shares from a given validator
+// like Undelegate, except that the unbonding delegation entry is created for
+// the recipient, who receives the unbonded tokens once the entry matures.
+func (k Keeper) UndelegateTo(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recipient sdk.AccAddress, sharesAmount sdk.Dec,
 ) (time.Time, error) {
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
 		return time.Time{}, types.ErrNoDelegatorForAddress
 	}
 
-	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+	if k.HasMaxUnbondingDelegationEntries(ctx, recipient, valAddr) {
 		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
@@ -684,12 +712,136 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd := k.SetUnbondingDelegationEntry(ctx, recipient, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
 	return completionTime, nil
 }
 
+// TransferUnbonding moves up to wantAmt of the unbonding balance of fromAddr
+// from a given validator to unbonding delegation entries of toAddr with the
+// same completion times. It returns the amount transferred, which is less than
+// wantAmt if fromAddr does not have enough unbonding or toAddr reaches the
+// maximum number of unbonding entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		if entry.Balance.Equal(toXfer) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(toXfer)
+		entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(toXfer), entry.Balance)
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// CancelUnbondingDelegation cancels an amount of the unbonding delegation
+// entry of a delegator created at the given height and delegates it back to
+// the validator. Once the whole balance of the entry is cancelled, the entry is
+// removed from the unbonding delegation and from the unbonding queue.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) (newShares sdk.Dec, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return sdk.ZeroDec(), types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegation
+	}
+
+	// mature entries are completed in the EndBlocker and cannot be cancelled
+	ctxTime := ctx.BlockHeader().Time
+	index := -1
+
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctxTime) {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegationEntry
+	}
+
+	entry := ubd.Entries[index]
+	if entry.Balance.LT(amount) {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrCancelUnbondingAmountTooLarge, entry.Balance.String())
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	newShares, err = k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if entry.Balance.Equal(amount) {
+		ubd.RemoveEntry(int64(index))
+		k.RemoveUBDQueue(ctx, ubd, entry.CompletionTime)
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(amount), entry.Balance)
+		ubd.Entries[index] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
 // CompleteUnbonding completes the unbonding of all mature entries in the
 // retrieved unbonding delegation object and returns the total unbonding balance
 // or an error upon failure.
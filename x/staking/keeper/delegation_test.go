@@ -221,6 +221,178 @@ func TestUnbondDelegation(t *testing.T) {
 	require.Equal(t, remainingTokens, validator.BondedTokens())
 }
 
+func TestUndelegateTo(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t,
+		app.BankKeeper.SetBalances(
+			ctx,
+			notBondedPool.GetAddress(),
+			sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens)),
+		),
+	)
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a validator and a delegator to that validator
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	require.Equal(t, startTokens, issuedShares.RoundInt())
+
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// undelegate part of the tokens to the second address
+	unbondTokens := sdk.TokensFromConsensusPower(6)
+	completionTime, err := app.StakingKeeper.UndelegateTo(ctx, delAddrs[0], valAddrs[0], delAddrs[1], unbondTokens.ToDec())
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)), completionTime)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(unbondTokens), delegation.Shares.RoundInt())
+
+	// the unbonding delegation entry belongs to the recipient
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens, ubd.Entries[0].Balance)
+	require.Equal(t, completionTime, ubd.Entries[0].CompletionTime)
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completionTime := time.Unix(100, 0).UTC()
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 1, completionTime, sdk.NewInt(5))
+	ubd.AddEntry(2, completionTime.Add(time.Hour), sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// transfer the first entry and part of the second one
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(8))
+	require.Equal(t, sdk.NewInt(8), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, sdk.NewInt(7), ubdFrom.Entries[0].Balance)
+	require.Equal(t, completionTime.Add(time.Hour), ubdFrom.Entries[0].CompletionTime)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[0].Balance)
+	require.Equal(t, completionTime, ubdTo.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(3), ubdTo.Entries[1].Balance)
+	require.Equal(t, completionTime.Add(time.Hour), ubdTo.Entries[1].CompletionTime)
+
+	// transferring more than what is left only transfers the remainder
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(7), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+
+	// nothing is transferred without an unbonding delegation
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(1))
+	require.True(t, transferred.IsZero())
+}
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	_, err := msgServer.CreateValidator(sdk.WrapSDKContext(ctx), types.NewMsgCreateValidator(
+		valAddrs[0], PKs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(10)), types.Description{},
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(
+		delAddrs[1], valAddrs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(20)),
+	))
+	require.NoError(t, err)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// undelegate half of the delegation
+	ctx = ctx.WithBlockHeight(10)
+	unbondTokens := sdk.TokensFromConsensusPower(10)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, delAddrs[1], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 11, unbondTokens)
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err), err)
+
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, unbondTokens.AddRaw(1))
+	require.True(t, types.ErrCancelUnbondingAmountTooLarge.Is(err), err)
+
+	// cancel part of the unbonding delegation entry
+	cancelTokens := sdk.TokensFromConsensusPower(4)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, cancelTokens)
+	require.NoError(t, err)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(14).ToDec(), delegation.Shares)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(24), validator.Tokens)
+
+	_, broken := keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// cancelling the rest of the entry removes it from the unbonding queue
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, unbondTokens.Sub(cancelTokens))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(20).ToDec(), delegation.Shares)
+
+	_, broken = keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// mature entries cannot be cancelled
+	completionTime, err = app.StakingKeeper.Undelegate(ctx, delAddrs[1], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, unbondTokens)
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err), err)
+}
+
 func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	_, app, ctx := createTestInput()
 
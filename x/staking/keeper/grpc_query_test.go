@@ -747,3 +747,38 @@ func createValidators(ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sd
 
 	return addrs, valAddrs, vals
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+
+	record1 := types.NewTokenizeShareRecord(1, addrs[0], vals[0].OperatorAddress)
+	record2 := types.NewTokenizeShareRecord(2, addrs[0], vals[1].OperatorAddress)
+	app.StakingKeeper.SetTokenizeShareRecord(ctx, record1)
+	app.StakingKeeper.SetTokenizeShareRecord(ctx, record2)
+	app.StakingKeeper.SetValidatorLiquidShares(ctx, vals[0].OperatorAddress, vals[0].DelegatorShares.QuoInt64(2))
+
+	// Query record by id
+	res, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 2})
+	suite.NoError(err)
+	suite.Equal(record2, res.Record)
+
+	_, err = queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 3})
+	suite.Error(err)
+
+	// Query records by owner
+	resOwned, err := queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: addrs[0].String()})
+	suite.NoError(err)
+	suite.Equal([]types.TokenizeShareRecord{record1, record2}, resOwned.Records)
+
+	resOwned, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: addrs[1].String()})
+	suite.NoError(err)
+	suite.Empty(resOwned.Records)
+
+	_, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: "invalid"})
+	suite.Error(err)
+
+	// Query total liquid staked
+	resTotal, err := queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{})
+	suite.NoError(err)
+	suite.Equal(vals[0].Tokens.QuoRaw(2), resTotal.Tokens)
+}
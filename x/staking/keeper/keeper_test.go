@@ -10,7 +10,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -63,6 +65,56 @@ func TestParams(t *testing.T) {
 	require.True(t, expParams.Equal(resParams))
 }
 
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the liquid staking params are not stored in version 1
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyGlobalLiquidStakingCap)
+	paramStore.Delete(types.KeyValidatorLiquidStakingCap)
+	require.Panics(t, func() { app.StakingKeeper.GetParams(ctx) })
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+
+	// the existing params are kept
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.True(t, params.Equal(app.StakingKeeper.GetParams(ctx)))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, valAddrs, _ := createValidators(ctx, app, []int64{9, 8, 7})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.GlobalMinSelfDelegation = sdk.NewInt(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+
+	// the existing params are kept
+	require.True(t, params.Equal(app.StakingKeeper.GetParams(ctx)))
+
+	for _, valAddr := range valAddrs[:2] {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.Rate)
+		require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.MaxRate)
+		require.Equal(t, sdk.NewInt(10), validator.MinSelfDelegation)
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the amount of tokens backing the shares
+// held by all tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroDec()
+
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if found {
+			total = total.Add(validator.TokensFromShares(shares))
+		}
+
+		return false
+	})
+
+	return total.TruncateInt()
+}
+
+// TokenizeShares moves the shares backing amt tokens of a delegation to a new
+// tokenize share record and mints share tokens of the record denom to the
+// delegator. The record is owned by the delegator.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Int,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	if sdk.ValAddress(delAddr).Equals(valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSelfDelegation
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amt)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// share tokens are minted one for one with whole shares
+	shares = shares.TruncateDec()
+	if shares.IsZero() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTinyTokenizeAmount
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	// vesting accounts may only tokenize delegations of free coins
+	bondDenom := k.BondDenom(ctx)
+	vacc, isVesting := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	if isVesting && vacc.GetDelegatedFree().AmountOf(bondDenom).LT(tokens) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeVestingDelegation
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx).ToDec()
+	totalLiquid := k.GetTotalLiquidStakedTokens(ctx).Add(tokens).ToDec()
+	if totalLiquid.GT(k.GlobalLiquidStakingCap(ctx).Mul(totalBonded)) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	if liquidShares.GT(k.ValidatorLiquidStakingCap(ctx).Mul(validator.DelegatorShares)) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	// the tokenized free coins are released from the delegation tracking of
+	// the vesting account
+	if isVesting {
+		vacc.TrackUndelegation(sdk.NewCoins(sdk.NewCoin(bondDenom, tokens)))
+		k.authKeeper.SetAccount(ctx, vacc)
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, delAddr, valAddr)
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares)
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens held by the delegator and moves the
+// shares they represent from the tokenize share record back to a delegation
+// of the delegator. Once all the shares of a record are redeemed, the record is
+// removed and any remaining balance of its account, such as withdrawn rewards,
+// is sent to the record owner. It returns the amount of tokens backing the
+// redeemed shares.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	id, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found || record.GetShareTokenDenom() != shareToken.Denom {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordNotFound
+	}
+
+	validator, found := k.GetValidator(ctx, record.Validator)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddr, record.Validator)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	shares := shareToken.Amount.ToDec()
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, types.ErrNotEnoughDelegationShares
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.transferDelegationShares(ctx, recordAddr, delAddr, record.Validator, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, record.Validator).Sub(shares)
+	if liquidShares.IsNegative() {
+		liquidShares = sdk.ZeroDec()
+	}
+
+	k.SetValidatorLiquidShares(ctx, record.Validator, liquidShares)
+
+	if _, found := k.GetDelegation(ctx, recordAddr, record.Validator); !found {
+		k.DeleteTokenizeShareRecord(ctx, record)
+
+		balance := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, record.Owner, balance); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), validator.TokensFromShares(shares).TruncateInt()), nil
+}
+
+// transferDelegationShares moves delegation shares of a validator from one
+// delegator to another. The validator tokens and shares are left untouched,
+// while the delegation hooks are called so that the rewards of both
+// delegations are settled.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	from, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if from.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, from.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	from.Shares = from.Shares.Sub(shares)
+	if from.Shares.IsZero() {
+		k.RemoveDelegation(ctx, from)
+	} else {
+		k.SetDelegation(ctx, from)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	to, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		to = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	to.Shares = to.Shares.Add(shares)
+	k.SetDelegation(ctx, to)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLiquidStakeTest creates a bonded validator operated by the first address
+// and a delegation of the second address to it.
+func setupLiquidStakeTest(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, []sdk.ValAddress) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(100))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	_, err := msgServer.CreateValidator(sdk.WrapSDKContext(ctx), types.NewMsgCreateValidator(
+		addrVals[0], PKs[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(10)), types.Description{},
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(
+		addrDels[1], addrVals[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(50)),
+	))
+	require.NoError(t, err)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	return app, ctx, addrDels, addrVals
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, addrDels, addrVals := setupLiquidStakeTest(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	record, shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(20))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record.Id)
+	require.Equal(t, addrDels[1], record.Owner)
+	require.Equal(t, addrVals[0], record.Validator)
+	require.Equal(t, sdk.NewCoin(record.GetShareTokenDenom(), sdk.TokensFromConsensusPower(20)), shareToken)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+
+	// the shares are moved from the delegator to the record
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(30).ToDec(), delegation.Shares)
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(20).ToDec(), recordDelegation.Shares)
+
+	// the validator is left untouched
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(60), validator.Tokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(60).ToDec(), validator.DelegatorShares)
+
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, addrDels[1], shareToken.Denom))
+	require.Equal(t, sdk.TokensFromConsensusPower(20).ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+	require.Equal(t, sdk.TokensFromConsensusPower(20), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[1]))
+
+	// share tokens can be redeemed by any holder
+	err = app.BankKeeper.SendCoins(ctx, addrDels[1], addrDels[2], sdk.NewCoins(sdk.NewCoin(shareToken.Denom, sdk.TokensFromConsensusPower(5))))
+	require.NoError(t, err)
+
+	amount, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[2], sdk.NewCoin(shareToken.Denom, sdk.TokensFromConsensusPower(5)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(5)), amount)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[2], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(5).ToDec(), delegation.Shares)
+	require.True(t, app.BankKeeper.GetBalance(ctx, addrDels[2], shareToken.Denom).IsZero())
+	require.Equal(t, sdk.TokensFromConsensusPower(15).ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(t, found)
+
+	// redeeming the remaining share tokens removes the record
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(shareToken.Denom, sdk.TokensFromConsensusPower(15)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(45).ToDec(), delegation.Shares)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), addrVals[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[1]))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+}
+
+func TestTokenizeSharesErrors(t *testing.T) {
+	app, ctx, addrDels, addrVals := setupLiquidStakeTest(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.TokensFromConsensusPower(1))
+	require.True(t, types.ErrTokenizeSelfDelegation.Is(err), err)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[2], sdk.TokensFromConsensusPower(1))
+	require.True(t, types.ErrNoValidatorFound.Is(err), err)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[2], addrVals[0], sdk.TokensFromConsensusPower(1))
+	require.True(t, types.ErrNoDelegation.Is(err), err)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(60))
+	require.True(t, types.ErrBadSharesAmount.Is(err), err)
+
+	// the validator cap limits the tokenized shares of a validator
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(10))
+	require.True(t, types.ErrValidatorLiquidStakingCapExceeded.Is(err), err)
+
+	// the global cap limits the tokenized shares of all validators
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(10))
+	require.True(t, types.ErrGlobalLiquidStakingCapExceeded.Is(err), err)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(6))
+	require.NoError(t, err)
+
+	// delegations received through a redelegation cannot be tokenized
+	app.StakingKeeper.SetRedelegation(ctx, types.NewRedelegation(
+		addrDels[1], addrVals[1], addrVals[0], 0, time.Unix(0, 0), sdk.NewInt(5), sdk.NewDec(5),
+	))
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(1))
+	require.True(t, types.ErrTokenizeRedelegationInProgress.Is(err), err)
+
+	// only share tokens of existing records can be redeemed
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1)))
+	require.True(t, types.ErrNotTokenizeShareDenom.Is(err), err)
+
+	record := types.NewTokenizeShareRecord(2, addrDels[1], addrVals[0])
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(record.GetShareTokenDenom(), sdk.TokensFromConsensusPower(1)))
+	require.True(t, types.ErrTokenizeShareRecordNotFound.Is(err), err)
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	app, ctx, addrDels, addrVals := setupLiquidStakeTest(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// the delegation of the delegator is made of vesting coins
+	acc := app.AccountKeeper.GetAccount(ctx, addrDels[1]).(*authtypes.BaseAccount)
+	origVesting := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(50)))
+	bva := vestingtypes.NewBaseVestingAccount(acc, origVesting, ctx.BlockTime().Unix()+1000)
+	vacc := vestingtypes.NewContinuousVestingAccountRaw(bva, ctx.BlockTime().Unix())
+	vacc.DelegatedVesting = origVesting
+	app.AccountKeeper.SetAccount(ctx, vacc)
+
+	// vesting delegations cannot be tokenized
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(10))
+	require.True(t, types.ErrTokenizeVestingDelegation.Is(err), err)
+
+	// free delegations are released from the delegation tracking
+	vacc.DelegatedVesting = sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(40)))
+	vacc.DelegatedFree = sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(10)))
+	app.AccountKeeper.SetAccount(ctx, vacc)
+
+	// the delegation tracking is left untouched when a cap is exceeded
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(10))
+	require.True(t, types.ErrValidatorLiquidStakingCapExceeded.Is(err), err)
+
+	stored := app.AccountKeeper.GetAccount(ctx, addrDels[1]).(*vestingtypes.ContinuousVestingAccount)
+	require.Equal(t, vacc.DelegatedFree, stored.DelegatedFree)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(10))
+	require.NoError(t, err)
+
+	vacc = app.AccountKeeper.GetAccount(ctx, addrDels[1]).(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, vacc.DelegatedFree.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(40))), vacc.DelegatedVesting)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/staking store from version 1 to 2. The
+// GlobalLiquidStakingCap and ValidatorLiquidStakingCap params added in version
+// 2 are set to their default values.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	ps := m.keeper.paramstore
+
+	if !ps.Has(ctx, types.KeyGlobalLiquidStakingCap) {
+		ps.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	}
+
+	if !ps.Has(ctx, types.KeyValidatorLiquidStakingCap) {
+		ps.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the x/staking store from version 2 to 3. The
+// MinCommissionRate and GlobalMinSelfDelegation params added in version 3 are
+// set to their default values, and the validators are raised to these floors.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	ps := m.keeper.paramstore
+
+	if !ps.Has(ctx, types.KeyMinCommissionRate) {
+		ps.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	if !ps.Has(ctx, types.KeyGlobalMinSelfDelegation) {
+		ps.Set(ctx, types.KeyGlobalMinSelfDelegation, types.DefaultGlobalMinSelfDelegation)
+	}
+
+	m.keeper.ApplyValidatorParamFloors(ctx)
+
+	return nil
+}
@@ -39,6 +39,39 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens which
+// may be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the delegator shares of a
+// validator which may be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// MinCommissionRate - Minimum commission rate of the validators
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// GlobalMinSelfDelegation - Minimum self delegation of the validators
+func (k Keeper) GlobalMinSelfDelegation(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyGlobalMinSelfDelegation, &res)
+	return
+}
+
+// ValidatorParamFloorsModified returns true if the MinCommissionRate or the
+// GlobalMinSelfDelegation params were set in the current block
+func (k Keeper) ValidatorParamFloorsModified(ctx sdk.Context) bool {
+	return k.paramstore.Modified(ctx, types.KeyMinCommissionRate) ||
+		k.paramstore.Modified(ctx, types.KeyGlobalMinSelfDelegation)
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -47,6 +80,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
+		k.MinCommissionRate(ctx),
+		k.GlobalMinSelfDelegation(ctx),
 	)
 }
 
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+// created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+// created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and its owner index.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(types.GetTokenizeShareRecordByOwnerKey(record.Owner, record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its owner
+// index.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordByOwnerKey(record.Owner, record.Id))
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records of an
+// owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordsByOwnerKey(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record owner index without record")
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by
+// tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator held by
+// tokenize share records. Zero shares are removed from the store.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorLiquidSharesKey(valAddr)
+
+	if shares.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares}))
+}
+
+// IterateValidatorLiquidShares iterates over the validators with tokenized
+// shares and performs a callback function.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dp)
+
+		if cb(sdk.ValAddress(iterator.Key()[1:]), dp.Dec) {
+			break
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -173,12 +174,62 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minCommissionRate := k.MinCommissionRate(ctx); newRate.LT(minCommissionRate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "got %s, expected at least %s", newRate, minCommissionRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// ApplyValidatorParamFloors raises the validators below the MinCommissionRate
+// and GlobalMinSelfDelegation params up to them. The max commission rate of a
+// validator is raised along with its commission rate when needed, and the
+// commission update time is set to the block time. Validators whose self
+// delegation falls below their raised minimum self delegation are jailed.
+func (k Keeper) ApplyValidatorParamFloors(ctx sdk.Context) {
+	minCommissionRate := k.MinCommissionRate(ctx)
+	globalMinSelfDelegation := k.GlobalMinSelfDelegation(ctx)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if !validator.Commission.Rate.LT(minCommissionRate) && !validator.MinSelfDelegation.LT(globalMinSelfDelegation) {
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validator.Commission.Rate = minCommissionRate
+			validator.Commission.MaxRate = sdk.MaxDec(validator.Commission.MaxRate, minCommissionRate)
+			validator.Commission.UpdateTime = ctx.BlockHeader().Time
+		}
+
+		validator.MinSelfDelegation = sdk.MaxInt(validator.MinSelfDelegation, globalMinSelfDelegation)
+
+		k.SetValidator(ctx, validator)
+
+		// as when undelegating, the validator is jailed if its self delegation
+		// is below its minimum self delegation
+		if !validator.Jailed && k.selfDelegationTokens(ctx, validator).LT(validator.MinSelfDelegation) {
+			k.jailValidator(ctx, validator)
+		}
+	}
+}
+
+// selfDelegationTokens returns the amount of tokens delegated by the operator
+// of the validator to it.
+func (k Keeper) selfDelegationTokens(ctx sdk.Context, validator types.Validator) sdk.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.OperatorAddress), validator.OperatorAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -10,6 +11,7 @@ import (
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,11 +19,14 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator   = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate        = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCreateValidator           = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator             = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate                  = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
+	OpWeightMsgTokenizeShares            = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares     = "op_weight_msg_redeem_tokens_for_shares"
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -30,11 +35,14 @@ func WeightedOperations(
 	bk types.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreateValidator int
-		weightMsgEditValidator   int
-		weightMsgDelegate        int
-		weightMsgUndelegate      int
-		weightMsgBeginRedelegate int
+		weightMsgCreateValidator           int
+		weightMsgEditValidator             int
+		weightMsgDelegate                  int
+		weightMsgUndelegate                int
+		weightMsgBeginRedelegate           int
+		weightMsgTokenizeShares            int
+		weightMsgRedeemTokensForShares     int
+		weightMsgCancelUnbondingDelegation int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +75,24 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &weightMsgTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgTokenizeShares = simappparams.DefaultWeightMsgTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &weightMsgRedeemTokensForShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeemTokensForShares = simappparams.DefaultWeightMsgRedeemTokensForShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +114,18 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTokenizeShares,
+			SimulateMsgTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeemTokensForShares,
+			SimulateMsgRedeemTokensForShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
 	}
 }
 
@@ -141,14 +179,21 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, k k
 			simtypes.RandStringOfLength(r, 10),
 		)
 
-		maxCommission := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+		// the commission rate cannot be lower than the minimum commission rate
+		minCommission := k.MinCommissionRate(ctx)
+		maxCommission := sdk.MaxDec(sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2), minCommission)
 		commission := types.NewCommissionRates(
-			simtypes.RandomDecAmount(r, maxCommission),
+			minCommission.Add(simtypes.RandomDecAmount(r, maxCommission.Sub(minCommission))),
 			maxCommission,
 			simtypes.RandomDecAmount(r, maxCommission),
 		)
 
-		msg := types.NewMsgCreateValidator(address, simAccount.ConsKey.PubKey(), selfDelegation, description, commission, sdk.OneInt())
+		minSelfDelegation := sdk.MaxInt(sdk.OneInt(), k.GlobalMinSelfDelegation(ctx))
+		if selfDelegation.Amount.LT(minSelfDelegation) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "self delegation is below the global minimum"), nil, nil
+		}
+
+		msg := types.NewMsgCreateValidator(address, simAccount.ConsKey.PubKey(), selfDelegation, description, commission, minSelfDelegation)
 
 		txGen := simappparams.MakeEncodingConfig().TxConfig
 		tx, err := helpers.GenTx(
@@ -191,7 +236,12 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 
 		address := val.GetOperator()
 
-		newCommissionRate := simtypes.RandomDecAmount(r, val.Commission.MaxRate)
+		minCommission := k.MinCommissionRate(ctx)
+		if val.Commission.MaxRate.LT(minCommission) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, "max commission rate is below the minimum commission rate"), nil, nil
+		}
+
+		newCommissionRate := minCommission.Add(simtypes.RandomDecAmount(r, val.Commission.MaxRate.Sub(minCommission)))
 
 		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.BlockHeader().Time); err != nil {
 			// skip as the commission is invalid
@@ -340,6 +390,10 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		if isTokenizeShareRecordAddress(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "delegation is held by a tokenize share record"), nil, nil
+		}
+
 		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
 		}
@@ -408,6 +462,74 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 	}
 }
 
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation
+// with random values
+// nolint: interfacer
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		ubds := k.GetUnbondingDelegations(ctx, simAccount.Address, math.MaxUint16)
+		if len(ubds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "account does not have any unbonding delegations"), nil, nil
+		}
+
+		ubd := ubds[r.Intn(len(ubds))]
+
+		validator, found := k.GetValidator(ctx, ubd.ValidatorAddress)
+		if !found || validator.IsJailed() || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is not ok"), nil, nil
+		}
+
+		// mature entries are completed in the EndBlocker and cannot be cancelled
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) || !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry is not ok"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid cancel amount"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			simAccount.Address, ubd.ValidatorAddress, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgBeginRedelegate generates a MsgBeginRedelegate with random values
 // nolint: interfacer
 func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
@@ -430,6 +552,10 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		if isTokenizeShareRecordAddress(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "delegation is held by a tokenize share record"), nil, nil
+		}
+
 		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
 		}
@@ -520,3 +646,189 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgTokenizeShares generates a MsgTokenizeShares with random values
+// nolint: interfacer
+func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "unable to pick validator"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+
+		simAccount, found := simtypes.FindAccount(accs, delegation.GetDelegatorAddr())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "delegator is not a simulation account"), nil, nil
+		}
+
+		if sdk.ValAddress(simAccount.Address).Equals(valAddr) || k.HasReceivingRedelegation(ctx, simAccount.Address, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "checks failed"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if _, ok := account.(vestexported.VestingAccount); ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "account is a vesting account"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "total bond is negative"), nil, nil
+		}
+
+		tokenizeAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "unable to generate positive amount"), nil, err
+		}
+
+		// check that the shares do not truncate to zero and the liquid staking
+		// caps are not exceeded
+		shares, err := validator.SharesFromTokens(tokenizeAmt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "invalid shares"), nil, err
+		}
+
+		shares = shares.TruncateDec()
+		if shares.IsZero() || shares.GT(delegation.GetShares()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "invalid shares amount"), nil, nil
+		}
+
+		totalLiquid := k.GetTotalLiquidStakedTokens(ctx).Add(validator.TokensFromShares(shares).TruncateInt()).ToDec()
+		if totalLiquid.GT(k.GlobalLiquidStakingCap(ctx).Mul(k.TotalBondedTokens(ctx).ToDec())) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "global liquid staking cap exceeded"), nil, nil
+		}
+
+		liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+		if liquidShares.GT(k.ValidatorLiquidStakingCap(ctx).Mul(validator.DelegatorShares)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator liquid staking cap exceeded"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgTokenizeShares(simAccount.Address, valAddr, sdk.NewCoin(k.BondDenom(ctx), tokenizeAmt))
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares generates a MsgRedeemTokensForShares with
+// random values
+// nolint: interfacer
+func SimulateMsgRedeemTokensForShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		// find the share tokens held by the account
+		var shareTokens sdk.Coins
+
+		for _, coin := range spendable {
+			id, err := types.ParseShareTokenDenom(coin.Denom)
+			if err != nil {
+				continue
+			}
+
+			record, found := k.GetTokenizeShareRecord(ctx, id)
+			if found && record.GetShareTokenDenom() == coin.Denom {
+				shareTokens = append(shareTokens, coin)
+			}
+		}
+
+		if len(shareTokens) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "account does not have any share tokens"), nil, nil
+		}
+
+		shareToken := shareTokens[r.Intn(len(shareTokens))]
+
+		redeemAmt, err := simtypes.RandPositiveInt(r, shareToken.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "unable to generate positive amount"), nil, err
+		}
+
+		redeemCoin := sdk.NewCoin(shareToken.Denom, redeemAmt)
+
+		var fees sdk.Coins
+
+		coins, hasNeg := spendable.SafeSub(sdk.Coins{redeemCoin})
+		if !hasNeg {
+			fees, err = simtypes.RandomFees(r, ctx, coins)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "unable to generate fees"), nil, err
+			}
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(simAccount.Address, redeemCoin)
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// isTokenizeShareRecordAddress returns true if the address is the account of a
+// tokenize share record, for which no simulation account can sign.
+func isTokenizeShareRecordAddress(ctx sdk.Context, k keeper.Keeper, addr sdk.AccAddress) bool {
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		if record.GetModuleAddress().Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -31,5 +31,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GetHistEntries(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMinCommissionRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinCommissionRate(r))
+			},
+		),
 	}
 }
@@ -22,11 +22,12 @@ func TestParamChanges(t *testing.T) {
 		{"staking/MaxValidators", "MaxValidators", "82", "staking"},
 		{"staking/UnbondingTime", "UnbondingTime", "\"275307000000000\"", "staking"},
 		{"staking/HistoricalEntries", "HistoricalEntries", "29", "staking"},
+		{"staking/MinCommissionRate", "MinCommissionRate", "\"0.030000000000000000\"", "staking"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 4)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -57,4 +57,18 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+
+	ErrTokenizeShareRecordNotFound       = sdkerrors.Register(ModuleName, 48, "tokenize share record not found")
+	ErrNotTokenizeShareDenom             = sdkerrors.Register(ModuleName, 49, "not a tokenize share denom")
+	ErrTokenizeSelfDelegation            = sdkerrors.Register(ModuleName, 50, "validator self delegation cannot be tokenized")
+	ErrTokenizeRedelegationInProgress    = sdkerrors.Register(ModuleName, 51, "delegation with a redelegation in progress cannot be tokenized")
+	ErrTokenizeVestingDelegation         = sdkerrors.Register(ModuleName, 52, "vesting delegation cannot be tokenized")
+	ErrTinyTokenizeAmount                = sdkerrors.Register(ModuleName, 53, "too few shares to tokenize (truncates to zero tokens)")
+	ErrGlobalLiquidStakingCapExceeded    = sdkerrors.Register(ModuleName, 54, "global liquid staking cap exceeded")
+	ErrValidatorLiquidStakingCapExceeded = sdkerrors.Register(ModuleName, 55, "validator liquid staking cap exceeded")
+	ErrInvalidCreationHeight             = sdkerrors.Register(ModuleName, 56, "invalid unbonding delegation creation height")
+	ErrNoUnbondingDelegationEntry        = sdkerrors.Register(ModuleName, 57, "no unbonding delegation entry found at creation height")
+	ErrCancelUnbondingAmountTooLarge     = sdkerrors.Register(ModuleName, 58, "amount is greater than the unbonding delegation entry balance")
+	ErrCommissionLTMinRate               = sdkerrors.Register(ModuleName, 59, "commission cannot be less than the min rate")
+	ErrMinSelfDelegationBelowGlobalMin   = sdkerrors.Register(ModuleName, 60, "minimum self delegation cannot be less than the global min self delegation")
 )
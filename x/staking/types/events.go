@@ -2,13 +2,16 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +20,7 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyCreationHeight    = "creation_height"
 	AttributeValueCategory        = ModuleName
 )
@@ -43,6 +43,12 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at
+	// genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record
+	// created.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +133,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -192,38 +212,43 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x86, 0x6b, 0xba, 0x76, 0x95, 0x5b, 0x10, 0x32, 0x1d, 0x44, 0x15, 0x4a, 0x4a, 0x54, 0xa1,
-	0x0a, 0x58, 0xa2, 0x8e, 0x1b, 0xe2, 0x42, 0x84, 0x98, 0x06, 0x1c, 0xaa, 0x00, 0x3b, 0x70, 0xa9,
-	0xdc, 0xc5, 0x0a, 0xd1, 0xd2, 0x38, 0xca, 0xcf, 0xdd, 0x9f, 0x3b, 0xe2, 0xcc, 0xc7, 0xda, 0x71,
-	0x07, 0x0e, 0x88, 0x43, 0x84, 0xda, 0x6f, 0xb0, 0x23, 0x27, 0x14, 0x3b, 0x0d, 0xa1, 0x5d, 0x10,
-	0xa7, 0xd6, 0xd6, 0xf3, 0x3e, 0xaf, 0xed, 0xd8, 0x78, 0x70, 0xc4, 0x61, 0xc6, 0xc1, 0x06, 0x41,
-	0x8f, 0x83, 0xc8, 0xb7, 0x4f, 0x46, 0x53, 0x26, 0xe8, 0xc8, 0xf6, 0x59, 0xc4, 0x20, 0x00, 0x2b,
-	0x4e, 0xb8, 0xe0, 0xe4, 0xae, 0xa2, 0xac, 0x9c, 0xb2, 0x72, 0xaa, 0xd7, 0xf5, 0xb9, 0xcf, 0x25,
-	0x62, 0x67, 0xff, 0x14, 0xdd, 0xab, 0x72, 0xae, 0xd2, 0x92, 0x32, 0xbf, 0x35, 0x70, 0x67, 0x5f,
-	0xb5, 0xbc, 0x13, 0x54, 0x30, 0xf2, 0x1c, 0x37, 0x63, 0x9a, 0xd0, 0x19, 0x68, 0xa8, 0x8f, 0x86,
-	0xed, 0x3d, 0xdd, 0xba, 0xbe, 0xd5, 0x1a, 0x4b, 0xca, 0xd9, 0xba, 0x48, 0x8d, 0x9a, 0x9b, 0x67,
-	0x08, 0xe0, 0xdb, 0x21, 0x05, 0x31, 0x11, 0x5c, 0xd0, 0x70, 0x12, 0xf3, 0x53, 0x96, 0x68, 0x37,
-	0xfa, 0x68, 0xd8, 0x71, 0x0e, 0x32, 0xee, 0x47, 0x6a, 0x3c, 0xf4, 0x03, 0xf1, 0x69, 0x3e, 0xb5,
-	0x8e, 0xf8, 0xcc, 0xce, 0x57, 0xa8, 0x7e, 0x76, 0xc1, 0x3b, 0xb6, 0xc5, 0x79, 0xcc, 0xc0, 0x3a,
-	0x88, 0xc4, 0x55, 0x6a, 0xdc, 0x3b, 0xa7, 0xb3, 0xf0, 0x99, 0xb9, 0xee, 0x33, 0xdd, 0x5b, 0xd9,
-	0xd4, 0xfb, 0x6c, 0x66, 0x9c, 0x4d, 0x90, 0xcf, 0x08, 0xef, 0x48, 0xea, 0x84, 0x86, 0x81, 0x47,
-	0x05, 0x4f, 0x14, 0x09, 0x5a, 0xbd, 0x5f, 0x1f, 0xb6, 0xf7, 0x1e, 0x55, 0x6d, 0xe1, 0x2d, 0x05,
-	0x71, 0xb8, 0xca, 0x48, 0x97, 0x33, 0xc8, 0x96, 0x79, 0x95, 0x1a, 0xf7, 0x4b, 0xe5, 0xeb, 0x5a,
-	0xd3, 0xbd, 0x13, 0x6e, 0x24, 0x81, 0xec, 0x63, 0x5c, 0x90, 0xa0, 0x6d, 0xc9, 0xea, 0x07, 0x55,
-	0xd5, 0x45, 0x38, 0x3f, 0xc0, 0x52, 0x94, 0xbc, 0xc6, 0x6d, 0x8f, 0x85, 0xcc, 0xa7, 0x22, 0xe0,
-	0x11, 0x68, 0x0d, 0x69, 0x32, 0xab, 0x4c, 0x2f, 0x0b, 0x34, 0x57, 0x95, 0xc3, 0xe4, 0x0b, 0xc2,
-	0x3b, 0xf3, 0x68, 0xca, 0x23, 0x2f, 0x88, 0xfc, 0x49, 0x59, 0xdb, 0x94, 0xda, 0xc7, 0x55, 0xda,
-	0x0f, 0xab, 0x50, 0xc9, 0xbf, 0x76, 0x38, 0xd7, 0x7a, 0x4d, 0xb7, 0x3b, 0xdf, 0x8c, 0x02, 0x19,
-	0xe3, 0x9b, 0x09, 0x2b, 0xf7, 0x6f, 0xcb, 0xfe, 0x41, 0x55, 0xbf, 0x5b, 0x82, 0xf3, 0x8d, 0xfd,
-	0x2d, 0x20, 0x3d, 0xdc, 0x62, 0x67, 0x31, 0x4f, 0x04, 0xf3, 0xb4, 0x56, 0x1f, 0x0d, 0x5b, 0x6e,
-	0x31, 0x36, 0x4f, 0x31, 0xd9, 0xfc, 0xb8, 0xe4, 0x0d, 0xde, 0xa6, 0x9e, 0x97, 0x30, 0x50, 0x97,
-	0xbb, 0xe3, 0x8c, 0x7e, 0xa5, 0xc6, 0xee, 0x7f, 0x5c, 0xc8, 0x43, 0x1a, 0xbe, 0x50, 0x41, 0x77,
-	0x65, 0x20, 0x5d, 0xdc, 0xf8, 0x73, 0xbf, 0xeb, 0xae, 0x1a, 0x38, 0xaf, 0x2e, 0x16, 0x3a, 0xba,
-	0x5c, 0xe8, 0xe8, 0xe7, 0x42, 0x47, 0x5f, 0x97, 0x7a, 0xed, 0x72, 0xa9, 0xd7, 0xbe, 0x2f, 0xf5,
-	0xda, 0xc7, 0x27, 0xff, 0xec, 0x39, 0x2b, 0xde, 0xa9, 0x6c, 0x9c, 0x36, 0xe5, 0xf3, 0x7c, 0xfa,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0xac, 0x6c, 0x97, 0xa5, 0x1a, 0x04, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x8f, 0xd2, 0x40,
+	0x1c, 0x86, 0xa9, 0xcb, 0x02, 0x0e, 0x68, 0xcc, 0xc8, 0xae, 0x95, 0xb8, 0x2d, 0x36, 0x68, 0x1a,
+	0x75, 0xdb, 0xb0, 0xde, 0x8c, 0x17, 0x1b, 0xe3, 0x06, 0xf5, 0x40, 0xba, 0xeb, 0x1e, 0xbc, 0x34,
+	0x03, 0x33, 0xe9, 0x56, 0x4a, 0x87, 0x74, 0x86, 0xfd, 0xe3, 0xd9, 0x18, 0x8f, 0x7e, 0xac, 0x4d,
+	0xbc, 0xec, 0xd1, 0x78, 0x68, 0x0c, 0x7c, 0x03, 0x8e, 0x9e, 0x4c, 0xa7, 0x05, 0xbb, 0x40, 0x37,
+	0x9e, 0xa0, 0x93, 0xf7, 0x79, 0xde, 0xfe, 0x9a, 0x99, 0x01, 0xad, 0x3e, 0x65, 0x43, 0xca, 0x4c,
+	0xc6, 0xd1, 0xc0, 0x0b, 0x5c, 0xf3, 0xa4, 0xdd, 0x23, 0x1c, 0xb5, 0x4d, 0x97, 0x04, 0x84, 0x79,
+	0xcc, 0x18, 0x85, 0x94, 0x53, 0xb8, 0x9d, 0xa4, 0x8c, 0x34, 0x65, 0xa4, 0xa9, 0x46, 0xdd, 0xa5,
+	0x2e, 0x15, 0x11, 0x33, 0xfe, 0x97, 0xa4, 0x1b, 0x79, 0xce, 0x39, 0x2d, 0x52, 0xda, 0x8f, 0x32,
+	0xa8, 0xed, 0x27, 0x2d, 0x07, 0x1c, 0x71, 0x02, 0x5f, 0x82, 0xd2, 0x08, 0x85, 0x68, 0xc8, 0x64,
+	0xa9, 0x29, 0xe9, 0xd5, 0x3d, 0xc5, 0x58, 0xdf, 0x6a, 0x74, 0x45, 0xca, 0x2a, 0x5e, 0x44, 0x6a,
+	0xc1, 0x4e, 0x19, 0xc8, 0xc0, 0x1d, 0x1f, 0x31, 0xee, 0x70, 0xca, 0x91, 0xef, 0x8c, 0xe8, 0x29,
+	0x09, 0xe5, 0x1b, 0x4d, 0x49, 0xaf, 0x59, 0x9d, 0x38, 0xf7, 0x2b, 0x52, 0x1f, 0xbb, 0x1e, 0x3f,
+	0x1e, 0xf7, 0x8c, 0x3e, 0x1d, 0x9a, 0xe9, 0x1b, 0x26, 0x3f, 0xbb, 0x0c, 0x0f, 0x4c, 0x7e, 0x3e,
+	0x22, 0xcc, 0xe8, 0x04, 0x7c, 0x16, 0xa9, 0xf7, 0xce, 0xd1, 0xd0, 0x7f, 0xa1, 0x2d, 0xfb, 0x34,
+	0xfb, 0x76, 0xbc, 0x74, 0x18, 0xaf, 0x74, 0xe3, 0x05, 0xf8, 0x45, 0x02, 0x5b, 0x22, 0x75, 0x82,
+	0x7c, 0x0f, 0x23, 0x4e, 0xc3, 0x24, 0xc9, 0xe4, 0x8d, 0xe6, 0x86, 0x5e, 0xdd, 0x7b, 0x92, 0x37,
+	0xc2, 0x7b, 0xc4, 0xf8, 0xd1, 0x9c, 0x11, 0x2e, 0xab, 0x15, 0xbf, 0xe6, 0x2c, 0x52, 0x1f, 0x64,
+	0xca, 0x97, 0xb5, 0x9a, 0x7d, 0xd7, 0x5f, 0x21, 0x19, 0xdc, 0x07, 0x60, 0x91, 0x64, 0x72, 0x51,
+	0x54, 0x3f, 0xcc, 0xab, 0x5e, 0xc0, 0xe9, 0x07, 0xcc, 0xa0, 0xf0, 0x2d, 0xa8, 0x62, 0xe2, 0x13,
+	0x17, 0x71, 0x8f, 0x06, 0x4c, 0xde, 0x14, 0x26, 0x2d, 0xcf, 0xf4, 0x7a, 0x11, 0x4d, 0x55, 0x59,
+	0x18, 0x7e, 0x95, 0xc0, 0xd6, 0x38, 0xe8, 0xd1, 0x00, 0x7b, 0x81, 0xeb, 0x64, 0xb5, 0x25, 0xa1,
+	0x7d, 0x9a, 0xa7, 0xfd, 0x30, 0x87, 0x32, 0xfe, 0xa5, 0x8f, 0xb3, 0xd6, 0xab, 0xd9, 0xf5, 0xf1,
+	0x2a, 0xca, 0x60, 0x17, 0xdc, 0x0a, 0x49, 0xb6, 0xbf, 0x2c, 0xfa, 0x5b, 0x79, 0xfd, 0x36, 0xc1,
+	0xcb, 0x83, 0x5d, 0x15, 0xc0, 0x06, 0xa8, 0x90, 0xb3, 0x11, 0x0d, 0x39, 0xc1, 0x72, 0xa5, 0x29,
+	0xe9, 0x15, 0x7b, 0xf1, 0x0c, 0xbf, 0x49, 0x60, 0x9b, 0xd3, 0x01, 0x09, 0xbc, 0xcf, 0xc4, 0x61,
+	0xc7, 0x28, 0x24, 0x4e, 0x48, 0xfa, 0x34, 0xc4, 0x4c, 0xbe, 0x79, 0xfd, 0xdc, 0x87, 0x29, 0x75,
+	0x10, 0x43, 0xb6, 0x60, 0xac, 0x47, 0xe9, 0xdc, 0x3b, 0xc9, 0xdc, 0xeb, 0xc5, 0x9a, 0x5d, 0xe7,
+	0xab, 0x2c, 0x83, 0x9f, 0xc0, 0x4e, 0xba, 0x85, 0xd7, 0x50, 0x8e, 0x87, 0x65, 0xd0, 0x94, 0xf4,
+	0xa2, 0xa5, 0xcf, 0x22, 0xb5, 0x75, 0x65, 0xc7, 0xaf, 0x8f, 0x6b, 0xf6, 0xfd, 0x64, 0xfb, 0xaf,
+	0x54, 0x75, 0xb0, 0x76, 0x0a, 0xe0, 0xea, 0x9e, 0x86, 0xef, 0x40, 0x19, 0x61, 0x1c, 0x12, 0x96,
+	0x9c, 0xe9, 0x9a, 0xd5, 0xfe, 0x13, 0xa9, 0xbb, 0xff, 0x71, 0x0e, 0x8f, 0x90, 0xff, 0x2a, 0x01,
+	0xed, 0xb9, 0x01, 0xd6, 0xc1, 0xe6, 0xbf, 0x63, 0xbd, 0x61, 0x27, 0x0f, 0xd6, 0x9b, 0x8b, 0x89,
+	0x22, 0x5d, 0x4e, 0x14, 0xe9, 0xf7, 0x44, 0x91, 0xbe, 0x4f, 0x95, 0xc2, 0xe5, 0x54, 0x29, 0xfc,
+	0x9c, 0x2a, 0x85, 0x8f, 0xcf, 0xae, 0xed, 0x39, 0x5b, 0x5c, 0x4f, 0xa2, 0xb1, 0x57, 0x12, 0xb7,
+	0xd2, 0xf3, 0xbf, 0x03, 0x00, 0x79, 0x69, 0x5f, 0xa9, 0x11, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +271,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -438,6 +482,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -748,6 +801,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,6 +46,11 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordKey        = []byte{0x61} // prefix for each key to a tokenize share record
+	TokenizeShareRecordByOwnerKey = []byte{0x62} // prefix for each key to a tokenize share record index, by owner
+	LastTokenizeShareRecordIDKey  = []byte{0x63} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey      = []byte{0x64} // prefix for each key to the tokenized shares of a validator
 )
 
 // gets the key for the validator with address
@@ -325,3 +330,29 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordsByOwnerKey returns the key prefix of the tokenize
+// share records index of an owner.
+func GetTokenizeShareRecordsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerKey, owner.Bytes()...)
+}
+
+// GetTokenizeShareRecordByOwnerKey returns the index key of a tokenize share
+// record by owner.
+// VALUE: none (the record id is the last 8 bytes of the key)
+func GetTokenizeShareRecordByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized shares of a
+// validator.
+// VALUE: sdk.DecProto
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, valAddr.Bytes()...)
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,66 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateLiquidStakingCaps(t *testing.T) {
+	tests := []struct {
+		name       string
+		cap        sdk.Dec
+		expectPass bool
+	}{
+		{"zero", sdk.ZeroDec(), true},
+		{"fraction", sdk.NewDecWithPrec(25, 2), true},
+		{"one", sdk.OneDec(), true},
+		{"negative", sdk.NewDec(-1), false},
+		{"greater than one", sdk.NewDecWithPrec(11, 1), false},
+		{"nil", sdk.Dec{}, false},
+	}
+
+	for _, tc := range tests {
+		params := DefaultParams()
+		params.GlobalLiquidStakingCap = tc.cap
+		require.Equal(t, tc.expectPass, params.Validate() == nil, "test: %v", tc.name)
+
+		params = DefaultParams()
+		params.ValidatorLiquidStakingCap = tc.cap
+		require.Equal(t, tc.expectPass, params.Validate() == nil, "test: %v", tc.name)
+	}
+}
+
+func TestValidateValidatorParamFloors(t *testing.T) {
+	rateTests := []struct {
+		name       string
+		rate       sdk.Dec
+		expectPass bool
+	}{
+		{"zero", sdk.ZeroDec(), true},
+		{"fraction", sdk.NewDecWithPrec(5, 2), true},
+		{"one", sdk.OneDec(), true},
+		{"negative", sdk.NewDecWithPrec(-1, 2), false},
+		{"greater than one", sdk.NewDecWithPrec(11, 1), false},
+		{"nil", sdk.Dec{}, false},
+	}
+
+	for _, tc := range rateTests {
+		params := DefaultParams()
+		params.MinCommissionRate = tc.rate
+		require.Equal(t, tc.expectPass, params.Validate() == nil, "test: %v", tc.name)
+	}
+
+	selfDelegationTests := []struct {
+		name       string
+		amount     sdk.Int
+		expectPass bool
+	}{
+		{"zero", sdk.ZeroInt(), true},
+		{"positive", sdk.NewInt(1000), true},
+		{"negative", sdk.NewInt(-1), false},
+		{"nil", sdk.Int{}, false},
+	}
+
+	for _, tc := range selfDelegationTests {
+		params := DefaultParams()
+		params.GlobalMinSelfDelegation = tc.amount
+		require.Equal(t, tc.expectPass, params.Validate() == nil, "test: %v", tc.name)
+	}
+}
@@ -1484,6 +1484,272 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryTokenizeShareRecordByIdRequest is request type for the
+// Query/TokenizeShareRecordById RPC method.
+type QueryTokenizeShareRecordByIdRequest struct {
+	// id defines the id of the tokenize share record to query for.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordByIdRequest) Reset()         { *m = QueryTokenizeShareRecordByIdRequest{} }
+func (m *QueryTokenizeShareRecordByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordByIdRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordByIdRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordByIdRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTokenizeShareRecordByIdResponse is response type for the
+// Query/TokenizeShareRecordById RPC method.
+type QueryTokenizeShareRecordByIdResponse struct {
+	Record TokenizeShareRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) Reset()         { *m = QueryTokenizeShareRecordByIdResponse{} }
+func (m *QueryTokenizeShareRecordByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordByIdResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordByIdResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordByIdResponse) GetRecord() TokenizeShareRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TokenizeShareRecord{}
+}
+
+// QueryTokenizeShareRecordsOwnedRequest is request type for the
+// Query/TokenizeShareRecordsOwned RPC method.
+type QueryTokenizeShareRecordsOwnedRequest struct {
+	// owner defines the owner address to query for.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) Reset()         { *m = QueryTokenizeShareRecordsOwnedRequest{} }
+func (m *QueryTokenizeShareRecordsOwnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordsOwnedRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordsOwnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordsOwnedRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryTokenizeShareRecordsOwnedResponse is response type for the
+// Query/TokenizeShareRecordsOwned RPC method.
+type QueryTokenizeShareRecordsOwnedResponse struct {
+	Records []TokenizeShareRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) Reset() {
+	*m = QueryTokenizeShareRecordsOwnedResponse{}
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordsOwnedResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordsOwnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordsOwnedResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) GetRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// QueryTotalLiquidStakedRequest is request type for the Query/TotalLiquidStaked
+// RPC method.
+type QueryTotalLiquidStakedRequest struct {
+}
+
+func (m *QueryTotalLiquidStakedRequest) Reset()         { *m = QueryTotalLiquidStakedRequest{} }
+func (m *QueryTotalLiquidStakedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{32}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedRequest proto.InternalMessageInfo
+
+// QueryTotalLiquidStakedResponse is response type for the
+// Query/TotalLiquidStaked RPC method.
+type QueryTotalLiquidStakedResponse struct {
+	// tokens defines the amount of bonded tokens held by tokenize share records.
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+}
+
+func (m *QueryTotalLiquidStakedResponse) Reset()         { *m = QueryTotalLiquidStakedResponse{} }
+func (m *QueryTotalLiquidStakedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{33}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1513,6 +1779,12 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordByIdRequest)(nil), "cosmos.staking.v1beta1.QueryTokenizeShareRecordByIdRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordByIdResponse)(nil), "cosmos.staking.v1beta1.QueryTokenizeShareRecordByIdResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordsOwnedRequest)(nil), "cosmos.staking.v1beta1.QueryTokenizeShareRecordsOwnedRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordsOwnedResponse)(nil), "cosmos.staking.v1beta1.QueryTokenizeShareRecordsOwnedResponse")
+	proto.RegisterType((*QueryTotalLiquidStakedRequest)(nil), "cosmos.staking.v1beta1.QueryTotalLiquidStakedRequest")
+	proto.RegisterType((*QueryTotalLiquidStakedResponse)(nil), "cosmos.staking.v1beta1.QueryTotalLiquidStakedResponse")
 }
 
 func init() {
@@ -1520,90 +1792,106 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdf, 0x4f, 0x1c, 0xd5,
-	0x17, 0xe7, 0x52, 0xbe, 0x24, 0x9c, 0x7e, 0xdb, 0xd4, 0xbb, 0x80, 0x38, 0xc5, 0x85, 0x4e, 0x08,
-	0x52, 0x5a, 0x76, 0x04, 0x2a, 0x45, 0x6d, 0x54, 0xb0, 0x52, 0x49, 0x1f, 0x84, 0x35, 0x62, 0xd5,
-	0x87, 0xcd, 0xec, 0xce, 0x74, 0x76, 0xd2, 0x65, 0x67, 0x99, 0x3b, 0x90, 0x56, 0xc2, 0x83, 0xbe,
-	0xf9, 0x66, 0xe2, 0x93, 0x7f, 0x81, 0x89, 0x3e, 0xea, 0x3f, 0x60, 0x34, 0xb1, 0x26, 0x9a, 0x60,
-	0xac, 0x89, 0xbe, 0xa0, 0x01, 0x1f, 0x8c, 0xff, 0x80, 0xd1, 0x17, 0xcd, 0xdc, 0x39, 0x33, 0x3b,
-	0xc3, 0xfc, 0xdc, 0x65, 0x69, 0xca, 0x13, 0xbb, 0x77, 0xef, 0x39, 0xe7, 0xf3, 0x39, 0xbf, 0xe6,
-	0x9c, 0x01, 0xc4, 0x8a, 0xc1, 0xd6, 0x0d, 0x26, 0x31, 0x4b, 0xbe, 0xa3, 0xd7, 0x35, 0x69, 0x6b,
-	0xba, 0xac, 0x5a, 0xf2, 0xb4, 0xb4, 0xb1, 0xa9, 0x9a, 0xf7, 0x0a, 0x0d, 0xd3, 0xb0, 0x0c, 0x3a,
-	0xe8, 0xdc, 0x29, 0xe0, 0x9d, 0x02, 0xde, 0x11, 0x26, 0x51, 0xb6, 0x2c, 0x33, 0xd5, 0x11, 0xf0,
-	0xc4, 0x1b, 0xb2, 0xa6, 0xd7, 0x65, 0x4b, 0x37, 0xea, 0x8e, 0x0e, 0xa1, 0x5f, 0x33, 0x34, 0x83,
-	0x7f, 0x94, 0xec, 0x4f, 0x78, 0x3a, 0xac, 0x19, 0x86, 0x56, 0x53, 0x25, 0xb9, 0xa1, 0x4b, 0x72,
-	0xbd, 0x6e, 0x58, 0x5c, 0x84, 0xe1, 0xaf, 0x63, 0x31, 0xd8, 0x5c, 0x1c, 0xfc, 0x96, 0x78, 0x17,
-	0x06, 0x57, 0x6d, 0xdb, 0x6b, 0x72, 0x4d, 0x57, 0x64, 0xcb, 0x30, 0x59, 0x51, 0xdd, 0xd8, 0x54,
-	0x99, 0x45, 0x07, 0xa1, 0x97, 0x59, 0xb2, 0xb5, 0xc9, 0x86, 0xc8, 0x28, 0x99, 0xe8, 0x2b, 0xe2,
-	0x37, 0xba, 0x04, 0xd0, 0xc4, 0x37, 0xd4, 0x3d, 0x4a, 0x26, 0x4e, 0xcf, 0x8c, 0x17, 0x90, 0xa4,
-	0x4d, 0xa6, 0xe0, 0xb0, 0x47, 0x7b, 0x85, 0x15, 0x59, 0x53, 0x51, 0x67, 0xd1, 0x27, 0x29, 0x7e,
-	0x46, 0xe0, 0xf1, 0x90, 0x69, 0xd6, 0x30, 0xea, 0x4c, 0xa5, 0x37, 0x00, 0xb6, 0xbc, 0xd3, 0x21,
-	0x32, 0x7a, 0x6a, 0xe2, 0xf4, 0xcc, 0x85, 0x42, 0xb4, 0x23, 0x0b, 0x9e, 0xfc, 0x62, 0xcf, 0xfd,
-	0xbd, 0x91, 0xae, 0xa2, 0x4f, 0xd4, 0x56, 0x14, 0x02, 0xfb, 0x54, 0x2a, 0x58, 0x07, 0x45, 0x00,
-	0xed, 0x06, 0x0c, 0x04, 0xc1, 0xba, 0x6e, 0xba, 0x05, 0x67, 0x3d, 0x7b, 0x25, 0x59, 0x51, 0x4c,
-	0xee, 0xae, 0xff, 0x2f, 0x4e, 0xff, 0xb3, 0x37, 0x32, 0xa5, 0xe9, 0x56, 0x75, 0xb3, 0x5c, 0xa8,
-	0x18, 0xeb, 0x12, 0x46, 0xc3, 0xf9, 0x33, 0xc5, 0x94, 0x3b, 0x92, 0x75, 0xaf, 0xa1, 0x32, 0x1b,
-	0xfd, 0x82, 0xa2, 0x98, 0x2a, 0x63, 0xc5, 0x33, 0x9e, 0x22, 0xfb, 0x44, 0x2c, 0x1d, 0x0e, 0x8d,
-	0xe7, 0x9e, 0x57, 0xa0, 0xcf, 0xbb, 0xca, 0xcd, 0xb5, 0xe0, 0x9d, 0xa6, 0xa4, 0xf8, 0x35, 0x81,
-	0xd1, 0xa0, 0x85, 0xeb, 0x6a, 0x4d, 0xd5, 0x9c, 0x2c, 0x3a, 0x76, 0x7e, 0x1d, 0x4b, 0xa4, 0x3f,
-	0x08, 0x5c, 0x48, 0xa0, 0x81, 0x3e, 0x7b, 0x17, 0xfa, 0x15, 0xef, 0xb8, 0x64, 0xe2, 0xb1, 0x9b,
-	0x5c, 0x93, 0x71, 0xee, 0x6b, 0xaa, 0x72, 0x35, 0x2d, 0x9e, 0xb7, 0xfd, 0xf8, 0xe9, 0xaf, 0x23,
-	0xb9, 0xf0, 0x6f, 0xac, 0x98, 0x53, 0xc2, 0x87, 0x9d, 0xcb, 0xc2, 0xef, 0x09, 0x5c, 0x0c, 0x52,
-	0x7d, 0xa3, 0x5e, 0x36, 0xea, 0x8a, 0x5e, 0xd7, 0x4e, 0x64, 0xe8, 0x7e, 0x21, 0x30, 0x99, 0x85,
-	0x0f, 0xc6, 0xb0, 0x0c, 0xb9, 0x4d, 0xf7, 0xf7, 0x50, 0x08, 0x2f, 0xc5, 0x85, 0x30, 0x42, 0x25,
-	0xd6, 0x02, 0xf5, 0xb4, 0x1d, 0x43, 0xac, 0xbe, 0x23, 0x58, 0xbf, 0xfe, 0x34, 0xf1, 0x02, 0x83,
-	0x69, 0xd2, 0x5e, 0x60, 0x16, 0x2a, 0x15, 0x2f, 0x30, 0x9e, 0x22, 0x1e, 0x98, 0x70, 0xc8, 0xbb,
-	0x3b, 0xd4, 0x8d, 0xb6, 0xb0, 0x5b, 0x87, 0x93, 0x9e, 0xbe, 0x03, 0xb9, 0x88, 0xd2, 0xc2, 0xc6,
-	0xd4, 0x42, 0x65, 0x15, 0x69, 0xb8, 0x78, 0xc4, 0x9f, 0x08, 0x8c, 0x70, 0xc3, 0x11, 0x61, 0x3c,
-	0xc9, 0xfe, 0x5c, 0xc7, 0xde, 0x1b, 0x49, 0x0b, 0x1d, 0xbb, 0x0c, 0xbd, 0x4e, 0x86, 0xa2, 0x2f,
-	0xdb, 0x48, 0x71, 0x54, 0xd0, 0xec, 0xf5, 0xd7, 0x5d, 0x7e, 0xd1, 0x0d, 0xe3, 0x98, 0xfc, 0xd8,
-	0xa9, 0x86, 0xf1, 0x83, 0xdb, 0xeb, 0xa3, 0x69, 0xa0, 0xdf, 0x2a, 0x1d, 0xeb, 0xf5, 0x8e, 0x13,
-	0x1f, 0x52, 0x53, 0xf7, 0x38, 0xa5, 0x34, 0xf5, 0x47, 0x3c, 0x46, 0x5e, 0x53, 0x4f, 0xe1, 0x73,
-	0x12, 0x9b, 0xfa, 0xbf, 0xdd, 0xf0, 0x04, 0xe7, 0x56, 0x54, 0x95, 0x87, 0x19, 0x9b, 0x12, 0x50,
-	0x66, 0x56, 0x4a, 0x9d, 0xea, 0x45, 0xe7, 0x98, 0x59, 0x59, 0x0b, 0x3c, 0xd1, 0x4b, 0x40, 0x15,
-	0x66, 0x1d, 0x36, 0x70, 0xaa, 0x6d, 0x03, 0x0a, 0xb3, 0xd6, 0x12, 0x46, 0x86, 0x9e, 0xb6, 0xb3,
-	0x6b, 0x97, 0x80, 0x10, 0x15, 0x01, 0xcc, 0x26, 0x1d, 0x06, 0x4d, 0x35, 0xa1, 0xf8, 0x2f, 0xc7,
-	0x25, 0x94, 0x5f, 0xdd, 0xa1, 0xf2, 0x1f, 0x30, 0xd5, 0x63, 0x6d, 0x00, 0x5f, 0xb9, 0x8f, 0x38,
-	0xaf, 0x60, 0xc2, 0xdb, 0xd8, 0xa3, 0x5f, 0xf6, 0x5f, 0x84, 0x9e, 0x30, 0x27, 0x62, 0xb1, 0x7b,
-	0x40, 0x20, 0x1f, 0x03, 0xfb, 0x24, 0x8f, 0x17, 0xd5, 0xd8, 0x94, 0xea, 0xf4, 0x16, 0x79, 0x05,
-	0xeb, 0xf1, 0x55, 0x9d, 0x59, 0x86, 0xa9, 0x57, 0xe4, 0xda, 0x72, 0xfd, 0xb6, 0xe1, 0x7b, 0x8b,
-	0x50, 0x55, 0x75, 0xad, 0x6a, 0x71, 0x0b, 0xa7, 0x8a, 0xf8, 0x4d, 0x7c, 0x0b, 0xce, 0x47, 0x4a,
-	0x21, 0xb6, 0xe7, 0xa0, 0xa7, 0xaa, 0x33, 0x0b, 0x61, 0x8d, 0xc7, 0xc1, 0x3a, 0x24, 0xcd, 0x65,
-	0x44, 0x0a, 0xe7, 0xb8, 0xea, 0x15, 0xc3, 0xa8, 0x21, 0x0c, 0xf1, 0x26, 0x3c, 0xe6, 0x3b, 0x43,
-	0x23, 0x73, 0xd0, 0xd3, 0x30, 0x8c, 0x1a, 0x1a, 0x19, 0x8e, 0x33, 0x62, 0xcb, 0x20, 0x6d, 0x7e,
-	0x5f, 0xec, 0x07, 0xea, 0x28, 0x93, 0x4d, 0x79, 0xdd, 0xad, 0x50, 0xf1, 0x75, 0xc8, 0x05, 0x4e,
-	0xd1, 0xc8, 0x35, 0xe8, 0x6d, 0xf0, 0x13, 0x34, 0x93, 0x8f, 0x35, 0xc3, 0x6f, 0xb9, 0x63, 0x9b,
-	0x23, 0x33, 0xf3, 0xe7, 0x00, 0xfc, 0x8f, 0x6b, 0xa5, 0x1f, 0x13, 0x80, 0x66, 0x41, 0xd1, 0x42,
-	0x9c, 0x9a, 0xe8, 0xb7, 0x39, 0x82, 0x94, 0xf9, 0x3e, 0xce, 0xdd, 0x93, 0xef, 0xff, 0xf8, 0xfb,
-	0x47, 0xdd, 0x63, 0x54, 0x94, 0x62, 0xde, 0x23, 0xf9, 0x8a, 0xf1, 0x13, 0x02, 0x7d, 0x9e, 0x0a,
-	0x3a, 0x95, 0xcd, 0x94, 0x8b, 0xac, 0x90, 0xf5, 0x3a, 0x02, 0x7b, 0x9e, 0x03, 0x7b, 0x86, 0xce,
-	0xa6, 0x03, 0x93, 0xb6, 0x83, 0xd5, 0xb5, 0x43, 0x1f, 0x10, 0xe8, 0x8f, 0x7a, 0x4d, 0x40, 0xe7,
-	0xb3, 0xa1, 0x08, 0x0f, 0x64, 0xc2, 0xb3, 0x6d, 0x48, 0x22, 0x95, 0x1b, 0x9c, 0xca, 0x02, 0x7d,
-	0xb1, 0x0d, 0x2a, 0x92, 0xef, 0xe9, 0x47, 0xff, 0x26, 0xf0, 0x64, 0xe2, 0x0a, 0x4d, 0x17, 0xb2,
-	0xa1, 0x4c, 0x98, 0x3c, 0x85, 0xc5, 0xa3, 0xa8, 0x40, 0xc6, 0xab, 0x9c, 0xf1, 0x4d, 0xba, 0xdc,
-	0x0e, 0xe3, 0xe6, 0x98, 0xe8, 0xe7, 0xfe, 0x0d, 0x01, 0x68, 0x9a, 0x4a, 0x29, 0x8c, 0xd0, 0xee,
-	0x98, 0x52, 0x18, 0xe1, 0x95, 0x40, 0xbc, 0xc5, 0x29, 0x14, 0xe9, 0xca, 0x11, 0x83, 0x26, 0x6d,
-	0x07, 0x1f, 0x2a, 0x3b, 0xf4, 0x2f, 0x02, 0xb9, 0x08, 0xef, 0xd1, 0xab, 0x89, 0x10, 0xe3, 0xf7,
-	0x62, 0x61, 0xbe, 0x75, 0x41, 0x24, 0xb9, 0xce, 0x49, 0x6a, 0x54, 0xed, 0x34, 0xc9, 0xc8, 0x20,
-	0xd2, 0x6f, 0x09, 0xf4, 0x47, 0x6d, 0x74, 0x29, 0x65, 0x99, 0xb0, 0xcb, 0xa6, 0x94, 0x65, 0xd2,
-	0xfa, 0x28, 0x5e, 0xe3, 0xe4, 0xe7, 0xe8, 0x95, 0x38, 0xf2, 0x89, 0x51, 0xb4, 0x6b, 0x31, 0x71,
-	0xf3, 0x49, 0xa9, 0xc5, 0x2c, 0x5b, 0x60, 0x4a, 0x2d, 0x66, 0x5a, 0xbc, 0xd2, 0x6b, 0xd1, 0x63,
-	0x96, 0x31, 0x8c, 0x8c, 0x7e, 0x49, 0xe0, 0x4c, 0x60, 0x2e, 0xa7, 0xd3, 0x89, 0x40, 0xa3, 0xb6,
-	0x28, 0x61, 0xa6, 0x15, 0x11, 0xe4, 0xb2, 0xcc, 0xb9, 0xbc, 0x4c, 0x17, 0xda, 0xe1, 0x62, 0x06,
-	0x10, 0xef, 0x12, 0xc8, 0x45, 0x8c, 0xb0, 0x29, 0x55, 0x18, 0x3f, 0xba, 0x0b, 0xf3, 0xad, 0x0b,
-	0x22, 0xab, 0x25, 0xce, 0xea, 0x25, 0xfa, 0x42, 0x3b, 0xac, 0x7c, 0xcf, 0xe7, 0x3d, 0x02, 0x34,
-	0x6c, 0x87, 0xce, 0xb5, 0x08, 0xcc, 0x25, 0x74, 0xb5, 0x65, 0x39, 0xe4, 0xf3, 0x26, 0xe7, 0xb3,
-	0x4a, 0x5f, 0x3b, 0x1a, 0x9f, 0xf0, 0x63, 0xfd, 0x73, 0x02, 0x67, 0x83, 0xb3, 0x20, 0x4d, 0xce,
-	0xa2, 0xc8, 0x61, 0x55, 0x98, 0x6d, 0x49, 0x06, 0x49, 0xcd, 0x73, 0x52, 0x33, 0xf4, 0xe9, 0x38,
-	0x52, 0x55, 0x4f, 0xae, 0xa4, 0xd7, 0x6f, 0x1b, 0xd2, 0xb6, 0x33, 0x02, 0xef, 0xd0, 0xf7, 0x08,
-	0xf4, 0xd8, 0xc3, 0x25, 0x9d, 0x48, 0xb4, 0xeb, 0x9b, 0x63, 0x85, 0x8b, 0x19, 0x6e, 0x22, 0xae,
-	0x31, 0x8e, 0x2b, 0x4f, 0x87, 0xe3, 0x70, 0xd9, 0xb3, 0x2c, 0xfd, 0x80, 0x40, 0xaf, 0x33, 0x79,
-	0xd2, 0xc9, 0x64, 0xdd, 0xfe, 0x61, 0x57, 0xb8, 0x94, 0xe9, 0x2e, 0x22, 0x19, 0xe7, 0x48, 0x46,
-	0x69, 0x3e, 0x16, 0x89, 0x33, 0xfa, 0x2e, 0xdd, 0xdf, 0xcf, 0x93, 0xdd, 0xfd, 0x3c, 0xf9, 0x6d,
-	0x3f, 0x4f, 0x3e, 0x3c, 0xc8, 0x77, 0xed, 0x1e, 0xe4, 0xbb, 0x7e, 0x3e, 0xc8, 0x77, 0xbd, 0x7d,
-	0x39, 0x71, 0x17, 0xba, 0xeb, 0x29, 0xe4, 0x5b, 0x51, 0xb9, 0x97, 0xff, 0x6b, 0x73, 0xf6, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xa1, 0xcd, 0x3f, 0x9e, 0x1d, 0x00, 0x00,
+	// 1579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdf, 0x6f, 0x14, 0xd5,
+	0x17, 0xef, 0x2d, 0xa5, 0xdf, 0x70, 0xf8, 0x42, 0xe0, 0xb6, 0x94, 0x32, 0xc0, 0xb6, 0x8c, 0xb5,
+	0x96, 0x42, 0x77, 0x6c, 0x0b, 0xa5, 0xca, 0x2f, 0xbb, 0x42, 0xb1, 0xc1, 0x04, 0x58, 0x14, 0x51,
+	0x1f, 0x36, 0xd3, 0x9d, 0x61, 0x77, 0xd2, 0xed, 0xcc, 0x76, 0xee, 0x2c, 0xbf, 0x9a, 0x3e, 0xe8,
+	0x9b, 0x6f, 0x26, 0x3e, 0xf9, 0x17, 0x98, 0xe8, 0xa3, 0x26, 0x3e, 0x1b, 0x4d, 0xc4, 0x44, 0x93,
+	0x12, 0x31, 0x51, 0x63, 0xaa, 0x82, 0x0f, 0xfe, 0x07, 0x46, 0x5f, 0x34, 0x73, 0xe7, 0xcc, 0xec,
+	0x4e, 0xe7, 0xe7, 0x2e, 0x5b, 0x42, 0x9f, 0xe8, 0xde, 0xbd, 0xe7, 0x9c, 0xcf, 0xe7, 0x9c, 0x7b,
+	0xce, 0xde, 0xcf, 0x0d, 0x20, 0x16, 0x0d, 0xb6, 0x68, 0x30, 0x89, 0x59, 0xf2, 0x82, 0xa6, 0x97,
+	0xa4, 0x9b, 0xe3, 0xf3, 0xaa, 0x25, 0x8f, 0x4b, 0x4b, 0x35, 0xd5, 0xbc, 0x93, 0xad, 0x9a, 0x86,
+	0x65, 0xd0, 0x3e, 0x67, 0x4f, 0x16, 0xf7, 0x64, 0x71, 0x8f, 0x30, 0x8a, 0xb6, 0xf3, 0x32, 0x53,
+	0x1d, 0x03, 0xcf, 0xbc, 0x2a, 0x97, 0x34, 0x5d, 0xb6, 0x34, 0x43, 0x77, 0x7c, 0x08, 0xbd, 0x25,
+	0xa3, 0x64, 0xf0, 0x3f, 0x25, 0xfb, 0x2f, 0x5c, 0x3d, 0x50, 0x32, 0x8c, 0x52, 0x45, 0x95, 0xe4,
+	0xaa, 0x26, 0xc9, 0xba, 0x6e, 0x58, 0xdc, 0x84, 0xe1, 0xb7, 0x43, 0x11, 0xd8, 0x5c, 0x1c, 0x7c,
+	0x97, 0x78, 0x1b, 0xfa, 0xae, 0xd8, 0xb1, 0xaf, 0xc9, 0x15, 0x4d, 0x91, 0x2d, 0xc3, 0x64, 0x79,
+	0x75, 0xa9, 0xa6, 0x32, 0x8b, 0xf6, 0x41, 0x37, 0xb3, 0x64, 0xab, 0xc6, 0xfa, 0xc9, 0x20, 0x19,
+	0xd9, 0x96, 0xc7, 0x4f, 0x74, 0x16, 0xa0, 0x8e, 0xaf, 0xbf, 0x73, 0x90, 0x8c, 0x6c, 0x9f, 0x18,
+	0xce, 0x22, 0x49, 0x9b, 0x4c, 0xd6, 0x61, 0x8f, 0xf1, 0xb2, 0x97, 0xe5, 0x92, 0x8a, 0x3e, 0xf3,
+	0x0d, 0x96, 0xe2, 0x27, 0x04, 0xf6, 0x06, 0x42, 0xb3, 0xaa, 0xa1, 0x33, 0x95, 0x5e, 0x00, 0xb8,
+	0xe9, 0xad, 0xf6, 0x93, 0xc1, 0x2d, 0x23, 0xdb, 0x27, 0x0e, 0x65, 0xc3, 0x13, 0x99, 0xf5, 0xec,
+	0x73, 0x5d, 0xf7, 0xd6, 0x06, 0x3a, 0xf2, 0x0d, 0xa6, 0xb6, 0xa3, 0x00, 0xd8, 0xe7, 0x12, 0xc1,
+	0x3a, 0x28, 0x7c, 0x68, 0x97, 0x60, 0x8f, 0x1f, 0xac, 0x9b, 0xa6, 0xeb, 0xb0, 0xd3, 0x8b, 0x57,
+	0x90, 0x15, 0xc5, 0xe4, 0xe9, 0xfa, 0x7f, 0x6e, 0xfc, 0x9f, 0xb5, 0x81, 0xb1, 0x92, 0x66, 0x95,
+	0x6b, 0xf3, 0xd9, 0xa2, 0xb1, 0x28, 0x61, 0x35, 0x9c, 0x7f, 0xc6, 0x98, 0xb2, 0x20, 0x59, 0x77,
+	0xaa, 0x2a, 0xb3, 0xd1, 0xcf, 0x28, 0x8a, 0xa9, 0x32, 0x96, 0xdf, 0xe1, 0x39, 0xb2, 0x57, 0xc4,
+	0xc2, 0xfa, 0xd2, 0x78, 0xe9, 0x39, 0x0f, 0xdb, 0xbc, 0xad, 0x3c, 0x5c, 0x13, 0xd9, 0xa9, 0x5b,
+	0x8a, 0x5f, 0x11, 0x18, 0xf4, 0x47, 0x38, 0xa7, 0x56, 0xd4, 0x92, 0x73, 0x8a, 0x36, 0x9c, 0x5f,
+	0xdb, 0x0e, 0xd2, 0x9f, 0x04, 0x0e, 0xc5, 0xd0, 0xc0, 0x9c, 0xdd, 0x85, 0x5e, 0xc5, 0x5b, 0x2e,
+	0x98, 0xb8, 0xec, 0x1e, 0xae, 0xd1, 0xa8, 0xf4, 0xd5, 0x5d, 0xb9, 0x9e, 0x72, 0xfb, 0xed, 0x3c,
+	0x7e, 0xfc, 0xeb, 0x40, 0x4f, 0xf0, 0x3b, 0x96, 0xef, 0x51, 0x82, 0x8b, 0xed, 0x3b, 0x85, 0xdf,
+	0x11, 0x38, 0xec, 0xa7, 0xfa, 0xba, 0x3e, 0x6f, 0xe8, 0x8a, 0xa6, 0x97, 0x36, 0x65, 0xe9, 0x7e,
+	0x22, 0x30, 0x9a, 0x86, 0x0f, 0xd6, 0x70, 0x1e, 0x7a, 0x6a, 0xee, 0xf7, 0x81, 0x12, 0x1e, 0x89,
+	0x2a, 0x61, 0x88, 0x4b, 0xec, 0x05, 0xea, 0x79, 0xdb, 0x80, 0x5a, 0x7d, 0x4b, 0xb0, 0x7f, 0x1b,
+	0x8f, 0x89, 0x57, 0x18, 0x3c, 0x26, 0xad, 0x15, 0x66, 0xa6, 0x58, 0xf4, 0x0a, 0xe3, 0x39, 0xe2,
+	0x85, 0x09, 0x96, 0xbc, 0xb3, 0x4d, 0xd3, 0xe8, 0x26, 0xec, 0x0d, 0xb0, 0xc1, 0xb2, 0xbc, 0x0d,
+	0x3d, 0x21, 0xad, 0x85, 0x83, 0xa9, 0x89, 0xce, 0xca, 0xd3, 0x60, 0xf3, 0x88, 0x3f, 0x10, 0x18,
+	0xe0, 0x81, 0x43, 0xca, 0xb8, 0x99, 0xf3, 0xb9, 0x08, 0x83, 0xd1, 0xb4, 0x30, 0xb1, 0x73, 0xd0,
+	0xed, 0x9c, 0x50, 0xcc, 0x65, 0x0b, 0x47, 0x1c, 0x1d, 0xd4, 0x67, 0xfd, 0x39, 0x97, 0x5f, 0xf8,
+	0xc0, 0xd8, 0xa0, 0x3c, 0xb6, 0x6b, 0x60, 0xdc, 0x77, 0x67, 0x7d, 0x38, 0x0d, 0xcc, 0x5b, 0xb1,
+	0x6d, 0xb3, 0xde, 0x49, 0xe2, 0x13, 0x1a, 0xea, 0x1e, 0xa7, 0x84, 0xa1, 0xfe, 0x94, 0xd7, 0xc8,
+	0x1b, 0xea, 0x09, 0x7c, 0x36, 0xe3, 0x50, 0xff, 0xb7, 0x13, 0xf6, 0x71, 0x6e, 0x79, 0x55, 0x79,
+	0x92, 0xb5, 0x29, 0x00, 0x65, 0x66, 0xb1, 0xd0, 0xae, 0x59, 0xb4, 0x8b, 0x99, 0xc5, 0x6b, 0xbe,
+	0x5f, 0xf4, 0x02, 0x50, 0x85, 0x59, 0xeb, 0x03, 0x6c, 0x69, 0x39, 0x80, 0xc2, 0xac, 0x6b, 0x31,
+	0x57, 0x86, 0xae, 0x96, 0x4f, 0xd7, 0x2a, 0x01, 0x21, 0xac, 0x02, 0x78, 0x9a, 0x34, 0xe8, 0x33,
+	0xd5, 0x98, 0xe6, 0x3f, 0x1a, 0x75, 0xa0, 0x1a, 0xdd, 0xad, 0x6b, 0xff, 0x3d, 0xa6, 0xba, 0xa1,
+	0x03, 0xe0, 0x4b, 0xf7, 0x27, 0xce, 0x6b, 0x98, 0xa0, 0x1a, 0x7b, 0xfa, 0xdb, 0xfe, 0xb3, 0xc0,
+	0x2f, 0xcc, 0xa6, 0x10, 0x76, 0x0f, 0x08, 0x64, 0x22, 0x60, 0x6f, 0xe6, 0xeb, 0x45, 0x39, 0xf2,
+	0x48, 0xb5, 0x5b, 0x45, 0x1e, 0xc3, 0x7e, 0x7c, 0x45, 0x63, 0x96, 0x61, 0x6a, 0x45, 0xb9, 0x32,
+	0xa7, 0xdf, 0x30, 0x1a, 0x5e, 0x11, 0xca, 0xaa, 0x56, 0x2a, 0x5b, 0x3c, 0xc2, 0x96, 0x3c, 0x7e,
+	0x12, 0xdf, 0x84, 0xfd, 0xa1, 0x56, 0x88, 0xed, 0x45, 0xe8, 0x2a, 0x6b, 0xcc, 0xea, 0x27, 0xfe,
+	0xe3, 0xb8, 0x1e, 0xd6, 0x3a, 0x6b, 0x6e, 0x23, 0x52, 0xd8, 0xc5, 0x5d, 0x5f, 0x36, 0x8c, 0x0a,
+	0xc2, 0x10, 0x2f, 0xc2, 0xee, 0x86, 0x35, 0x0c, 0x32, 0x05, 0x5d, 0x55, 0xc3, 0xa8, 0x60, 0x90,
+	0x03, 0x51, 0x41, 0x6c, 0x1b, 0xa4, 0xcd, 0xf7, 0x8b, 0xbd, 0x40, 0x1d, 0x67, 0xb2, 0x29, 0x2f,
+	0xba, 0x1d, 0x2a, 0x5e, 0x85, 0x1e, 0xdf, 0x2a, 0x06, 0x39, 0x05, 0xdd, 0x55, 0xbe, 0x82, 0x61,
+	0x32, 0x91, 0x61, 0xf8, 0x2e, 0xf7, 0xda, 0xe6, 0xd8, 0x88, 0xc7, 0xe1, 0x19, 0xee, 0xf4, 0x35,
+	0x63, 0x41, 0xd5, 0xb5, 0xbb, 0xea, 0xd5, 0xb2, 0x6c, 0xaa, 0x79, 0xb5, 0x68, 0x98, 0x4a, 0xee,
+	0xce, 0x9c, 0xe2, 0x66, 0x79, 0x27, 0x74, 0x6a, 0xce, 0x25, 0xb1, 0x2b, 0xdf, 0xa9, 0x29, 0xe2,
+	0x12, 0x0c, 0xc5, 0x9b, 0xd5, 0x2f, 0x98, 0x26, 0x5f, 0x4d, 0xba, 0x60, 0x86, 0x39, 0x42, 0xa4,
+	0x8e, 0x03, 0xf1, 0x34, 0x3c, 0x1b, 0x15, 0x92, 0x5d, 0xba, 0xa5, 0xab, 0x1e, 0xd6, 0x5e, 0xd8,
+	0x6a, 0xdc, 0xd2, 0x55, 0x13, 0x9f, 0x95, 0x9c, 0x0f, 0x62, 0x0d, 0x86, 0x93, 0xcc, 0x11, 0xf3,
+	0x45, 0xf8, 0x9f, 0x13, 0x32, 0xf1, 0x8e, 0x10, 0x0d, 0xda, 0xf5, 0x20, 0x0e, 0xc0, 0x41, 0x0c,
+	0x6b, 0xc9, 0x95, 0x57, 0xb5, 0xa5, 0x9a, 0xa6, 0x5c, 0xb5, 0xe4, 0x05, 0x0f, 0xad, 0x58, 0x86,
+	0x4c, 0xd4, 0x06, 0xc4, 0x33, 0x0b, 0xdd, 0x96, 0x1d, 0x08, 0xdf, 0xc9, 0x72, 0x59, 0x3b, 0xc2,
+	0xcf, 0x6b, 0x03, 0xc3, 0x29, 0xfa, 0x77, 0x4e, 0xb7, 0xf2, 0x68, 0x3d, 0xf1, 0x8b, 0x00, 0x5b,
+	0x79, 0x28, 0xfa, 0x21, 0x01, 0xa8, 0xcf, 0x4e, 0x9a, 0x8d, 0xe2, 0x17, 0xfe, 0x70, 0x27, 0x48,
+	0xa9, 0xf7, 0xa3, 0xc4, 0x1a, 0x7d, 0xf7, 0xfb, 0x3f, 0x3e, 0xe8, 0x1c, 0xa2, 0xa2, 0x14, 0xf1,
+	0x64, 0xd8, 0x30, 0x77, 0x3f, 0x22, 0xb0, 0xcd, 0x73, 0x41, 0xc7, 0xd2, 0x85, 0x72, 0x91, 0x65,
+	0xd3, 0x6e, 0x47, 0x60, 0x27, 0x39, 0xb0, 0xe3, 0x74, 0x32, 0x19, 0x98, 0xb4, 0xec, 0x1f, 0xa4,
+	0x2b, 0xf4, 0x01, 0x81, 0xde, 0xb0, 0x17, 0x21, 0x3a, 0x9d, 0x0e, 0x45, 0xf0, 0xee, 0x2d, 0xbc,
+	0xd0, 0x82, 0x25, 0x52, 0xb9, 0xc0, 0xa9, 0xcc, 0xd0, 0xb3, 0x2d, 0x50, 0x91, 0x1a, 0x2e, 0x3a,
+	0xf4, 0x6f, 0x02, 0x07, 0x63, 0x5f, 0x4b, 0xe8, 0x4c, 0x3a, 0x94, 0x31, 0x22, 0x43, 0xc8, 0x3d,
+	0x8e, 0x0b, 0x64, 0x7c, 0x85, 0x33, 0xbe, 0x48, 0xe7, 0x5a, 0x61, 0x5c, 0x57, 0x04, 0x8d, 0xdc,
+	0xbf, 0x26, 0x00, 0xf5, 0x50, 0x09, 0x8d, 0x11, 0x78, 0x26, 0x10, 0xa4, 0xd4, 0xfb, 0x91, 0xc2,
+	0x75, 0x4e, 0x21, 0x4f, 0x2f, 0x3f, 0x66, 0xd1, 0xa4, 0x65, 0xff, 0xfd, 0x61, 0x85, 0xfe, 0x45,
+	0xa0, 0x27, 0x24, 0x7b, 0xf4, 0x44, 0x2c, 0xc4, 0xe8, 0x27, 0x10, 0x61, 0xba, 0x79, 0x43, 0x24,
+	0xb9, 0xc8, 0x49, 0x96, 0xa8, 0xda, 0x6e, 0x92, 0xa1, 0x45, 0xa4, 0xdf, 0x10, 0xe8, 0x0d, 0x13,
+	0xef, 0x09, 0x6d, 0x19, 0xf3, 0x6c, 0x91, 0xd0, 0x96, 0x71, 0x2f, 0x05, 0xe2, 0x29, 0x4e, 0x7e,
+	0x8a, 0x1e, 0x8b, 0x22, 0x1f, 0x5b, 0x45, 0xbb, 0x17, 0x63, 0x45, 0x6e, 0x42, 0x2f, 0xa6, 0x11,
+	0xfc, 0x09, 0xbd, 0x98, 0x4a, 0x63, 0x27, 0xf7, 0xa2, 0xc7, 0x2c, 0x65, 0x19, 0x19, 0xfd, 0x82,
+	0xc0, 0x0e, 0x9f, 0x04, 0xa3, 0xe3, 0xb1, 0x40, 0xc3, 0x04, 0xb3, 0x30, 0xd1, 0x8c, 0x09, 0x72,
+	0x99, 0xe3, 0x5c, 0x5e, 0xa6, 0x33, 0xad, 0x70, 0x31, 0x7d, 0x88, 0x57, 0x09, 0xf4, 0x84, 0xa8,
+	0x95, 0x84, 0x2e, 0x8c, 0x56, 0x69, 0xc2, 0x74, 0xf3, 0x86, 0xc8, 0x6a, 0x96, 0xb3, 0x7a, 0x89,
+	0x9e, 0x69, 0x85, 0x55, 0xc3, 0xef, 0xf3, 0x1a, 0x01, 0x1a, 0x8c, 0x43, 0xa7, 0x9a, 0x04, 0xe6,
+	0x12, 0x3a, 0xd1, 0xb4, 0x1d, 0xf2, 0x79, 0x83, 0xf3, 0xb9, 0x42, 0x2f, 0x3d, 0x1e, 0x9f, 0xe0,
+	0xcf, 0xfa, 0xa7, 0x04, 0x76, 0xfa, 0xaf, 0xfd, 0x34, 0xfe, 0x14, 0x85, 0xea, 0x12, 0x61, 0xb2,
+	0x29, 0x1b, 0x24, 0x35, 0xcd, 0x49, 0x4d, 0xd0, 0xe7, 0xa3, 0x48, 0x95, 0x3d, 0xbb, 0x82, 0xa6,
+	0xdf, 0x30, 0xa4, 0x65, 0x47, 0xed, 0xac, 0xd0, 0x77, 0x08, 0x74, 0xd9, 0x3a, 0x82, 0x8e, 0xc4,
+	0xc6, 0x6d, 0x90, 0x2c, 0xc2, 0xe1, 0x14, 0x3b, 0x11, 0xd7, 0x10, 0xc7, 0x95, 0xa1, 0x07, 0xa2,
+	0x70, 0xd9, 0xb2, 0x85, 0xbe, 0x47, 0xa0, 0xdb, 0x11, 0x19, 0x74, 0x34, 0xde, 0x77, 0xa3, 0xae,
+	0x11, 0x8e, 0xa4, 0xda, 0x8b, 0x48, 0x86, 0x39, 0x92, 0x41, 0x9a, 0x89, 0x44, 0xe2, 0x00, 0xb8,
+	0x4f, 0x60, 0x6f, 0x84, 0x38, 0xa1, 0x27, 0x63, 0x03, 0xc6, 0x2b, 0x21, 0xe1, 0x54, 0x6b, 0xc6,
+	0x69, 0x2f, 0x9c, 0x16, 0x3a, 0x28, 0x30, 0xdb, 0x43, 0x01, 0x65, 0x84, 0xb4, 0xac, 0x29, 0x2b,
+	0xf4, 0x77, 0x02, 0xfb, 0x22, 0xe5, 0x0b, 0x3d, 0xdd, 0x2c, 0x30, 0x9f, 0x6a, 0x12, 0xce, 0xb4,
+	0x6a, 0x8e, 0xcc, 0xce, 0x73, 0x66, 0x67, 0xe9, 0xe9, 0x26, 0x99, 0x71, 0x75, 0xc6, 0xa4, 0x65,
+	0xfe, 0xef, 0x0a, 0xfd, 0x9c, 0xc0, 0xee, 0x80, 0x14, 0xa2, 0xc7, 0x13, 0xc0, 0x85, 0x6b, 0x2b,
+	0x61, 0xaa, 0x59, 0x33, 0xe4, 0x32, 0xc9, 0xb9, 0x8c, 0xd1, 0x23, 0xd1, 0x5c, 0x2c, 0xb9, 0x52,
+	0xa8, 0x70, 0xdb, 0x02, 0xe3, 0xc6, 0xb9, 0xd9, 0x7b, 0x0f, 0x33, 0x64, 0xf5, 0x61, 0x86, 0xfc,
+	0xf6, 0x30, 0x43, 0xde, 0x7f, 0x94, 0xe9, 0x58, 0x7d, 0x94, 0xe9, 0xf8, 0xf1, 0x51, 0xa6, 0xe3,
+	0xad, 0xa3, 0xb1, 0x42, 0xed, 0xb6, 0xe7, 0x9d, 0x4b, 0xb6, 0xf9, 0x6e, 0xfe, 0xff, 0x26, 0x26,
+	0xff, 0x1b, 0x00, 0x34, 0xd3, 0x0f, 0x8b, 0xfb, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1646,6 +1934,13 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecordById queries a tokenize share record by its id.
+	TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the amount of bonded tokens which are tokenized.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
 }
 
 type queryClient struct {
@@ -1782,6 +2077,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error) {
+	out := new(QueryTokenizeShareRecordByIdResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TokenizeShareRecordById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TotalLiquidStaked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1812,6 +2134,13 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecordById queries a tokenize share record by its id.
+	TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the amount of bonded tokens which are tokenized.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1860,6 +2189,15 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TokenizeShareRecordById(ctx context.Context, req *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordById not implemented")
+}
+func (*UnimplementedQueryServer) TokenizeShareRecordsOwned(ctx context.Context, req *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidStaked(ctx context.Context, req *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2117,6 +2455,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TokenizeShareRecordById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, req.(*QueryTokenizeShareRecordByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TotalLiquidStaked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2177,6 +2569,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecordById",
+			Handler:    _Query_TokenizeShareRecordById_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3283,32 +3687,216 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTokenizeShareRecordByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTokenizeShareRecordByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryValidatorsResponse) Size() (n int) {
@@ -3729,6 +4317,77 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenizeShareRecordByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenizeShareRecordsOwnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordsOwnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalLiquidStakedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidStakedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6759,6 +7418,476 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenizeShareRecordByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordsOwnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordsOwnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordsOwnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TokenizeShareRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,9 +14,9 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_protoc_gen_gogo_descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types "github.com/tendermint/tendermint/proto/tendermint/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	io_ioutil "io/ioutil"
 	math "math"
@@ -859,6 +859,18 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens which may be tokenized.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of a validator's
+	// delegator shares which may be tokenized.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// min_commission_rate is the chain-wide minimum commission rate that a
+	// validator can charge its delegators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// global_min_self_delegation is the chain-wide minimum self delegation that
+	// a validator must declare.
+	GlobalMinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=global_min_self_delegation,json=globalMinSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_min_self_delegation" yaml:"global_min_self_delegation"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1123,6 +1135,79 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation which was tokenized into a bank
+// denom with MsgTokenizeShares. The delegation is held by the record's module
+// account until the share tokens are redeemed.
+type TokenizeShareRecord struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account which may withdraw the delegation rewards of the
+	// record.
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string                                        `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	Validator     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()      { *m = TokenizeShareRecord{} }
+func (*TokenizeShareRecord) ProtoMessage() {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
@@ -1144,6 +1229,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1151,112 +1237,125 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x1b, 0xc7,
-	0x19, 0xd7, 0x92, 0x32, 0x45, 0x7d, 0x94, 0x44, 0x71, 0x6c, 0xab, 0x94, 0xea, 0x72, 0xe5, 0xad,
-	0xd1, 0xaa, 0x40, 0x4d, 0x55, 0x6a, 0xd1, 0xa2, 0xba, 0xb4, 0xa6, 0x68, 0x43, 0x42, 0xed, 0x56,
-	0x5e, 0xb9, 0x2a, 0xd0, 0x1a, 0x25, 0x86, 0xbb, 0x23, 0x6a, 0x2b, 0xee, 0x2e, 0xbb, 0x33, 0xb4,
-	0x25, 0xc3, 0x87, 0x5e, 0x0a, 0xb4, 0x05, 0x8a, 0xfa, 0xe8, 0xa3, 0xd0, 0xfe, 0x01, 0xfd, 0x0f,
-	0xda, 0xab, 0x83, 0x5c, 0x9c, 0x4b, 0x10, 0x04, 0x01, 0x13, 0xd8, 0x97, 0x24, 0x97, 0x04, 0x3c,
-	0xe4, 0x90, 0x4b, 0x82, 0x79, 0xec, 0x43, 0x4b, 0xd1, 0x16, 0x95, 0x00, 0x09, 0x10, 0x5f, 0xa4,
-	0x9d, 0x6f, 0xbe, 0xd7, 0xfc, 0xbe, 0xc7, 0x3c, 0x08, 0x57, 0x2c, 0x9f, 0xba, 0x3e, 0x5d, 0xa6,
-	0x0c, 0xef, 0x3b, 0x5e, 0x6b, 0xf9, 0xde, 0x4a, 0x93, 0x30, 0xbc, 0x12, 0x8e, 0xab, 0x9d, 0xc0,
-	0x67, 0x3e, 0x9a, 0x93, 0x5c, 0xd5, 0x90, 0xaa, 0xb8, 0x16, 0x2e, 0xb4, 0xfc, 0x96, 0x2f, 0x58,
-	0x96, 0xf9, 0x97, 0xe4, 0x5e, 0xb8, 0xc4, 0x88, 0x67, 0x93, 0xc0, 0x75, 0x3c, 0xb6, 0xcc, 0x0e,
-	0x3b, 0x84, 0xca, 0xbf, 0x6a, 0x56, 0x6f, 0xf9, 0x7e, 0xab, 0x4d, 0x96, 0xc5, 0xa8, 0xd9, 0xdd,
-	0x5d, 0x66, 0x8e, 0x4b, 0x28, 0xc3, 0x6e, 0x47, 0x31, 0x54, 0xd2, 0x0c, 0x76, 0x37, 0xc0, 0xcc,
-	0xf1, 0xbd, 0x70, 0x5e, 0xb9, 0xdc, 0xc4, 0x94, 0x44, 0xfe, 0x5a, 0xbe, 0xa3, 0xe6, 0x8d, 0xbf,
-	0x6b, 0x30, 0xb3, 0xe1, 0x50, 0xe6, 0x07, 0x8e, 0x85, 0xdb, 0x9b, 0xde, 0xae, 0x8f, 0x7e, 0x0a,
-	0xb9, 0x3d, 0x82, 0x6d, 0x12, 0x94, 0xb5, 0x45, 0x6d, 0xa9, 0xb0, 0x5a, 0xae, 0xc6, 0x2e, 0x56,
-	0xa5, 0x73, 0x1b, 0x62, 0xbe, 0x36, 0xfe, 0xa4, 0xa7, 0x8f, 0x99, 0x8a, 0x1b, 0xfd, 0x02, 0x72,
-	0xf7, 0x70, 0x9b, 0x12, 0x56, 0xce, 0x2c, 0x66, 0x97, 0x0a, 0xab, 0x97, 0xab, 0x27, 0x03, 0x51,
-	0xdd, 0xc1, 0x6d, 0xc7, 0xc6, 0xcc, 0x8f, 0x14, 0x48, 0x31, 0xe3, 0xbf, 0x19, 0x28, 0xae, 0xfb,
-	0xae, 0xeb, 0x50, 0xea, 0xf8, 0x9e, 0x89, 0x19, 0xa1, 0xa8, 0x06, 0xe3, 0x01, 0x66, 0x44, 0xb8,
-	0x32, 0x59, 0xab, 0x72, 0xfe, 0xb7, 0x7b, 0xfa, 0xf7, 0x5a, 0x0e, 0xdb, 0xeb, 0x36, 0xab, 0x96,
-	0xef, 0x2e, 0xab, 0x05, 0xca, 0x7f, 0x57, 0xa9, 0xbd, 0xaf, 0x00, 0xac, 0x13, 0xcb, 0x14, 0xb2,
-	0xe8, 0x2e, 0xe4, 0x5d, 0x7c, 0xd0, 0x10, 0x7a, 0x32, 0x42, 0xcf, 0xb5, 0xd1, 0xf4, 0xf4, 0x7b,
-	0x7a, 0xf1, 0x10, 0xbb, 0xed, 0x35, 0x23, 0xd4, 0x63, 0x98, 0x13, 0x2e, 0x3e, 0xe0, 0x2e, 0xa2,
-	0x0e, 0x14, 0x39, 0xd5, 0xda, 0xc3, 0x5e, 0x8b, 0x48, 0x23, 0x59, 0x61, 0x64, 0x63, 0x64, 0x23,
-	0x73, 0xb1, 0x91, 0x84, 0x3a, 0xc3, 0x9c, 0x76, 0xf1, 0xc1, 0xba, 0x20, 0x70, 0x8b, 0x6b, 0xf9,
-	0xc7, 0x47, 0xfa, 0xd8, 0xfb, 0x47, 0xba, 0x66, 0xbc, 0xa1, 0x01, 0xc4, 0x88, 0xa1, 0xbb, 0x30,
-	0x6b, 0x45, 0x23, 0x21, 0x4b, 0x55, 0x0c, 0xbf, 0x3f, 0x2c, 0x16, 0x29, 0xbc, 0x6b, 0x79, 0xee,
-	0xf4, 0xd3, 0x9e, 0xae, 0x99, 0x45, 0x2b, 0x15, 0x8a, 0x3f, 0x40, 0xa1, 0xdb, 0xb1, 0x31, 0x23,
-	0x0d, 0x9e, 0x84, 0x02, 0xc9, 0xc2, 0xea, 0x42, 0x55, 0x26, 0x60, 0x35, 0x4c, 0xc0, 0xea, 0x9d,
-	0x30, 0x43, 0x6b, 0x15, 0xae, 0xab, 0xdf, 0xd3, 0x91, 0x5c, 0x56, 0x42, 0xd8, 0x78, 0xf4, 0xae,
-	0xae, 0x99, 0x20, 0x29, 0x5c, 0x20, 0xb1, 0xa6, 0xd7, 0x34, 0x28, 0xd4, 0x09, 0xb5, 0x02, 0xa7,
-	0xc3, 0xf3, 0x18, 0x95, 0x61, 0xc2, 0xf5, 0x3d, 0x67, 0x5f, 0xe5, 0xe3, 0xa4, 0x19, 0x0e, 0xd1,
-	0x02, 0xe4, 0x1d, 0x9b, 0x78, 0xcc, 0x61, 0x87, 0x32, 0xae, 0x66, 0x34, 0xe6, 0x52, 0xf7, 0x49,
-	0x93, 0x3a, 0x61, 0x34, 0xcc, 0x70, 0x88, 0x6e, 0xc0, 0x2c, 0x25, 0x56, 0x37, 0x70, 0xd8, 0x61,
-	0xc3, 0xf2, 0x3d, 0x86, 0x2d, 0x56, 0x1e, 0x17, 0x01, 0xfb, 0x76, 0xbf, 0xa7, 0x7f, 0x4b, 0xfa,
-	0x9a, 0xe6, 0x30, 0xcc, 0x62, 0x48, 0x5a, 0x97, 0x14, 0x6e, 0xc1, 0x26, 0x0c, 0x3b, 0x6d, 0x5a,
-	0x3e, 0x27, 0x2d, 0xa8, 0x61, 0x62, 0x2d, 0xff, 0x9b, 0x80, 0xc9, 0x28, 0xdb, 0xd1, 0x7d, 0x98,
-	0xf5, 0x3b, 0x24, 0xe0, 0xdf, 0x0d, 0x6c, 0xdb, 0x01, 0xa1, 0x32, 0x3c, 0x53, 0xb5, 0x9b, 0xb1,
-	0xe5, 0x34, 0x87, 0xf1, 0x69, 0x4f, 0xbf, 0x7a, 0x8a, 0x0c, 0xda, 0xc1, 0xed, 0x6b, 0x52, 0xc2,
-	0x2c, 0x86, 0x3a, 0x14, 0x81, 0x2f, 0xd9, 0xf2, 0x3d, 0x4a, 0x3c, 0xda, 0xa5, 0x8d, 0x4e, 0xb7,
-	0xb9, 0x4f, 0x14, 0x60, 0xc9, 0x25, 0xa7, 0x39, 0x0c, 0x9e, 0x01, 0x8a, 0xb4, 0x25, 0x28, 0x68,
-	0x0e, 0x72, 0x7f, 0xc2, 0x4e, 0x9b, 0xd8, 0x02, 0xd3, 0xbc, 0xa9, 0x46, 0x68, 0x13, 0x72, 0x94,
-	0x61, 0xd6, 0xa5, 0x02, 0xc8, 0x73, 0xb5, 0x95, 0x53, 0xfa, 0x5c, 0xf3, 0x3d, 0x7b, 0x5b, 0x08,
-	0x9a, 0x4a, 0x01, 0xba, 0x01, 0x39, 0xe6, 0xef, 0x13, 0x4f, 0x81, 0x3a, 0x52, 0xc5, 0x6f, 0x7a,
-	0xcc, 0x54, 0xd2, 0x88, 0xc1, 0xac, 0x4d, 0xda, 0xa4, 0x25, 0xa0, 0xa4, 0x7b, 0x38, 0x20, 0xb4,
-	0x9c, 0x13, 0x1a, 0x37, 0x47, 0x2e, 0x4b, 0x05, 0x50, 0x5a, 0x9f, 0x61, 0x16, 0x23, 0xd2, 0xb6,
-	0xa0, 0xa0, 0x5f, 0x41, 0xc1, 0x8e, 0x53, 0xb7, 0x3c, 0x21, 0x4a, 0xe4, 0xbb, 0xc3, 0x6a, 0x2f,
-	0x91, 0xe5, 0xaa, 0x13, 0x26, 0xa5, 0x79, 0xd4, 0xba, 0x5e, 0xd3, 0xf7, 0x6c, 0xc7, 0x6b, 0x35,
-	0xf6, 0x88, 0xd3, 0xda, 0x63, 0xe5, 0xfc, 0xa2, 0xb6, 0x94, 0x4d, 0x46, 0x2d, 0xcd, 0x61, 0x98,
-	0xc5, 0x88, 0xb4, 0x21, 0x28, 0xc8, 0x86, 0x99, 0x98, 0x4b, 0x94, 0xee, 0xe4, 0x4b, 0x4b, 0xf7,
-	0xb2, 0x2a, 0xdd, 0x8b, 0x69, 0x2b, 0x71, 0xf5, 0x4e, 0x47, 0x44, 0x2e, 0x86, 0x36, 0x00, 0xe2,
-	0x86, 0x51, 0x06, 0x61, 0xc1, 0x78, 0x79, 0xd7, 0x51, 0x0b, 0x4f, 0xc8, 0xa2, 0x87, 0x70, 0xde,
-	0x75, 0xbc, 0x06, 0x25, 0xed, 0xdd, 0x86, 0x02, 0x98, 0xab, 0x2c, 0x88, 0xe8, 0xdd, 0x1c, 0x2d,
-	0x1f, 0xfa, 0x3d, 0x7d, 0x41, 0x35, 0xd5, 0x41, 0x95, 0x86, 0x59, 0x72, 0x1d, 0x6f, 0x9b, 0xb4,
-	0x77, 0xeb, 0x11, 0x6d, 0x6d, 0xea, 0x6f, 0x47, 0xfa, 0x58, 0x54, 0xc0, 0x0e, 0x4c, 0xc5, 0x85,
-	0x45, 0x28, 0xfa, 0x0d, 0x4c, 0xe2, 0x70, 0x50, 0xd6, 0x16, 0xb3, 0x4b, 0x53, 0xa7, 0x4e, 0xf6,
-	0x44, 0x81, 0xc6, 0x3a, 0x64, 0xaf, 0xf8, 0xcb, 0x3b, 0x8b, 0x9a, 0xf1, 0x8f, 0x0c, 0xe4, 0xea,
-	0x3b, 0x5b, 0xd8, 0x09, 0xd0, 0x03, 0x28, 0xc5, 0xc9, 0x76, 0xbc, 0x53, 0xdc, 0xea, 0xf7, 0xf4,
-	0x72, 0x3a, 0x1f, 0x47, 0x6c, 0x15, 0xd7, 0x2c, 0x2b, 0xf4, 0x24, 0x2e, 0x92, 0xb0, 0x57, 0x3c,
-	0x80, 0xd2, 0xbd, 0xb0, 0x63, 0x45, 0xb6, 0x33, 0x69, 0xdb, 0x03, 0x2c, 0x67, 0x68, 0x53, 0xb3,
-	0x91, 0x12, 0x45, 0x49, 0x34, 0xce, 0xeb, 0x30, 0x21, 0xb1, 0xa0, 0x68, 0x0d, 0xce, 0x75, 0xf8,
-	0x87, 0x80, 0xbb, 0xb0, 0x5a, 0x19, 0x5a, 0x4d, 0x82, 0x5f, 0xe5, 0x93, 0x14, 0x31, 0xfe, 0x9d,
-	0x05, 0xa8, 0xef, 0xec, 0xdc, 0x09, 0x9c, 0x4e, 0x9b, 0xb0, 0xaf, 0x14, 0xd7, 0xbf, 0x6a, 0x70,
-	0x31, 0x46, 0x8d, 0x06, 0x56, 0x0a, 0xdc, 0xdb, 0xfd, 0x9e, 0x7e, 0x29, 0x0d, 0x6e, 0x82, 0xed,
-	0x0c, 0x00, 0x9f, 0x8f, 0x14, 0x6d, 0x07, 0xd6, 0xc9, 0x7e, 0xd8, 0x94, 0x45, 0x7e, 0x64, 0x87,
-	0xfb, 0x91, 0x60, 0xfb, 0x42, 0x7e, 0xd4, 0x29, 0x1b, 0x8c, 0xf5, 0x36, 0x14, 0xe2, 0x18, 0x51,
-	0x54, 0x87, 0x3c, 0x53, 0xdf, 0x2a, 0xe4, 0xc6, 0xf0, 0x90, 0x87, 0x62, 0x2a, 0xec, 0x91, 0xa4,
-	0xf1, 0x66, 0x06, 0x20, 0xae, 0xea, 0x6f, 0x6a, 0x45, 0xf1, 0xed, 0x54, 0x6d, 0x7e, 0xd9, 0x33,
-	0x1d, 0xa0, 0x95, 0x74, 0x22, 0x5a, 0x1f, 0x64, 0xe0, 0xfc, 0x6f, 0xc3, 0xce, 0xff, 0x0a, 0x61,
-	0xb4, 0x05, 0x13, 0xc4, 0x63, 0x81, 0x23, 0x20, 0xe6, 0xd9, 0xfa, 0xa3, 0x61, 0xd9, 0x7a, 0x02,
-	0x6a, 0xd7, 0x3d, 0x16, 0x1c, 0xaa, 0xdc, 0x0d, 0xd5, 0x24, 0xb0, 0xfe, 0x57, 0x16, 0xca, 0xc3,
-	0xa4, 0xd0, 0x3a, 0x14, 0xad, 0x80, 0x08, 0x42, 0x78, 0x3a, 0xd0, 0xc4, 0xe9, 0x60, 0x21, 0xbe,
-	0x49, 0xa4, 0x18, 0x0c, 0x73, 0x26, 0xa4, 0xa8, 0xb3, 0x41, 0x0b, 0xf8, 0x31, 0x9f, 0x97, 0x0c,
-	0xe7, 0x3a, 0xe5, 0xb9, 0xde, 0x50, 0x87, 0x83, 0xd0, 0xc8, 0x71, 0x05, 0xf2, 0x74, 0x30, 0x13,
-	0x53, 0xc5, 0xf1, 0xe0, 0xcf, 0x50, 0x74, 0x3c, 0x87, 0x39, 0xb8, 0xdd, 0x68, 0xe2, 0x36, 0xf6,
-	0xac, 0xb3, 0xdc, 0x92, 0xe4, 0x86, 0xae, 0xcc, 0xa6, 0xd4, 0x19, 0xe6, 0x8c, 0xa2, 0xd4, 0x24,
-	0x01, 0x6d, 0xc0, 0x44, 0x68, 0x6a, 0xfc, 0x4c, 0x67, 0xc9, 0x50, 0x3c, 0x11, 0x91, 0x7f, 0x66,
-	0xa1, 0x64, 0x12, 0xfb, 0x55, 0x28, 0x46, 0x0b, 0xc5, 0x2d, 0x00, 0xd9, 0x48, 0xf8, 0x4e, 0x72,
-	0x86, 0x68, 0xf0, 0x56, 0x34, 0x29, 0x35, 0xd4, 0x29, 0x4b, 0xc4, 0xe3, 0xa3, 0x2c, 0x4c, 0x25,
-	0xe3, 0xf1, 0x6a, 0x8b, 0xff, 0xfa, 0x6c, 0xf1, 0x68, 0x33, 0x6e, 0x8d, 0xe3, 0xa2, 0x35, 0xfe,
-	0x60, 0x58, 0x6b, 0x1c, 0x28, 0xa9, 0xe1, 0x3d, 0xf1, 0x93, 0x0c, 0xe4, 0xb6, 0x70, 0x80, 0x5d,
-	0x8a, 0xac, 0x81, 0x8b, 0x8d, 0x7c, 0xec, 0x98, 0x1f, 0x28, 0x98, 0xba, 0x7a, 0x14, 0x7b, 0xc9,
-	0xbd, 0xe6, 0xf1, 0x09, 0xf7, 0x9a, 0x5f, 0xc2, 0x8c, 0x8b, 0x0f, 0x1a, 0xd1, 0xfa, 0x64, 0x30,
-	0xa7, 0x6b, 0xf3, 0xb1, 0x96, 0xe3, 0xf3, 0xf2, 0xb9, 0x26, 0xba, 0xf5, 0x53, 0xf4, 0x33, 0x28,
-	0x70, 0x8e, 0x78, 0x97, 0xe0, 0xe2, 0x73, 0xf1, 0xbb, 0x48, 0x62, 0xd2, 0x30, 0xc1, 0xc5, 0x07,
-	0xd7, 0xe5, 0x00, 0xdd, 0x04, 0xb4, 0x17, 0x3d, 0xcd, 0x35, 0x62, 0x28, 0xb9, 0xfc, 0x77, 0xfa,
-	0x3d, 0x7d, 0x5e, 0xca, 0x0f, 0xf2, 0x18, 0x66, 0x29, 0x26, 0x86, 0xda, 0x7e, 0x02, 0xc0, 0xd7,
-	0xd5, 0xb0, 0x89, 0xe7, 0xbb, 0xea, 0x76, 0x7d, 0xb1, 0xdf, 0xd3, 0x4b, 0x52, 0x4b, 0x3c, 0x67,
-	0x98, 0x93, 0x7c, 0x50, 0xe7, 0xdf, 0x09, 0xe0, 0xff, 0xa3, 0x01, 0x8a, 0xf7, 0x20, 0x93, 0xd0,
-	0x8e, 0xef, 0x51, 0x71, 0xef, 0x4b, 0x5c, 0xd2, 0xb4, 0x17, 0xdf, 0xfb, 0x62, 0xf9, 0xf0, 0xde,
-	0x97, 0x28, 0xdd, 0x9f, 0xc7, 0xfd, 0x3a, 0xa3, 0xe2, 0xa8, 0xd4, 0x34, 0x31, 0x25, 0x89, 0xbb,
-	0xa3, 0x13, 0x4a, 0x9f, 0xd0, 0xa0, 0x5f, 0xd7, 0x60, 0x7e, 0x20, 0x9b, 0x22, 0x67, 0xff, 0x08,
-	0x28, 0x48, 0x4c, 0x0a, 0xbc, 0x0e, 0x95, 0xd3, 0x23, 0x27, 0x67, 0x29, 0x18, 0xd8, 0x08, 0xbe,
-	0xbc, 0x2d, 0x67, 0x5c, 0xac, 0xe6, 0xff, 0x1a, 0x5c, 0x48, 0x9a, 0x8f, 0x16, 0xf2, 0x6b, 0x98,
-	0x4a, 0x5a, 0x57, 0x4b, 0xb8, 0x72, 0x9a, 0x25, 0x28, 0xef, 0x8f, 0xc9, 0xa3, 0xdb, 0x71, 0xa9,
-	0xca, 0xc7, 0xdb, 0x95, 0x53, 0xa3, 0x11, 0xfa, 0x94, 0x2e, 0x59, 0xb9, 0x82, 0xcf, 0x34, 0x18,
-	0xdf, 0xf2, 0xfd, 0x36, 0xf2, 0xa1, 0xe4, 0xf9, 0xac, 0xc1, 0x33, 0x8b, 0xd8, 0x0d, 0xf5, 0xc6,
-	0x23, 0x5f, 0x75, 0xd7, 0x47, 0x03, 0xe9, 0xc3, 0x9e, 0x3e, 0xa8, 0xca, 0x2c, 0x7a, 0x3e, 0xab,
-	0x09, 0xca, 0x1d, 0xf9, 0x02, 0xf4, 0x10, 0xa6, 0x8f, 0x1b, 0x93, 0x2f, 0x5e, 0xbf, 0x1b, 0xd9,
-	0xd8, 0x71, 0x35, 0xfd, 0x9e, 0x7e, 0x21, 0xae, 0x98, 0x88, 0x6c, 0x98, 0x53, 0xcd, 0x84, 0xf5,
-	0xb5, 0x3c, 0x5f, 0xfd, 0xc7, 0x47, 0xba, 0x56, 0xbb, 0xf1, 0xe4, 0x59, 0x45, 0x7b, 0xfa, 0xac,
-	0xa2, 0xbd, 0xf7, 0xac, 0xa2, 0x3d, 0x7a, 0x5e, 0x19, 0x7b, 0xfa, 0xbc, 0x32, 0xf6, 0xd6, 0xf3,
-	0xca, 0xd8, 0xef, 0x7f, 0xf8, 0x42, 0x17, 0x0e, 0xa2, 0x9f, 0x19, 0x84, 0x33, 0xcd, 0x9c, 0xe8,
-	0x66, 0x3f, 0xfe, 0x3c, 0x00, 0x00, 0xff, 0xff, 0x70, 0xa3, 0x33, 0x33, 0x85, 0x18, 0x00, 0x00,
+	// 1877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0xcf, 0x6f, 0x1b, 0x59,
+	0x39, 0x63, 0xbb, 0xb1, 0xf3, 0x39, 0x8d, 0x93, 0xd7, 0x36, 0xeb, 0x84, 0xe2, 0x49, 0x67, 0x57,
+	0x50, 0x24, 0xd6, 0x21, 0x05, 0x81, 0xc8, 0x05, 0xea, 0xb8, 0x25, 0x11, 0x2d, 0x64, 0x27, 0xdd,
+	0x20, 0xc1, 0x0a, 0xeb, 0x79, 0xe6, 0xd5, 0x79, 0x64, 0x3c, 0xe3, 0x9d, 0xf7, 0xdc, 0x26, 0xd5,
+	0x1e, 0xb8, 0x20, 0xf1, 0x43, 0x2b, 0x7a, 0x42, 0x7b, 0xac, 0xe0, 0x0f, 0x80, 0xbf, 0x00, 0xae,
+	0x8b, 0xb8, 0x94, 0x0b, 0x42, 0x08, 0x19, 0xd4, 0x5e, 0x80, 0x0b, 0xc8, 0x47, 0x2e, 0xa0, 0xf7,
+	0x63, 0x7e, 0x78, 0x6c, 0xb7, 0x71, 0x40, 0x02, 0x69, 0x7b, 0x49, 0xe6, 0x7d, 0xef, 0xfb, 0xf5,
+	0xbe, 0x5f, 0xef, 0x7d, 0x9f, 0xe1, 0x0d, 0x27, 0x60, 0xdd, 0x80, 0x6d, 0x32, 0x8e, 0x8f, 0xa9,
+	0xdf, 0xd9, 0x7c, 0xb0, 0xd5, 0x26, 0x1c, 0x6f, 0x45, 0xeb, 0x7a, 0x2f, 0x0c, 0x78, 0x80, 0x56,
+	0x15, 0x56, 0x3d, 0x82, 0x6a, 0xac, 0xf5, 0xcb, 0x9d, 0xa0, 0x13, 0x48, 0x94, 0x4d, 0xf1, 0xa5,
+	0xb0, 0xd7, 0xaf, 0x72, 0xe2, 0xbb, 0x24, 0xec, 0x52, 0x9f, 0x6f, 0xf2, 0xd3, 0x1e, 0x61, 0xea,
+	0xaf, 0xde, 0x35, 0x3b, 0x41, 0xd0, 0xf1, 0xc8, 0xa6, 0x5c, 0xb5, 0xfb, 0xf7, 0x37, 0x39, 0xed,
+	0x12, 0xc6, 0x71, 0xb7, 0xa7, 0x11, 0x6a, 0x59, 0x04, 0xb7, 0x1f, 0x62, 0x4e, 0x03, 0x3f, 0xda,
+	0xd7, 0x2a, 0xb7, 0x31, 0x23, 0xb1, 0xbe, 0x4e, 0x40, 0xf5, 0xbe, 0xf5, 0x03, 0x03, 0x96, 0x76,
+	0x29, 0xe3, 0x41, 0x48, 0x1d, 0xec, 0xed, 0xf9, 0xf7, 0x03, 0xf4, 0x79, 0x98, 0x3f, 0x22, 0xd8,
+	0x25, 0x61, 0xd5, 0xd8, 0x30, 0xae, 0x97, 0x6f, 0x54, 0xeb, 0x89, 0x8a, 0x75, 0xa5, 0xdc, 0xae,
+	0xdc, 0x6f, 0x14, 0x3e, 0x1c, 0x98, 0x73, 0xb6, 0xc6, 0x46, 0x5f, 0x82, 0xf9, 0x07, 0xd8, 0x63,
+	0x84, 0x57, 0x73, 0x1b, 0xf9, 0xeb, 0xe5, 0x1b, 0xd7, 0xea, 0x93, 0x0d, 0x51, 0x3f, 0xc4, 0x1e,
+	0x75, 0x31, 0x0f, 0x62, 0x06, 0x8a, 0xcc, 0xfa, 0x79, 0x0e, 0x2a, 0x3b, 0x41, 0xb7, 0x4b, 0x19,
+	0xa3, 0x81, 0x6f, 0x63, 0x4e, 0x18, 0x6a, 0x40, 0x21, 0xc4, 0x9c, 0x48, 0x55, 0x16, 0x1a, 0x75,
+	0x81, 0xff, 0x87, 0x81, 0xf9, 0x89, 0x0e, 0xe5, 0x47, 0xfd, 0x76, 0xdd, 0x09, 0xba, 0x9b, 0xfa,
+	0x80, 0xea, 0xdf, 0x9b, 0xcc, 0x3d, 0xd6, 0x06, 0x6c, 0x12, 0xc7, 0x96, 0xb4, 0xe8, 0x1d, 0x28,
+	0x75, 0xf1, 0x49, 0x4b, 0xf2, 0xc9, 0x49, 0x3e, 0x37, 0x67, 0xe3, 0x33, 0x1c, 0x98, 0x95, 0x53,
+	0xdc, 0xf5, 0xb6, 0xad, 0x88, 0x8f, 0x65, 0x17, 0xbb, 0xf8, 0x44, 0xa8, 0x88, 0x7a, 0x50, 0x11,
+	0x50, 0xe7, 0x08, 0xfb, 0x1d, 0xa2, 0x84, 0xe4, 0xa5, 0x90, 0xdd, 0x99, 0x85, 0xac, 0x26, 0x42,
+	0x52, 0xec, 0x2c, 0xfb, 0x62, 0x17, 0x9f, 0xec, 0x48, 0x80, 0x90, 0xb8, 0x5d, 0xfa, 0xe0, 0x89,
+	0x39, 0xf7, 0x97, 0x27, 0xa6, 0x61, 0xfd, 0xd6, 0x00, 0x48, 0x2c, 0x86, 0xde, 0x81, 0x65, 0x27,
+	0x5e, 0x49, 0x5a, 0xa6, 0x7d, 0xf8, 0xc9, 0x69, 0xbe, 0xc8, 0xd8, 0xbb, 0x51, 0x12, 0x4a, 0x3f,
+	0x1d, 0x98, 0x86, 0x5d, 0x71, 0x32, 0xae, 0xf8, 0x16, 0x94, 0xfb, 0x3d, 0x17, 0x73, 0xd2, 0x12,
+	0x41, 0x28, 0x2d, 0x59, 0xbe, 0xb1, 0x5e, 0x57, 0x01, 0x58, 0x8f, 0x02, 0xb0, 0x7e, 0x2f, 0x8a,
+	0xd0, 0x46, 0x4d, 0xf0, 0x1a, 0x0e, 0x4c, 0xa4, 0x8e, 0x95, 0x22, 0xb6, 0x1e, 0xff, 0xc9, 0x34,
+	0x6c, 0x50, 0x10, 0x41, 0x90, 0x3a, 0xd3, 0xaf, 0x0d, 0x28, 0x37, 0x09, 0x73, 0x42, 0xda, 0x13,
+	0x71, 0x8c, 0xaa, 0x50, 0xec, 0x06, 0x3e, 0x3d, 0xd6, 0xf1, 0xb8, 0x60, 0x47, 0x4b, 0xb4, 0x0e,
+	0x25, 0xea, 0x12, 0x9f, 0x53, 0x7e, 0xaa, 0xfc, 0x6a, 0xc7, 0x6b, 0x41, 0xf5, 0x90, 0xb4, 0x19,
+	0x8d, 0xbc, 0x61, 0x47, 0x4b, 0x74, 0x1b, 0x96, 0x19, 0x71, 0xfa, 0x21, 0xe5, 0xa7, 0x2d, 0x27,
+	0xf0, 0x39, 0x76, 0x78, 0xb5, 0x20, 0x1d, 0xf6, 0xb1, 0xe1, 0xc0, 0x7c, 0x4d, 0xe9, 0x9a, 0xc5,
+	0xb0, 0xec, 0x4a, 0x04, 0xda, 0x51, 0x10, 0x21, 0xc1, 0x25, 0x1c, 0x53, 0x8f, 0x55, 0x2f, 0x28,
+	0x09, 0x7a, 0x99, 0x3a, 0xcb, 0x2f, 0x8b, 0xb0, 0x10, 0x47, 0x3b, 0x7a, 0x08, 0xcb, 0x41, 0x8f,
+	0x84, 0xe2, 0xbb, 0x85, 0x5d, 0x37, 0x24, 0x4c, 0xb9, 0x67, 0xb1, 0x71, 0x27, 0x91, 0x9c, 0xc5,
+	0xb0, 0xfe, 0x39, 0x30, 0xdf, 0x3c, 0x43, 0x04, 0x1d, 0x62, 0xef, 0xa6, 0xa2, 0xb0, 0x2b, 0x11,
+	0x0f, 0x0d, 0x10, 0x47, 0x76, 0x02, 0x9f, 0x11, 0x9f, 0xf5, 0x59, 0xab, 0xd7, 0x6f, 0x1f, 0x13,
+	0x6d, 0xb0, 0xf4, 0x91, 0xb3, 0x18, 0x96, 0x5d, 0x89, 0x41, 0xfb, 0x12, 0x82, 0x56, 0x61, 0xfe,
+	0x3b, 0x98, 0x7a, 0xc4, 0x95, 0x36, 0x2d, 0xd9, 0x7a, 0x85, 0xf6, 0x60, 0x9e, 0x71, 0xcc, 0xfb,
+	0x4c, 0x1a, 0xf2, 0x42, 0x63, 0xeb, 0x8c, 0x3a, 0x37, 0x02, 0xdf, 0x3d, 0x90, 0x84, 0xb6, 0x66,
+	0x80, 0x6e, 0xc3, 0x3c, 0x0f, 0x8e, 0x89, 0xaf, 0x8d, 0x3a, 0x53, 0xc6, 0xef, 0xf9, 0xdc, 0xd6,
+	0xd4, 0x88, 0xc3, 0xb2, 0x4b, 0x3c, 0xd2, 0x91, 0xa6, 0x64, 0x47, 0x38, 0x24, 0xac, 0x3a, 0x2f,
+	0x39, 0xee, 0xcd, 0x9c, 0x96, 0xda, 0x40, 0x59, 0x7e, 0x96, 0x5d, 0x89, 0x41, 0x07, 0x12, 0x82,
+	0xbe, 0x0a, 0x65, 0x37, 0x09, 0xdd, 0x6a, 0x51, 0xa6, 0xc8, 0xeb, 0xd3, 0x72, 0x2f, 0x15, 0xe5,
+	0xba, 0x12, 0xa6, 0xa9, 0x85, 0xd7, 0xfa, 0x7e, 0x3b, 0xf0, 0x5d, 0xea, 0x77, 0x5a, 0x47, 0x84,
+	0x76, 0x8e, 0x78, 0xb5, 0xb4, 0x61, 0x5c, 0xcf, 0xa7, 0xbd, 0x96, 0xc5, 0xb0, 0xec, 0x4a, 0x0c,
+	0xda, 0x95, 0x10, 0xe4, 0xc2, 0x52, 0x82, 0x25, 0x53, 0x77, 0xe1, 0xa5, 0xa9, 0x7b, 0x4d, 0xa7,
+	0xee, 0x95, 0xac, 0x94, 0x24, 0x7b, 0x2f, 0xc6, 0x40, 0x41, 0x86, 0x76, 0x01, 0x92, 0x82, 0x51,
+	0x05, 0x29, 0xc1, 0x7a, 0x79, 0xd5, 0xd1, 0x07, 0x4f, 0xd1, 0xa2, 0xf7, 0xe0, 0x52, 0x97, 0xfa,
+	0x2d, 0x46, 0xbc, 0xfb, 0x2d, 0x6d, 0x60, 0xc1, 0xb2, 0x2c, 0xbd, 0x77, 0x67, 0xb6, 0x78, 0x18,
+	0x0e, 0xcc, 0x75, 0x5d, 0x54, 0xc7, 0x59, 0x5a, 0xf6, 0x4a, 0x97, 0xfa, 0x07, 0xc4, 0xbb, 0xdf,
+	0x8c, 0x61, 0xdb, 0x8b, 0xdf, 0x7f, 0x62, 0xce, 0xc5, 0x09, 0x4c, 0x61, 0x31, 0x49, 0x2c, 0xc2,
+	0xd0, 0xd7, 0x61, 0x01, 0x47, 0x8b, 0xaa, 0xb1, 0x91, 0xbf, 0xbe, 0x78, 0xe6, 0x60, 0x4f, 0x25,
+	0x68, 0xc2, 0x43, 0xd5, 0x8a, 0xef, 0xfe, 0x71, 0xc3, 0xb0, 0x7e, 0x98, 0x83, 0xf9, 0xe6, 0xe1,
+	0x3e, 0xa6, 0x21, 0x7a, 0x04, 0x2b, 0x49, 0xb0, 0x8d, 0x56, 0x8a, 0xbb, 0xc3, 0x81, 0x59, 0xcd,
+	0xc6, 0xe3, 0x8c, 0xa5, 0xe2, 0xa6, 0xe3, 0x44, 0x9a, 0x24, 0x49, 0x12, 0xd5, 0x8a, 0x47, 0xb0,
+	0xf2, 0x20, 0xaa, 0x58, 0xb1, 0xec, 0x5c, 0x56, 0xf6, 0x18, 0xca, 0x39, 0xca, 0xd4, 0x72, 0xcc,
+	0x44, 0x43, 0x52, 0x85, 0xf3, 0x16, 0x14, 0x95, 0x2d, 0x18, 0xda, 0x86, 0x0b, 0x3d, 0xf1, 0x21,
+	0xcd, 0x5d, 0xbe, 0x51, 0x9b, 0x9a, 0x4d, 0x12, 0x5f, 0xc7, 0x93, 0x22, 0xb1, 0x7e, 0x9a, 0x07,
+	0x68, 0x1e, 0x1e, 0xde, 0x0b, 0x69, 0xcf, 0x23, 0xfc, 0x7f, 0x6a, 0xd7, 0xef, 0x19, 0x70, 0x25,
+	0xb1, 0x1a, 0x0b, 0x9d, 0x8c, 0x71, 0xdf, 0x1a, 0x0e, 0xcc, 0xab, 0x59, 0xe3, 0xa6, 0xd0, 0xce,
+	0x61, 0xe0, 0x4b, 0x31, 0xa3, 0x83, 0xd0, 0x99, 0xac, 0x87, 0xcb, 0x78, 0xac, 0x47, 0x7e, 0xba,
+	0x1e, 0x29, 0xb4, 0xff, 0x48, 0x8f, 0x26, 0xe3, 0xe3, 0xbe, 0x3e, 0x80, 0x72, 0xe2, 0x23, 0x86,
+	0x9a, 0x50, 0xe2, 0xfa, 0x5b, 0xbb, 0xdc, 0x9a, 0xee, 0xf2, 0x88, 0x4c, 0xbb, 0x3d, 0xa6, 0xb4,
+	0x7e, 0x97, 0x03, 0x48, 0xb2, 0xfa, 0xa3, 0x9a, 0x51, 0xe2, 0x3a, 0xd5, 0x97, 0x5f, 0xfe, 0x5c,
+	0x0f, 0x68, 0x4d, 0x9d, 0xf2, 0xd6, 0x5f, 0x73, 0x70, 0xe9, 0xed, 0xa8, 0xf2, 0xbf, 0xb2, 0x30,
+	0xda, 0x87, 0x22, 0xf1, 0x79, 0x48, 0xa5, 0x89, 0x45, 0xb4, 0x7e, 0x66, 0x5a, 0xb4, 0x4e, 0xb0,
+	0xda, 0x2d, 0x9f, 0x87, 0xa7, 0x3a, 0x76, 0x23, 0x36, 0x29, 0x5b, 0xff, 0x38, 0x0f, 0xd5, 0x69,
+	0x54, 0x68, 0x07, 0x2a, 0x4e, 0x48, 0x24, 0x20, 0x7a, 0x1d, 0x18, 0xf2, 0x75, 0xb0, 0x9e, 0x74,
+	0x12, 0x19, 0x04, 0xcb, 0x5e, 0x8a, 0x20, 0xfa, 0x6d, 0xd0, 0x01, 0xf1, 0xcc, 0x17, 0x29, 0x23,
+	0xb0, 0xce, 0xf8, 0xae, 0xb7, 0xf4, 0xe3, 0x20, 0x12, 0x32, 0xca, 0x40, 0xbd, 0x0e, 0x96, 0x12,
+	0xa8, 0x7c, 0x1e, 0xbc, 0x0b, 0x15, 0xea, 0x53, 0x4e, 0xb1, 0xd7, 0x6a, 0x63, 0x0f, 0xfb, 0xce,
+	0x79, 0xba, 0x24, 0x75, 0xa1, 0x6b, 0xb1, 0x19, 0x76, 0x96, 0xbd, 0xa4, 0x21, 0x0d, 0x05, 0x40,
+	0xbb, 0x50, 0x8c, 0x44, 0x15, 0xce, 0xf5, 0x96, 0x8c, 0xc8, 0x53, 0x1e, 0x79, 0x3f, 0x0f, 0x2b,
+	0x36, 0x71, 0x5f, 0xb9, 0x62, 0x36, 0x57, 0xdc, 0x05, 0x50, 0x85, 0x44, 0xdc, 0x24, 0xd5, 0xc2,
+	0xb9, 0x4a, 0xd1, 0x82, 0xe2, 0xd0, 0x64, 0x3c, 0xe5, 0x8f, 0xbf, 0xe7, 0x61, 0x31, 0xed, 0x8f,
+	0x57, 0x57, 0xfc, 0xff, 0xcf, 0x15, 0x8f, 0xf6, 0x92, 0xd2, 0x58, 0x90, 0xa5, 0xf1, 0x53, 0xd3,
+	0x4a, 0xe3, 0x58, 0x4a, 0x4d, 0xaf, 0x89, 0xbf, 0x28, 0xc2, 0xfc, 0x3e, 0x0e, 0x71, 0x97, 0x21,
+	0x67, 0xac, 0xb1, 0x51, 0xc3, 0x8e, 0xb5, 0xb1, 0x84, 0x69, 0xea, 0xa1, 0xd8, 0x4b, 0xfa, 0x9a,
+	0x0f, 0x26, 0xf4, 0x35, 0x5f, 0x86, 0x25, 0x31, 0x8f, 0x89, 0xcf, 0xa7, 0x9c, 0x79, 0xb1, 0xb1,
+	0x96, 0x70, 0x19, 0xdd, 0x57, 0xe3, 0x9a, 0xb8, 0xeb, 0x67, 0xe8, 0x0b, 0x50, 0x16, 0x18, 0xc9,
+	0x2d, 0x21, 0xc8, 0x57, 0x93, 0xb9, 0x48, 0x6a, 0xd3, 0xb2, 0xa1, 0x8b, 0x4f, 0x6e, 0xa9, 0x05,
+	0xba, 0x03, 0xe8, 0x28, 0x1e, 0xcd, 0xb5, 0x12, 0x53, 0x0a, 0xfa, 0x8f, 0x0f, 0x07, 0xe6, 0x9a,
+	0xa2, 0x1f, 0xc7, 0xb1, 0xec, 0x95, 0x04, 0x18, 0x71, 0xfb, 0x1c, 0x80, 0x38, 0x57, 0xcb, 0x25,
+	0x7e, 0xd0, 0xd5, 0xdd, 0xf5, 0x95, 0xe1, 0xc0, 0x5c, 0x51, 0x5c, 0x92, 0x3d, 0xcb, 0x5e, 0x10,
+	0x8b, 0xa6, 0xf8, 0x46, 0xef, 0x1b, 0xb0, 0xd6, 0xf1, 0x82, 0x36, 0xf6, 0x5a, 0x1e, 0x7d, 0xb7,
+	0x4f, 0xdd, 0x96, 0xf6, 0x5d, 0xcb, 0xc1, 0x3d, 0xdd, 0x51, 0xdb, 0x33, 0x77, 0xd4, 0x1b, 0x4a,
+	0xe6, 0x54, 0xc6, 0x96, 0xbd, 0xaa, 0xf6, 0xee, 0xc8, 0xad, 0x03, 0xb5, 0xb3, 0x83, 0x7b, 0xe8,
+	0x27, 0x06, 0x5c, 0x4d, 0x82, 0x76, 0x82, 0x4a, 0x45, 0xa9, 0xd2, 0xdb, 0x33, 0xab, 0xf4, 0x7a,
+	0x36, 0x21, 0x26, 0x69, 0xb5, 0x16, 0x6f, 0x8f, 0x29, 0xa6, 0xbb, 0xd6, 0xcc, 0xfc, 0xad, 0x5a,
+	0x9a, 0xb9, 0x6b, 0x55, 0xea, 0xa4, 0xba, 0xd6, 0x0c, 0x4b, 0xd5, 0xb5, 0x8e, 0xce, 0xed, 0xd0,
+	0x63, 0x03, 0xd6, 0xb5, 0x35, 0x27, 0xf5, 0xce, 0x0b, 0x52, 0x8b, 0x83, 0x99, 0xeb, 0xfb, 0xb5,
+	0x11, 0x3f, 0x4d, 0x6c, 0xa1, 0x5f, 0x53, 0x9b, 0x77, 0xc7, 0x1a, 0xe9, 0x24, 0x65, 0x7f, 0x66,
+	0x00, 0x4a, 0x36, 0x6c, 0xc2, 0x7a, 0x81, 0xcf, 0xe4, 0xc4, 0x20, 0xa5, 0xa2, 0xf1, 0xe2, 0x89,
+	0x41, 0x42, 0x1f, 0x4d, 0x0c, 0x52, 0x45, 0xff, 0x8b, 0xc9, 0x4d, 0x9f, 0xd3, 0x15, 0x40, 0xb3,
+	0x69, 0x63, 0x46, 0x52, 0x53, 0x07, 0x1a, 0x51, 0x4f, 0xb8, 0xda, 0x7f, 0x63, 0xc0, 0xda, 0x58,
+	0x1d, 0x8a, 0x95, 0xfd, 0x36, 0xa0, 0x30, 0xb5, 0x29, 0x33, 0xed, 0x54, 0x2b, 0x3d, 0x73, 0x59,
+	0x5b, 0x09, 0xb3, 0x1b, 0xff, 0xc5, 0xc7, 0x4a, 0x41, 0x9e, 0xe6, 0x57, 0x06, 0x5c, 0x4e, 0x8b,
+	0x8f, 0x0f, 0xf2, 0x35, 0x58, 0x4c, 0x4b, 0xd7, 0x47, 0x78, 0xe3, 0x2c, 0x47, 0xd0, 0xda, 0x8f,
+	0xd0, 0xa3, 0xb7, 0x92, 0x22, 0xaf, 0xc6, 0xfe, 0x5b, 0x67, 0xb6, 0x46, 0xa4, 0x53, 0xb6, 0xd8,
+	0xab, 0x13, 0xfc, 0xcb, 0x80, 0xc2, 0x7e, 0x10, 0x78, 0x28, 0x80, 0x15, 0x3f, 0xe0, 0x2d, 0x51,
+	0x93, 0x88, 0xdb, 0xd2, 0xd3, 0x41, 0xf5, 0x7b, 0xc0, 0xce, 0x6c, 0x46, 0xfa, 0xdb, 0xc0, 0x1c,
+	0x67, 0x65, 0x57, 0xfc, 0x80, 0x37, 0x24, 0xe4, 0x9e, 0x04, 0xa0, 0xf7, 0xe0, 0xe2, 0xa8, 0x30,
+	0x35, 0x2b, 0xfd, 0xc6, 0xcc, 0xc2, 0x46, 0xd9, 0x0c, 0x07, 0xe6, 0xe5, 0xa4, 0xd6, 0xc6, 0x60,
+	0xcb, 0x5e, 0x6c, 0xa7, 0xa4, 0x6f, 0x97, 0xc4, 0xe9, 0xff, 0x21, 0x2c, 0xf0, 0xa3, 0x1c, 0x5c,
+	0x92, 0x40, 0xfa, 0x88, 0xc8, 0x01, 0xa3, 0x4d, 0x9c, 0x20, 0x74, 0xd1, 0x12, 0xe4, 0xa8, 0x2b,
+	0x2d, 0x50, 0xb0, 0x73, 0xd4, 0x45, 0x5f, 0x81, 0x0b, 0xc1, 0x43, 0x9f, 0x84, 0xfa, 0x99, 0xb1,
+	0x35, 0xfb, 0x5b, 0x46, 0xd1, 0xcb, 0xbb, 0x2e, 0x70, 0xfb, 0x1e, 0x69, 0x61, 0xc7, 0x09, 0xfa,
+	0x3e, 0xd7, 0x0f, 0xc3, 0xf4, 0x5d, 0x37, 0xb2, 0x2f, 0xee, 0x3a, 0x09, 0xb8, 0xa9, 0xd6, 0x62,
+	0x3e, 0x16, 0x97, 0xc8, 0x6a, 0x61, 0x26, 0x75, 0xd2, 0xf3, 0xb1, 0x98, 0x47, 0x92, 0x9f, 0x8d,
+	0xdb, 0x1f, 0x3e, 0xab, 0x19, 0x4f, 0x9f, 0xd5, 0x8c, 0x3f, 0x3f, 0xab, 0x19, 0x8f, 0x9f, 0xd7,
+	0xe6, 0x9e, 0x3e, 0xaf, 0xcd, 0xfd, 0xfe, 0x79, 0x6d, 0xee, 0x9b, 0x9f, 0x7e, 0x21, 0xf7, 0x93,
+	0xf8, 0xe7, 0x3a, 0x29, 0xa7, 0x3d, 0x2f, 0x5f, 0x05, 0x9f, 0xfd, 0xf7, 0x00, 0xb9, 0x4d, 0x61,
+	0x4b, 0xcd, 0x1b, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1265,570 +1364,643 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9000 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x74, 0x1c, 0xe7,
-		0x75, 0x18, 0x67, 0x1f, 0xc0, 0xee, 0xc5, 0x6b, 0xf1, 0x01, 0xa4, 0x96, 0x4b, 0x0a, 0x80, 0x46,
-		0xb2, 0x44, 0x51, 0xd2, 0x42, 0xa2, 0x28, 0x92, 0x5a, 0xda, 0x96, 0xb1, 0xc0, 0x12, 0x04, 0x89,
-		0x97, 0x06, 0x20, 0x25, 0xdb, 0x49, 0xe7, 0x0c, 0x66, 0x3f, 0x2c, 0x46, 0x9c, 0x9d, 0x19, 0xcd,
-		0xcc, 0x92, 0x00, 0x6d, 0x9f, 0xa3, 0xc4, 0x8f, 0xc6, 0x6a, 0x53, 0x3b, 0x8f, 0xd3, 0xda, 0x8e,
-		0xe5, 0xfa, 0xd1, 0xd6, 0xa9, 0xd3, 0x36, 0x49, 0x9d, 0xa6, 0x4d, 0xd3, 0xd3, 0x3a, 0xad, 0x93,
-		0x38, 0x6d, 0xd3, 0x63, 0x9f, 0xba, 0x6d, 0x9a, 0xd3, 0xd2, 0xa9, 0xec, 0xd3, 0x3a, 0xae, 0xdb,
-		0xa4, 0xac, 0xfa, 0xd4, 0x8f, 0xe4, 0x7c, 0xaf, 0x79, 0xec, 0x03, 0xbb, 0x0b, 0x51, 0xb2, 0x75,
-		0xa2, 0x5f, 0x98, 0xb9, 0x73, 0xef, 0xfd, 0xee, 0xbd, 0xdf, 0xbd, 0xf7, 0xbb, 0xdf, 0x6b, 0x01,
-		0x5f, 0x4d, 0xc0, 0x49, 0xdd, 0xf6, 0xea, 0xb6, 0x37, 0xbb, 0xa5, 0x79, 0x78, 0xf6, 0xf9, 0x06,
-		0x76, 0xf7, 0x66, 0xaf, 0x3f, 0xb6, 0x85, 0x7d, 0xed, 0xb1, 0x59, 0x47, 0xab, 0x19, 0x96, 0xe6,
-		0x1b, 0xb6, 0x55, 0x74, 0x5c, 0xdb, 0xb7, 0xd1, 0x51, 0x86, 0x5b, 0x24, 0xb8, 0x45, 0x8a, 0x5b,
-		0xe4, 0xb8, 0xb2, 0x05, 0x43, 0xeb, 0x5a, 0x0d, 0x2b, 0xf8, 0xf9, 0x06, 0xf6, 0x7c, 0x94, 0x83,
-		0xe4, 0x35, 0xbc, 0x97, 0x97, 0x66, 0xa4, 0x13, 0xc3, 0x0a, 0x79, 0x44, 0x47, 0x60, 0xc0, 0xde,
-		0xde, 0xf6, 0xb0, 0x9f, 0x4f, 0xcc, 0x48, 0x27, 0x52, 0x0a, 0x7f, 0x43, 0x93, 0x90, 0x36, 0x8d,
-		0xba, 0xe1, 0xe7, 0x93, 0x14, 0xcc, 0x5e, 0xd0, 0x34, 0x0c, 0xe9, 0x76, 0xc3, 0xf2, 0x55, 0xdf,
-		0xf6, 0x35, 0x33, 0x9f, 0x9a, 0x91, 0x4e, 0x64, 0x14, 0xa0, 0xa0, 0x4d, 0x02, 0x91, 0x9f, 0x82,
-		0x61, 0xd6, 0x9e, 0xe7, 0xd8, 0x96, 0x87, 0xd1, 0x51, 0xc8, 0x58, 0x78, 0xd7, 0x57, 0xc3, 0x56,
-		0x07, 0xc9, 0xfb, 0x65, 0xbc, 0x47, 0x5a, 0x60, 0x5c, 0x58, 0xc3, 0xec, 0xa5, 0x5c, 0xfe, 0xda,
-		0xcb, 0x53, 0xd2, 0xd7, 0x5f, 0x9e, 0x92, 0xfe, 0xe0, 0xe5, 0x29, 0xe9, 0xe3, 0xdf, 0x9e, 0x3a,
-		0xf4, 0xf5, 0x6f, 0x4f, 0x1d, 0xfa, 0xbd, 0x6f, 0x4f, 0x1d, 0x7a, 0xcf, 0x89, 0x9a, 0xe1, 0xef,
-		0x34, 0xb6, 0x8a, 0xba, 0x5d, 0x9f, 0xe5, 0xc6, 0x61, 0x7f, 0x1e, 0xf1, 0xaa, 0xd7, 0x66, 0xfd,
-		0x3d, 0x07, 0x7b, 0xcc, 0x50, 0x5b, 0x03, 0xd4, 0x2c, 0x8f, 0xc3, 0xcf, 0x9d, 0x87, 0x99, 0x9a,
-		0x6d, 0xd7, 0x4c, 0x3c, 0x4b, 0x21, 0x5b, 0x8d, 0xed, 0xd9, 0x2a, 0xf6, 0x74, 0xd7, 0x70, 0x7c,
-		0xdb, 0xe5, 0xc6, 0x1b, 0x63, 0x18, 0x45, 0x81, 0x21, 0xaf, 0xc0, 0xf8, 0x05, 0xc3, 0xc4, 0x0b,
-		0x01, 0xe2, 0x06, 0xf6, 0xd1, 0x39, 0x48, 0x6d, 0x1b, 0x26, 0xce, 0x4b, 0x33, 0xc9, 0x13, 0x43,
-		0xa7, 0xee, 0x2b, 0x36, 0x11, 0x15, 0xe3, 0x14, 0xeb, 0x04, 0xac, 0x50, 0x0a, 0xf9, 0x3b, 0x29,
-		0x98, 0x68, 0xf3, 0x15, 0x21, 0x48, 0x59, 0x5a, 0x1d, 0x53, 0xab, 0x64, 0x15, 0xfa, 0x8c, 0xf2,
-		0x30, 0xe8, 0x68, 0xfa, 0x35, 0xad, 0x86, 0xa9, 0x51, 0xb2, 0x8a, 0x78, 0x45, 0x53, 0x00, 0x55,
-		0xec, 0x60, 0xab, 0x8a, 0x2d, 0x7d, 0x2f, 0x9f, 0x9c, 0x49, 0x9e, 0xc8, 0x2a, 0x11, 0x08, 0x7a,
-		0x08, 0xc6, 0x9d, 0xc6, 0x96, 0x69, 0xe8, 0x6a, 0x04, 0x0d, 0x66, 0x92, 0x27, 0xd2, 0x4a, 0x8e,
-		0x7d, 0x58, 0x08, 0x91, 0x1f, 0x80, 0xb1, 0x1b, 0x58, 0xbb, 0x16, 0x45, 0x1d, 0xa2, 0xa8, 0xa3,
-		0x04, 0x1c, 0x41, 0x9c, 0x87, 0xe1, 0x3a, 0xf6, 0x3c, 0xad, 0x86, 0x55, 0x62, 0xdf, 0x7c, 0x8a,
-		0x6a, 0x3f, 0xd3, 0xa2, 0x7d, 0xb3, 0xe6, 0x43, 0x9c, 0x6a, 0x73, 0xcf, 0xc1, 0x68, 0x0e, 0xb2,
-		0xd8, 0x6a, 0xd4, 0x19, 0x87, 0x74, 0x07, 0xfb, 0x55, 0xac, 0x46, 0xbd, 0x99, 0x4b, 0x86, 0x90,
-		0x71, 0x16, 0x83, 0x1e, 0x76, 0xaf, 0x1b, 0x3a, 0xce, 0x0f, 0x50, 0x06, 0x0f, 0xb4, 0x30, 0xd8,
-		0x60, 0xdf, 0x9b, 0x79, 0x08, 0x3a, 0x34, 0x0f, 0x59, 0xbc, 0xeb, 0x63, 0xcb, 0x33, 0x6c, 0x2b,
-		0x3f, 0x48, 0x99, 0xbc, 0xad, 0x4d, 0x2f, 0x62, 0xb3, 0xda, 0xcc, 0x22, 0xa4, 0x43, 0x67, 0x60,
-		0xd0, 0x76, 0x48, 0xe0, 0x79, 0xf9, 0xcc, 0x8c, 0x74, 0x62, 0xe8, 0xd4, 0xf1, 0xb6, 0x8e, 0xb0,
-		0xc6, 0x70, 0x14, 0x81, 0x8c, 0x96, 0x20, 0xe7, 0xd9, 0x0d, 0x57, 0xc7, 0xaa, 0x6e, 0x57, 0xb1,
-		0x6a, 0x58, 0xdb, 0x76, 0x3e, 0x4b, 0x19, 0x4c, 0xb7, 0x2a, 0x42, 0x11, 0xe7, 0xed, 0x2a, 0x5e,
-		0xb2, 0xb6, 0x6d, 0x65, 0xd4, 0x8b, 0xbd, 0x93, 0x78, 0xf5, 0xf6, 0x2c, 0x5f, 0xdb, 0xcd, 0x0f,
-		0x53, 0x0f, 0xe1, 0x6f, 0xf2, 0xaf, 0x0d, 0xc0, 0x58, 0x2f, 0x2e, 0x76, 0x1e, 0xd2, 0xdb, 0x44,
-		0xcb, 0x7c, 0xa2, 0x1f, 0x1b, 0x30, 0x9a, 0xb8, 0x11, 0x07, 0x0e, 0x68, 0xc4, 0x39, 0x18, 0xb2,
-		0xb0, 0xe7, 0xe3, 0x2a, 0xf3, 0x88, 0x64, 0x8f, 0x3e, 0x05, 0x8c, 0xa8, 0xd5, 0xa5, 0x52, 0x07,
-		0x72, 0xa9, 0x67, 0x61, 0x2c, 0x10, 0x49, 0x75, 0x35, 0xab, 0x26, 0x7c, 0x73, 0xb6, 0x9b, 0x24,
-		0xc5, 0x8a, 0xa0, 0x53, 0x08, 0x99, 0x32, 0x8a, 0x63, 0xef, 0x68, 0x01, 0xc0, 0xb6, 0xb0, 0xbd,
-		0xad, 0x56, 0xb1, 0x6e, 0xe6, 0x33, 0x1d, 0xac, 0xb4, 0x46, 0x50, 0x5a, 0xac, 0x64, 0x33, 0xa8,
-		0x6e, 0xa2, 0x27, 0x43, 0x57, 0x1b, 0xec, 0xe0, 0x29, 0x2b, 0x2c, 0xc8, 0x5a, 0xbc, 0xed, 0x0a,
-		0x8c, 0xba, 0x98, 0xf8, 0x3d, 0xae, 0x72, 0xcd, 0xb2, 0x54, 0x88, 0x62, 0x57, 0xcd, 0x14, 0x4e,
-		0xc6, 0x14, 0x1b, 0x71, 0xa3, 0xaf, 0xe8, 0x5e, 0x08, 0x00, 0x2a, 0x75, 0x2b, 0xa0, 0x59, 0x68,
-		0x58, 0x00, 0x57, 0xb5, 0x3a, 0x2e, 0xdc, 0x84, 0xd1, 0xb8, 0x79, 0x48, 0x9a, 0xf7, 0x7c, 0xcd,
-		0xf5, 0xa9, 0x17, 0xa6, 0x15, 0xf6, 0x42, 0x06, 0x22, 0x6c, 0x55, 0x69, 0x96, 0x4b, 0x2b, 0xe4,
-		0x11, 0xbd, 0x2b, 0x54, 0x38, 0x49, 0x15, 0xbe, 0xbf, 0xb5, 0x47, 0x63, 0x9c, 0x9b, 0xf5, 0x2e,
-		0x9c, 0x85, 0x91, 0x98, 0x02, 0xbd, 0x36, 0x2d, 0xbf, 0x1f, 0x0e, 0xb7, 0x65, 0x8d, 0x9e, 0x85,
-		0xc9, 0x86, 0x65, 0x58, 0x3e, 0x76, 0x1d, 0x17, 0x13, 0x8f, 0x65, 0x4d, 0xe5, 0xff, 0xcb, 0x60,
-		0x07, 0x9f, 0xbb, 0x12, 0xc5, 0x66, 0x5c, 0x94, 0x89, 0x46, 0x2b, 0xf0, 0x64, 0x36, 0xf3, 0xdd,
-		0xc1, 0xdc, 0x0b, 0x2f, 0xbc, 0xf0, 0x42, 0x42, 0xfe, 0xc4, 0x00, 0x4c, 0xb6, 0x8b, 0x99, 0xb6,
-		0xe1, 0x7b, 0x04, 0x06, 0xac, 0x46, 0x7d, 0x0b, 0xbb, 0xd4, 0x48, 0x69, 0x85, 0xbf, 0xa1, 0x39,
-		0x48, 0x9b, 0xda, 0x16, 0x66, 0x43, 0xf2, 0xe8, 0xa9, 0x87, 0x7a, 0x8a, 0xca, 0xe2, 0x32, 0x21,
-		0x51, 0x18, 0x25, 0x7a, 0x27, 0xa4, 0x78, 0x8a, 0x26, 0x1c, 0x4e, 0xf6, 0xc6, 0x81, 0xc4, 0x92,
-		0x42, 0xe9, 0xd0, 0x31, 0xc8, 0x92, 0xbf, 0xcc, 0x37, 0x06, 0xa8, 0xcc, 0x19, 0x02, 0x20, 0x7e,
-		0x81, 0x0a, 0x90, 0xa1, 0x61, 0x52, 0xc5, 0x62, 0x68, 0x0b, 0xde, 0x89, 0x63, 0x55, 0xf1, 0xb6,
-		0xd6, 0x30, 0x7d, 0xf5, 0xba, 0x66, 0x36, 0x30, 0x75, 0xf8, 0xac, 0x32, 0xcc, 0x81, 0x57, 0x09,
-		0x8c, 0x54, 0x1e, 0x2c, 0xaa, 0x0c, 0xab, 0x8a, 0x77, 0x69, 0xf6, 0x4c, 0x2b, 0x2c, 0xd0, 0x96,
-		0x08, 0x84, 0x34, 0xff, 0x9c, 0x67, 0x5b, 0xc2, 0x35, 0x69, 0x13, 0x04, 0x40, 0x9b, 0x3f, 0xdb,
-		0x9c, 0xb8, 0xef, 0x6e, 0xaf, 0x5e, 0xb3, 0x4f, 0xc9, 0xbf, 0x9a, 0x80, 0x14, 0xcd, 0x17, 0x63,
-		0x30, 0xb4, 0xf9, 0xee, 0xf5, 0x8a, 0xba, 0xb0, 0x76, 0xa5, 0xbc, 0x5c, 0xc9, 0x49, 0x68, 0x14,
-		0x80, 0x02, 0x2e, 0x2c, 0xaf, 0xcd, 0x6d, 0xe6, 0x12, 0xc1, 0xfb, 0xd2, 0xea, 0xe6, 0x99, 0xd3,
-		0xb9, 0x64, 0x40, 0x70, 0x85, 0x01, 0x52, 0x51, 0x84, 0xc7, 0x4f, 0xe5, 0xd2, 0x28, 0x07, 0xc3,
-		0x8c, 0xc1, 0xd2, 0xb3, 0x95, 0x85, 0x33, 0xa7, 0x73, 0x03, 0x71, 0xc8, 0xe3, 0xa7, 0x72, 0x83,
-		0x68, 0x04, 0xb2, 0x14, 0x52, 0x5e, 0x5b, 0x5b, 0xce, 0x65, 0x02, 0x9e, 0x1b, 0x9b, 0xca, 0xd2,
-		0xea, 0x62, 0x2e, 0x1b, 0xf0, 0x5c, 0x54, 0xd6, 0xae, 0xac, 0xe7, 0x20, 0xe0, 0xb0, 0x52, 0xd9,
-		0xd8, 0x98, 0x5b, 0xac, 0xe4, 0x86, 0x02, 0x8c, 0xf2, 0xbb, 0x37, 0x2b, 0x1b, 0xb9, 0xe1, 0x98,
-		0x58, 0x8f, 0x9f, 0xca, 0x8d, 0x04, 0x4d, 0x54, 0x56, 0xaf, 0xac, 0xe4, 0x46, 0xd1, 0x38, 0x8c,
-		0xb0, 0x26, 0x84, 0x10, 0x63, 0x4d, 0xa0, 0x33, 0xa7, 0x73, 0xb9, 0x50, 0x10, 0xc6, 0x65, 0x3c,
-		0x06, 0x38, 0x73, 0x3a, 0x87, 0xe4, 0x79, 0x48, 0x53, 0xef, 0x42, 0x08, 0x46, 0x97, 0xe7, 0xca,
-		0x95, 0x65, 0x75, 0x6d, 0x7d, 0x73, 0x69, 0x6d, 0x75, 0x6e, 0x39, 0x27, 0x85, 0x30, 0xa5, 0xf2,
-		0xf4, 0x95, 0x25, 0xa5, 0xb2, 0x90, 0x4b, 0x44, 0x61, 0xeb, 0x95, 0xb9, 0xcd, 0xca, 0x42, 0x2e,
-		0x29, 0xeb, 0x30, 0xd9, 0x2e, 0x4f, 0xb6, 0x8d, 0x8c, 0x48, 0x17, 0x27, 0x3a, 0x74, 0x31, 0xe5,
-		0xd5, 0xd2, 0xc5, 0xdf, 0x4e, 0xc0, 0x44, 0x9b, 0xb1, 0xa2, 0x6d, 0x23, 0x4f, 0x41, 0x9a, 0xb9,
-		0x28, 0x1b, 0x3d, 0x1f, 0x6c, 0x3b, 0xe8, 0x50, 0x87, 0x6d, 0x19, 0x41, 0x29, 0x5d, 0xb4, 0x82,
-		0x48, 0x76, 0xa8, 0x20, 0x08, 0x8b, 0x96, 0x9c, 0xfe, 0xa3, 0x2d, 0x39, 0x9d, 0x0d, 0x7b, 0x67,
-		0x7a, 0x19, 0xf6, 0x28, 0xac, 0xbf, 0xdc, 0x9e, 0x6e, 0x93, 0xdb, 0xcf, 0xc3, 0x78, 0x0b, 0xa3,
-		0x9e, 0x73, 0xec, 0x07, 0x25, 0xc8, 0x77, 0x32, 0x4e, 0x97, 0x4c, 0x97, 0x88, 0x65, 0xba, 0xf3,
-		0xcd, 0x16, 0xbc, 0xa7, 0x73, 0x27, 0xb4, 0xf4, 0xf5, 0x17, 0x25, 0x38, 0xd2, 0xbe, 0x52, 0x6c,
-		0x2b, 0xc3, 0x3b, 0x61, 0xa0, 0x8e, 0xfd, 0x1d, 0x5b, 0x54, 0x4b, 0xf7, 0xb7, 0x19, 0x83, 0xc9,
-		0xe7, 0xe6, 0xce, 0xe6, 0x54, 0xd1, 0x41, 0x3c, 0xd9, 0xa9, 0xdc, 0x63, 0xd2, 0xb4, 0x48, 0xfa,
-		0xd1, 0x04, 0x1c, 0x6e, 0xcb, 0xbc, 0xad, 0xa0, 0x77, 0x03, 0x18, 0x96, 0xd3, 0xf0, 0x59, 0x45,
-		0xc4, 0x12, 0x6c, 0x96, 0x42, 0x68, 0xf2, 0x22, 0xc9, 0xb3, 0xe1, 0x07, 0xdf, 0x93, 0xf4, 0x3b,
-		0x30, 0x10, 0x45, 0x38, 0x17, 0x0a, 0x9a, 0xa2, 0x82, 0x4e, 0x75, 0xd0, 0xb4, 0xc5, 0x31, 0x1f,
-		0x85, 0x9c, 0x6e, 0x1a, 0xd8, 0xf2, 0x55, 0xcf, 0x77, 0xb1, 0x56, 0x37, 0xac, 0x1a, 0x1d, 0x41,
-		0x32, 0xa5, 0xf4, 0xb6, 0x66, 0x7a, 0x58, 0x19, 0x63, 0x9f, 0x37, 0xc4, 0x57, 0x42, 0x41, 0x1d,
-		0xc8, 0x8d, 0x50, 0x0c, 0xc4, 0x28, 0xd8, 0xe7, 0x80, 0x42, 0xfe, 0xe9, 0x2c, 0x0c, 0x45, 0xea,
-		0x6a, 0x74, 0x0f, 0x0c, 0x3f, 0xa7, 0x5d, 0xd7, 0x54, 0x31, 0x57, 0x62, 0x96, 0x18, 0x22, 0xb0,
-		0x75, 0x3e, 0x5f, 0x7a, 0x14, 0x26, 0x29, 0x8a, 0xdd, 0xf0, 0xb1, 0xab, 0xea, 0xa6, 0xe6, 0x79,
-		0xd4, 0x68, 0x19, 0x8a, 0x8a, 0xc8, 0xb7, 0x35, 0xf2, 0x69, 0x5e, 0x7c, 0x41, 0x4f, 0xc0, 0x04,
-		0xa5, 0xa8, 0x37, 0x4c, 0xdf, 0x70, 0x4c, 0xac, 0x92, 0xd9, 0x9b, 0x47, 0x47, 0x92, 0x40, 0xb2,
-		0x71, 0x82, 0xb1, 0xc2, 0x11, 0x88, 0x44, 0x1e, 0x5a, 0x80, 0xbb, 0x29, 0x59, 0x0d, 0x5b, 0xd8,
-		0xd5, 0x7c, 0xac, 0xe2, 0xe7, 0x1b, 0x9a, 0xe9, 0xa9, 0x9a, 0x55, 0x55, 0x77, 0x34, 0x6f, 0x27,
-		0x3f, 0x49, 0x18, 0x94, 0x13, 0x79, 0x49, 0x39, 0x4a, 0x10, 0x17, 0x39, 0x5e, 0x85, 0xa2, 0xcd,
-		0x59, 0xd5, 0x8b, 0x9a, 0xb7, 0x83, 0x4a, 0x70, 0x84, 0x72, 0xf1, 0x7c, 0xd7, 0xb0, 0x6a, 0xaa,
-		0xbe, 0x83, 0xf5, 0x6b, 0x6a, 0xc3, 0xdf, 0x3e, 0x97, 0x3f, 0x16, 0x6d, 0x9f, 0x4a, 0xb8, 0x41,
-		0x71, 0xe6, 0x09, 0xca, 0x15, 0x7f, 0xfb, 0x1c, 0xda, 0x80, 0x61, 0xd2, 0x19, 0x75, 0xe3, 0x26,
-		0x56, 0xb7, 0x6d, 0x97, 0x0e, 0x8d, 0xa3, 0x6d, 0x52, 0x53, 0xc4, 0x82, 0xc5, 0x35, 0x4e, 0xb0,
-		0x62, 0x57, 0x71, 0x29, 0xbd, 0xb1, 0x5e, 0xa9, 0x2c, 0x28, 0x43, 0x82, 0xcb, 0x05, 0xdb, 0x25,
-		0x0e, 0x55, 0xb3, 0x03, 0x03, 0x0f, 0x31, 0x87, 0xaa, 0xd9, 0xc2, 0xbc, 0x4f, 0xc0, 0x84, 0xae,
-		0x33, 0x9d, 0x0d, 0x5d, 0xe5, 0x73, 0x2c, 0x2f, 0x9f, 0x8b, 0x19, 0x4b, 0xd7, 0x17, 0x19, 0x02,
-		0xf7, 0x71, 0x0f, 0x3d, 0x09, 0x87, 0x43, 0x63, 0x45, 0x09, 0xc7, 0x5b, 0xb4, 0x6c, 0x26, 0x7d,
-		0x02, 0x26, 0x9c, 0xbd, 0x56, 0x42, 0x14, 0x6b, 0xd1, 0xd9, 0x6b, 0x26, 0x3b, 0x0b, 0x93, 0xce,
-		0x8e, 0xd3, 0x4a, 0x77, 0x32, 0x4a, 0x87, 0x9c, 0x1d, 0xa7, 0x99, 0xf0, 0x6d, 0x74, 0xc2, 0xed,
-		0x62, 0x5d, 0xf3, 0x71, 0x35, 0x7f, 0x57, 0x14, 0x3d, 0xf2, 0x01, 0xcd, 0x42, 0x4e, 0xd7, 0x55,
-		0x6c, 0x69, 0x5b, 0x26, 0x56, 0x35, 0x17, 0x5b, 0x9a, 0x97, 0x9f, 0x8e, 0x22, 0x8f, 0xea, 0x7a,
-		0x85, 0x7e, 0x9d, 0xa3, 0x1f, 0xd1, 0x49, 0x18, 0xb7, 0xb7, 0x9e, 0xd3, 0x99, 0x4b, 0xaa, 0x8e,
-		0x8b, 0xb7, 0x8d, 0xdd, 0xfc, 0x7d, 0xd4, 0xbe, 0x63, 0xe4, 0x03, 0x75, 0xc8, 0x75, 0x0a, 0x46,
-		0x0f, 0x42, 0x4e, 0xf7, 0x76, 0x34, 0xd7, 0xa1, 0x39, 0xd9, 0x73, 0x34, 0x1d, 0xe7, 0xdf, 0xc6,
-		0x50, 0x19, 0x7c, 0x55, 0x80, 0x49, 0x48, 0x78, 0x37, 0x8c, 0x6d, 0x5f, 0x70, 0x7c, 0x80, 0x85,
-		0x04, 0x85, 0x71, 0x6e, 0x27, 0x20, 0x47, 0x4c, 0x11, 0x6b, 0xf8, 0x04, 0x45, 0x1b, 0x75, 0x76,
-		0x9c, 0x68, 0xbb, 0xf7, 0xc2, 0x08, 0xc1, 0x0c, 0x1b, 0x7d, 0x90, 0x15, 0x64, 0xce, 0x4e, 0xa4,
-		0xc5, 0xd3, 0x70, 0x84, 0x20, 0xd5, 0xb1, 0xaf, 0x55, 0x35, 0x5f, 0x8b, 0x60, 0x3f, 0x4c, 0xb1,
-		0x89, 0xdd, 0x57, 0xf8, 0xc7, 0x98, 0x9c, 0x6e, 0x63, 0x6b, 0x2f, 0xf0, 0xac, 0x47, 0x98, 0x9c,
-		0x04, 0x26, 0x7c, 0xeb, 0x75, 0x2b, 0xba, 0xe5, 0x12, 0x0c, 0x47, 0x1d, 0x1f, 0x65, 0x81, 0xb9,
-		0x7e, 0x4e, 0x22, 0x55, 0xd0, 0xfc, 0xda, 0x02, 0xa9, 0x5f, 0xde, 0x53, 0xc9, 0x25, 0x48, 0x1d,
-		0xb5, 0xbc, 0xb4, 0x59, 0x51, 0x95, 0x2b, 0xab, 0x9b, 0x4b, 0x2b, 0x95, 0x5c, 0x32, 0x52, 0xb0,
-		0x5f, 0x4a, 0x65, 0xee, 0xcf, 0x3d, 0x20, 0x7f, 0x23, 0x01, 0xa3, 0xf1, 0x19, 0x18, 0x7a, 0x3b,
-		0xdc, 0x25, 0x96, 0x4b, 0x3c, 0xec, 0xab, 0x37, 0x0c, 0x97, 0x46, 0x64, 0x5d, 0x63, 0xa3, 0x63,
-		0xe0, 0x13, 0x93, 0x1c, 0x6b, 0x03, 0xfb, 0xcf, 0x18, 0x2e, 0x89, 0xb7, 0xba, 0xe6, 0xa3, 0x65,
-		0x98, 0xb6, 0x6c, 0xd5, 0xf3, 0x35, 0xab, 0xaa, 0xb9, 0x55, 0x35, 0x5c, 0xa8, 0x52, 0x35, 0x5d,
-		0xc7, 0x9e, 0x67, 0xb3, 0x91, 0x30, 0xe0, 0x72, 0xdc, 0xb2, 0x37, 0x38, 0x72, 0x38, 0x44, 0xcc,
-		0x71, 0xd4, 0x26, 0xff, 0x4d, 0x76, 0xf2, 0xdf, 0x63, 0x90, 0xad, 0x6b, 0x8e, 0x8a, 0x2d, 0xdf,
-		0xdd, 0xa3, 0x75, 0x77, 0x46, 0xc9, 0xd4, 0x35, 0xa7, 0x42, 0xde, 0xdf, 0x90, 0xe9, 0xcf, 0xa5,
-		0x54, 0x26, 0x93, 0xcb, 0x5e, 0x4a, 0x65, 0xb2, 0x39, 0x90, 0x5f, 0x4e, 0xc2, 0x70, 0xb4, 0x0e,
-		0x27, 0xd3, 0x1a, 0x9d, 0x0e, 0x59, 0x12, 0x4d, 0x6a, 0xf7, 0xee, 0x5b, 0xb5, 0x17, 0xe7, 0xc9,
-		0x58, 0x56, 0x1a, 0x60, 0xd5, 0xb1, 0xc2, 0x28, 0x49, 0x1d, 0x41, 0x9c, 0x0d, 0xb3, 0x6a, 0x24,
-		0xa3, 0xf0, 0x37, 0xb4, 0x08, 0x03, 0xcf, 0x79, 0x94, 0xf7, 0x00, 0xe5, 0x7d, 0xdf, 0xfe, 0xbc,
-		0x2f, 0x6d, 0x50, 0xe6, 0xd9, 0x4b, 0x1b, 0xea, 0xea, 0x9a, 0xb2, 0x32, 0xb7, 0xac, 0x70, 0x72,
-		0x74, 0x14, 0x52, 0xa6, 0x76, 0x73, 0x2f, 0x3e, 0xea, 0x51, 0x50, 0xaf, 0x9d, 0x70, 0x14, 0x52,
-		0x37, 0xb0, 0x76, 0x2d, 0x3e, 0xd6, 0x50, 0xd0, 0xeb, 0x18, 0x0c, 0xb3, 0x90, 0xa6, 0xf6, 0x42,
-		0x00, 0xdc, 0x62, 0xb9, 0x43, 0x28, 0x03, 0xa9, 0xf9, 0x35, 0x85, 0x04, 0x44, 0x0e, 0x86, 0x19,
-		0x54, 0x5d, 0x5f, 0xaa, 0xcc, 0x57, 0x72, 0x09, 0xf9, 0x09, 0x18, 0x60, 0x46, 0x20, 0xc1, 0x12,
-		0x98, 0x21, 0x77, 0x88, 0xbf, 0x72, 0x1e, 0x92, 0xf8, 0x7a, 0x65, 0xa5, 0x5c, 0x51, 0x72, 0x89,
-		0x78, 0x57, 0xa7, 0x72, 0x69, 0xd9, 0x83, 0xe1, 0x68, 0x21, 0xfe, 0xc6, 0x4c, 0xb2, 0xbf, 0x22,
-		0xc1, 0x50, 0xa4, 0xb0, 0x26, 0x15, 0x91, 0x66, 0x9a, 0xf6, 0x0d, 0x55, 0x33, 0x0d, 0xcd, 0xe3,
-		0xae, 0x01, 0x14, 0x34, 0x47, 0x20, 0xbd, 0x76, 0xdd, 0x1b, 0x14, 0x22, 0xe9, 0xdc, 0x80, 0xfc,
-		0x19, 0x09, 0x72, 0xcd, 0x95, 0x6d, 0x93, 0x98, 0xd2, 0x0f, 0x52, 0x4c, 0xf9, 0xd3, 0x12, 0x8c,
-		0xc6, 0xcb, 0xd9, 0x26, 0xf1, 0xee, 0xf9, 0x81, 0x8a, 0xf7, 0x07, 0x09, 0x18, 0x89, 0x15, 0xb1,
-		0xbd, 0x4a, 0xf7, 0x3c, 0x8c, 0x1b, 0x55, 0x5c, 0x77, 0x6c, 0x1f, 0x5b, 0xfa, 0x9e, 0x6a, 0xe2,
-		0xeb, 0xd8, 0xcc, 0xcb, 0x34, 0x69, 0xcc, 0xee, 0x5f, 0x26, 0x17, 0x97, 0x42, 0xba, 0x65, 0x42,
-		0x56, 0x9a, 0x58, 0x5a, 0xa8, 0xac, 0xac, 0xaf, 0x6d, 0x56, 0x56, 0xe7, 0xdf, 0xad, 0x5e, 0x59,
-		0xbd, 0xbc, 0xba, 0xf6, 0xcc, 0xaa, 0x92, 0x33, 0x9a, 0xd0, 0x5e, 0xc7, 0xb0, 0x5f, 0x87, 0x5c,
-		0xb3, 0x50, 0xe8, 0x2e, 0x68, 0x27, 0x56, 0xee, 0x10, 0x9a, 0x80, 0xb1, 0xd5, 0x35, 0x75, 0x63,
-		0x69, 0xa1, 0xa2, 0x56, 0x2e, 0x5c, 0xa8, 0xcc, 0x6f, 0x6e, 0xb0, 0x85, 0x8f, 0x00, 0x7b, 0x33,
-		0x16, 0xe0, 0xf2, 0xa7, 0x92, 0x30, 0xd1, 0x46, 0x12, 0x34, 0xc7, 0xa7, 0x2c, 0x6c, 0x16, 0xf5,
-		0x48, 0x2f, 0xd2, 0x17, 0x49, 0xcd, 0xb0, 0xae, 0xb9, 0x3e, 0x9f, 0xe1, 0x3c, 0x08, 0xc4, 0x4a,
-		0x96, 0x6f, 0x6c, 0x1b, 0xd8, 0xe5, 0xeb, 0x44, 0x6c, 0x1e, 0x33, 0x16, 0xc2, 0xd9, 0x52, 0xd1,
-		0xc3, 0x80, 0x1c, 0xdb, 0x33, 0x7c, 0xe3, 0x3a, 0x56, 0x0d, 0x4b, 0x2c, 0x2a, 0xa5, 0xe8, 0x2e,
-		0x53, 0x4e, 0x7c, 0x59, 0xb2, 0xfc, 0x00, 0xdb, 0xc2, 0x35, 0xad, 0x09, 0x9b, 0x24, 0xf3, 0xa4,
-		0x92, 0x13, 0x5f, 0x02, 0xec, 0x7b, 0x60, 0xb8, 0x6a, 0x37, 0x48, 0xb1, 0xc7, 0xf0, 0xc8, 0xd8,
-		0x21, 0x29, 0x43, 0x0c, 0x16, 0xa0, 0xf0, 0x32, 0x3e, 0x5c, 0xcd, 0x1a, 0x56, 0x86, 0x18, 0x8c,
-		0xa1, 0x3c, 0x00, 0x63, 0x5a, 0xad, 0xe6, 0x12, 0xe6, 0x82, 0x11, 0x9b, 0x98, 0x8c, 0x06, 0x60,
-		0x8a, 0x58, 0xb8, 0x04, 0x19, 0x61, 0x07, 0x32, 0x54, 0x13, 0x4b, 0xa8, 0x0e, 0x9b, 0x6d, 0x27,
-		0x4e, 0x64, 0x95, 0x8c, 0x25, 0x3e, 0xde, 0x03, 0xc3, 0x86, 0xa7, 0x86, 0x8b, 0xf3, 0x89, 0x99,
-		0xc4, 0x89, 0x8c, 0x32, 0x64, 0x78, 0xc1, 0xc2, 0xa6, 0xfc, 0xc5, 0x04, 0x8c, 0xc6, 0x37, 0x17,
-		0xd0, 0x02, 0x64, 0x4c, 0x5b, 0xa7, 0x5b, 0x89, 0x7c, 0x67, 0xeb, 0x44, 0x97, 0xfd, 0x88, 0xe2,
-		0x32, 0xc7, 0x57, 0x02, 0xca, 0xc2, 0xbf, 0x92, 0x20, 0x23, 0xc0, 0xe8, 0x08, 0xa4, 0x1c, 0xcd,
-		0xdf, 0xa1, 0xec, 0xd2, 0xe5, 0x44, 0x4e, 0x52, 0xe8, 0x3b, 0x81, 0x7b, 0x8e, 0x66, 0x51, 0x17,
-		0xe0, 0x70, 0xf2, 0x4e, 0xfa, 0xd5, 0xc4, 0x5a, 0x95, 0xce, 0x7a, 0xec, 0x7a, 0x1d, 0x5b, 0xbe,
-		0x27, 0xfa, 0x95, 0xc3, 0xe7, 0x39, 0x18, 0x3d, 0x04, 0xe3, 0xbe, 0xab, 0x19, 0x66, 0x0c, 0x37,
-		0x45, 0x71, 0x73, 0xe2, 0x43, 0x80, 0x5c, 0x82, 0xa3, 0x82, 0x6f, 0x15, 0xfb, 0x9a, 0xbe, 0x83,
-		0xab, 0x21, 0xd1, 0x00, 0x5d, 0xdd, 0xb8, 0x8b, 0x23, 0x2c, 0xf0, 0xef, 0x82, 0x56, 0xfe, 0x86,
-		0x04, 0xe3, 0x62, 0x9e, 0x56, 0x0d, 0x8c, 0xb5, 0x02, 0xa0, 0x59, 0x96, 0xed, 0x47, 0xcd, 0xd5,
-		0xea, 0xca, 0x2d, 0x74, 0xc5, 0xb9, 0x80, 0x48, 0x89, 0x30, 0x28, 0xd4, 0x01, 0xc2, 0x2f, 0x1d,
-		0xcd, 0x36, 0x0d, 0x43, 0x7c, 0xe7, 0x88, 0x6e, 0x3f, 0xb2, 0x99, 0x3d, 0x30, 0x10, 0x99, 0xd0,
-		0xa1, 0x49, 0x48, 0x6f, 0xe1, 0x9a, 0x61, 0xf1, 0xf5, 0x60, 0xf6, 0x22, 0xd6, 0x5f, 0x52, 0xc1,
-		0xfa, 0x4b, 0xf9, 0x63, 0x12, 0x4c, 0xe8, 0x76, 0xbd, 0x59, 0xde, 0x72, 0xae, 0x69, 0x79, 0xc1,
-		0xbb, 0x28, 0xbd, 0xe7, 0x9d, 0x91, 0x9d, 0xd6, 0x9a, 0x6d, 0x6a, 0x56, 0x2d, 0xdc, 0x3f, 0xa5,
-		0x0f, 0xfa, 0x23, 0x35, 0x6c, 0x3d, 0x52, 0xb3, 0x23, 0xbb, 0xa9, 0xe7, 0xc3, 0xc7, 0xff, 0x2b,
-		0x49, 0x9f, 0x4f, 0x24, 0x17, 0xd7, 0xcb, 0x5f, 0x4a, 0x14, 0x16, 0x59, 0x73, 0xeb, 0xc2, 0x3c,
-		0x0a, 0xde, 0x36, 0xb1, 0x4e, 0x54, 0x86, 0xef, 0x3d, 0x04, 0x93, 0x35, 0xbb, 0x66, 0x53, 0x8e,
-		0xb3, 0xe4, 0x89, 0xef, 0xc8, 0x66, 0x03, 0x68, 0xa1, 0xeb, 0xf6, 0x6d, 0x69, 0x15, 0x26, 0x38,
-		0xb2, 0x4a, 0xb7, 0x84, 0xd8, 0xc4, 0x06, 0xed, 0xbb, 0xac, 0x96, 0xff, 0xe5, 0xef, 0xd0, 0x01,
-		0x5d, 0x19, 0xe7, 0xa4, 0xe4, 0x1b, 0x9b, 0xfb, 0x94, 0x14, 0x38, 0x1c, 0xe3, 0xc7, 0xc2, 0x16,
-		0xbb, 0x5d, 0x38, 0xfe, 0x26, 0xe7, 0x38, 0x11, 0xe1, 0xb8, 0xc1, 0x49, 0x4b, 0xf3, 0x30, 0xd2,
-		0x0f, 0xaf, 0xdf, 0xe2, 0xbc, 0x86, 0x71, 0x94, 0xc9, 0x22, 0x8c, 0x51, 0x26, 0x7a, 0xc3, 0xf3,
-		0xed, 0x3a, 0xcd, 0x89, 0xfb, 0xb3, 0xf9, 0xed, 0xef, 0xb0, 0x38, 0x1a, 0x25, 0x64, 0xf3, 0x01,
-		0x55, 0xa9, 0x04, 0x74, 0x17, 0xac, 0x8a, 0x75, 0xb3, 0x0b, 0x87, 0xaf, 0x71, 0x41, 0x02, 0xfc,
-		0xd2, 0x55, 0x98, 0x24, 0xcf, 0x34, 0x65, 0x45, 0x25, 0xe9, 0xbe, 0x06, 0x97, 0xff, 0xc6, 0x07,
-		0x59, 0xa8, 0x4e, 0x04, 0x0c, 0x22, 0x32, 0x45, 0x7a, 0xb1, 0x86, 0x7d, 0x1f, 0xbb, 0x9e, 0xaa,
-		0x99, 0xed, 0xc4, 0x8b, 0x2c, 0x62, 0xe4, 0x3f, 0xf9, 0xfd, 0x78, 0x2f, 0x2e, 0x32, 0xca, 0x39,
-		0xd3, 0x2c, 0x5d, 0x81, 0xbb, 0xda, 0x78, 0x45, 0x0f, 0x3c, 0x3f, 0xc5, 0x79, 0x4e, 0xb6, 0x78,
-		0x06, 0x61, 0xbb, 0x0e, 0x02, 0x1e, 0xf4, 0x65, 0x0f, 0x3c, 0x7f, 0x8e, 0xf3, 0x44, 0x9c, 0x56,
-		0x74, 0x29, 0xe1, 0x78, 0x09, 0xc6, 0xaf, 0x63, 0x77, 0xcb, 0xf6, 0xf8, 0xc2, 0x51, 0x0f, 0xec,
-		0x3e, 0xcd, 0xd9, 0x8d, 0x71, 0x42, 0xba, 0x92, 0x44, 0x78, 0x3d, 0x09, 0x99, 0x6d, 0x4d, 0xc7,
-		0x3d, 0xb0, 0x78, 0x89, 0xb3, 0x18, 0x24, 0xf8, 0x84, 0x74, 0x0e, 0x86, 0x6b, 0x36, 0x1f, 0xb5,
-		0xba, 0x93, 0x7f, 0x86, 0x93, 0x0f, 0x09, 0x1a, 0xce, 0xc2, 0xb1, 0x9d, 0x86, 0x49, 0x86, 0xb4,
-		0xee, 0x2c, 0xfe, 0xaa, 0x60, 0x21, 0x68, 0x38, 0x8b, 0x3e, 0xcc, 0xfa, 0x59, 0xc1, 0xc2, 0x8b,
-		0xd8, 0xf3, 0x29, 0x18, 0xb2, 0x2d, 0x73, 0xcf, 0xb6, 0x7a, 0x11, 0xe2, 0x73, 0x9c, 0x03, 0x70,
-		0x12, 0xc2, 0xe0, 0x3c, 0x64, 0x7b, 0xed, 0x88, 0xbf, 0xfe, 0x7d, 0x11, 0x1e, 0xa2, 0x07, 0x16,
-		0x61, 0x4c, 0x24, 0x28, 0xc3, 0xb6, 0x7a, 0x60, 0xf1, 0x37, 0x38, 0x8b, 0xd1, 0x08, 0x19, 0x57,
-		0xc3, 0xc7, 0x9e, 0x5f, 0xc3, 0xbd, 0x30, 0xf9, 0xa2, 0x50, 0x83, 0x93, 0x70, 0x53, 0x6e, 0x61,
-		0x4b, 0xdf, 0xe9, 0x8d, 0xc3, 0xcf, 0x0b, 0x53, 0x0a, 0x1a, 0xc2, 0x62, 0x1e, 0x46, 0xea, 0x9a,
-		0xeb, 0xed, 0x68, 0x66, 0x4f, 0xdd, 0xf1, 0x37, 0x39, 0x8f, 0xe1, 0x80, 0x88, 0x5b, 0xa4, 0x61,
-		0xf5, 0xc3, 0xe6, 0x4b, 0xc2, 0x22, 0x11, 0x32, 0x1e, 0x7a, 0x9e, 0x4f, 0x57, 0xd9, 0xfa, 0xe1,
-		0xf6, 0x0b, 0x22, 0xf4, 0x18, 0xed, 0x4a, 0x94, 0xe3, 0x79, 0xc8, 0x7a, 0xc6, 0xcd, 0x9e, 0xd8,
-		0xfc, 0x2d, 0xd1, 0xd3, 0x94, 0x80, 0x10, 0xbf, 0x1b, 0x8e, 0xb6, 0x1d, 0x26, 0x7a, 0x60, 0xf6,
-		0xb7, 0x39, 0xb3, 0x23, 0x6d, 0x86, 0x0a, 0x9e, 0x12, 0xfa, 0x65, 0xf9, 0x77, 0x44, 0x4a, 0xc0,
-		0x4d, 0xbc, 0xd6, 0xc9, 0x3c, 0xc2, 0xd3, 0xb6, 0xfb, 0xb3, 0xda, 0x2f, 0x0a, 0xab, 0x31, 0xda,
-		0x98, 0xd5, 0x36, 0xe1, 0x08, 0xe7, 0xd8, 0x5f, 0xbf, 0xfe, 0x92, 0x48, 0xac, 0x8c, 0xfa, 0x4a,
-		0xbc, 0x77, 0xdf, 0x0b, 0x85, 0xc0, 0x9c, 0xa2, 0x60, 0xf5, 0xd4, 0xba, 0xe6, 0xf4, 0xc0, 0xf9,
-		0x97, 0x39, 0x67, 0x91, 0xf1, 0x83, 0x8a, 0xd7, 0x5b, 0xd1, 0x1c, 0xc2, 0xfc, 0x59, 0xc8, 0x0b,
-		0xe6, 0x0d, 0xcb, 0xc5, 0xba, 0x5d, 0xb3, 0x8c, 0x9b, 0xb8, 0xda, 0x03, 0xeb, 0xbf, 0xdb, 0xd4,
-		0x55, 0x57, 0x22, 0xe4, 0x84, 0xf3, 0x12, 0xe4, 0x82, 0x5a, 0x45, 0x35, 0xea, 0x8e, 0xed, 0xfa,
-		0x5d, 0x38, 0x7e, 0x59, 0xf4, 0x54, 0x40, 0xb7, 0x44, 0xc9, 0x4a, 0x15, 0x18, 0xa5, 0xaf, 0xbd,
-		0xba, 0xe4, 0xaf, 0x70, 0x46, 0x23, 0x21, 0x15, 0x4f, 0x1c, 0xba, 0x5d, 0x77, 0x34, 0xb7, 0x97,
-		0xfc, 0xf7, 0xf7, 0x44, 0xe2, 0xe0, 0x24, 0x3c, 0x71, 0xf8, 0x7b, 0x0e, 0x26, 0xa3, 0x7d, 0x0f,
-		0x1c, 0x7e, 0x55, 0x24, 0x0e, 0x41, 0xc3, 0x59, 0x88, 0x82, 0xa1, 0x07, 0x16, 0x7f, 0x5f, 0xb0,
-		0x10, 0x34, 0x84, 0xc5, 0xd3, 0xe1, 0x40, 0xeb, 0xe2, 0x9a, 0xe1, 0xf9, 0x2e, 0x2b, 0x93, 0xf7,
-		0x67, 0xf5, 0x0f, 0xbe, 0x1f, 0x2f, 0xc2, 0x94, 0x08, 0x29, 0xc9, 0x44, 0x7c, 0xd9, 0x95, 0xce,
-		0xa2, 0xba, 0x0b, 0xf6, 0x6b, 0x22, 0x13, 0x45, 0xc8, 0x88, 0x6c, 0x91, 0x0a, 0x91, 0x98, 0x5d,
-		0x27, 0x73, 0x87, 0x1e, 0xd8, 0xfd, 0xc3, 0x26, 0xe1, 0x36, 0x04, 0x2d, 0xe1, 0x19, 0xa9, 0x7f,
-		0x1a, 0xd6, 0x35, 0xbc, 0xd7, 0x93, 0x77, 0xfe, 0x7a, 0x53, 0xfd, 0x73, 0x85, 0x51, 0xb2, 0x1c,
-		0x32, 0xd6, 0x54, 0x4f, 0xa1, 0x6e, 0xe7, 0x87, 0xf2, 0x3f, 0xf6, 0x0a, 0xd7, 0x37, 0x5e, 0x4e,
-		0x95, 0x96, 0x89, 0x93, 0xc7, 0x8b, 0x9e, 0xee, 0xcc, 0x3e, 0xf8, 0x4a, 0xe0, 0xe7, 0xb1, 0x9a,
-		0xa7, 0x74, 0x01, 0x46, 0x62, 0x05, 0x4f, 0x77, 0x56, 0x1f, 0xe2, 0xac, 0x86, 0xa3, 0xf5, 0x4e,
-		0xe9, 0x09, 0x48, 0x91, 0xe2, 0xa5, 0x3b, 0xf9, 0x87, 0x39, 0x39, 0x45, 0x2f, 0xbd, 0x03, 0x32,
-		0xa2, 0x68, 0xe9, 0x4e, 0xfa, 0x11, 0x4e, 0x1a, 0x90, 0x10, 0x72, 0x51, 0xb0, 0x74, 0x27, 0xff,
-		0xf3, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xde, 0x4d, 0xf8, 0x95, 0xbf, 0x90, 0xe2, 0x83, 0x8e, 0xb0,
-		0xdd, 0x79, 0x18, 0xe4, 0x95, 0x4a, 0x77, 0xea, 0x8f, 0xf2, 0xc6, 0x05, 0x45, 0xe9, 0x2c, 0xa4,
-		0x7b, 0x34, 0xf8, 0x4f, 0x72, 0x52, 0x86, 0x5f, 0x9a, 0x87, 0xa1, 0x48, 0x75, 0xd2, 0x9d, 0xfc,
-		0x2f, 0x71, 0xf2, 0x28, 0x15, 0x11, 0x9d, 0x57, 0x27, 0xdd, 0x19, 0x7c, 0x4c, 0x88, 0xce, 0x29,
-		0x88, 0xd9, 0x44, 0x61, 0xd2, 0x9d, 0xfa, 0xe3, 0xc2, 0xea, 0x82, 0xa4, 0xf4, 0x14, 0x64, 0x83,
-		0xc1, 0xa6, 0x3b, 0xfd, 0x4f, 0x71, 0xfa, 0x90, 0x86, 0x58, 0x20, 0x32, 0xd8, 0x75, 0x67, 0xf1,
-		0xd3, 0xc2, 0x02, 0x11, 0x2a, 0x12, 0x46, 0xcd, 0x05, 0x4c, 0x77, 0x4e, 0x3f, 0x23, 0xc2, 0xa8,
-		0xa9, 0x7e, 0x21, 0xbd, 0x49, 0x73, 0x7e, 0x77, 0x16, 0x3f, 0x2b, 0x7a, 0x93, 0xe2, 0x13, 0x31,
-		0x9a, 0x2b, 0x82, 0xee, 0x3c, 0xfe, 0x8a, 0x10, 0xa3, 0xa9, 0x20, 0x28, 0xad, 0x03, 0x6a, 0xad,
-		0x06, 0xba, 0xf3, 0xfb, 0x04, 0xe7, 0x37, 0xde, 0x52, 0x0c, 0x94, 0x9e, 0x81, 0x23, 0xed, 0x2b,
-		0x81, 0xee, 0x5c, 0x3f, 0xf9, 0x4a, 0xd3, 0xdc, 0x2d, 0x5a, 0x08, 0x94, 0x36, 0xc3, 0x21, 0x25,
-		0x5a, 0x05, 0x74, 0x67, 0xfb, 0xa9, 0x57, 0xe2, 0x89, 0x3b, 0x5a, 0x04, 0x94, 0xe6, 0x00, 0xc2,
-		0x01, 0xb8, 0x3b, 0xaf, 0x4f, 0x73, 0x5e, 0x11, 0x22, 0x12, 0x1a, 0x7c, 0xfc, 0xed, 0x4e, 0xff,
-		0x92, 0x08, 0x0d, 0x4e, 0x41, 0x42, 0x43, 0x0c, 0xbd, 0xdd, 0xa9, 0x3f, 0x23, 0x42, 0x43, 0x90,
-		0x10, 0xcf, 0x8e, 0x8c, 0x6e, 0xdd, 0x39, 0x7c, 0x4e, 0x78, 0x76, 0x84, 0xaa, 0xb4, 0x0a, 0xe3,
-		0x2d, 0x03, 0x62, 0x77, 0x56, 0x9f, 0xe7, 0xac, 0x72, 0xcd, 0xe3, 0x61, 0x74, 0xf0, 0xe2, 0x83,
-		0x61, 0x77, 0x6e, 0x5f, 0x68, 0x1a, 0xbc, 0xf8, 0x58, 0x58, 0x3a, 0x0f, 0x19, 0xab, 0x61, 0x9a,
-		0x24, 0x78, 0xd0, 0xfe, 0x67, 0xfe, 0xf2, 0x7f, 0xf8, 0x2a, 0xb7, 0x8e, 0x20, 0x28, 0x3d, 0x01,
-		0x69, 0x5c, 0xdf, 0xc2, 0xd5, 0x6e, 0x94, 0xdf, 0x7b, 0x55, 0x24, 0x4c, 0x82, 0x5d, 0x7a, 0x0a,
-		0x80, 0x2d, 0x8d, 0xd0, 0xed, 0xc1, 0x2e, 0xb4, 0xff, 0xf5, 0x55, 0x7e, 0x1a, 0x27, 0x24, 0x09,
-		0x19, 0xb0, 0xb3, 0x3d, 0xfb, 0x33, 0xf8, 0x7e, 0x9c, 0x01, 0xed, 0x91, 0x27, 0x61, 0xf0, 0x39,
-		0xcf, 0xb6, 0x7c, 0xad, 0xd6, 0x8d, 0xfa, 0xbf, 0x71, 0x6a, 0x81, 0x4f, 0x0c, 0x56, 0xb7, 0x5d,
-		0xec, 0x6b, 0x35, 0xaf, 0x1b, 0xed, 0x7f, 0xe7, 0xb4, 0x01, 0x01, 0x21, 0xd6, 0x35, 0xcf, 0xef,
-		0x45, 0xef, 0x3f, 0x12, 0xc4, 0x82, 0x80, 0x08, 0x4d, 0x9e, 0xaf, 0xe1, 0xbd, 0x6e, 0xb4, 0x7f,
-		0x2c, 0x84, 0xe6, 0xf8, 0xa5, 0x77, 0x40, 0x96, 0x3c, 0xb2, 0x23, 0x76, 0x5d, 0x88, 0xff, 0x07,
-		0x27, 0x0e, 0x29, 0x48, 0xcb, 0x9e, 0x5f, 0xf5, 0x8d, 0xee, 0xc6, 0xbe, 0xcd, 0x7b, 0x5a, 0xe0,
-		0x97, 0xe6, 0x60, 0xc8, 0xf3, 0xab, 0xd5, 0x06, 0xaf, 0x4f, 0xbb, 0x90, 0xff, 0xcf, 0x57, 0x83,
-		0x25, 0x8b, 0x80, 0x86, 0xf4, 0xf6, 0x8d, 0x6b, 0xbe, 0x63, 0xd3, 0x2d, 0x90, 0x6e, 0x1c, 0x5e,
-		0xe1, 0x1c, 0x22, 0x24, 0xa5, 0x79, 0x18, 0x26, 0xba, 0xb8, 0xd8, 0xc1, 0x74, 0xbf, 0xaa, 0x0b,
-		0x8b, 0xff, 0xc5, 0x0d, 0x10, 0x23, 0x2a, 0xff, 0x68, 0xa7, 0x7b, 0x37, 0xed, 0x97, 0x8d, 0x61,
-		0xd1, 0x5e, 0xb4, 0xd9, 0x82, 0xf1, 0x7b, 0xe4, 0xd8, 0x72, 0x71, 0xcd, 0x0e, 0x57, 0x6b, 0x83,
-		0x49, 0x0e, 0xfc, 0x66, 0x9a, 0x54, 0xcd, 0x74, 0x2d, 0x57, 0x73, 0x8c, 0xd9, 0x1d, 0xdf, 0x77,
-		0xf8, 0x6a, 0x2f, 0x70, 0xd6, 0x9a, 0x63, 0xc8, 0x7b, 0x90, 0xba, 0xe8, 0xfb, 0x0e, 0x3a, 0x09,
-		0x69, 0xb7, 0x61, 0x62, 0x8f, 0xaf, 0xb2, 0x4f, 0x16, 0x43, 0x9c, 0x22, 0x41, 0x50, 0x1a, 0x26,
-		0x56, 0x18, 0x0a, 0xaa, 0xc0, 0xf4, 0x76, 0xc3, 0x34, 0xf7, 0xd4, 0x2a, 0xa6, 0x97, 0x2b, 0x82,
-		0x73, 0x8c, 0x78, 0xd7, 0xd1, 0xc4, 0x66, 0x08, 0x31, 0xd9, 0x71, 0x8a, 0xb6, 0x40, 0xb1, 0xc4,
-		0x19, 0xc6, 0x8a, 0xc0, 0x91, 0x7f, 0x3f, 0x01, 0x19, 0xc1, 0x1a, 0x15, 0x20, 0xe3, 0x61, 0x13,
-		0xeb, 0xbe, 0xed, 0xf2, 0xc3, 0x65, 0xc1, 0x3b, 0x42, 0x90, 0xac, 0xf1, 0xdb, 0x52, 0xd9, 0x8b,
-		0x87, 0x14, 0xf2, 0x42, 0x60, 0x4e, 0x83, 0x5d, 0x95, 0xa2, 0x30, 0xa7, 0xe1, 0xa3, 0x49, 0x48,
-		0x39, 0xb6, 0xe7, 0xb3, 0x0d, 0x8a, 0x8b, 0x87, 0x14, 0xfa, 0x86, 0xf2, 0x30, 0x50, 0xc5, 0x26,
-		0xf6, 0xd9, 0x0e, 0x13, 0x81, 0xf3, 0x77, 0x74, 0x04, 0xd2, 0x8e, 0xe6, 0xeb, 0x3b, 0xec, 0xe8,
-		0xf4, 0xc5, 0x43, 0x0a, 0x7b, 0x45, 0x08, 0x52, 0x5b, 0x76, 0x75, 0x8f, 0x1f, 0x8a, 0xa6, 0xcf,
-		0xe8, 0x2c, 0x0c, 0xb0, 0x68, 0x6f, 0x3e, 0xcd, 0x4c, 0x0c, 0xc4, 0x96, 0x55, 0x89, 0x2e, 0xeb,
-		0x1a, 0xa9, 0xe0, 0x2d, 0xd2, 0x08, 0x43, 0x47, 0x15, 0x98, 0xd0, 0xaa, 0x55, 0x83, 0x38, 0x82,
-		0x66, 0xaa, 0x5b, 0x86, 0x55, 0x35, 0xac, 0x9a, 0x47, 0x6f, 0xff, 0x74, 0x32, 0x33, 0x0a, 0x09,
-		0xca, 0x1c, 0x9f, 0x1f, 0x17, 0xa5, 0x37, 0xbc, 0x54, 0x2a, 0x1c, 0xbb, 0x8b, 0x32, 0x2c, 0x80,
-		0x65, 0xbb, 0xba, 0x57, 0xce, 0xc2, 0xa0, 0xc3, 0x04, 0x90, 0xcf, 0xc3, 0x78, 0x8b, 0x54, 0x44,
-		0xb1, 0x6b, 0x86, 0x55, 0x15, 0xe7, 0x18, 0xc9, 0x33, 0x81, 0xd1, 0x6d, 0x10, 0xb6, 0xcf, 0x41,
-		0x9f, 0xcb, 0x3f, 0x2e, 0x75, 0x74, 0xcd, 0xd1, 0x88, 0x6b, 0x6a, 0x8e, 0x51, 0xce, 0x52, 0xfe,
-		0xc4, 0xad, 0xd6, 0xa5, 0xf7, 0xcc, 0xf1, 0x0f, 0x6c, 0x07, 0xa3, 0x68, 0xbb, 0xb5, 0xd9, 0x1a,
-		0xb6, 0xc4, 0x6e, 0x03, 0xf9, 0xa4, 0x39, 0x86, 0x47, 0xdd, 0x31, 0xdc, 0x96, 0xf1, 0xce, 0x47,
-		0x9e, 0xe9, 0x46, 0x46, 0x6a, 0x71, 0x6e, 0x7d, 0x29, 0xb8, 0x52, 0xf6, 0x1b, 0x09, 0x38, 0x1e,
-		0xf1, 0xe3, 0x08, 0x72, 0xab, 0x3b, 0x17, 0xda, 0x7b, 0x7c, 0x0f, 0x9b, 0x1a, 0x97, 0x21, 0x45,
-		0xf0, 0x51, 0x97, 0x53, 0x98, 0xf9, 0x5f, 0xfa, 0xe7, 0xff, 0x48, 0xa6, 0x0e, 0xd0, 0xbe, 0xeb,
-		0x28, 0x93, 0xf2, 0x47, 0x7a, 0xb7, 0x5f, 0x2e, 0xdc, 0x91, 0xf2, 0xee, 0x9c, 0x19, 0x9b, 0x6d,
-		0xf8, 0xf1, 0x04, 0x4c, 0x37, 0x9b, 0x80, 0x24, 0x53, 0xcf, 0xd7, 0xea, 0x4e, 0xa7, 0x5b, 0x79,
-		0xe7, 0x21, 0xbb, 0x29, 0x70, 0x50, 0x1e, 0x06, 0x3d, 0xac, 0xdb, 0x56, 0xd5, 0xa3, 0xde, 0x93,
-		0x54, 0xc4, 0x2b, 0x9a, 0x84, 0xb4, 0xa5, 0x59, 0xb6, 0xc7, 0x0f, 0x0d, 0xb3, 0x97, 0xf2, 0x5f,
-		0x96, 0xfa, 0xcb, 0x6e, 0xa3, 0x41, 0x53, 0xc2, 0x0a, 0x0f, 0xed, 0xb7, 0x25, 0xc6, 0xee, 0x1e,
-		0x06, 0x2a, 0x44, 0xf6, 0xbf, 0xa6, 0x9a, 0xf7, 0xbf, 0x9e, 0xc1, 0xa6, 0x79, 0xd9, 0xb2, 0x6f,
-		0x58, 0x9b, 0x84, 0x26, 0x30, 0xc9, 0xef, 0x4a, 0x30, 0x43, 0x6f, 0x43, 0xb8, 0x75, 0xc3, 0xf2,
-		0x67, 0x4d, 0x63, 0xcb, 0x9b, 0xdd, 0x32, 0x7c, 0x8f, 0x5d, 0x6b, 0xe4, 0x36, 0x99, 0x0c, 0x31,
-		0x8a, 0x04, 0xa3, 0x48, 0x30, 0xe4, 0xd3, 0x90, 0x29, 0x1b, 0xfe, 0x9c, 0xeb, 0x6a, 0x7b, 0x34,
-		0x57, 0x18, 0xbe, 0x30, 0x0a, 0x7d, 0x26, 0x16, 0xc1, 0x26, 0xae, 0x7b, 0x74, 0xe7, 0x35, 0xa5,
-		0xb0, 0x97, 0xf2, 0x95, 0x8e, 0xd7, 0x2c, 0xcf, 0x47, 0x34, 0x8d, 0x88, 0x14, 0x79, 0x64, 0x7d,
-		0xdf, 0x4e, 0xdc, 0x40, 0x9f, 0x2f, 0xa5, 0xe0, 0xee, 0x08, 0x82, 0xee, 0xee, 0x39, 0x3e, 0x1d,
-		0x17, 0xec, 0x6d, 0xae, 0xcc, 0x78, 0x44, 0x19, 0xf6, 0xb9, 0xd0, 0x76, 0x37, 0x50, 0xde, 0x86,
-		0xf4, 0x3a, 0xa1, 0x0b, 0xef, 0x8b, 0x32, 0xed, 0xd8, 0x0b, 0x81, 0xb2, 0x1b, 0x21, 0x09, 0x06,
-		0x35, 0xc4, 0x65, 0x10, 0x13, 0x6b, 0xdb, 0xec, 0x04, 0x6e, 0x92, 0x6e, 0xc0, 0x67, 0x08, 0x80,
-		0x1e, 0xb6, 0x9d, 0x84, 0xb4, 0xd6, 0x60, 0x7b, 0xc7, 0xc9, 0x13, 0xc3, 0x0a, 0x7b, 0x91, 0x2f,
-		0xc3, 0x20, 0xdf, 0xaf, 0x6a, 0x73, 0x4b, 0xb6, 0x08, 0x69, 0x2a, 0x3c, 0xbf, 0x5a, 0x90, 0x2f,
-		0xb6, 0x48, 0x5f, 0xa4, 0x42, 0x2a, 0x0c, 0x4d, 0xbe, 0x04, 0x99, 0x05, 0xbb, 0x6e, 0x58, 0x76,
-		0x9c, 0x5b, 0x96, 0x71, 0xa3, 0x32, 0x93, 0x01, 0x83, 0xa5, 0x39, 0xf6, 0x42, 0x6f, 0xe2, 0xd2,
-		0x13, 0xd9, 0x7c, 0xff, 0x9b, 0xbf, 0xc9, 0xf3, 0x30, 0x48, 0x79, 0xaf, 0x39, 0xa4, 0x7f, 0x83,
-		0xd3, 0x70, 0x59, 0x7e, 0xed, 0x86, 0xb3, 0x4f, 0x84, 0xc2, 0x22, 0x48, 0x55, 0x35, 0x5f, 0xe3,
-		0x7a, 0xd3, 0x67, 0xf9, 0x9d, 0x90, 0xe1, 0x4c, 0x3c, 0x74, 0x0a, 0x92, 0xb6, 0x23, 0xc6, 0xd6,
-		0x42, 0x27, 0x55, 0xd6, 0x9c, 0x72, 0xea, 0x6b, 0xb7, 0xa6, 0x0f, 0x29, 0x04, 0xb9, 0xac, 0x74,
-		0xf4, 0x97, 0x73, 0xfd, 0xfb, 0x0b, 0x6b, 0x26, 0x70, 0x96, 0xcf, 0x25, 0x60, 0x2a, 0xf2, 0xf5,
-		0x3a, 0x76, 0xc9, 0x88, 0x1c, 0x73, 0x7d, 0x14, 0x11, 0x92, 0x7f, 0xef, 0xe0, 0x2e, 0xef, 0x80,
-		0xe4, 0x9c, 0xe3, 0x90, 0x11, 0x9c, 0xed, 0x54, 0xdb, 0xcc, 0x5f, 0x52, 0x4a, 0xf0, 0x4e, 0x47,
-		0x77, 0x7b, 0xdb, 0xbf, 0xa1, 0xb9, 0xc1, 0x5d, 0x24, 0xf1, 0x2e, 0x3f, 0x09, 0xd9, 0x79, 0x32,
-		0x82, 0x59, 0x5e, 0x83, 0x86, 0xce, 0x96, 0x69, 0xeb, 0xd7, 0x38, 0x07, 0xf6, 0x42, 0x0c, 0xae,
-		0x39, 0x0e, 0xbf, 0xb5, 0x4c, 0x1e, 0x4b, 0xa9, 0xef, 0x7e, 0x76, 0x5a, 0x2a, 0x6f, 0x74, 0x34,
-		0xd1, 0x93, 0xfd, 0x9b, 0x88, 0x2b, 0x19, 0xd8, 0xe8, 0xd7, 0x8f, 0xc2, 0xf1, 0x28, 0x29, 0xcb,
-		0x38, 0x11, 0x0b, 0xe5, 0x22, 0x16, 0xa2, 0xf0, 0xf6, 0xf6, 0x29, 0x74, 0xcb, 0xbc, 0x85, 0xae,
-		0x79, 0xa8, 0xb0, 0x7f, 0x64, 0x17, 0xba, 0xf4, 0xa5, 0xfc, 0x24, 0x8c, 0xac, 0x6b, 0xae, 0xbf,
-		0x81, 0xfd, 0x8b, 0x58, 0xab, 0x62, 0x37, 0x1e, 0xd8, 0x23, 0x22, 0xb0, 0x11, 0xa4, 0x68, 0xf4,
-		0x32, 0xc7, 0xa6, 0xcf, 0xf2, 0x0e, 0xa4, 0xe8, 0x69, 0x97, 0x20, 0xe8, 0x39, 0x05, 0x0b, 0x7a,
-		0xd2, 0x5d, 0x7b, 0x3e, 0xf6, 0x38, 0x09, 0x7b, 0x41, 0xa7, 0x45, 0xe8, 0x26, 0xf7, 0x0f, 0x5d,
-		0xee, 0xed, 0x3c, 0x80, 0x4d, 0x18, 0x2c, 0x93, 0xde, 0x5e, 0x5a, 0x08, 0x04, 0x91, 0x42, 0x41,
-		0xd0, 0x0a, 0x8c, 0x39, 0x9a, 0xeb, 0xd3, 0x63, 0xbe, 0x3b, 0x54, 0x0b, 0x9e, 0x19, 0xa6, 0x8b,
-		0xcd, 0xfd, 0x50, 0x8c, 0x29, 0xcb, 0x5b, 0x19, 0x71, 0xa2, 0x40, 0xf9, 0x3f, 0xa7, 0x60, 0x80,
-		0x1b, 0xe3, 0x1d, 0x30, 0xc8, 0x8d, 0x46, 0x1b, 0x24, 0xb5, 0x5d, 0xab, 0xef, 0x17, 0x03, 0x1f,
-		0xe5, 0xfc, 0x04, 0x0d, 0xba, 0x1f, 0x32, 0xfa, 0x8e, 0x66, 0x58, 0xaa, 0xc1, 0xce, 0xbb, 0x66,
-		0xcb, 0x43, 0x2f, 0xdf, 0x9a, 0x1e, 0x9c, 0x27, 0xb0, 0xa5, 0x05, 0x65, 0x90, 0x7e, 0x5c, 0xaa,
-		0x92, 0x64, 0xb3, 0x83, 0x8d, 0xda, 0x0e, 0x4b, 0x36, 0x49, 0x85, 0xbf, 0xa1, 0x73, 0x90, 0x22,
-		0x0e, 0xc1, 0x6f, 0x81, 0x14, 0x5a, 0xea, 0x8f, 0x60, 0x60, 0x2c, 0x67, 0x48, 0xc3, 0x1f, 0xff,
-		0xd6, 0xb4, 0xa4, 0x50, 0x0a, 0x34, 0x0f, 0x23, 0xa6, 0xe6, 0xf9, 0x2a, 0x0d, 0x12, 0xd2, 0x7c,
-		0x9a, 0xb2, 0x38, 0xda, 0x6a, 0x10, 0x6e, 0x58, 0x2e, 0xfa, 0x10, 0xa1, 0x62, 0xa0, 0x2a, 0x3a,
-		0x01, 0x39, 0xca, 0x44, 0xb7, 0xeb, 0x75, 0xc3, 0x67, 0xe9, 0x7b, 0x80, 0xda, 0x7d, 0x94, 0xc0,
-		0xe7, 0x29, 0x98, 0x26, 0xf1, 0x63, 0x90, 0xa5, 0xc7, 0xce, 0x29, 0x0a, 0x3b, 0x62, 0x95, 0x21,
-		0x00, 0xfa, 0xf1, 0x01, 0x18, 0xbb, 0xae, 0x99, 0x46, 0x55, 0xf3, 0x6d, 0xd7, 0x63, 0x28, 0x19,
-		0xc6, 0x25, 0x04, 0x53, 0xc4, 0x47, 0x61, 0x92, 0xfe, 0x3c, 0x41, 0x33, 0x76, 0x96, 0x62, 0x23,
-		0xf2, 0xed, 0x6a, 0x9c, 0xe2, 0x6d, 0xa4, 0x68, 0xe2, 0xc6, 0x67, 0xb8, 0x40, 0x71, 0x47, 0x02,
-		0x28, 0x45, 0x3b, 0x0a, 0x19, 0xcd, 0x71, 0x18, 0xc2, 0x10, 0xfb, 0xdd, 0x03, 0xcd, 0x71, 0xe8,
-		0xa7, 0x93, 0x30, 0x4e, 0x75, 0x74, 0xb1, 0xd7, 0x30, 0x7d, 0xce, 0x64, 0x98, 0xe2, 0x8c, 0x91,
-		0x0f, 0x0a, 0x83, 0x53, 0xdc, 0x7b, 0x61, 0x04, 0x5f, 0x37, 0xaa, 0xd8, 0xd2, 0x31, 0xc3, 0x1b,
-		0xa1, 0x78, 0xc3, 0x02, 0x48, 0x91, 0x1e, 0x84, 0x9c, 0xe3, 0xda, 0x8e, 0xed, 0x61, 0x57, 0xd5,
-		0xaa, 0x55, 0x17, 0x7b, 0x5e, 0x7e, 0x94, 0xf1, 0x13, 0xf0, 0x39, 0x06, 0x96, 0x1f, 0x86, 0xd4,
-		0x82, 0xe6, 0x6b, 0x24, 0x87, 0xf9, 0xbb, 0x6c, 0x08, 0x18, 0x56, 0xc8, 0x63, 0xdb, 0x70, 0xfb,
-		0x6e, 0x02, 0x52, 0x57, 0x6d, 0x1f, 0xa3, 0xc7, 0x23, 0xe3, 0xce, 0x68, 0x3b, 0x1f, 0xdf, 0x30,
-		0x6a, 0x16, 0xae, 0xae, 0x78, 0xb5, 0xc8, 0x7d, 0xd0, 0xd0, 0xc5, 0x12, 0x31, 0x17, 0x9b, 0x84,
-		0xb4, 0x6b, 0x37, 0xac, 0xaa, 0x38, 0xb1, 0x44, 0x5f, 0x50, 0x05, 0x32, 0x81, 0xe7, 0xa4, 0xba,
-		0x79, 0xce, 0x18, 0xf1, 0x1c, 0xe2, 0xd7, 0x1c, 0xa0, 0x0c, 0x6e, 0x71, 0x07, 0x2a, 0x43, 0x36,
-		0x48, 0x68, 0xdc, 0x03, 0x7b, 0x73, 0xe2, 0x90, 0x0c, 0x3d, 0x04, 0xe3, 0x81, 0x3f, 0x04, 0x06,
-		0x65, 0x5e, 0x98, 0x0b, 0x3e, 0x70, 0x8b, 0xc6, 0x5c, 0x8d, 0xdf, 0x4d, 0x1d, 0xa4, 0x7a, 0x85,
-		0xae, 0xc6, 0xee, 0xa7, 0x1e, 0x87, 0xac, 0x67, 0xd4, 0x2c, 0xcd, 0x6f, 0xb8, 0x98, 0x7b, 0x63,
-		0x08, 0x90, 0x7f, 0x2a, 0x01, 0x03, 0xcc, 0xbb, 0x23, 0x76, 0x93, 0xda, 0xdb, 0x2d, 0xd1, 0xc9,
-		0x6e, 0xc9, 0x83, 0xdb, 0x6d, 0x0e, 0x20, 0x10, 0xc6, 0xe3, 0x77, 0x0b, 0x8f, 0xb5, 0x32, 0x62,
-		0x22, 0x6e, 0x18, 0x35, 0x1e, 0xbc, 0x11, 0xa2, 0xc0, 0x83, 0xd2, 0x91, 0x3c, 0x79, 0x1e, 0xb2,
-		0x5b, 0x86, 0xaf, 0x6a, 0xa4, 0x3a, 0xa5, 0x26, 0x1c, 0x3a, 0x35, 0x55, 0x6c, 0x57, 0xc6, 0x16,
-		0x45, 0x0d, 0xab, 0x64, 0xb6, 0xf8, 0x93, 0xfc, 0x1f, 0x25, 0x32, 0x18, 0xf3, 0x06, 0xd1, 0x1c,
-		0x8c, 0x08, 0x45, 0xd5, 0x6d, 0x53, 0xab, 0x71, 0x67, 0xbc, 0xbb, 0xa3, 0xb6, 0x17, 0x4c, 0xad,
-		0xa6, 0x0c, 0x71, 0x05, 0xc9, 0x4b, 0xfb, 0x8e, 0x4d, 0x74, 0xe8, 0xd8, 0x98, 0x27, 0x25, 0x0f,
-		0xe6, 0x49, 0xb1, 0x3e, 0x4f, 0x35, 0xf7, 0xf9, 0x97, 0x13, 0xb4, 0x28, 0x73, 0x6c, 0x4f, 0x33,
-		0xdf, 0x88, 0x10, 0x3b, 0x06, 0x59, 0xc7, 0x36, 0x55, 0xf6, 0x85, 0x1d, 0x0d, 0xcc, 0x38, 0xb6,
-		0xa9, 0xb4, 0xf8, 0x51, 0xfa, 0x0e, 0xc5, 0xdf, 0xc0, 0x1d, 0xb0, 0xda, 0x60, 0xb3, 0xd5, 0x5c,
-		0x18, 0x66, 0xa6, 0xe0, 0x03, 0xe6, 0xa3, 0xc4, 0x06, 0x74, 0x04, 0x96, 0x5a, 0x07, 0x78, 0x26,
-		0x36, 0xc3, 0x54, 0x38, 0x1e, 0xa1, 0x60, 0xe3, 0x4b, 0xbb, 0x6a, 0x3e, 0xea, 0xe7, 0x0a, 0xc7,
-		0x93, 0xff, 0xa9, 0x04, 0x59, 0xaa, 0xea, 0x0a, 0xf6, 0xb5, 0x98, 0xa9, 0xa4, 0x83, 0x9b, 0xea,
-		0x6e, 0x00, 0xc6, 0xc6, 0x33, 0x6e, 0x62, 0xde, 0x81, 0x59, 0x0a, 0xd9, 0x30, 0x6e, 0x62, 0x74,
-		0x26, 0xd0, 0x2b, 0xb9, 0xbf, 0x5e, 0x3c, 0x14, 0x85, 0x76, 0x77, 0xc1, 0x20, 0xfd, 0x69, 0x8c,
-		0x5d, 0x76, 0x36, 0x36, 0x49, 0x2f, 0xce, 0x6e, 0xee, 0x7a, 0xf2, 0x73, 0x30, 0xb8, 0xb9, 0xcb,
-		0xa6, 0x52, 0xc7, 0x20, 0xeb, 0xda, 0x36, 0x1f, 0x5f, 0x59, 0x5d, 0x93, 0x21, 0x00, 0x3a, 0x9c,
-		0x88, 0xe9, 0x43, 0x22, 0x9c, 0x3e, 0x84, 0xf3, 0x9f, 0x64, 0x4f, 0xf3, 0x9f, 0x93, 0xff, 0x4e,
-		0x82, 0xa1, 0x48, 0x18, 0xa2, 0xc7, 0xe0, 0x70, 0x79, 0x79, 0x6d, 0xfe, 0xb2, 0xba, 0xb4, 0xa0,
-		0x5e, 0x58, 0x9e, 0x5b, 0x0c, 0xcf, 0x98, 0x17, 0x8e, 0xbc, 0xf8, 0xd2, 0x0c, 0x8a, 0xe0, 0x5e,
-		0xb1, 0xae, 0x91, 0xf9, 0x31, 0x9a, 0x85, 0xc9, 0x38, 0xc9, 0x5c, 0x79, 0xa3, 0xb2, 0xba, 0x99,
-		0x93, 0x0a, 0x87, 0x5f, 0x7c, 0x69, 0x66, 0x3c, 0x42, 0x31, 0xb7, 0xe5, 0x61, 0xcb, 0x6f, 0x25,
-		0x98, 0x5f, 0x5b, 0x59, 0x59, 0xda, 0xcc, 0x25, 0x5a, 0x08, 0x78, 0xa2, 0x7d, 0x10, 0xc6, 0xe3,
-		0x04, 0xab, 0x4b, 0xcb, 0xb9, 0x64, 0x01, 0xbd, 0xf8, 0xd2, 0xcc, 0x68, 0x04, 0x7b, 0xd5, 0x30,
-		0x0b, 0x99, 0x9f, 0xf8, 0xc2, 0xd4, 0xa1, 0x9f, 0xff, 0x6b, 0x53, 0x12, 0xd1, 0x6c, 0x24, 0x16,
-		0x8a, 0xe8, 0x61, 0xb8, 0x6b, 0x63, 0x69, 0x71, 0xb5, 0xb2, 0xa0, 0xae, 0x6c, 0x2c, 0xaa, 0xec,
-		0x72, 0x7d, 0xa0, 0xdd, 0xd8, 0x8b, 0x2f, 0xcd, 0x0c, 0x71, 0x95, 0x3a, 0x61, 0xaf, 0x2b, 0x95,
-		0xab, 0x6b, 0x9b, 0x95, 0x9c, 0xc4, 0xb0, 0xd7, 0x5d, 0x7c, 0xdd, 0xf6, 0xd9, 0x6f, 0xe7, 0x3c,
-		0x0a, 0x47, 0xdb, 0x60, 0x07, 0x8a, 0x8d, 0xbf, 0xf8, 0xd2, 0xcc, 0xc8, 0xba, 0x8b, 0x99, 0x9b,
-		0x52, 0x8a, 0x22, 0xe4, 0x5b, 0x29, 0xd6, 0xd6, 0xd7, 0x36, 0xe6, 0x96, 0x73, 0x33, 0x85, 0xdc,
-		0x8b, 0x2f, 0xcd, 0x0c, 0x8b, 0x9c, 0x43, 0xf0, 0x43, 0xcd, 0xca, 0x4f, 0x77, 0x9c, 0xbf, 0x9c,
-		0xed, 0x7f, 0xfe, 0xe2, 0xc7, 0x96, 0x37, 0xfe, 0x62, 0x02, 0xa6, 0x5a, 0x16, 0xbd, 0xf8, 0xfa,
-		0x77, 0xa7, 0x05, 0x9f, 0x12, 0x64, 0x16, 0xc4, 0xb2, 0x7a, 0xbf, 0xeb, 0x3d, 0x3f, 0xdb, 0xe7,
-		0x7a, 0xcf, 0x88, 0x68, 0x49, 0x2c, 0xf7, 0x9c, 0xec, 0xbe, 0xdc, 0x23, 0xe4, 0x3f, 0xc0, 0x6a,
-		0xcf, 0x87, 0x92, 0x30, 0x15, 0xfd, 0x79, 0x2f, 0xf1, 0xc3, 0x5e, 0xba, 0x6d, 0x08, 0x73, 0x4c,
-		0x44, 0x7f, 0xd2, 0x8b, 0x7f, 0xef, 0x30, 0xe3, 0x5d, 0x84, 0xd4, 0xbc, 0x6d, 0x58, 0xc4, 0x14,
-		0x55, 0x6c, 0xd9, 0x75, 0xbe, 0x3a, 0xc0, 0x5e, 0xd0, 0xbd, 0x30, 0xa0, 0xd5, 0xed, 0x86, 0xe5,
-		0x8b, 0xe9, 0x00, 0x49, 0x16, 0xbf, 0x7f, 0x6b, 0x3a, 0xb9, 0x64, 0xf9, 0x0a, 0xff, 0xc4, 0x26,
-		0xb0, 0xf2, 0x25, 0x18, 0x5c, 0xc0, 0xfa, 0x41, 0x78, 0x2d, 0x60, 0xbd, 0x89, 0xd7, 0x83, 0x90,
-		0x59, 0xb2, 0x7c, 0x76, 0x61, 0xfd, 0x6e, 0x48, 0x1a, 0x16, 0xab, 0x66, 0x9a, 0xda, 0x27, 0x70,
-		0x82, 0xba, 0x80, 0xf5, 0x00, 0xb5, 0x8a, 0xf5, 0x66, 0x54, 0xc2, 0x9e, 0xc0, 0xcb, 0x0b, 0xbf,
-		0xf7, 0x9f, 0xa6, 0x0e, 0xbd, 0xf0, 0xf2, 0xd4, 0xa1, 0x8e, 0xae, 0x2a, 0x77, 0xff, 0x91, 0xb0,
-		0xa0, 0x1b, 0x3e, 0xfc, 0x18, 0xdc, 0xc7, 0x71, 0x3c, 0x5f, 0xbb, 0x66, 0x58, 0xb5, 0xa0, 0x27,
-		0xf8, 0x3b, 0xef, 0x8c, 0x23, 0xbc, 0x33, 0x04, 0x74, 0xdf, 0xfe, 0x28, 0xec, 0x3b, 0x4f, 0xef,
-		0x3e, 0xff, 0xee, 0x12, 0x28, 0x85, 0x2e, 0x9e, 0x23, 0x7f, 0x54, 0x82, 0xd1, 0x8b, 0x86, 0xe7,
-		0xdb, 0xae, 0xa1, 0x6b, 0x26, 0xbd, 0xb9, 0x70, 0xa6, 0xd7, 0x21, 0xb2, 0x69, 0x28, 0x79, 0x0a,
-		0x06, 0xae, 0x6b, 0x26, 0xfb, 0x6d, 0xb8, 0x24, 0xfd, 0xa5, 0x85, 0xf6, 0x86, 0x28, 0x06, 0x73,
-		0x24, 0xc1, 0x80, 0x91, 0xc9, 0xbf, 0x98, 0x80, 0x31, 0x9a, 0x6c, 0x3d, 0xf6, 0xd3, 0x3a, 0x64,
-		0x3e, 0x5e, 0x86, 0x94, 0xab, 0xf9, 0x7c, 0x0d, 0xab, 0x5c, 0xe4, 0x7d, 0x7c, 0x7f, 0xf7, 0x7e,
-		0x2b, 0x12, 0x37, 0xa0, 0xb4, 0xe8, 0x47, 0x20, 0x53, 0xd7, 0x76, 0x55, 0xca, 0x87, 0xb9, 0xe2,
-		0x5c, 0x7f, 0x7c, 0x6e, 0xdf, 0x9a, 0x1e, 0xdb, 0xd3, 0xea, 0x66, 0x49, 0x16, 0x7c, 0x64, 0x65,
-		0xb0, 0xae, 0xed, 0x12, 0x11, 0x91, 0x03, 0x63, 0x04, 0xaa, 0xef, 0x68, 0x56, 0x0d, 0xb3, 0x46,
-		0xe8, 0x8a, 0x5c, 0xf9, 0x62, 0xdf, 0x8d, 0x1c, 0x09, 0x1b, 0x89, 0xb0, 0x93, 0x95, 0x91, 0xba,
-		0xb6, 0x3b, 0x4f, 0x01, 0xa4, 0xc5, 0x52, 0xe6, 0x13, 0x9f, 0x9d, 0x3e, 0x44, 0xe3, 0xe6, 0x1b,
-		0x12, 0x40, 0x68, 0x31, 0xf4, 0x23, 0x90, 0xd3, 0x83, 0x37, 0x4a, 0xeb, 0xf1, 0x3e, 0x7c, 0xa0,
-		0x53, 0x5f, 0x34, 0xd9, 0x9b, 0x95, 0x58, 0x5f, 0xbf, 0x35, 0x2d, 0x29, 0x63, 0x7a, 0x53, 0x57,
-		0xbc, 0x17, 0x86, 0x1a, 0x4e, 0x55, 0xf3, 0xb1, 0x4a, 0xe7, 0xfc, 0x89, 0xae, 0xe5, 0xda, 0x14,
-		0xe1, 0x75, 0xfb, 0xd6, 0x34, 0x62, 0x6a, 0x45, 0x88, 0x65, 0x5a, 0xc4, 0x01, 0x83, 0x10, 0x82,
-		0x88, 0x4e, 0xbf, 0x23, 0xc1, 0xd0, 0x42, 0xe4, 0x04, 0x51, 0x1e, 0x06, 0xeb, 0xb6, 0x65, 0x5c,
-		0xc3, 0x62, 0x73, 0x4d, 0xbc, 0xa2, 0x02, 0x64, 0xd8, 0x65, 0x2e, 0x7f, 0x4f, 0xac, 0xcc, 0x89,
-		0x77, 0x42, 0x75, 0x03, 0x6f, 0x79, 0x86, 0xe8, 0x0d, 0x45, 0xbc, 0xa2, 0x0b, 0x90, 0xf3, 0xb0,
-		0xde, 0x70, 0x0d, 0x7f, 0x4f, 0xd5, 0x6d, 0xcb, 0xd7, 0x74, 0xbe, 0xeb, 0x56, 0x3e, 0x76, 0xfb,
-		0xd6, 0xf4, 0x5d, 0x4c, 0xd6, 0x66, 0x0c, 0x59, 0x19, 0x13, 0xa0, 0x79, 0x06, 0x21, 0x2d, 0x54,
-		0xb1, 0xaf, 0x19, 0xa6, 0xc7, 0x36, 0xe7, 0x14, 0xf1, 0x1a, 0xd1, 0xe5, 0x1f, 0x0f, 0x42, 0x36,
-		0xf0, 0x76, 0x74, 0x03, 0x72, 0xb6, 0x83, 0xdd, 0xd8, 0x7c, 0x82, 0x96, 0x53, 0xe5, 0xe5, 0xb0,
-		0xe5, 0x66, 0x0c, 0xf9, 0xff, 0xdf, 0x9a, 0x7e, 0xa4, 0x07, 0x0f, 0xba, 0xaa, 0x99, 0x7c, 0x2e,
-		0xa2, 0x8c, 0x09, 0x1e, 0x62, 0x72, 0x72, 0x81, 0xf8, 0x85, 0x58, 0x85, 0x70, 0x1a, 0x5b, 0x62,
-		0x05, 0x38, 0xa6, 0x72, 0x33, 0x86, 0x4c, 0x3c, 0x80, 0x83, 0xd6, 0x29, 0x84, 0x4c, 0x20, 0x9e,
-		0xd3, 0x0c, 0x53, 0xdc, 0x70, 0x55, 0xf8, 0x1b, 0x5a, 0x82, 0x01, 0xcf, 0xd7, 0xfc, 0x06, 0xab,
-		0x21, 0xd3, 0xe5, 0xc7, 0x7a, 0x94, 0xb9, 0x6c, 0x5b, 0xd5, 0x0d, 0x4a, 0xa8, 0x70, 0x06, 0xe8,
-		0x02, 0x0c, 0xf8, 0xf6, 0x35, 0x6c, 0x71, 0xa3, 0xf6, 0x15, 0xf1, 0x74, 0x8c, 0x62, 0xd4, 0xc8,
-		0x87, 0x5c, 0x15, 0x9b, 0xb8, 0x46, 0x4d, 0xe9, 0xed, 0x68, 0x64, 0x9e, 0x4a, 0xb7, 0x4a, 0xcb,
-		0x4b, 0x7d, 0x87, 0x25, 0x37, 0x50, 0x33, 0x3f, 0x59, 0x19, 0x0b, 0x40, 0x1b, 0x14, 0x82, 0x2e,
-		0xc7, 0x0e, 0xbf, 0xf1, 0x9f, 0x62, 0xbb, 0xb7, 0x53, 0xec, 0x45, 0xbc, 0x5c, 0xac, 0x6e, 0x45,
-		0x8f, 0xce, 0x5d, 0x80, 0x5c, 0xc3, 0xda, 0xb2, 0xe9, 0x26, 0xaa, 0xca, 0x27, 0x6e, 0x19, 0x52,
-		0xcf, 0x44, 0x7b, 0xad, 0x19, 0x43, 0x56, 0xc6, 0x02, 0xd0, 0x45, 0x36, 0xbd, 0xab, 0xc2, 0x68,
-		0x88, 0x45, 0x43, 0x37, 0xdb, 0x35, 0x74, 0xef, 0xe1, 0xa1, 0x7b, 0xb8, 0xb9, 0x95, 0x30, 0x7a,
-		0x47, 0x02, 0x20, 0x21, 0x43, 0x17, 0x01, 0xc2, 0x84, 0x41, 0x57, 0xb9, 0x86, 0x4e, 0xc9, 0xdd,
-		0xb3, 0x8e, 0x58, 0x19, 0x08, 0x69, 0xd1, 0xfb, 0x61, 0xa2, 0x6e, 0x58, 0xaa, 0x87, 0xcd, 0x6d,
-		0x95, 0x1b, 0x98, 0xb0, 0xa4, 0xbf, 0x2a, 0x52, 0x5e, 0xee, 0xcf, 0x1f, 0x6e, 0xdf, 0x9a, 0x2e,
-		0xf0, 0xa4, 0xda, 0xca, 0x52, 0x56, 0xc6, 0xeb, 0x86, 0xb5, 0x81, 0xcd, 0xed, 0x85, 0x00, 0x56,
-		0x1a, 0xfe, 0x89, 0xcf, 0x4e, 0x1f, 0x0a, 0x02, 0xd8, 0x80, 0xe1, 0x30, 0xb0, 0xb0, 0x87, 0xd6,
-		0x20, 0xab, 0x89, 0x17, 0xb6, 0x1e, 0xd6, 0xb3, 0xb3, 0x47, 0x02, 0x34, 0xe4, 0xc1, 0x72, 0xc5,
-		0x0b, 0xff, 0x61, 0x46, 0x92, 0x5f, 0x4c, 0xc0, 0xc0, 0xc2, 0xd5, 0x75, 0xcd, 0x70, 0xd1, 0x4d,
-		0x18, 0x0f, 0x9d, 0x2d, 0x9e, 0x29, 0x56, 0x6e, 0xdf, 0x9a, 0xce, 0x37, 0xfb, 0x63, 0x9f, 0xa9,
-		0x62, 0x4e, 0xd7, 0x85, 0x24, 0x61, 0x90, 0x88, 0x5c, 0x71, 0xb3, 0xe3, 0xaa, 0x47, 0xb4, 0xed,
-		0x16, 0x94, 0x03, 0xa4, 0xa9, 0x96, 0x45, 0x94, 0x48, 0xe2, 0xac, 0xc0, 0x20, 0xb3, 0x85, 0x87,
-		0x4a, 0x90, 0x76, 0xc8, 0x03, 0xdf, 0x81, 0x9a, 0xea, 0x18, 0x4d, 0x14, 0x3f, 0x58, 0x97, 0x27,
-		0x24, 0xf2, 0xe7, 0x93, 0x00, 0x0b, 0x57, 0xaf, 0x6e, 0xba, 0x86, 0x63, 0x62, 0xff, 0x07, 0x6a,
-		0xd7, 0x0f, 0x4b, 0x70, 0x38, 0xb4, 0x9a, 0xe7, 0xea, 0x4d, 0xc6, 0x7d, 0xfa, 0xf6, 0xad, 0xe9,
-		0xe3, 0xcd, 0xc6, 0x8d, 0xa0, 0x1d, 0xc0, 0xc0, 0x13, 0x01, 0xa3, 0x0d, 0x57, 0x6f, 0x2f, 0x47,
-		0xd5, 0xf3, 0x03, 0x39, 0x92, 0x9d, 0xe5, 0x88, 0xa0, 0xbd, 0x26, 0x39, 0x16, 0x3c, 0xbf, 0xb5,
-		0xaf, 0x37, 0x60, 0x28, 0xec, 0x23, 0x0f, 0x2d, 0x40, 0xc6, 0xe7, 0xcf, 0xbc, 0xcb, 0xe5, 0xce,
-		0x5d, 0x2e, 0xc8, 0x78, 0xb7, 0x07, 0x94, 0xf2, 0xbf, 0x4d, 0x00, 0x84, 0x51, 0xfd, 0x67, 0x35,
-		0xa2, 0xc8, 0x70, 0xca, 0x07, 0xbf, 0xe4, 0x81, 0x0a, 0x68, 0x4e, 0x1d, 0xe9, 0xad, 0x3f, 0x4c,
-		0xc0, 0xc4, 0x15, 0x91, 0xf9, 0xdf, 0xb2, 0x30, 0x5a, 0x87, 0x41, 0x6c, 0xf9, 0xae, 0x41, 0x4d,
-		0x4c, 0xbc, 0xf5, 0xd1, 0x4e, 0xde, 0xda, 0xc6, 0x6a, 0xf4, 0x87, 0x73, 0xc4, 0xa6, 0x1c, 0x67,
-		0x13, 0xb1, 0xf5, 0xc7, 0x92, 0x90, 0xef, 0x44, 0x85, 0xe6, 0x61, 0x4c, 0x77, 0x31, 0x05, 0xa8,
-		0xd1, 0x1d, 0x80, 0x72, 0x21, 0x9c, 0x49, 0x34, 0x21, 0xc8, 0xca, 0xa8, 0x80, 0xf0, 0xda, 0xa0,
-		0x06, 0xa4, 0xcc, 0x27, 0x21, 0x43, 0xb0, 0x7a, 0xac, 0xeb, 0x65, 0x5e, 0x1c, 0x88, 0x46, 0xe2,
-		0x0c, 0x58, 0x75, 0x30, 0x1a, 0x42, 0x69, 0x79, 0xf0, 0x3c, 0x8c, 0x19, 0x96, 0xe1, 0x1b, 0x9a,
-		0xa9, 0x6e, 0x69, 0xa6, 0x66, 0xe9, 0x07, 0x99, 0x25, 0xb1, 0x01, 0x9d, 0x37, 0xdb, 0xc4, 0x4e,
-		0x56, 0x46, 0x39, 0xa4, 0xcc, 0x00, 0xe8, 0x22, 0x0c, 0x8a, 0xa6, 0x52, 0x07, 0xaa, 0x25, 0x05,
-		0x79, 0xa4, 0x47, 0x7e, 0x32, 0x09, 0xe3, 0x0a, 0xae, 0xbe, 0xd5, 0x15, 0xfd, 0x75, 0xc5, 0x0a,
-		0x00, 0x4b, 0x24, 0x64, 0x24, 0x39, 0x40, 0x6f, 0x90, 0x54, 0x94, 0x65, 0x1c, 0x16, 0x3c, 0x3f,
-		0xd2, 0x1f, 0x7f, 0x94, 0x84, 0xe1, 0x68, 0x7f, 0xbc, 0x35, 0xc4, 0xff, 0xf0, 0x0c, 0xf1, 0x68,
-		0x29, 0x4c, 0x8d, 0x29, 0xfe, 0x03, 0xa8, 0x1d, 0x52, 0x63, 0x4b, 0x48, 0x75, 0xce, 0x89, 0xff,
-		0x3b, 0x01, 0x03, 0xeb, 0x9a, 0xab, 0xd5, 0x3d, 0xa4, 0xb7, 0x4c, 0x6c, 0xc4, 0xfe, 0x4a, 0xcb,
-		0x2f, 0x57, 0xf3, 0x45, 0xb1, 0x2e, 0xf3, 0x9a, 0x4f, 0xb4, 0x99, 0xd7, 0xbc, 0x0b, 0x46, 0xeb,
-		0xda, 0x6e, 0x64, 0xcb, 0x9f, 0x76, 0xe6, 0x48, 0xf9, 0x68, 0xc8, 0x25, 0xfe, 0x9d, 0x2d, 0xd7,
-		0x84, 0xe7, 0x00, 0xd0, 0x59, 0x18, 0x22, 0x18, 0xe1, 0x28, 0x41, 0xc8, 0x8f, 0x84, 0xeb, 0x22,
-		0x91, 0x8f, 0xb2, 0x02, 0x75, 0x6d, 0xb7, 0xc2, 0x5e, 0xd0, 0x32, 0xa0, 0x9d, 0x60, 0x69, 0x4e,
-		0x0d, 0x4d, 0x49, 0xe8, 0xef, 0xbe, 0x7d, 0x6b, 0xfa, 0x28, 0xa3, 0x6f, 0xc5, 0x91, 0x95, 0xf1,
-		0x10, 0x28, 0xb8, 0x9d, 0x06, 0x20, 0x7a, 0xa9, 0x6c, 0xa5, 0x96, 0xcd, 0xae, 0x0f, 0xdf, 0xbe,
-		0x35, 0x3d, 0xce, 0xb8, 0x84, 0xdf, 0x64, 0x25, 0x4b, 0x5e, 0x16, 0xc8, 0x73, 0xc4, 0xf0, 0x5f,
-		0x90, 0x00, 0x85, 0x63, 0x50, 0xf0, 0x2f, 0x1b, 0x2e, 0x02, 0x44, 0x26, 0x69, 0xd2, 0xfe, 0xf3,
-		0xbe, 0x90, 0x5e, 0xcc, 0xfb, 0x22, 0xa1, 0xfb, 0x64, 0x98, 0xaf, 0x13, 0xbc, 0x1f, 0xdb, 0x2c,
-		0x6b, 0x17, 0xe7, 0x6d, 0x43, 0x50, 0xb7, 0x49, 0xd0, 0xff, 0x42, 0x82, 0xa3, 0x2d, 0xde, 0x14,
-		0x08, 0xfb, 0xe7, 0x00, 0xb9, 0x91, 0x8f, 0xfc, 0x87, 0xec, 0x98, 0xd0, 0x7d, 0x3b, 0xe7, 0xb8,
-		0xdb, 0x32, 0x10, 0xdc, 0xb9, 0x21, 0x87, 0xad, 0x8b, 0xff, 0x13, 0x09, 0x26, 0xa3, 0xcd, 0x07,
-		0x8a, 0xac, 0xc2, 0x70, 0xb4, 0x75, 0xae, 0xc2, 0x7d, 0xbd, 0xa8, 0xc0, 0xa5, 0x8f, 0xd1, 0xa3,
-		0xa7, 0xc3, 0x50, 0x65, 0x8b, 0xb7, 0x8f, 0xf5, 0x6c, 0x0d, 0x21, 0x53, 0x73, 0xc8, 0x32, 0x0d,
-		0xfe, 0x44, 0x82, 0xd4, 0xba, 0x6d, 0x9b, 0xc8, 0x86, 0x71, 0xcb, 0xf6, 0x55, 0xe2, 0x59, 0xb8,
-		0xaa, 0xf2, 0x35, 0x1e, 0xb6, 0xaa, 0x3b, 0xdf, 0x9f, 0x91, 0xbe, 0x77, 0x6b, 0xba, 0x95, 0x95,
-		0x32, 0x66, 0xd9, 0x7e, 0x99, 0x42, 0x36, 0xd9, 0x0a, 0xd0, 0xfb, 0x61, 0x24, 0xde, 0x18, 0x5b,
-		0xf1, 0x7a, 0xa6, 0xef, 0xc6, 0xe2, 0x6c, 0x6e, 0xdf, 0x9a, 0x9e, 0x0c, 0x23, 0x26, 0x00, 0xcb,
-		0xca, 0xf0, 0x56, 0xa4, 0xf5, 0x52, 0x86, 0x68, 0xff, 0xc7, 0x9f, 0x9d, 0x96, 0xca, 0x17, 0x3a,
-		0xee, 0x3e, 0x3c, 0xbc, 0xaf, 0x08, 0xbb, 0xc1, 0x36, 0x43, 0x7c, 0x1f, 0xe2, 0x33, 0xe7, 0x41,
-		0xee, 0xb0, 0x0f, 0xc1, 0xfe, 0x99, 0xcb, 0xfe, 0xbb, 0x10, 0x7d, 0xfc, 0xa7, 0x98, 0x4e, 0x3b,
-		0x16, 0xfb, 0x9d, 0x68, 0x2f, 0xf4, 0xb4, 0x47, 0x22, 0xef, 0xc2, 0x91, 0xa7, 0x49, 0xdb, 0x61,
-		0x9a, 0x14, 0xff, 0x73, 0xe6, 0x48, 0xb0, 0x96, 0x28, 0xf1, 0xff, 0x58, 0x21, 0x16, 0x06, 0x21,
-		0x94, 0x8f, 0x27, 0x88, 0xfb, 0x8b, 0x1d, 0xff, 0x95, 0x4d, 0x31, 0xf2, 0x7f, 0x6c, 0x94, 0x08,
-		0xa5, 0xfc, 0x0b, 0x12, 0xdc, 0xd5, 0xd2, 0x34, 0x8f, 0xaa, 0x45, 0x80, 0x48, 0x9e, 0x97, 0xfa,
-		0xdb, 0xc5, 0x88, 0x90, 0x12, 0x46, 0x2d, 0xc2, 0x3e, 0xd0, 0x55, 0x58, 0x26, 0x45, 0x4c, 0xda,
-		0xe7, 0xe1, 0x70, 0x5c, 0x58, 0x61, 0xa6, 0x67, 0x61, 0x34, 0x3e, 0x9f, 0xe1, 0x45, 0xce, 0x01,
-		0x56, 0xa3, 0x46, 0x62, 0x73, 0x1a, 0x59, 0x6d, 0xee, 0x9a, 0xc0, 0x3c, 0x15, 0xc8, 0x06, 0xa8,
-		0x3c, 0xe3, 0xf4, 0x6c, 0x9d, 0x90, 0x52, 0xfe, 0xaa, 0x04, 0x33, 0xf1, 0x16, 0xc2, 0x61, 0xc1,
-		0x7b, 0xdd, 0xf5, 0xbb, 0x63, 0x8e, 0xf4, 0x5d, 0x09, 0xee, 0xd9, 0x47, 0x0d, 0x6e, 0xb3, 0x9b,
-		0x30, 0x19, 0x19, 0x6f, 0xc4, 0x8d, 0x17, 0xe1, 0x5c, 0x27, 0xbb, 0x0f, 0x94, 0x41, 0x7a, 0x3d,
-		0x46, 0xec, 0xf8, 0xa5, 0x6f, 0x4d, 0x4f, 0xb4, 0x7e, 0xf3, 0x94, 0x89, 0xd6, 0x31, 0xe2, 0x0e,
-		0x7a, 0xe1, 0xef, 0x4a, 0xf0, 0x60, 0x5c, 0xd5, 0x36, 0xb3, 0xd2, 0x37, 0x51, 0xd7, 0xfd, 0x7b,
-		0x09, 0x4e, 0xf6, 0xa2, 0x0f, 0xef, 0xc3, 0x2d, 0x98, 0x08, 0x0b, 0xc5, 0xe6, 0x2e, 0x7c, 0xa8,
-		0x8f, 0xe9, 0x3e, 0x8f, 0x05, 0x14, 0x70, 0x7b, 0x1d, 0xfa, 0xea, 0x5f, 0x4a, 0x3c, 0x7e, 0xa3,
-		0x6e, 0x12, 0x74, 0x4c, 0x7c, 0xe2, 0xd3, 0x67, 0xc7, 0x44, 0x26, 0x3f, 0x23, 0xb1, 0xc9, 0x4f,
-		0x9b, 0x2e, 0x4f, 0xdc, 0xa1, 0x6c, 0x74, 0x9d, 0x67, 0xeb, 0x36, 0x95, 0xe7, 0x7b, 0x61, 0xa2,
-		0x4d, 0x68, 0xf1, 0xc4, 0xd4, 0x47, 0x64, 0x29, 0xa8, 0x35, 0x78, 0xe4, 0x7f, 0x23, 0xc1, 0x34,
-		0x6d, 0xb8, 0x4d, 0x37, 0xbe, 0x99, 0xed, 0x59, 0xe7, 0xb9, 0xb7, 0xad, 0x5a, 0xdc, 0xb0, 0x4b,
-		0x30, 0xc0, 0x3c, 0x94, 0xdb, 0xf2, 0x00, 0x2e, 0xce, 0x19, 0x84, 0xb9, 0x7e, 0x41, 0xe8, 0xd7,
-		0x3e, 0x61, 0xbc, 0x4e, 0x76, 0xbc, 0x53, 0x09, 0xe3, 0x1b, 0x22, 0xd7, 0xb7, 0x57, 0x83, 0xdb,
-		0x4d, 0xbf, 0x63, 0xb9, 0x9e, 0x19, 0xf1, 0x0d, 0x4a, 0xea, 0x81, 0x4e, 0x5d, 0x92, 0xfa, 0x0f,
-		0x79, 0x1f, 0x05, 0x49, 0xbd, 0x8b, 0x3e, 0x6f, 0xc6, 0xa4, 0xfe, 0x27, 0x09, 0x38, 0x4a, 0x75,
-		0x8b, 0xce, 0xbe, 0xde, 0x80, 0xbe, 0x51, 0x01, 0x79, 0xae, 0xae, 0xde, 0xa9, 0x5c, 0x94, 0xf3,
-		0x5c, 0xfd, 0x6a, 0x6c, 0x44, 0x57, 0x01, 0x55, 0x3d, 0xbf, 0xb9, 0x81, 0xe4, 0x81, 0x1b, 0xa8,
-		0x7a, 0xfe, 0xd5, 0x7d, 0x4a, 0x86, 0xd4, 0x81, 0xbd, 0xeb, 0xeb, 0x12, 0x14, 0xda, 0xf5, 0x00,
-		0xf7, 0x26, 0x03, 0x8e, 0xc4, 0x16, 0x16, 0x9a, 0x1d, 0xea, 0xe1, 0x5e, 0xa6, 0xd3, 0x4d, 0xe1,
-		0x7f, 0xd8, 0xc5, 0xaf, 0x6b, 0x02, 0xf8, 0x67, 0x62, 0x88, 0x0b, 0x02, 0xa6, 0x75, 0x36, 0xf6,
-		0xc3, 0x1f, 0xf6, 0xbf, 0xd2, 0x32, 0xc2, 0xbc, 0x29, 0x26, 0x76, 0xdf, 0x94, 0x60, 0xaa, 0x83,
-		0xd8, 0x6f, 0xe6, 0xf2, 0x62, 0xa7, 0xa3, 0x4b, 0xdd, 0xe9, 0x59, 0xe4, 0x69, 0x1e, 0x8f, 0xf1,
-		0xc3, 0x8b, 0x91, 0x55, 0x84, 0x76, 0xb7, 0x62, 0xe4, 0x77, 0xc3, 0xb1, 0xb6, 0x54, 0x5c, 0xb6,
-		0x12, 0xa4, 0x76, 0x0c, 0xcf, 0xe7, 0x62, 0xdd, 0xdf, 0x49, 0xac, 0x26, 0x6a, 0x4a, 0x23, 0x23,
-		0xc8, 0x51, 0xd6, 0xeb, 0xb6, 0x6d, 0x72, 0x31, 0xe4, 0xcb, 0x30, 0x1e, 0x81, 0xf1, 0x46, 0xce,
-		0x40, 0xca, 0xb1, 0xf9, 0x45, 0xd3, 0xa1, 0x53, 0xc7, 0x3b, 0x35, 0x42, 0x68, 0xb8, 0xda, 0x14,
-		0x5f, 0x9e, 0x04, 0xc4, 0x98, 0xd1, 0xd5, 0x6f, 0xd1, 0xc4, 0x06, 0x4c, 0xc4, 0xa0, 0xbc, 0x91,
-		0xb7, 0xc3, 0x80, 0x43, 0x21, 0xbc, 0x99, 0x8e, 0xc7, 0x26, 0x18, 0x9d, 0x28, 0xdb, 0x18, 0xcd,
-		0xa9, 0xef, 0x1d, 0x86, 0x34, 0xe5, 0x8a, 0x3e, 0x29, 0x01, 0x44, 0xd6, 0xb2, 0x8b, 0x9d, 0xd8,
-		0xb4, 0x5f, 0xcd, 0x29, 0xcc, 0xf6, 0x8c, 0xcf, 0xeb, 0xee, 0x93, 0x3f, 0xfe, 0xaf, 0xbf, 0xf3,
-		0x33, 0x89, 0xfb, 0x90, 0x3c, 0xdb, 0x61, 0x1d, 0x29, 0x12, 0x8c, 0x5f, 0x94, 0xa2, 0xa7, 0xeb,
-		0x1e, 0xe9, 0xad, 0x29, 0x21, 0x59, 0xb1, 0x57, 0x74, 0x2e, 0xd8, 0x79, 0x2a, 0xd8, 0x13, 0xe8,
-		0xf1, 0xee, 0x82, 0xcd, 0xbe, 0x2f, 0x1e, 0x5d, 0x1f, 0x40, 0xdf, 0x94, 0x60, 0xb2, 0xdd, 0x32,
-		0x01, 0x3a, 0xd7, 0x9b, 0x14, 0xad, 0x05, 0x59, 0xe1, 0xc9, 0x03, 0x50, 0x72, 0x55, 0x16, 0xa9,
-		0x2a, 0x73, 0xe8, 0xa9, 0x03, 0xa8, 0x32, 0x1b, 0x19, 0xfd, 0xd0, 0xff, 0x93, 0xe0, 0xee, 0x7d,
-		0xa7, 0xd0, 0x68, 0xae, 0x37, 0x29, 0xf7, 0xa9, 0x3c, 0x0b, 0xe5, 0xd7, 0xc2, 0x82, 0x6b, 0xfc,
-		0x34, 0xd5, 0xf8, 0x32, 0x5a, 0x3a, 0x88, 0xc6, 0x61, 0x99, 0x18, 0xd5, 0xfd, 0xb7, 0xa5, 0xd8,
-		0x01, 0x93, 0xfd, 0xdd, 0xa9, 0x65, 0xee, 0xd8, 0x25, 0x30, 0x5a, 0xa7, 0x04, 0xf2, 0xb3, 0x54,
-		0x05, 0x05, 0xad, 0xbf, 0xc6, 0x4e, 0x9b, 0x7d, 0x5f, 0x7c, 0x50, 0xf9, 0x00, 0xfa, 0x3f, 0x52,
-		0xfb, 0x13, 0x1d, 0x67, 0xf7, 0x15, 0xb1, 0xf3, 0xbc, 0xb8, 0x70, 0xae, 0x7f, 0x42, 0xae, 0x64,
-		0x9d, 0x2a, 0x59, 0x43, 0xf8, 0x4e, 0x2b, 0xd9, 0xb6, 0x13, 0xd1, 0xef, 0x48, 0x30, 0xd9, 0x6e,
-		0x46, 0xd7, 0x25, 0x2c, 0xf7, 0x99, 0xcb, 0x76, 0x09, 0xcb, 0xfd, 0xa6, 0x8f, 0xf2, 0xdb, 0xa9,
-		0xf2, 0x67, 0xd0, 0xe9, 0x4e, 0xca, 0xef, 0xdb, 0x8b, 0x24, 0x16, 0xf7, 0x9d, 0xf9, 0x74, 0x89,
-		0xc5, 0x5e, 0x66, 0x81, 0x5d, 0x62, 0xb1, 0xa7, 0x89, 0x57, 0xf7, 0x58, 0x0c, 0x34, 0xeb, 0xb1,
-		0x1b, 0x3d, 0xf4, 0x1b, 0x12, 0x8c, 0xc4, 0xea, 0x72, 0xf4, 0xd8, 0xbe, 0x82, 0xb6, 0x9b, 0x45,
-		0x15, 0x4e, 0xf5, 0x43, 0xc2, 0x75, 0x59, 0xa2, 0xba, 0xcc, 0xa3, 0xb9, 0x83, 0xe8, 0xe2, 0xc6,
-		0x24, 0xfe, 0xba, 0x04, 0x13, 0x6d, 0x4a, 0xd8, 0x2e, 0x51, 0xd8, 0xb9, 0x74, 0x2f, 0x9c, 0xeb,
-		0x9f, 0x90, 0x6b, 0x75, 0x81, 0x6a, 0xf5, 0x2e, 0xf4, 0xce, 0x83, 0x68, 0x15, 0x19, 0x9f, 0x6f,
-		0x85, 0x3b, 0xc6, 0x91, 0x76, 0xd0, 0x99, 0x3e, 0x05, 0x13, 0x0a, 0x9d, 0xed, 0x9b, 0x8e, 0xeb,
-		0xf3, 0x0c, 0xd5, 0xe7, 0x69, 0xb4, 0xf6, 0xda, 0xf4, 0x69, 0x1d, 0xd6, 0xbf, 0xdc, 0x7a, 0x79,
-		0x66, 0x7f, 0x2f, 0x6a, 0x5b, 0xac, 0x16, 0x1e, 0xef, 0x8b, 0x86, 0x2b, 0x75, 0x8e, 0x2a, 0x75,
-		0x0a, 0x3d, 0xda, 0x49, 0xa9, 0xc8, 0xb1, 0x00, 0xc3, 0xda, 0xb6, 0x67, 0xdf, 0xc7, 0x4a, 0xe0,
-		0x0f, 0xa0, 0x1f, 0x13, 0x5b, 0xb2, 0x27, 0xf6, 0x6d, 0x37, 0x52, 0xc7, 0x16, 0x1e, 0xec, 0x01,
-		0x93, 0xcb, 0x75, 0x1f, 0x95, 0x6b, 0x0a, 0x1d, 0xef, 0x24, 0x17, 0xa9, 0x65, 0xd1, 0x47, 0xa5,
-		0xe0, 0x14, 0xc7, 0xc9, 0xfd, 0x79, 0x47, 0x8b, 0xdd, 0xc2, 0x43, 0x3d, 0xe1, 0x72, 0x49, 0xee,
-		0xa7, 0x92, 0xcc, 0xa0, 0xa9, 0x8e, 0x92, 0xb0, 0xd2, 0xf7, 0x4e, 0x6f, 0xd0, 0x7e, 0x62, 0x02,
-		0xa6, 0x3b, 0xb4, 0xe8, 0xef, 0x1e, 0xe8, 0x8e, 0x58, 0x97, 0x4b, 0x5c, 0x3d, 0xee, 0xb9, 0xbe,
-		0x9a, 0x02, 0xb4, 0xe2, 0xd5, 0xe6, 0x5d, 0xcc, 0xfe, 0x61, 0x10, 0x0f, 0xc7, 0xa6, 0x3b, 0x0b,
-		0xd2, 0x6b, 0xba, 0xb3, 0xb0, 0x12, 0xbb, 0x05, 0x90, 0xe8, 0xef, 0xee, 0x51, 0xcf, 0x57, 0x01,
-		0x92, 0x6f, 0xc8, 0x55, 0x80, 0xf6, 0x67, 0xc9, 0x52, 0x3f, 0xc0, 0x23, 0xad, 0xe9, 0x37, 0xe6,
-		0x48, 0xeb, 0x11, 0x18, 0xe0, 0x97, 0x84, 0xd8, 0xff, 0x65, 0xe7, 0x6f, 0xe8, 0x09, 0xf1, 0xef,
-		0xac, 0x07, 0x7b, 0x3b, 0x9e, 0xc3, 0xb0, 0xf9, 0x41, 0x90, 0xaf, 0x24, 0x21, 0xb7, 0xe2, 0xd5,
-		0x2a, 0x55, 0xc3, 0x7f, 0x9d, 0x7c, 0xcf, 0xe9, 0x7c, 0x0a, 0x78, 0xfe, 0xf6, 0xad, 0xe9, 0x51,
-		0x66, 0xb2, 0x3b, 0x69, 0xa8, 0x3a, 0x8c, 0x35, 0xdd, 0xb7, 0xe3, 0xae, 0xb9, 0x70, 0x90, 0x6b,
-		0x7f, 0x4d, 0xac, 0x64, 0x7a, 0x70, 0x33, 0x12, 0x20, 0x68, 0xb7, 0x7d, 0x34, 0xb0, 0x93, 0x46,
-		0x17, 0x5f, 0xcf, 0x4b, 0x31, 0xac, 0x0b, 0xbf, 0x9a, 0x80, 0xa1, 0x15, 0x4f, 0x14, 0x75, 0xf8,
-		0xcf, 0xec, 0x91, 0xef, 0xb3, 0xc1, 0xc5, 0xe6, 0x64, 0x6f, 0x81, 0x10, 0xbf, 0xec, 0xfc, 0xad,
-		0x24, 0xcd, 0xc3, 0x65, 0x5c, 0x33, 0xac, 0xa0, 0xac, 0xc4, 0x6f, 0x9d, 0x5c, 0xfd, 0x21, 0x3a,
-		0xb9, 0x1a, 0xf6, 0x70, 0xea, 0x20, 0x3d, 0xfc, 0x5b, 0x09, 0x18, 0x59, 0xf1, 0x6a, 0x57, 0xac,
-		0xea, 0x5b, 0xa1, 0xf2, 0x5a, 0x42, 0xe5, 0x8e, 0x97, 0x66, 0x9f, 0xca, 0x74, 0xbc, 0xc3, 0x5f,
-		0xc3, 0x16, 0xf6, 0x0c, 0xef, 0x40, 0xf5, 0x59, 0x6f, 0xf5, 0xd7, 0x37, 0xd3, 0x30, 0xbc, 0xc8,
-		0x5a, 0xd9, 0xf0, 0x89, 0x53, 0xbc, 0xa6, 0x35, 0x5a, 0xe4, 0xf1, 0x1f, 0x3f, 0xa3, 0xbf, 0x7f,
-		0xa7, 0x3a, 0xf6, 0x0d, 0x2c, 0x96, 0xf1, 0x97, 0xfa, 0x2e, 0x8c, 0xf8, 0x65, 0xd2, 0x66, 0x7e,
-		0x32, 0xfb, 0x1d, 0xb5, 0x4d, 0x02, 0x59, 0x27, 0x00, 0xf4, 0x21, 0x09, 0x0e, 0x53, 0xac, 0xd0,
-		0x5d, 0x28, 0xa6, 0xb8, 0xfc, 0xd2, 0xb1, 0x98, 0x5f, 0xd6, 0x22, 0x3b, 0x73, 0x94, 0x57, 0xf9,
-		0x3e, 0x7e, 0x16, 0xfb, 0x78, 0xa4, 0xf1, 0x66, 0xb6, 0xb2, 0x32, 0x61, 0xb6, 0x50, 0x7a, 0x4d,
-		0xfb, 0x39, 0xa9, 0x83, 0xef, 0xe7, 0x5c, 0x22, 0x05, 0x48, 0x30, 0x09, 0xcf, 0xa7, 0xbb, 0xdc,
-		0x37, 0x6b, 0xde, 0xf4, 0x8d, 0x12, 0xa3, 0x8f, 0x48, 0x70, 0xb8, 0xed, 0xfa, 0x04, 0xfd, 0x07,
-		0x92, 0x7d, 0x6e, 0x2a, 0x37, 0x19, 0xa7, 0x2d, 0x5f, 0x59, 0x99, 0x6c, 0xb4, 0x5b, 0xe8, 0x59,
-		0x87, 0x91, 0xd8, 0xda, 0x42, 0x5e, 0xfc, 0x1b, 0xd8, 0xde, 0x4f, 0x07, 0xc7, 0x19, 0xa0, 0x02,
-		0x64, 0xf0, 0xae, 0x63, 0xbb, 0x3e, 0xae, 0xd2, 0x2b, 0xc8, 0x19, 0x25, 0x78, 0x97, 0x6f, 0x00,
-		0x6a, 0xed, 0x5c, 0x74, 0x19, 0x06, 0xe3, 0x69, 0xee, 0x00, 0x7b, 0x4b, 0x82, 0x03, 0x9a, 0x84,
-		0x74, 0xe8, 0xdf, 0x49, 0x85, 0xbd, 0xdc, 0xe9, 0xe4, 0xf0, 0xa7, 0x01, 0x00, 0x00, 0xff, 0xff,
-		0x36, 0x66, 0xc7, 0xfd, 0x47, 0x91, 0x00, 0x00,
+		// 10166 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x74, 0x1c, 0xd7,
+		0x75, 0x18, 0xce, 0xd9, 0x5d, 0x00, 0xbb, 0x17, 0x5f, 0x83, 0x07, 0x10, 0x5c, 0x2c, 0x49, 0x00,
+		0x1a, 0x51, 0x14, 0x45, 0x49, 0x0b, 0x89, 0xa2, 0x48, 0x09, 0xb4, 0x2c, 0x63, 0x81, 0x25, 0x08,
+		0x12, 0x5f, 0x1a, 0x00, 0x94, 0x64, 0x3b, 0xbf, 0x3d, 0x83, 0xdd, 0x87, 0xc5, 0x88, 0xb3, 0x33,
+		0xab, 0x99, 0x59, 0x92, 0x90, 0xed, 0xdf, 0x51, 0x62, 0xc7, 0xb5, 0x95, 0xba, 0xb6, 0xeb, 0x9e,
+		0xc6, 0x76, 0x2c, 0xd7, 0x1f, 0x6d, 0x9d, 0x3a, 0x6d, 0xe3, 0xd4, 0x69, 0xdc, 0xd4, 0x3d, 0x6d,
+		0xdc, 0x3a, 0x89, 0x93, 0x34, 0x3d, 0xf2, 0x49, 0xda, 0xa6, 0x39, 0x29, 0x1d, 0x7f, 0x9c, 0xd6,
+		0x71, 0xdc, 0x26, 0x65, 0xdd, 0xd3, 0x0f, 0x9d, 0xd3, 0xf4, 0xbc, 0xaf, 0xf9, 0xda, 0x6f, 0x90,
+		0x94, 0xa5, 0x13, 0xfd, 0x05, 0xbc, 0xfb, 0xee, 0xbd, 0xef, 0xbe, 0x7b, 0xef, 0xbb, 0xef, 0xbd,
+		0xfb, 0xde, 0x9b, 0x85, 0x6f, 0xe7, 0x60, 0xba, 0x6c, 0x59, 0x65, 0x03, 0xcf, 0x54, 0x6d, 0xcb,
+		0xb5, 0xb6, 0x6b, 0x3b, 0x33, 0x25, 0xec, 0x14, 0x6d, 0xbd, 0xea, 0x5a, 0x76, 0x96, 0xc2, 0xd0,
+		0x30, 0xc3, 0xc8, 0x0a, 0x0c, 0x65, 0x05, 0x46, 0xce, 0xeb, 0x06, 0x5e, 0xf0, 0x10, 0x37, 0xb0,
+		0x8b, 0x1e, 0x83, 0xc4, 0x8e, 0x6e, 0xe0, 0xb4, 0x34, 0x1d, 0x3f, 0xd1, 0x7f, 0xea, 0x58, 0x36,
+		0x42, 0x94, 0x0d, 0x53, 0xac, 0x13, 0xb0, 0x4a, 0x29, 0x94, 0xff, 0x9b, 0x80, 0xd1, 0x06, 0xb5,
+		0x08, 0x41, 0xc2, 0xd4, 0x2a, 0x84, 0xa3, 0x74, 0x22, 0xa5, 0xd2, 0xff, 0x51, 0x1a, 0xfa, 0xaa,
+		0x5a, 0xf1, 0x8a, 0x56, 0xc6, 0xe9, 0x18, 0x05, 0x8b, 0x22, 0x9a, 0x04, 0x28, 0xe1, 0x2a, 0x36,
+		0x4b, 0xd8, 0x2c, 0xee, 0xa5, 0xe3, 0xd3, 0xf1, 0x13, 0x29, 0x35, 0x00, 0x41, 0xf7, 0xc3, 0x48,
+		0xb5, 0xb6, 0x6d, 0xe8, 0xc5, 0x42, 0x00, 0x0d, 0xa6, 0xe3, 0x27, 0x7a, 0x54, 0x99, 0x55, 0x2c,
+		0xf8, 0xc8, 0xf7, 0xc2, 0xf0, 0x35, 0xac, 0x5d, 0x09, 0xa2, 0xf6, 0x53, 0xd4, 0x21, 0x02, 0x0e,
+		0x20, 0xce, 0xc3, 0x40, 0x05, 0x3b, 0x8e, 0x56, 0xc6, 0x05, 0x77, 0xaf, 0x8a, 0xd3, 0x09, 0xda,
+		0xfb, 0xe9, 0xba, 0xde, 0x47, 0x7b, 0xde, 0xcf, 0xa9, 0x36, 0xf7, 0xaa, 0x18, 0xcd, 0x41, 0x0a,
+		0x9b, 0xb5, 0x0a, 0xe3, 0xd0, 0xd3, 0x44, 0x7f, 0x79, 0xb3, 0x56, 0x89, 0x72, 0x49, 0x12, 0x32,
+		0xce, 0xa2, 0xcf, 0xc1, 0xf6, 0x55, 0xbd, 0x88, 0xd3, 0xbd, 0x94, 0xc1, 0xbd, 0x75, 0x0c, 0x36,
+		0x58, 0x7d, 0x94, 0x87, 0xa0, 0x43, 0xf3, 0x90, 0xc2, 0xd7, 0x5d, 0x6c, 0x3a, 0xba, 0x65, 0xa6,
+		0xfb, 0x28, 0x93, 0x7b, 0x1a, 0x58, 0x11, 0x1b, 0xa5, 0x28, 0x0b, 0x9f, 0x0e, 0x9d, 0x81, 0x3e,
+		0xab, 0xea, 0xea, 0x96, 0xe9, 0xa4, 0x93, 0xd3, 0xd2, 0x89, 0xfe, 0x53, 0x47, 0x1a, 0x3a, 0xc2,
+		0x1a, 0xc3, 0x51, 0x05, 0x32, 0x5a, 0x02, 0xd9, 0xb1, 0x6a, 0x76, 0x11, 0x17, 0x8a, 0x56, 0x09,
+		0x17, 0x74, 0x73, 0xc7, 0x4a, 0xa7, 0x28, 0x83, 0xa9, 0xfa, 0x8e, 0x50, 0xc4, 0x79, 0xab, 0x84,
+		0x97, 0xcc, 0x1d, 0x4b, 0x1d, 0x72, 0x42, 0x65, 0x34, 0x0e, 0xbd, 0xce, 0x9e, 0xe9, 0x6a, 0xd7,
+		0xd3, 0x03, 0xd4, 0x43, 0x78, 0x89, 0xb8, 0x0e, 0x2e, 0xe9, 0xa4, 0xb9, 0xf4, 0x20, 0x73, 0x1d,
+		0x5e, 0x54, 0x7e, 0xb5, 0x17, 0x86, 0x3b, 0x71, 0xbe, 0x73, 0xd0, 0xb3, 0x43, 0xfa, 0x9f, 0x8e,
+		0x75, 0xa3, 0x1d, 0x46, 0x13, 0x56, 0x6f, 0xef, 0x3e, 0xd5, 0x3b, 0x07, 0xfd, 0x26, 0x76, 0x5c,
+		0x5c, 0x62, 0xbe, 0x12, 0xef, 0xd0, 0xdb, 0x80, 0x11, 0xd5, 0x3b, 0x5b, 0x62, 0x5f, 0xce, 0xf6,
+		0x0c, 0x0c, 0x7b, 0x22, 0x15, 0x6c, 0xcd, 0x2c, 0x0b, 0xaf, 0x9d, 0x69, 0x27, 0x49, 0x36, 0x2f,
+		0xe8, 0x54, 0x42, 0xa6, 0x0e, 0xe1, 0x50, 0x19, 0x2d, 0x00, 0x58, 0x26, 0xb6, 0x76, 0x0a, 0x25,
+		0x5c, 0x34, 0xd2, 0xc9, 0x26, 0x5a, 0x5a, 0x23, 0x28, 0x75, 0x5a, 0xb2, 0x18, 0xb4, 0x68, 0xa0,
+		0xc7, 0x7d, 0x27, 0xec, 0x6b, 0xe2, 0x43, 0x2b, 0x6c, 0xf8, 0xd5, 0xf9, 0xe1, 0x16, 0x0c, 0xd9,
+		0x98, 0x8c, 0x08, 0x5c, 0xe2, 0x3d, 0x4b, 0x51, 0x21, 0xb2, 0x6d, 0x7b, 0xa6, 0x72, 0x32, 0xd6,
+		0xb1, 0x41, 0x3b, 0x58, 0x44, 0x77, 0x83, 0x07, 0x28, 0x50, 0xb7, 0x02, 0x1a, 0x9f, 0x06, 0x04,
+		0x70, 0x55, 0xab, 0xe0, 0xcc, 0x0b, 0x30, 0x14, 0x56, 0x0f, 0x1a, 0x83, 0x1e, 0xc7, 0xd5, 0x6c,
+		0x97, 0x7a, 0x61, 0x8f, 0xca, 0x0a, 0x48, 0x86, 0x38, 0x36, 0x4b, 0x34, 0xfe, 0xf5, 0xa8, 0xe4,
+		0x5f, 0xf4, 0x36, 0xbf, 0xc3, 0x71, 0xda, 0xe1, 0xe3, 0xf5, 0x16, 0x0d, 0x71, 0x8e, 0xf6, 0x3b,
+		0x73, 0x16, 0x06, 0x43, 0x1d, 0xe8, 0xb4, 0x69, 0xe5, 0xdd, 0x70, 0xb0, 0x21, 0x6b, 0xf4, 0x0c,
+		0x8c, 0xd5, 0x4c, 0xdd, 0x74, 0xb1, 0x5d, 0xb5, 0x31, 0xf1, 0x58, 0xd6, 0x54, 0xfa, 0x3f, 0xf7,
+		0x35, 0xf1, 0xb9, 0xad, 0x20, 0x36, 0xe3, 0xa2, 0x8e, 0xd6, 0xea, 0x81, 0x27, 0x53, 0xc9, 0xef,
+		0xf7, 0xc9, 0x2f, 0xbe, 0xf8, 0xe2, 0x8b, 0x31, 0xe5, 0x6b, 0xbd, 0x30, 0xd6, 0x68, 0xcc, 0x34,
+		0x1c, 0xbe, 0xe3, 0xd0, 0x6b, 0xd6, 0x2a, 0xdb, 0xd8, 0xa6, 0x4a, 0xea, 0x51, 0x79, 0x09, 0xcd,
+		0x41, 0x8f, 0xa1, 0x6d, 0x63, 0x23, 0x9d, 0x98, 0x96, 0x4e, 0x0c, 0x9d, 0xba, 0xbf, 0xa3, 0x51,
+		0x99, 0x5d, 0x26, 0x24, 0x2a, 0xa3, 0x44, 0x6f, 0x85, 0x04, 0x0f, 0xde, 0x84, 0xc3, 0xc9, 0xce,
+		0x38, 0x90, 0xb1, 0xa4, 0x52, 0x3a, 0x74, 0x18, 0x52, 0xe4, 0x2f, 0xf3, 0x8d, 0x5e, 0x2a, 0x73,
+		0x92, 0x00, 0x88, 0x5f, 0xa0, 0x0c, 0x24, 0xe9, 0x30, 0x29, 0x61, 0x31, 0xe9, 0x79, 0x65, 0xe2,
+		0x58, 0x25, 0xbc, 0xa3, 0xd5, 0x0c, 0xb7, 0x70, 0x55, 0x33, 0x6a, 0x98, 0x3a, 0x7c, 0x4a, 0x1d,
+		0xe0, 0xc0, 0xcb, 0x04, 0x86, 0xa6, 0xa0, 0x9f, 0x8d, 0x2a, 0xdd, 0x2c, 0xe1, 0xeb, 0x34, 0xae,
+		0xf6, 0xa8, 0x6c, 0xa0, 0x2d, 0x11, 0x08, 0x69, 0xfe, 0x39, 0xc7, 0x32, 0x85, 0x6b, 0xd2, 0x26,
+		0x08, 0x80, 0x36, 0x7f, 0x36, 0x1a, 0xd2, 0x8f, 0x36, 0xee, 0x5e, 0xdd, 0x58, 0xba, 0x17, 0x86,
+		0x29, 0xc6, 0x23, 0xdc, 0xf4, 0x9a, 0x91, 0x1e, 0x99, 0x96, 0x4e, 0x24, 0xd5, 0x21, 0x06, 0x5e,
+		0xe3, 0x50, 0xe5, 0x57, 0x62, 0x90, 0xa0, 0x81, 0x65, 0x18, 0xfa, 0x37, 0x9f, 0x5d, 0xcf, 0x17,
+		0x16, 0xd6, 0xb6, 0x72, 0xcb, 0x79, 0x59, 0x42, 0x43, 0x00, 0x14, 0x70, 0x7e, 0x79, 0x6d, 0x6e,
+		0x53, 0x8e, 0x79, 0xe5, 0xa5, 0xd5, 0xcd, 0x33, 0xa7, 0xe5, 0xb8, 0x47, 0xb0, 0xc5, 0x00, 0x89,
+		0x20, 0xc2, 0x23, 0xa7, 0xe4, 0x1e, 0x24, 0xc3, 0x00, 0x63, 0xb0, 0xf4, 0x4c, 0x7e, 0xe1, 0xcc,
+		0x69, 0xb9, 0x37, 0x0c, 0x79, 0xe4, 0x94, 0xdc, 0x87, 0x06, 0x21, 0x45, 0x21, 0xb9, 0xb5, 0xb5,
+		0x65, 0x39, 0xe9, 0xf1, 0xdc, 0xd8, 0x54, 0x97, 0x56, 0x17, 0xe5, 0x94, 0xc7, 0x73, 0x51, 0x5d,
+		0xdb, 0x5a, 0x97, 0xc1, 0xe3, 0xb0, 0x92, 0xdf, 0xd8, 0x98, 0x5b, 0xcc, 0xcb, 0xfd, 0x1e, 0x46,
+		0xee, 0xd9, 0xcd, 0xfc, 0x86, 0x3c, 0x10, 0x12, 0xeb, 0x91, 0x53, 0xf2, 0xa0, 0xd7, 0x44, 0x7e,
+		0x75, 0x6b, 0x45, 0x1e, 0x42, 0x23, 0x30, 0xc8, 0x9a, 0x10, 0x42, 0x0c, 0x47, 0x40, 0x67, 0x4e,
+		0xcb, 0xb2, 0x2f, 0x08, 0xe3, 0x32, 0x12, 0x02, 0x9c, 0x39, 0x2d, 0x23, 0x65, 0x1e, 0x7a, 0xa8,
+		0x1b, 0x22, 0x04, 0x43, 0xcb, 0x73, 0xb9, 0xfc, 0x72, 0x61, 0x6d, 0x7d, 0x73, 0x69, 0x6d, 0x75,
+		0x6e, 0x59, 0x96, 0x7c, 0x98, 0x9a, 0x7f, 0x6a, 0x6b, 0x49, 0xcd, 0x2f, 0xc8, 0xb1, 0x20, 0x6c,
+		0x3d, 0x3f, 0xb7, 0x99, 0x5f, 0x90, 0xe3, 0x4a, 0x11, 0xc6, 0x1a, 0x05, 0xd4, 0x86, 0x43, 0x28,
+		0xe0, 0x0b, 0xb1, 0x26, 0xbe, 0x40, 0x79, 0x45, 0x7d, 0x41, 0xf9, 0x6e, 0x0c, 0x46, 0x1b, 0x4c,
+		0x2a, 0x0d, 0x1b, 0x79, 0x12, 0x7a, 0x98, 0x2f, 0xb3, 0x69, 0xf6, 0xbe, 0x86, 0xb3, 0x13, 0xf5,
+		0xec, 0xba, 0xa9, 0x96, 0xd2, 0x05, 0x17, 0x21, 0xf1, 0x26, 0x8b, 0x10, 0xc2, 0xa2, 0xce, 0x61,
+		0x7f, 0xa2, 0x2e, 0xf8, 0xb3, 0xf9, 0xf1, 0x4c, 0x27, 0xf3, 0x23, 0x85, 0x75, 0x37, 0x09, 0xf4,
+		0x34, 0x98, 0x04, 0xce, 0xc1, 0x48, 0x1d, 0xa3, 0x8e, 0x83, 0xf1, 0x7b, 0x25, 0x48, 0x37, 0x53,
+		0x4e, 0x9b, 0x90, 0x18, 0x0b, 0x85, 0xc4, 0x73, 0x51, 0x0d, 0xde, 0xd5, 0xdc, 0x08, 0x75, 0xb6,
+		0xfe, 0x82, 0x04, 0xe3, 0x8d, 0x17, 0x9b, 0x0d, 0x65, 0x78, 0x2b, 0xf4, 0x56, 0xb0, 0xbb, 0x6b,
+		0x89, 0x65, 0xd5, 0xf1, 0x06, 0x93, 0x35, 0xa9, 0x8e, 0x1a, 0x9b, 0x53, 0xa1, 0xc7, 0xa3, 0xb2,
+		0x4e, 0x35, 0x5b, 0xfa, 0xd6, 0x49, 0xfa, 0xc1, 0x18, 0x1c, 0x6c, 0xc8, 0xbc, 0xa1, 0xa0, 0x47,
+		0x01, 0x74, 0xb3, 0x5a, 0x73, 0xd9, 0xd2, 0x89, 0x45, 0xe2, 0x14, 0x85, 0xd0, 0xe0, 0x45, 0xa2,
+		0x6c, 0xcd, 0xf5, 0xea, 0xe3, 0xb4, 0x1e, 0x18, 0x88, 0x22, 0x3c, 0xe6, 0x0b, 0x9a, 0xa0, 0x82,
+		0x4e, 0x36, 0xe9, 0x69, 0x9d, 0x63, 0x3e, 0x04, 0x72, 0xd1, 0xd0, 0xb1, 0xe9, 0x16, 0x1c, 0xd7,
+		0xc6, 0x5a, 0x45, 0x37, 0xcb, 0x74, 0xaa, 0x49, 0xce, 0xf6, 0xec, 0x68, 0x86, 0x83, 0xd5, 0x61,
+		0x56, 0xbd, 0x21, 0x6a, 0x09, 0x05, 0x75, 0x20, 0x3b, 0x40, 0xd1, 0x1b, 0xa2, 0x60, 0xd5, 0x1e,
+		0x85, 0xf2, 0xd1, 0x14, 0xf4, 0x07, 0x96, 0xe6, 0xe8, 0x2e, 0x18, 0x78, 0x4e, 0xbb, 0xaa, 0x15,
+		0xc4, 0x76, 0x8b, 0x69, 0xa2, 0x9f, 0xc0, 0xd6, 0x19, 0x08, 0x3d, 0x04, 0x63, 0x14, 0xc5, 0xaa,
+		0xb9, 0xd8, 0x2e, 0x14, 0x0d, 0xcd, 0x71, 0xa8, 0xd2, 0x92, 0x14, 0x15, 0x91, 0xba, 0x35, 0x52,
+		0x35, 0x2f, 0x6a, 0xd0, 0xa3, 0x30, 0x4a, 0x29, 0x2a, 0x35, 0xc3, 0xd5, 0xab, 0x06, 0x2e, 0x90,
+		0x0d, 0xa0, 0x93, 0x86, 0xa0, 0x64, 0x23, 0x04, 0x63, 0x85, 0x23, 0x10, 0x89, 0x1c, 0xb4, 0x00,
+		0x47, 0x29, 0x59, 0x19, 0x9b, 0xd8, 0xd6, 0x5c, 0x5c, 0xc0, 0xcf, 0xd7, 0x34, 0xc3, 0x29, 0x68,
+		0x66, 0xa9, 0xb0, 0xab, 0x39, 0xbb, 0xe9, 0x31, 0xc2, 0x20, 0x17, 0x4b, 0x4b, 0xea, 0x04, 0x41,
+		0x5c, 0xe4, 0x78, 0x79, 0x8a, 0x36, 0x67, 0x96, 0x2e, 0x68, 0xce, 0x2e, 0x9a, 0x85, 0x71, 0xca,
+		0xc5, 0x71, 0x6d, 0xdd, 0x2c, 0x17, 0x8a, 0xbb, 0xb8, 0x78, 0xa5, 0x50, 0x73, 0x77, 0x1e, 0x4b,
+		0x1f, 0x0e, 0xb6, 0x4f, 0x25, 0xdc, 0xa0, 0x38, 0xf3, 0x04, 0x65, 0xcb, 0xdd, 0x79, 0x0c, 0x6d,
+		0xc0, 0x00, 0x31, 0x46, 0x45, 0x7f, 0x01, 0x17, 0x76, 0x2c, 0x9b, 0xce, 0xa1, 0x43, 0x0d, 0x42,
+		0x53, 0x40, 0x83, 0xd9, 0x35, 0x4e, 0xb0, 0x62, 0x95, 0xf0, 0x6c, 0xcf, 0xc6, 0x7a, 0x3e, 0xbf,
+		0xa0, 0xf6, 0x0b, 0x2e, 0xe7, 0x2d, 0x9b, 0x38, 0x54, 0xd9, 0xf2, 0x14, 0xdc, 0xcf, 0x1c, 0xaa,
+		0x6c, 0x09, 0xf5, 0x3e, 0x0a, 0xa3, 0xc5, 0x22, 0xeb, 0xb3, 0x5e, 0x2c, 0xf0, 0x6d, 0x9a, 0x93,
+		0x96, 0x43, 0xca, 0x2a, 0x16, 0x17, 0x19, 0x02, 0xf7, 0x71, 0x07, 0x3d, 0x0e, 0x07, 0x7d, 0x65,
+		0x05, 0x09, 0x47, 0xea, 0x7a, 0x19, 0x25, 0x7d, 0x14, 0x46, 0xab, 0x7b, 0xf5, 0x84, 0x28, 0xd4,
+		0x62, 0x75, 0x2f, 0x4a, 0x76, 0x16, 0xc6, 0xaa, 0xbb, 0xd5, 0x7a, 0xba, 0x93, 0x41, 0x3a, 0x54,
+		0xdd, 0xad, 0x46, 0x09, 0xef, 0xa1, 0x7b, 0x76, 0x1b, 0x17, 0x35, 0x17, 0x97, 0xd2, 0x87, 0x82,
+		0xe8, 0x81, 0x0a, 0x94, 0x05, 0xb9, 0x58, 0x2c, 0x60, 0x53, 0xdb, 0x36, 0x70, 0x41, 0xb3, 0xb1,
+		0xa9, 0x39, 0xe9, 0x29, 0x8a, 0x9c, 0x70, 0xed, 0x1a, 0x56, 0x87, 0x8a, 0xc5, 0x3c, 0xad, 0x9c,
+		0xa3, 0x75, 0xe8, 0x24, 0x8c, 0x58, 0xdb, 0xcf, 0x15, 0x99, 0x47, 0x16, 0xaa, 0x36, 0xde, 0xd1,
+		0xaf, 0xa7, 0x8f, 0x51, 0xf5, 0x0e, 0x93, 0x0a, 0xea, 0x8f, 0xeb, 0x14, 0x8c, 0xee, 0x03, 0xb9,
+		0xe8, 0xec, 0x6a, 0x76, 0x95, 0x86, 0x64, 0xa7, 0xaa, 0x15, 0x71, 0xfa, 0x1e, 0x86, 0xca, 0xe0,
+		0xab, 0x02, 0x4c, 0x46, 0x84, 0x73, 0x4d, 0xdf, 0x71, 0x05, 0xc7, 0x7b, 0xd9, 0x88, 0xa0, 0x30,
+		0xce, 0xed, 0x04, 0xc8, 0x44, 0x13, 0xa1, 0x86, 0x4f, 0x50, 0xb4, 0xa1, 0xea, 0x6e, 0x35, 0xd8,
+		0xee, 0xdd, 0x30, 0x58, 0xdd, 0x0d, 0x36, 0x7a, 0x1f, 0x5b, 0xb8, 0x55, 0x77, 0x03, 0x2d, 0x9e,
+		0x86, 0x71, 0x82, 0x54, 0xc1, 0xae, 0x56, 0xd2, 0x5c, 0x2d, 0x80, 0xfd, 0x00, 0xc5, 0x26, 0x6a,
+		0x5f, 0xe1, 0x95, 0x21, 0x39, 0xed, 0xda, 0xf6, 0x9e, 0xe7, 0x58, 0x0f, 0x32, 0x39, 0x09, 0x4c,
+		0xb8, 0xd6, 0x1d, 0x5b, 0x9c, 0x2b, 0xb3, 0x30, 0x10, 0xf4, 0x7b, 0x94, 0x02, 0xe6, 0xf9, 0xb2,
+		0x44, 0x16, 0x41, 0xf3, 0x6b, 0x0b, 0x64, 0xf9, 0xf2, 0xf6, 0xbc, 0x1c, 0x23, 0xcb, 0xa8, 0xe5,
+		0xa5, 0xcd, 0x7c, 0x41, 0xdd, 0x5a, 0xdd, 0x5c, 0x5a, 0xc9, 0xcb, 0xf1, 0xc0, 0xc2, 0xfe, 0x62,
+		0x22, 0x79, 0x5c, 0xbe, 0x57, 0xf9, 0x6a, 0x1c, 0x86, 0xc2, 0x3b, 0x35, 0xf4, 0x16, 0x38, 0x24,
+		0x12, 0x2e, 0x0e, 0x76, 0x0b, 0xd7, 0x74, 0x9b, 0x0e, 0xc8, 0x8a, 0xc6, 0x26, 0x47, 0xcf, 0x7f,
+		0xc6, 0x38, 0xd6, 0x06, 0x76, 0x9f, 0xd6, 0x6d, 0x32, 0xdc, 0x2a, 0x9a, 0x8b, 0x96, 0x61, 0xca,
+		0xb4, 0x0a, 0x8e, 0xab, 0x99, 0x25, 0xcd, 0x2e, 0x15, 0xfc, 0x54, 0x57, 0x41, 0x2b, 0x16, 0xb1,
+		0xe3, 0x58, 0x6c, 0x22, 0xf4, 0xb8, 0x1c, 0x31, 0xad, 0x0d, 0x8e, 0xec, 0xcf, 0x10, 0x73, 0x1c,
+		0x35, 0xe2, 0xbe, 0xf1, 0x66, 0xee, 0x7b, 0x18, 0x52, 0x15, 0xad, 0x5a, 0xc0, 0xa6, 0x6b, 0xef,
+		0xd1, 0xf5, 0x79, 0x52, 0x4d, 0x56, 0xb4, 0x6a, 0x9e, 0x94, 0xd1, 0x65, 0x38, 0xee, 0xa3, 0x16,
+		0x0c, 0x5c, 0xd6, 0x8a, 0x7b, 0x05, 0xba, 0x18, 0xa7, 0x69, 0x83, 0x42, 0xd1, 0x32, 0x77, 0x0c,
+		0xbd, 0xe8, 0x3a, 0xe9, 0x7e, 0x2f, 0xc6, 0x29, 0x3e, 0xc5, 0x32, 0x25, 0xb8, 0xe8, 0x58, 0x26,
+		0x5d, 0x83, 0xcf, 0x0b, 0xec, 0xd7, 0x64, 0xfb, 0x75, 0x31, 0x91, 0x4c, 0xc8, 0x3d, 0x17, 0x13,
+		0xc9, 0x1e, 0xb9, 0xf7, 0x62, 0x22, 0xd9, 0x2b, 0xf7, 0x5d, 0x4c, 0x24, 0x93, 0x72, 0xea, 0x62,
+		0x22, 0x99, 0x92, 0x41, 0xf9, 0x4a, 0x12, 0x06, 0x82, 0x3b, 0x03, 0xb2, 0xd1, 0x2a, 0xd2, 0xb9,
+		0x51, 0xa2, 0xd1, 0xf3, 0xee, 0x96, 0xfb, 0x88, 0xec, 0x3c, 0x99, 0x34, 0x67, 0x7b, 0xd9, 0x32,
+		0x5c, 0x65, 0x94, 0x64, 0xc1, 0x42, 0xdc, 0x1a, 0xb3, 0x65, 0x4f, 0x52, 0xe5, 0x25, 0xb4, 0x08,
+		0xbd, 0xcf, 0x39, 0x94, 0x77, 0x2f, 0xe5, 0x7d, 0xac, 0x35, 0xef, 0x8b, 0x1b, 0x94, 0x79, 0xea,
+		0xe2, 0x46, 0x61, 0x75, 0x4d, 0x5d, 0x99, 0x5b, 0x56, 0x39, 0x39, 0x9a, 0x80, 0x84, 0xa1, 0xbd,
+		0xb0, 0x17, 0x9e, 0x5e, 0x29, 0x08, 0x65, 0x61, 0xb8, 0x66, 0x5e, 0xc5, 0xb6, 0xbe, 0xa3, 0x13,
+		0x53, 0x11, 0xac, 0xe1, 0x20, 0xd6, 0x90, 0x5f, 0xbb, 0x4c, 0xf0, 0x3b, 0x74, 0x8f, 0x09, 0x48,
+		0x90, 0xa4, 0x62, 0x78, 0x12, 0xa4, 0x20, 0x74, 0x02, 0x06, 0x4a, 0x78, 0xbb, 0x56, 0x2e, 0xd8,
+		0xb8, 0xa4, 0x15, 0xdd, 0x70, 0xe8, 0xef, 0xa7, 0x55, 0x2a, 0xad, 0x41, 0x97, 0x20, 0x45, 0x6c,
+		0x64, 0x52, 0x1b, 0x8f, 0x50, 0x15, 0x3c, 0xd8, 0x5a, 0x05, 0xdc, 0xc4, 0x82, 0x48, 0xf5, 0xe9,
+		0xd1, 0x79, 0xe8, 0x75, 0x35, 0xbb, 0x8c, 0x5d, 0x1a, 0xf9, 0x87, 0x4e, 0x65, 0x3b, 0xe1, 0xb4,
+		0x49, 0x29, 0xe8, 0x9e, 0x96, 0x53, 0xdf, 0xc1, 0x28, 0x33, 0x03, 0x3d, 0xd4, 0x3d, 0x10, 0x00,
+		0x77, 0x10, 0xf9, 0x00, 0x4a, 0x42, 0x62, 0x7e, 0x4d, 0x25, 0x91, 0x46, 0x86, 0x01, 0x06, 0x2d,
+		0xac, 0x2f, 0xe5, 0xe7, 0xf3, 0x72, 0x4c, 0x79, 0x14, 0x7a, 0x99, 0xcd, 0x49, 0x14, 0xf2, 0xac,
+		0x2e, 0x1f, 0xe0, 0x45, 0xce, 0x43, 0x12, 0xb5, 0x5b, 0x2b, 0xb9, 0xbc, 0x2a, 0xc7, 0x94, 0x2d,
+		0x18, 0x8e, 0xe8, 0x09, 0x1d, 0x84, 0x11, 0x35, 0xbf, 0x99, 0x5f, 0x25, 0xfb, 0xac, 0xc2, 0xd6,
+		0xea, 0xa5, 0xd5, 0xb5, 0xa7, 0x57, 0xe5, 0x03, 0x61, 0xb0, 0x08, 0x69, 0x12, 0x1a, 0x03, 0xd9,
+		0x07, 0x6f, 0xac, 0x6d, 0xa9, 0x54, 0x9a, 0xbf, 0x1a, 0x03, 0x39, 0xaa, 0x35, 0x74, 0x08, 0x46,
+		0x37, 0xe7, 0xd4, 0xc5, 0xfc, 0x66, 0x81, 0xed, 0x1d, 0x3d, 0xd6, 0x63, 0x20, 0x07, 0x2b, 0xce,
+		0x2f, 0xd1, 0xad, 0xf1, 0x14, 0x1c, 0x0e, 0x42, 0xf3, 0xcf, 0x6c, 0xe6, 0x57, 0x37, 0x68, 0xe3,
+		0x73, 0xab, 0x8b, 0x24, 0xbe, 0x46, 0xf8, 0x89, 0xdd, 0x6a, 0x9c, 0x88, 0x1a, 0xe6, 0x97, 0x5f,
+		0x5e, 0x90, 0x13, 0x51, 0xf0, 0xda, 0x6a, 0x7e, 0xed, 0xbc, 0xdc, 0x13, 0x6d, 0x9d, 0xee, 0x60,
+		0x7b, 0x51, 0x06, 0xc6, 0xa3, 0xd0, 0x42, 0x7e, 0x75, 0x53, 0x7d, 0x56, 0xee, 0x8b, 0x36, 0xbc,
+		0x91, 0x57, 0x2f, 0x2f, 0xcd, 0xe7, 0xe5, 0x24, 0x1a, 0x07, 0x14, 0x96, 0x68, 0xf3, 0xc2, 0xda,
+		0x82, 0x9c, 0xaa, 0x8b, 0x28, 0x8a, 0x03, 0x03, 0xc1, 0x6d, 0xe4, 0x6b, 0x93, 0x4b, 0xfa, 0x78,
+		0x0c, 0xfa, 0x03, 0xdb, 0x42, 0xb2, 0x9e, 0xd7, 0x0c, 0xc3, 0xba, 0x56, 0xd0, 0x0c, 0x5d, 0x73,
+		0x78, 0xbc, 0x01, 0x0a, 0x9a, 0x23, 0x90, 0x4e, 0xc7, 0x77, 0xe7, 0x11, 0xbe, 0xf7, 0xf5, 0x18,
+		0xe1, 0x7b, 0xe4, 0x5e, 0xe5, 0xd3, 0x12, 0xc8, 0xd1, 0xfd, 0x5e, 0xa4, 0xfb, 0x52, 0xb3, 0xee,
+		0xbf, 0x26, 0xb6, 0xfb, 0x94, 0x04, 0x43, 0xe1, 0x4d, 0x5e, 0x44, 0xbc, 0xbb, 0x7e, 0xac, 0xe2,
+		0xfd, 0x71, 0x0c, 0x06, 0x43, 0x5b, 0xbb, 0x4e, 0xa5, 0x7b, 0x1e, 0x46, 0xf4, 0x12, 0xae, 0x54,
+		0x2d, 0x97, 0x9c, 0x36, 0x15, 0x0c, 0x7c, 0x15, 0x1b, 0x69, 0x85, 0x06, 0xe5, 0x99, 0xd6, 0x9b,
+		0xc7, 0xec, 0x92, 0x4f, 0xb7, 0x4c, 0xc8, 0x66, 0x47, 0x97, 0x16, 0xf2, 0x2b, 0xeb, 0x6b, 0x9b,
+		0xf9, 0xd5, 0xf9, 0x67, 0x45, 0x74, 0x51, 0x65, 0x3d, 0x82, 0x76, 0x07, 0x83, 0xf6, 0x3a, 0xc8,
+		0x51, 0xa1, 0x48, 0xac, 0x68, 0x20, 0x96, 0x7c, 0x00, 0x8d, 0xc2, 0xf0, 0xea, 0x5a, 0x61, 0x63,
+		0x69, 0x21, 0x5f, 0xc8, 0x9f, 0x3f, 0x9f, 0x9f, 0xdf, 0xdc, 0x60, 0xe9, 0x40, 0x0f, 0x7b, 0x53,
+		0x8e, 0x05, 0x55, 0xfc, 0xc9, 0x38, 0x8c, 0x36, 0x90, 0x04, 0xcd, 0xf1, 0x8d, 0x3c, 0xcb, 0x2d,
+		0x3c, 0xd8, 0x89, 0xf4, 0x59, 0xb2, 0x94, 0x5e, 0xd7, 0x6c, 0x97, 0xef, 0xfb, 0xef, 0x03, 0xa2,
+		0x25, 0xd3, 0x25, 0x33, 0xbb, 0xcd, 0xd3, 0xac, 0x6c, 0x77, 0x3f, 0xec, 0xc3, 0x59, 0xa6, 0xf5,
+		0x01, 0x40, 0x55, 0xcb, 0xd1, 0x5d, 0xfd, 0x2a, 0x39, 0xc3, 0x12, 0x39, 0x59, 0xb2, 0xdb, 0x4f,
+		0xa8, 0xb2, 0xa8, 0x59, 0x32, 0x5d, 0x0f, 0xdb, 0xc4, 0x65, 0x2d, 0x82, 0x4d, 0x56, 0x1e, 0x71,
+		0x55, 0x16, 0x35, 0x1e, 0xf6, 0x5d, 0x30, 0x50, 0xb2, 0x6a, 0x64, 0x0b, 0xc4, 0xf0, 0x48, 0xb4,
+		0x90, 0xd4, 0x7e, 0x06, 0xf3, 0x50, 0xf8, 0xe6, 0xd6, 0x4f, 0x06, 0x0f, 0xa8, 0xfd, 0x0c, 0xc6,
+		0x50, 0xee, 0x85, 0x61, 0xad, 0x5c, 0xb6, 0x09, 0x73, 0xc1, 0x88, 0x6d, 0xd7, 0x87, 0x3c, 0x30,
+		0x45, 0xcc, 0x5c, 0x84, 0xa4, 0xd0, 0x03, 0x59, 0xc1, 0x12, 0x4d, 0x14, 0xaa, 0x2c, 0x07, 0x15,
+		0x23, 0xf9, 0x61, 0x53, 0x54, 0xde, 0x05, 0x03, 0xba, 0x53, 0xf0, 0xcf, 0xb6, 0x62, 0xd3, 0xb1,
+		0x13, 0x49, 0xb5, 0x5f, 0x77, 0xbc, 0x73, 0x01, 0xe5, 0x0b, 0x31, 0x18, 0x0a, 0x9f, 0xda, 0xa1,
+		0x05, 0x48, 0x1a, 0x56, 0x51, 0xa3, 0xae, 0xc5, 0x8e, 0x8c, 0x4f, 0xb4, 0x39, 0xe8, 0xcb, 0x2e,
+		0x73, 0x7c, 0xd5, 0xa3, 0xcc, 0xfc, 0x1b, 0x09, 0x92, 0x02, 0x8c, 0xc6, 0x21, 0x51, 0xd5, 0xdc,
+		0x5d, 0xca, 0xae, 0x27, 0x17, 0x93, 0x25, 0x95, 0x96, 0x09, 0xdc, 0xa9, 0x6a, 0x66, 0x3a, 0xe6,
+		0xc3, 0x49, 0x99, 0xd8, 0xd5, 0xc0, 0x5a, 0x89, 0xe6, 0x02, 0xac, 0x4a, 0x05, 0x9b, 0xae, 0x23,
+		0xec, 0xca, 0xe1, 0xf3, 0x1c, 0x4c, 0x0e, 0x8f, 0x5d, 0x5b, 0xd3, 0x8d, 0x10, 0x6e, 0x82, 0xe2,
+		0xca, 0xa2, 0xc2, 0x43, 0x9e, 0x85, 0x09, 0xc1, 0xb7, 0x84, 0x5d, 0xad, 0xb8, 0x8b, 0x4b, 0x3e,
+		0x51, 0x2f, 0xcd, 0xf9, 0x1d, 0xe2, 0x08, 0x0b, 0xbc, 0x5e, 0xd0, 0x2a, 0xaf, 0xc4, 0x60, 0x44,
+		0x64, 0x2f, 0x4a, 0x9e, 0xb2, 0x56, 0x00, 0x34, 0xd3, 0xb4, 0xdc, 0xa0, 0xba, 0xea, 0x5d, 0xb9,
+		0x8e, 0x2e, 0x3b, 0xe7, 0x11, 0xa9, 0x01, 0x06, 0x99, 0x3f, 0x95, 0x00, 0xfc, 0xaa, 0xa6, 0x7a,
+		0x9b, 0x82, 0x7e, 0x7e, 0x26, 0x4b, 0x0f, 0xf6, 0x59, 0xc2, 0x0b, 0x18, 0x88, 0xe4, 0x39, 0x48,
+		0x5a, 0x72, 0x1b, 0x97, 0x75, 0x93, 0x9f, 0xa7, 0xb0, 0x82, 0x48, 0x4b, 0x26, 0xfc, 0xe3, 0x29,
+		0x15, 0x92, 0x0e, 0xae, 0x68, 0xa6, 0xab, 0x17, 0xf9, 0x09, 0xc9, 0x99, 0xae, 0x84, 0xcf, 0x6e,
+		0x70, 0x6a, 0xd5, 0xe3, 0xa3, 0x9c, 0x80, 0xa4, 0x80, 0x92, 0x85, 0xdf, 0xea, 0xda, 0x6a, 0x5e,
+		0x3e, 0x80, 0xfa, 0x20, 0xbe, 0x91, 0xdf, 0x94, 0x25, 0xb2, 0xed, 0x9c, 0x5b, 0x5e, 0x9a, 0xdb,
+		0x90, 0x63, 0xb9, 0xff, 0x1f, 0x46, 0x8b, 0x56, 0x25, 0xda, 0x60, 0x4e, 0x8e, 0xa4, 0xfc, 0x9c,
+		0x0b, 0xd2, 0xdb, 0x1f, 0xe4, 0x48, 0x65, 0xcb, 0xd0, 0xcc, 0x72, 0xd6, 0xb2, 0xcb, 0xfe, 0xb5,
+		0x08, 0xb2, 0x3b, 0x70, 0x02, 0x97, 0x23, 0xaa, 0xdb, 0xff, 0x4b, 0x92, 0x3e, 0x17, 0x8b, 0x2f,
+		0xae, 0xe7, 0xbe, 0x18, 0xcb, 0x2c, 0x32, 0xc2, 0x75, 0xd1, 0x1d, 0x15, 0xef, 0x18, 0xb8, 0x48,
+		0x84, 0x87, 0x1f, 0xdc, 0x0f, 0x63, 0x65, 0xab, 0x6c, 0x51, 0x4e, 0x33, 0xe4, 0x3f, 0x26, 0x04,
+		0x4a, 0x79, 0xd0, 0x4c, 0xdb, 0x4b, 0x18, 0xb3, 0xab, 0x30, 0xca, 0x91, 0x0b, 0xf4, 0xf8, 0x96,
+		0x25, 0x17, 0x50, 0xcb, 0xcc, 0x76, 0xfa, 0x97, 0xbe, 0x47, 0x57, 0x25, 0xea, 0x08, 0x27, 0x25,
+		0x75, 0x2c, 0xff, 0x30, 0xab, 0xc2, 0xc1, 0x10, 0x3f, 0x16, 0x23, 0xb0, 0xdd, 0x86, 0xe3, 0xaf,
+		0x73, 0x8e, 0xa3, 0x01, 0x8e, 0x1b, 0x9c, 0x74, 0x76, 0x1e, 0x06, 0xbb, 0xe1, 0xf5, 0x1b, 0x9c,
+		0xd7, 0x00, 0x0e, 0x32, 0x59, 0x84, 0x61, 0xca, 0xa4, 0x58, 0x73, 0x5c, 0xab, 0x42, 0x03, 0x70,
+		0x6b, 0x36, 0xbf, 0xf9, 0x3d, 0x36, 0x68, 0x87, 0x08, 0xd9, 0xbc, 0x47, 0x35, 0x3b, 0x0b, 0xf4,
+		0xc4, 0x9a, 0x9c, 0x24, 0xb7, 0xe1, 0xf0, 0x0d, 0x2e, 0x88, 0x87, 0x3f, 0x7b, 0x19, 0xc6, 0xc8,
+		0xff, 0x34, 0x3e, 0x06, 0x25, 0x69, 0x9f, 0x06, 0x4f, 0x7f, 0xf3, 0xbd, 0x2c, 0x2e, 0x8c, 0x7a,
+		0x0c, 0x02, 0x32, 0x05, 0xac, 0x58, 0xc6, 0xae, 0x8b, 0x6d, 0xa7, 0xa0, 0x19, 0x8d, 0xc4, 0x0b,
+		0xe4, 0x11, 0xd3, 0x9f, 0xf8, 0x61, 0xd8, 0x8a, 0x8b, 0x8c, 0x72, 0xce, 0x30, 0x66, 0xb7, 0xe0,
+		0x50, 0x03, 0xaf, 0xe8, 0x80, 0xe7, 0x27, 0x39, 0xcf, 0xb1, 0x3a, 0xcf, 0x20, 0x6c, 0xd7, 0x41,
+		0xc0, 0x3d, 0x5b, 0x76, 0xc0, 0xf3, 0xe7, 0x38, 0x4f, 0xc4, 0x69, 0x85, 0x49, 0x09, 0xc7, 0x8b,
+		0x30, 0x72, 0x15, 0xdb, 0xdb, 0x96, 0xc3, 0x73, 0xb7, 0x1d, 0xb0, 0xfb, 0x14, 0x67, 0x37, 0xcc,
+		0x09, 0x69, 0x32, 0x97, 0xf0, 0x7a, 0x1c, 0x92, 0x3b, 0x5a, 0x11, 0x77, 0xc0, 0xe2, 0x65, 0xce,
+		0xa2, 0x8f, 0xe0, 0x13, 0xd2, 0x39, 0x18, 0x28, 0x5b, 0x7c, 0x8a, 0x6c, 0x4f, 0xfe, 0x69, 0x4e,
+		0xde, 0x2f, 0x68, 0x38, 0x8b, 0xaa, 0x55, 0xad, 0x19, 0x64, 0xfe, 0x6c, 0xcf, 0xe2, 0x6f, 0x09,
+		0x16, 0x82, 0x86, 0xb3, 0xe8, 0x42, 0xad, 0x9f, 0x11, 0x2c, 0x9c, 0x80, 0x3e, 0x9f, 0x24, 0x47,
+		0xba, 0xc6, 0x9e, 0x65, 0x76, 0x22, 0xc4, 0x67, 0x39, 0x07, 0xe0, 0x24, 0x84, 0xc1, 0x39, 0x48,
+		0x75, 0x6a, 0x88, 0xbf, 0xf3, 0x43, 0x31, 0x3c, 0x84, 0x05, 0x16, 0x61, 0x58, 0x04, 0x28, 0x72,
+		0x05, 0xa4, 0x3d, 0x8b, 0xbf, 0xcb, 0x59, 0x0c, 0x05, 0xc8, 0x78, 0x37, 0x5c, 0xec, 0xb8, 0x65,
+		0xdc, 0x09, 0x93, 0x2f, 0x88, 0x6e, 0x70, 0x12, 0xae, 0xca, 0x6d, 0x6c, 0x16, 0x77, 0x3b, 0xe3,
+		0xf0, 0xf3, 0x42, 0x95, 0x82, 0x86, 0xb0, 0x98, 0x87, 0xc1, 0x8a, 0x66, 0x3b, 0xbb, 0x9a, 0xd1,
+		0x91, 0x39, 0xfe, 0x1e, 0xe7, 0x31, 0xe0, 0x11, 0x71, 0x8d, 0xd4, 0xcc, 0x6e, 0xd8, 0x7c, 0x51,
+		0x68, 0xa4, 0x66, 0x86, 0x18, 0xad, 0xc3, 0x98, 0xe3, 0xd2, 0x44, 0x77, 0x37, 0xdc, 0x7e, 0x41,
+		0x0c, 0x3d, 0x46, 0xbb, 0x12, 0xe4, 0x78, 0x0e, 0x52, 0x8e, 0xfe, 0x42, 0x47, 0x6c, 0xfe, 0xbe,
+		0xb0, 0x34, 0x25, 0x20, 0xc4, 0xcf, 0xc2, 0x44, 0xc3, 0x69, 0xa2, 0x03, 0x66, 0xff, 0x80, 0x33,
+		0x1b, 0x6f, 0x30, 0x55, 0xf0, 0x90, 0xd0, 0x2d, 0xcb, 0x7f, 0x28, 0x42, 0x02, 0x8e, 0xf0, 0x5a,
+		0x27, 0x9b, 0x16, 0x47, 0xdb, 0xe9, 0x4e, 0x6b, 0xbf, 0x28, 0xb4, 0xc6, 0x68, 0x43, 0x5a, 0xdb,
+		0x84, 0x71, 0xce, 0xb1, 0x3b, 0xbb, 0x7e, 0x49, 0x04, 0x56, 0x46, 0xbd, 0x15, 0xb6, 0xee, 0x3b,
+		0x20, 0xe3, 0xa9, 0x53, 0xac, 0x8e, 0x9d, 0x02, 0xc9, 0x0e, 0xb7, 0xe7, 0xfc, 0x4b, 0x9c, 0xb3,
+		0x88, 0xf8, 0xde, 0xf2, 0xda, 0x59, 0xd1, 0xaa, 0x84, 0xf9, 0x33, 0x90, 0x16, 0xcc, 0x6b, 0xa6,
+		0x8d, 0x8b, 0x56, 0xd9, 0xd4, 0x5f, 0xc0, 0xa5, 0x0e, 0x58, 0xff, 0xa3, 0x88, 0xa9, 0xb6, 0x02,
+		0xe4, 0x84, 0xf3, 0x12, 0xc8, 0xde, 0x5a, 0xa5, 0xa0, 0x57, 0xaa, 0x96, 0xed, 0xb6, 0xe1, 0xf8,
+		0x65, 0x61, 0x29, 0x8f, 0x6e, 0x89, 0x92, 0xcd, 0xe6, 0x81, 0xdd, 0xfe, 0xe8, 0xd4, 0x25, 0x7f,
+		0x99, 0x33, 0x1a, 0xf4, 0xa9, 0x78, 0xe0, 0x28, 0x5a, 0x95, 0xaa, 0x66, 0x77, 0x12, 0xff, 0xfe,
+		0xb1, 0x08, 0x1c, 0x9c, 0x84, 0x07, 0x0e, 0xb2, 0xa2, 0x23, 0xb3, 0x7d, 0x07, 0x1c, 0x7e, 0x45,
+		0x04, 0x0e, 0x41, 0xc3, 0x59, 0x88, 0x05, 0x43, 0x07, 0x2c, 0xbe, 0x22, 0x58, 0x08, 0x1a, 0xc2,
+		0xe2, 0x29, 0x7f, 0xa2, 0xb5, 0x71, 0x59, 0x77, 0x5c, 0x9b, 0x2d, 0xc9, 0x5b, 0xb3, 0xfa, 0x27,
+		0x3f, 0x0c, 0x2f, 0xc2, 0xd4, 0x00, 0x29, 0x89, 0x44, 0xfc, 0xe8, 0x83, 0x6e, 0xd9, 0xda, 0x0b,
+		0xf6, 0xab, 0x22, 0x12, 0x05, 0xc8, 0x88, 0x6c, 0x81, 0x15, 0x22, 0x51, 0x7b, 0x91, 0x6c, 0x54,
+		0x3a, 0x60, 0xf7, 0x4f, 0x23, 0xc2, 0x6d, 0x08, 0x5a, 0xc2, 0x33, 0xb0, 0xfe, 0xa9, 0x99, 0x57,
+		0xf0, 0x5e, 0x47, 0xde, 0xf9, 0xd5, 0xc8, 0xfa, 0x67, 0x8b, 0x51, 0xb2, 0x18, 0x32, 0x1c, 0x59,
+		0x4f, 0xa1, 0x76, 0x77, 0xfd, 0xd2, 0x3f, 0xf9, 0x23, 0xde, 0xdf, 0xf0, 0x72, 0x6a, 0x76, 0x19,
+		0x64, 0x0e, 0xf1, 0x17, 0xb0, 0x6d, 0x99, 0xbd, 0xf7, 0x47, 0x9e, 0x9f, 0x87, 0xd6, 0x3c, 0xb3,
+		0xe7, 0x61, 0x30, 0xb4, 0xe0, 0x69, 0xcf, 0xea, 0x7d, 0x9c, 0xd5, 0x40, 0x70, 0xbd, 0x33, 0xfb,
+		0x28, 0x24, 0xc8, 0xe2, 0xa5, 0x3d, 0xf9, 0x4f, 0x73, 0x72, 0x8a, 0x3e, 0xfb, 0x04, 0x24, 0xc5,
+		0xa2, 0xa5, 0x3d, 0xe9, 0xfb, 0x39, 0xa9, 0x47, 0x42, 0xc8, 0xc5, 0x82, 0xa5, 0x3d, 0xf9, 0x5f,
+		0x11, 0xe4, 0x82, 0x84, 0x90, 0x77, 0xae, 0xc2, 0x5f, 0xfb, 0x99, 0x04, 0x23, 0x17, 0x24, 0xb3,
+		0xe4, 0xf6, 0x09, 0x5b, 0xa9, 0xb4, 0xa7, 0xfe, 0x20, 0x6f, 0x5c, 0x50, 0xcc, 0x9e, 0x85, 0x9e,
+		0x0e, 0x15, 0xfe, 0x21, 0x4e, 0xca, 0xf0, 0x67, 0xe7, 0xa1, 0x3f, 0xb0, 0x3a, 0x69, 0x4f, 0xfe,
+		0xd7, 0x38, 0x79, 0x90, 0x8a, 0x88, 0xce, 0x57, 0x27, 0xed, 0x19, 0x7c, 0x58, 0x88, 0xce, 0x29,
+		0x88, 0xda, 0xc4, 0xc2, 0xa4, 0x3d, 0xf5, 0x47, 0x84, 0xd6, 0x05, 0xc9, 0xec, 0x93, 0x90, 0xf2,
+		0x26, 0x9b, 0xf6, 0xf4, 0x1f, 0xe5, 0xf4, 0x3e, 0x0d, 0xd1, 0x40, 0xcd, 0xec, 0x82, 0xc5, 0x5f,
+		0x17, 0x1a, 0x08, 0x50, 0x91, 0x61, 0x14, 0x5d, 0xc0, 0xb4, 0xe7, 0xf4, 0x31, 0x31, 0x8c, 0x22,
+		0xeb, 0x17, 0x62, 0x4d, 0x1a, 0xf3, 0xdb, 0xb3, 0xf8, 0x1b, 0xc2, 0x9a, 0x14, 0x9f, 0x88, 0x11,
+		0x5d, 0x11, 0xb4, 0xe7, 0xf1, 0xb3, 0x42, 0x8c, 0xc8, 0x82, 0x60, 0x76, 0x1d, 0x50, 0xfd, 0x6a,
+		0xa0, 0x3d, 0xbf, 0x8f, 0x73, 0x7e, 0x23, 0x75, 0x8b, 0x81, 0xd9, 0xa7, 0x61, 0xbc, 0xf1, 0x4a,
+		0xa0, 0x3d, 0xd7, 0x4f, 0xfc, 0x28, 0xb2, 0x77, 0x0b, 0x2e, 0x04, 0x66, 0x37, 0x61, 0xac, 0xd1,
+		0x2a, 0xa0, 0x3d, 0xdb, 0x4f, 0xfe, 0x28, 0x1c, 0xb8, 0x83, 0x8b, 0x80, 0xd9, 0x39, 0x00, 0x7f,
+		0x02, 0x6e, 0xcf, 0xeb, 0x53, 0x9c, 0x57, 0x80, 0x88, 0x0c, 0x0d, 0x3e, 0xff, 0xb6, 0xa7, 0x7f,
+		0x59, 0x0c, 0x0d, 0x4e, 0x41, 0x86, 0x86, 0x98, 0x7a, 0xdb, 0x53, 0x7f, 0x5a, 0x0c, 0x0d, 0x41,
+		0x42, 0x3c, 0x3b, 0x30, 0xbb, 0xb5, 0xe7, 0xf0, 0x59, 0xe1, 0xd9, 0x01, 0xaa, 0xd9, 0x55, 0x18,
+		0xa9, 0x9b, 0x10, 0xdb, 0xb3, 0xfa, 0x1c, 0x67, 0x25, 0x47, 0xe7, 0xc3, 0xe0, 0xe4, 0xc5, 0x27,
+		0xc3, 0xf6, 0xdc, 0x3e, 0x1f, 0x99, 0xbc, 0xf8, 0x5c, 0x38, 0x7b, 0x0e, 0x92, 0x66, 0xcd, 0x30,
+		0xc8, 0xe0, 0x41, 0xad, 0xef, 0xe7, 0xa6, 0xff, 0xe4, 0x55, 0xae, 0x1d, 0x41, 0x30, 0xfb, 0x28,
+		0xf4, 0xe0, 0xca, 0x36, 0x2e, 0xb5, 0xa3, 0xfc, 0xc1, 0xab, 0x22, 0x60, 0x12, 0xec, 0xd9, 0x27,
+		0x01, 0x58, 0x6a, 0x84, 0x1e, 0x9c, 0xb7, 0xa1, 0xfd, 0xd3, 0x57, 0xf9, 0x85, 0x38, 0x9f, 0xc4,
+		0x67, 0xc0, 0xae, 0xd7, 0xb5, 0x66, 0xf0, 0xc3, 0x30, 0x03, 0x6a, 0x91, 0xc7, 0xa1, 0x8f, 0x1c,
+		0xa4, 0xb9, 0x5a, 0xb9, 0x1d, 0xf5, 0x7f, 0xe1, 0xd4, 0x02, 0x9f, 0x28, 0xac, 0x62, 0xd9, 0xd8,
+		0xd5, 0xca, 0x4e, 0x3b, 0xda, 0xff, 0xca, 0x69, 0x3d, 0x02, 0x42, 0x5c, 0xd4, 0x1c, 0xb7, 0x93,
+		0x7e, 0xff, 0x99, 0x20, 0x16, 0x04, 0x44, 0x68, 0xf2, 0xff, 0x15, 0xbc, 0xd7, 0x8e, 0xf6, 0xcf,
+		0x85, 0xd0, 0x1c, 0x7f, 0xf6, 0x09, 0x48, 0x91, 0x7f, 0xd9, 0x2d, 0xd7, 0x36, 0xc4, 0xff, 0x8d,
+		0x13, 0xfb, 0x14, 0xa4, 0x65, 0xc7, 0x2d, 0xb9, 0x7a, 0x7b, 0x65, 0xdf, 0xe4, 0x96, 0x16, 0xf8,
+		0xb3, 0x73, 0xd0, 0xef, 0xb8, 0xa5, 0x52, 0x8d, 0xaf, 0x4f, 0xdb, 0x90, 0xff, 0xf7, 0x57, 0xbd,
+		0x94, 0x85, 0x47, 0x43, 0xac, 0x7d, 0xed, 0x8a, 0x5b, 0xb5, 0xe8, 0x79, 0x4b, 0x3b, 0x0e, 0x3f,
+		0xe2, 0x1c, 0x02, 0x24, 0xb3, 0xf3, 0x30, 0x40, 0xfa, 0x62, 0xe3, 0x2a, 0xa6, 0x87, 0x63, 0x6d,
+		0x58, 0xfc, 0x0f, 0xae, 0x80, 0x10, 0x51, 0xee, 0x27, 0xbe, 0xf1, 0x9d, 0x49, 0xe9, 0x95, 0xef,
+		0x4c, 0x4a, 0x7f, 0xfc, 0x9d, 0x49, 0xe9, 0x23, 0xdf, 0x9d, 0x3c, 0xf0, 0xca, 0x77, 0x27, 0x0f,
+		0xfc, 0xc1, 0x77, 0x27, 0x0f, 0x34, 0xce, 0x12, 0xc3, 0xa2, 0xb5, 0x68, 0xb1, 0xfc, 0xf0, 0xdb,
+		0x95, 0xb2, 0xee, 0xee, 0xd6, 0xb6, 0xb3, 0x45, 0xab, 0x42, 0xd3, 0xb8, 0x7e, 0xb6, 0xd6, 0xdb,
+		0xe4, 0xc0, 0xfb, 0xe2, 0x30, 0x59, 0xb4, 0x9c, 0x8a, 0xe5, 0xcc, 0x6c, 0x6b, 0x0e, 0x9e, 0xb9,
+		0xfa, 0xf0, 0x36, 0x76, 0xb5, 0x87, 0x67, 0x8a, 0x96, 0x6e, 0x32, 0xae, 0x68, 0x94, 0xd5, 0x67,
+		0x49, 0x7d, 0x96, 0xd7, 0x67, 0x1a, 0x66, 0x88, 0x95, 0x45, 0x48, 0xcc, 0x5b, 0xba, 0x49, 0x12,
+		0xed, 0x25, 0x6c, 0x5a, 0x15, 0x7e, 0x09, 0x93, 0x15, 0xd0, 0xdd, 0xd0, 0xab, 0x55, 0xac, 0x9a,
+		0xe9, 0xb2, 0xd4, 0x7c, 0xae, 0xff, 0x1b, 0x37, 0xa6, 0x0e, 0xfc, 0xe1, 0x8d, 0xa9, 0xf8, 0x92,
+		0xe9, 0xaa, 0xbc, 0x6a, 0x36, 0xf1, 0xfd, 0xcf, 0x4c, 0x49, 0xca, 0x45, 0xe8, 0x5b, 0xc0, 0xc5,
+		0xfd, 0xf0, 0x5a, 0xc0, 0xc5, 0x08, 0xaf, 0xfb, 0x20, 0xb9, 0x64, 0xba, 0xec, 0x9a, 0xec, 0x51,
+		0x88, 0xeb, 0x26, 0xbb, 0x79, 0x15, 0x69, 0x9f, 0xc0, 0x09, 0xea, 0x02, 0x2e, 0x7a, 0xa8, 0x25,
+		0x5c, 0x4c, 0x4b, 0xf5, 0xec, 0x09, 0x3c, 0xb7, 0xf0, 0x07, 0xdf, 0x9e, 0x3c, 0xf0, 0xe2, 0x77,
+		0x26, 0x0f, 0x34, 0xb3, 0x4f, 0x48, 0xfd, 0x5c, 0xc5, 0xec, 0xcf, 0x83, 0x4e, 0xe9, 0x0a, 0x4b,
+		0xcf, 0x6f, 0xf7, 0xb2, 0x97, 0x05, 0xf0, 0x89, 0x38, 0xdc, 0xcd, 0x71, 0x1c, 0x57, 0xbb, 0xa2,
+		0x9b, 0x65, 0xcf, 0x12, 0xf8, 0x2a, 0x36, 0x5d, 0x87, 0xdb, 0x62, 0x9c, 0xdb, 0x82, 0x23, 0xb5,
+		0x36, 0x47, 0xa6, 0x8d, 0x65, 0x95, 0xdf, 0x93, 0x60, 0x30, 0x4f, 0xd8, 0x2f, 0x60, 0x83, 0x9e,
+		0xa4, 0xa1, 0x23, 0x90, 0x2a, 0xb1, 0xff, 0x2d, 0x9b, 0x2b, 0xdc, 0x07, 0x90, 0xda, 0xab, 0x9a,
+		0xa1, 0x97, 0x68, 0x2d, 0xbf, 0x4f, 0xec, 0x01, 0xd0, 0x59, 0xcf, 0x24, 0xec, 0x5a, 0xf3, 0x44,
+		0xb6, 0x81, 0xe3, 0x64, 0x89, 0x4d, 0x73, 0x09, 0xa2, 0x4e, 0x61, 0x26, 0x72, 0x5a, 0x64, 0xe2,
+		0x6b, 0x05, 0x72, 0x7b, 0x11, 0xf3, 0x53, 0xaa, 0x5c, 0x96, 0x2b, 0xfc, 0x78, 0x7b, 0x25, 0x66,
+		0x89, 0x4d, 0x52, 0x26, 0xbe, 0xb6, 0x41, 0x19, 0xe4, 0xce, 0x37, 0xb5, 0xc8, 0x03, 0x2d, 0x99,
+		0x5d, 0xf7, 0x54, 0x1f, 0xb6, 0xcd, 0xcf, 0xc6, 0x60, 0x2a, 0x7a, 0xdc, 0x41, 0x62, 0x8c, 0xe3,
+		0x6a, 0x95, 0x6a, 0xb3, 0x27, 0xa7, 0xe7, 0x20, 0xb5, 0x29, 0x70, 0xc8, 0x4b, 0x3e, 0x07, 0x17,
+		0x2d, 0xb3, 0xe4, 0x50, 0x9d, 0xc6, 0x55, 0x51, 0x24, 0xce, 0x6d, 0x6a, 0xa6, 0xe5, 0xf0, 0xeb,
+		0xec, 0xac, 0x90, 0x7b, 0x59, 0xea, 0x6e, 0xd0, 0x0f, 0x79, 0x4d, 0x51, 0xd7, 0x5d, 0x97, 0xde,
+		0x7e, 0xaa, 0xed, 0xc1, 0xd0, 0x15, 0xd3, 0xba, 0x66, 0xfa, 0xfd, 0x08, 0x9d, 0x0e, 0x4d, 0x46,
+		0x4f, 0x87, 0x9e, 0xc6, 0x86, 0x71, 0x89, 0x10, 0x6c, 0x86, 0x34, 0xf3, 0xbb, 0x12, 0x4c, 0xd3,
+		0x77, 0x3d, 0x76, 0x45, 0x37, 0xdd, 0x19, 0x43, 0xdf, 0x76, 0x66, 0xb6, 0x75, 0xd7, 0xe1, 0x76,
+		0x61, 0xaa, 0x19, 0xf3, 0x31, 0xb2, 0x04, 0x23, 0x4b, 0x30, 0x94, 0xd3, 0x90, 0xcc, 0xe9, 0xee,
+		0x9c, 0x6d, 0x6b, 0x7b, 0xe4, 0xee, 0x3a, 0x81, 0x71, 0xdd, 0xd0, 0xff, 0x89, 0x62, 0xb0, 0x81,
+		0x2b, 0x0e, 0x3d, 0x04, 0x4d, 0xa8, 0xac, 0x90, 0xdb, 0x6a, 0x6a, 0xda, 0x73, 0x01, 0xd3, 0x06,
+		0x44, 0x0a, 0xfc, 0xcb, 0x46, 0x47, 0x23, 0x71, 0xbd, 0xfe, 0x7c, 0x31, 0x01, 0x47, 0x03, 0x08,
+		0x45, 0x7b, 0xaf, 0xea, 0xd2, 0xa8, 0x69, 0xed, 0xf0, 0xce, 0x8c, 0x04, 0x3a, 0xc3, 0xaa, 0x9b,
+		0x44, 0xc2, 0x1d, 0xe8, 0x59, 0x27, 0x74, 0xa4, 0x23, 0xae, 0xe5, 0x6a, 0x06, 0xef, 0x1d, 0x2b,
+		0x10, 0x28, 0x7b, 0xdb, 0x14, 0x63, 0x50, 0x5d, 0x3c, 0x6b, 0x32, 0xb0, 0xb6, 0xc3, 0xae, 0x88,
+		0xc7, 0xe9, 0x59, 0x78, 0x92, 0x00, 0xe8, 0x6d, 0xf0, 0x31, 0xe8, 0xd1, 0x6a, 0xec, 0x18, 0x37,
+		0x7e, 0x62, 0x40, 0x65, 0x05, 0xe5, 0x12, 0xf4, 0xf1, 0xd3, 0x1c, 0x72, 0x8e, 0x79, 0x05, 0xef,
+		0xd1, 0x76, 0x06, 0x54, 0xf2, 0x2f, 0xca, 0x42, 0x0f, 0x15, 0x9e, 0xbf, 0x7d, 0x49, 0x67, 0xeb,
+		0xa4, 0xcf, 0x52, 0x21, 0x55, 0x86, 0xa6, 0x5c, 0x84, 0xe4, 0x82, 0x55, 0xd1, 0x4d, 0x2b, 0xcc,
+		0x2d, 0xc5, 0xb8, 0x51, 0x99, 0xab, 0x35, 0x1e, 0x71, 0x55, 0x56, 0x20, 0x17, 0x1c, 0xd9, 0x93,
+		0x01, 0x7e, 0x14, 0xcd, 0x4b, 0xca, 0x3c, 0xf4, 0x51, 0xde, 0x6b, 0x55, 0x62, 0x5f, 0xef, 0x16,
+		0x65, 0x8a, 0x3f, 0x20, 0xe3, 0xec, 0x63, 0xbe, 0xb0, 0x08, 0x12, 0x25, 0xcd, 0xd5, 0x78, 0xbf,
+		0xe9, 0xff, 0xca, 0x5b, 0x21, 0xc9, 0x99, 0x38, 0xe8, 0x14, 0xc4, 0xad, 0xaa, 0xc3, 0x0f, 0x93,
+		0x33, 0xcd, 0xba, 0xb2, 0x56, 0xe5, 0xc1, 0x85, 0x20, 0xe7, 0xd4, 0xa6, 0xfe, 0xf2, 0x58, 0xf7,
+		0xfe, 0xc2, 0x9a, 0xf1, 0x9c, 0xe5, 0xb3, 0x31, 0x98, 0x0c, 0xd4, 0x5e, 0xc5, 0x36, 0xd9, 0xd2,
+		0x84, 0x5c, 0x1f, 0x05, 0x84, 0xe4, 0xf5, 0x4d, 0xdc, 0xe5, 0x09, 0x88, 0xcf, 0x55, 0xab, 0xe4,
+		0xe5, 0x1c, 0x2d, 0x17, 0x2d, 0xe6, 0x2f, 0x09, 0xd5, 0x2b, 0x93, 0x3a, 0xc7, 0xda, 0x71, 0xaf,
+		0x69, 0xb6, 0xf7, 0xaa, 0x4e, 0x94, 0x95, 0xc7, 0x21, 0x35, 0x6f, 0x99, 0x0e, 0x36, 0x9d, 0x1a,
+		0x1d, 0x3a, 0xdb, 0x86, 0x55, 0xbc, 0xc2, 0x39, 0xb0, 0x02, 0x51, 0xb8, 0x56, 0xad, 0x52, 0xca,
+		0x84, 0x4a, 0xfe, 0x65, 0xb3, 0x63, 0x6e, 0xa3, 0xa9, 0x8a, 0x1e, 0xef, 0x5e, 0x45, 0xbc, 0x93,
+		0x9e, 0x8e, 0xbe, 0x3a, 0x01, 0x47, 0x82, 0xa4, 0x34, 0xf2, 0x04, 0x35, 0x24, 0xfb, 0xb5, 0x59,
+		0x0a, 0x6f, 0x32, 0x93, 0xb5, 0x0b, 0xc0, 0x99, 0xb6, 0x71, 0x28, 0xd3, 0x7a, 0x64, 0x67, 0xda,
+		0xd8, 0x52, 0x79, 0x1c, 0x06, 0xc9, 0xed, 0x91, 0x0d, 0xec, 0x5e, 0xc0, 0x5a, 0x09, 0xdb, 0xe1,
+		0x81, 0x3d, 0x28, 0x06, 0x36, 0x82, 0x04, 0x1d, 0xbd, 0xcc, 0xb1, 0xe9, 0xff, 0xca, 0x2e, 0x24,
+		0x08, 0xa9, 0x3f, 0xe8, 0x39, 0x05, 0x2d, 0x50, 0x73, 0xed, 0xb9, 0xd8, 0xe1, 0x24, 0xac, 0x80,
+		0x4e, 0x8b, 0xa1, 0x1b, 0x6f, 0x3d, 0x74, 0xb9, 0xb7, 0xf3, 0x01, 0x6c, 0x40, 0x5f, 0x8e, 0x58,
+		0x7b, 0x69, 0xc1, 0x13, 0x44, 0xf2, 0x05, 0x41, 0x2b, 0x30, 0x5c, 0xd5, 0x6c, 0x97, 0x5e, 0x44,
+		0xdf, 0xa5, 0xbd, 0xe0, 0x91, 0x61, 0x2a, 0x1b, 0xb5, 0x43, 0x36, 0xd4, 0x59, 0xde, 0xca, 0x60,
+		0x35, 0x08, 0x54, 0xfe, 0x53, 0x02, 0x7a, 0xb9, 0x32, 0x9e, 0x80, 0x3e, 0xae, 0xb4, 0xb4, 0xc4,
+		0xdf, 0xd9, 0xd5, 0xfb, 0x7e, 0xd6, 0xf3, 0x51, 0xce, 0x4f, 0xd0, 0xa0, 0xe3, 0x90, 0x2c, 0xee,
+		0x6a, 0xba, 0x59, 0xd0, 0x4b, 0x62, 0x3d, 0xf7, 0x9d, 0x1b, 0x53, 0x7d, 0xf3, 0x04, 0xb6, 0xb4,
+		0xa0, 0xf6, 0xd1, 0xca, 0xa5, 0x12, 0x09, 0x36, 0xbb, 0x58, 0x2f, 0xef, 0xb2, 0x60, 0x13, 0x57,
+		0x79, 0x89, 0x7c, 0xcb, 0x81, 0x38, 0x04, 0x7f, 0xa6, 0x94, 0xa9, 0x5b, 0x67, 0x7b, 0xf3, 0x63,
+		0x2e, 0x49, 0x1a, 0xfe, 0xc8, 0xb7, 0xa6, 0x24, 0x95, 0x52, 0xa0, 0x79, 0x18, 0x34, 0x34, 0xc7,
+		0x2d, 0xd0, 0x41, 0x42, 0x9a, 0xef, 0xe1, 0x6b, 0x97, 0x3a, 0x85, 0x70, 0xc5, 0x72, 0xd1, 0xfb,
+		0x09, 0x15, 0x03, 0x95, 0xc8, 0x2b, 0x0a, 0xca, 0x84, 0x5c, 0x9a, 0xd1, 0x5d, 0x16, 0xbe, 0x7b,
+		0xa9, 0xde, 0x87, 0x08, 0x7c, 0x9e, 0x82, 0x69, 0x10, 0x3f, 0x0c, 0x29, 0xfa, 0x30, 0x82, 0xa2,
+		0xb0, 0xdb, 0x4e, 0x49, 0x02, 0xa0, 0x95, 0xf7, 0xc2, 0xb0, 0xb7, 0x9a, 0x72, 0x18, 0x4a, 0x92,
+		0x71, 0xf1, 0xc1, 0x14, 0xf1, 0x21, 0x18, 0x33, 0xf1, 0x75, 0xb7, 0xe0, 0x83, 0x19, 0x76, 0x8a,
+		0x62, 0x23, 0x52, 0x77, 0x39, 0x4c, 0x71, 0x0f, 0x0c, 0x15, 0x85, 0xf2, 0x19, 0x2e, 0x50, 0xdc,
+		0x41, 0x0f, 0x4a, 0xd1, 0x26, 0x20, 0xa9, 0x55, 0xab, 0x0c, 0xa1, 0x9f, 0x22, 0xf4, 0x69, 0xd5,
+		0x2a, 0xad, 0x3a, 0x09, 0x23, 0xb4, 0x8f, 0x36, 0x76, 0x6a, 0x86, 0xcb, 0x99, 0x0c, 0x50, 0x9c,
+		0x61, 0x52, 0xa1, 0x32, 0x38, 0xc5, 0xbd, 0x1b, 0x06, 0xf1, 0x55, 0xbd, 0x84, 0xcd, 0x22, 0x66,
+		0x78, 0x83, 0x14, 0x6f, 0x40, 0x00, 0x29, 0xd2, 0x7d, 0x20, 0x57, 0x6d, 0xab, 0x6a, 0x39, 0xe4,
+		0x58, 0xa5, 0x54, 0xb2, 0xb1, 0xe3, 0xa4, 0x87, 0x18, 0x3f, 0x01, 0x9f, 0x63, 0x60, 0xe5, 0x01,
+		0x48, 0x2c, 0x68, 0xae, 0x46, 0x62, 0x98, 0x7b, 0x9d, 0x4d, 0x01, 0x03, 0x2a, 0xf9, 0xb7, 0xe1,
+		0x70, 0xfb, 0x7e, 0x0c, 0x12, 0x97, 0x2d, 0x17, 0xa3, 0x47, 0x02, 0xf3, 0xce, 0x50, 0x23, 0x1f,
+		0xdf, 0xd0, 0xcb, 0x26, 0x2e, 0xad, 0x38, 0xe5, 0xc0, 0xcb, 0x66, 0xdf, 0xc5, 0x62, 0x21, 0x17,
+		0x1b, 0x83, 0x1e, 0xdb, 0xaa, 0x99, 0x25, 0x71, 0x77, 0x88, 0x16, 0x50, 0x1e, 0x92, 0x9e, 0xe7,
+		0x24, 0xda, 0x79, 0xce, 0x30, 0xf1, 0x1c, 0xe2, 0xd7, 0x1c, 0xa0, 0xf6, 0x6d, 0x73, 0x07, 0xca,
+		0x41, 0xca, 0x0b, 0x68, 0xe9, 0x9e, 0x2e, 0x9c, 0xd8, 0x27, 0x23, 0x57, 0xbe, 0x3c, 0x7f, 0xf0,
+		0x14, 0xca, 0xbc, 0x50, 0xf6, 0x2a, 0xb8, 0x46, 0x43, 0xae, 0xc6, 0x5f, 0x59, 0xf7, 0xd1, 0x7e,
+		0xf9, 0xae, 0xc6, 0x5e, 0x5a, 0x1f, 0x21, 0x87, 0xb1, 0x65, 0x53, 0x73, 0x6b, 0x36, 0xe6, 0xde,
+		0xe8, 0x03, 0x94, 0x8f, 0xc6, 0xa0, 0x97, 0x79, 0x77, 0x40, 0x6f, 0x52, 0x63, 0xbd, 0xc5, 0x9a,
+		0xe9, 0x2d, 0xbe, 0x7f, 0xbd, 0xcd, 0x01, 0x78, 0xc2, 0x38, 0xfc, 0xf1, 0xeb, 0xe1, 0x7a, 0x46,
+		0x4c, 0xc4, 0x0d, 0xbd, 0xcc, 0x07, 0x6f, 0x80, 0xc8, 0xf3, 0xa0, 0x9e, 0x40, 0x9c, 0x3c, 0x07,
+		0xa9, 0x6d, 0xdd, 0x2d, 0x68, 0x64, 0x75, 0x9a, 0xee, 0xe5, 0x4f, 0x1f, 0x1b, 0x2d, 0x63, 0xb3,
+		0x62, 0x0d, 0xab, 0x26, 0xb7, 0xf9, 0x7f, 0xca, 0x7f, 0x94, 0x20, 0xe5, 0x35, 0x88, 0xe6, 0x60,
+		0x50, 0x74, 0xb4, 0xb0, 0x63, 0x68, 0x65, 0xee, 0x8c, 0x47, 0x9b, 0xf6, 0xf6, 0xbc, 0xa1, 0x95,
+		0xd5, 0x7e, 0xde, 0x41, 0x52, 0x68, 0x6c, 0xd8, 0x58, 0x13, 0xc3, 0x86, 0x3c, 0x29, 0xbe, 0x3f,
+		0x4f, 0x0a, 0xd9, 0x3c, 0x11, 0xb5, 0xf9, 0x97, 0x63, 0x74, 0x51, 0x56, 0xb5, 0x1c, 0xcd, 0x78,
+		0x2d, 0x86, 0xd8, 0x61, 0x48, 0x55, 0x2d, 0xa3, 0xc0, 0x6a, 0xd8, 0x25, 0xbd, 0x64, 0xd5, 0x32,
+		0xd4, 0x3a, 0x3f, 0xea, 0xb9, 0x4d, 0xe3, 0xaf, 0xf7, 0x36, 0x68, 0xad, 0x2f, 0xaa, 0x35, 0x1b,
+		0x06, 0x98, 0x2a, 0xf8, 0x84, 0xf9, 0x10, 0xd1, 0x01, 0xf9, 0x2f, 0x2d, 0xd5, 0x4f, 0xf0, 0x4c,
+		0x6c, 0x86, 0xa9, 0xf6, 0xee, 0x7a, 0x14, 0x6c, 0x7e, 0x49, 0xc7, 0x9a, 0x51, 0x30, 0xb7, 0x53,
+		0x39, 0x9e, 0xf2, 0x2f, 0x25, 0x48, 0xd1, 0xae, 0x92, 0x27, 0x77, 0x21, 0x55, 0x49, 0xfb, 0x57,
+		0xd5, 0x51, 0x00, 0xc6, 0x86, 0xa4, 0x94, 0xb9, 0x01, 0x53, 0x14, 0x42, 0x12, 0xc5, 0xe8, 0x8c,
+		0xd7, 0xaf, 0x78, 0xeb, 0x7e, 0x89, 0x1c, 0x00, 0xef, 0xdd, 0x21, 0xe8, 0xa3, 0x1f, 0x79, 0xb9,
+		0xce, 0x12, 0x00, 0x71, 0xfa, 0xb2, 0x7b, 0xf3, 0xba, 0xa3, 0x3c, 0x07, 0x7d, 0x9b, 0xd7, 0xd9,
+		0x56, 0xea, 0x30, 0xa4, 0x6c, 0xcb, 0xe2, 0xf3, 0x2b, 0x5b, 0xd7, 0x24, 0x09, 0x80, 0x4e, 0x27,
+		0x62, 0xfb, 0x10, 0xf3, 0xb7, 0x0f, 0xfe, 0xfe, 0x27, 0xde, 0xd1, 0xfe, 0xe7, 0xe4, 0xbf, 0x97,
+		0xa0, 0x3f, 0x30, 0x0c, 0xd1, 0xc3, 0x70, 0x30, 0xb7, 0xbc, 0x36, 0x7f, 0xa9, 0xb0, 0xb4, 0x50,
+		0x38, 0xbf, 0x3c, 0xb7, 0xe8, 0x5f, 0xf7, 0xce, 0x8c, 0xbf, 0xf4, 0xf2, 0x34, 0x0a, 0xe0, 0x6e,
+		0x99, 0x74, 0x43, 0x8d, 0x66, 0x60, 0x2c, 0x4c, 0x32, 0x97, 0xdb, 0x20, 0x77, 0xbf, 0xa5, 0xcc,
+		0xc1, 0x97, 0x5e, 0x9e, 0x1e, 0x09, 0x50, 0xcc, 0x6d, 0x3b, 0xd8, 0x74, 0xeb, 0x09, 0xe6, 0xd7,
+		0x56, 0x56, 0x96, 0x36, 0xe5, 0x58, 0x1d, 0x01, 0x0f, 0xb4, 0xf7, 0xc1, 0x48, 0x98, 0x60, 0x75,
+		0x69, 0x59, 0x8e, 0x67, 0xd0, 0x4b, 0x2f, 0x4f, 0x0f, 0x05, 0xb0, 0x57, 0x75, 0x23, 0x93, 0xfc,
+		0xc0, 0xe7, 0x27, 0x0f, 0xfc, 0xfc, 0xdf, 0x9e, 0x94, 0x48, 0xcf, 0x06, 0x43, 0x43, 0x11, 0x3d,
+		0x00, 0x87, 0x36, 0x96, 0x16, 0x57, 0xf3, 0x0b, 0x85, 0x95, 0x8d, 0xc5, 0xc8, 0x0b, 0x9e, 0xcc,
+		0xf0, 0x4b, 0x2f, 0x4f, 0xf7, 0xf3, 0x2e, 0x35, 0xc3, 0x5e, 0x57, 0xf3, 0x97, 0xd7, 0x36, 0xf3,
+		0xb2, 0xc4, 0xb0, 0xd7, 0x6d, 0x7c, 0xd5, 0x72, 0xd9, 0xf7, 0xa1, 0x1e, 0x82, 0x89, 0x06, 0xd8,
+		0x5e, 0xc7, 0x46, 0x5e, 0x7a, 0x79, 0x7a, 0x70, 0x9d, 0x1c, 0xd6, 0x90, 0x0e, 0x51, 0x8a, 0x2c,
+		0xa4, 0xeb, 0x29, 0xd6, 0xd6, 0xd7, 0x36, 0xe6, 0x96, 0xe5, 0xe9, 0x8c, 0xfc, 0xd2, 0xcb, 0xd3,
+		0x03, 0x22, 0xe6, 0x10, 0x7c, 0xbf, 0x67, 0xb9, 0xa7, 0x9a, 0xee, 0x5f, 0xce, 0x76, 0xbf, 0x7f,
+		0x09, 0x27, 0x7e, 0x3e, 0x16, 0x83, 0xc9, 0xba, 0x7b, 0xae, 0x3c, 0x3b, 0xdc, 0x2c, 0xef, 0x33,
+		0x0b, 0xc9, 0x05, 0x8e, 0xd2, 0x75, 0xda, 0xe7, 0xe7, 0xba, 0x4c, 0xfb, 0x0c, 0x8a, 0x96, 0x44,
+		0xd6, 0xe7, 0xe1, 0x0e, 0xb3, 0x3e, 0xa2, 0x13, 0xfb, 0x4b, 0xfa, 0x9c, 0x85, 0x63, 0x4d, 0x52,
+		0x95, 0xbc, 0xbc, 0xaf, 0x5c, 0x65, 0xcb, 0x7d, 0x62, 0xfb, 0xfd, 0x5f, 0x1b, 0x43, 0xb5, 0x4d,
+		0x85, 0x7e, 0x50, 0x82, 0xa1, 0x0b, 0xba, 0xe3, 0x5a, 0xb6, 0x5e, 0xd4, 0x0c, 0x7a, 0x89, 0xfd,
+		0x4c, 0xa7, 0x21, 0x3a, 0x12, 0xca, 0x9e, 0x84, 0xde, 0xab, 0x9a, 0xe1, 0x60, 0x97, 0xbf, 0xe1,
+		0xb8, 0x2b, 0xdb, 0x58, 0x11, 0x59, 0x6f, 0x8d, 0x2e, 0x18, 0x30, 0x32, 0xe5, 0x17, 0x63, 0x30,
+		0x4c, 0x07, 0xbb, 0xc3, 0x3e, 0x52, 0x44, 0xf6, 0x83, 0x39, 0x48, 0xd8, 0x9a, 0xcb, 0x73, 0x28,
+		0x5d, 0x67, 0x47, 0x29, 0x2d, 0x7a, 0x27, 0x24, 0x2b, 0xda, 0xf5, 0x02, 0xe5, 0xc3, 0x76, 0x59,
+		0x73, 0xdd, 0xf1, 0xb9, 0x79, 0x63, 0x6a, 0x78, 0x4f, 0xab, 0x18, 0xb3, 0x8a, 0xe0, 0xa3, 0xa8,
+		0x7d, 0x15, 0xed, 0x3a, 0x11, 0x11, 0x55, 0x61, 0x98, 0x40, 0x8b, 0xbb, 0x9a, 0x59, 0xc6, 0xac,
+		0x11, 0x9a, 0x11, 0xca, 0x5d, 0xe8, 0xba, 0x91, 0x71, 0xbf, 0x91, 0x00, 0x3b, 0x45, 0x1d, 0xac,
+		0x68, 0xd7, 0xe7, 0x29, 0x80, 0xb4, 0x38, 0x9b, 0xfc, 0xf8, 0x67, 0xa6, 0x0e, 0xd0, 0x14, 0xff,
+		0x37, 0x25, 0x00, 0x5f, 0x63, 0xe8, 0x9d, 0x20, 0x17, 0xbd, 0x12, 0xa5, 0x75, 0xb8, 0x0d, 0xef,
+		0x6d, 0x66, 0x8b, 0x88, 0xbe, 0xd9, 0x14, 0xff, 0xca, 0x8d, 0x29, 0x49, 0x1d, 0x2e, 0x46, 0x4c,
+		0xf1, 0x0e, 0xe8, 0xaf, 0x55, 0x4b, 0x9a, 0x8b, 0x0b, 0x74, 0xcf, 0x19, 0x6b, 0xbb, 0x5c, 0x98,
+		0x24, 0xbc, 0x6e, 0xde, 0x98, 0x42, 0xac, 0x5b, 0x01, 0x62, 0x85, 0x2e, 0x22, 0x80, 0x41, 0x08,
+		0x41, 0xa0, 0x4f, 0xbf, 0x25, 0x41, 0xff, 0x42, 0xe0, 0x7e, 0x47, 0x1a, 0xfa, 0x2a, 0x96, 0xa9,
+		0x5f, 0xc1, 0x22, 0x31, 0x2f, 0x8a, 0x24, 0x33, 0xc4, 0xde, 0xf5, 0xb8, 0x7b, 0x22, 0x33, 0x24,
+		0xca, 0x84, 0xea, 0x1a, 0xde, 0x76, 0x74, 0x61, 0x0d, 0x55, 0x14, 0xd1, 0x79, 0xf2, 0xc5, 0x8d,
+		0x62, 0xcd, 0xd6, 0xdd, 0x3d, 0xf2, 0xa4, 0xcf, 0x25, 0xef, 0x75, 0x59, 0xee, 0xfd, 0xf0, 0xcd,
+		0x1b, 0x53, 0x87, 0x98, 0xac, 0x51, 0x0c, 0x45, 0x1d, 0x16, 0xa0, 0x79, 0x06, 0x21, 0x2d, 0x94,
+		0xb0, 0xab, 0xe9, 0x86, 0x43, 0x57, 0x60, 0x29, 0x55, 0x14, 0x03, 0x7d, 0xf9, 0xe7, 0x7d, 0x90,
+		0xf2, 0xbc, 0x1d, 0x5d, 0x03, 0xd9, 0xaa, 0x62, 0x3b, 0xb4, 0x9e, 0xa5, 0xd3, 0x79, 0x6e, 0xd9,
+		0x6f, 0x39, 0x8a, 0xa1, 0xfc, 0x9f, 0x1b, 0x53, 0x0f, 0x76, 0xe0, 0x41, 0x97, 0x35, 0x83, 0xaf,
+		0x85, 0xd5, 0x61, 0xc1, 0x83, 0x03, 0x48, 0x97, 0xfd, 0x5d, 0x70, 0xb5, 0xb6, 0x2d, 0x32, 0x90,
+		0xa1, 0x2e, 0x47, 0x31, 0x14, 0x75, 0xd8, 0x03, 0xad, 0x53, 0x08, 0x59, 0xc0, 0x3e, 0xa7, 0xe9,
+		0x86, 0x78, 0x44, 0xa9, 0xf2, 0x12, 0x5a, 0x82, 0x5e, 0xc7, 0xd5, 0xdc, 0x1a, 0x5b, 0xc3, 0xf4,
+		0xe4, 0x1e, 0xee, 0x50, 0xe6, 0x9c, 0x65, 0x96, 0x36, 0x28, 0xa1, 0xca, 0x19, 0xd0, 0x27, 0xcd,
+		0xd6, 0x15, 0x6c, 0x72, 0xa5, 0x76, 0x35, 0xe2, 0xe9, 0x71, 0x1a, 0xa3, 0x46, 0x2e, 0xc8, 0xde,
+		0xf9, 0x8d, 0x38, 0x61, 0xa1, 0xdf, 0xeb, 0xca, 0x2d, 0x75, 0x3d, 0x2c, 0xb9, 0x82, 0xa2, 0xfc,
+		0x14, 0x75, 0xd8, 0x03, 0xb1, 0x23, 0x18, 0x74, 0x29, 0x74, 0x35, 0x89, 0x7f, 0xd4, 0xee, 0xee,
+		0x66, 0x63, 0x2f, 0xe0, 0xe5, 0x22, 0xbb, 0x12, 0xa0, 0x26, 0x56, 0xab, 0x99, 0xdb, 0x96, 0x49,
+		0x1f, 0x28, 0xf1, 0x8d, 0x03, 0xd9, 0x89, 0xc6, 0x83, 0x56, 0x8b, 0x62, 0x28, 0xea, 0xb0, 0x07,
+		0xba, 0x40, 0x21, 0xa8, 0x04, 0x43, 0x3e, 0x16, 0x1d, 0xba, 0xa9, 0xb6, 0x43, 0xf7, 0x2e, 0x3e,
+		0x74, 0x0f, 0x46, 0x5b, 0xf1, 0x47, 0xef, 0xa0, 0x07, 0x24, 0x64, 0xe8, 0x02, 0x80, 0x1f, 0x30,
+		0x68, 0x96, 0xa5, 0xff, 0x94, 0xd2, 0x3e, 0xea, 0x88, 0x9d, 0xa9, 0x4f, 0x8b, 0xde, 0x0d, 0xa3,
+		0x15, 0xdd, 0x2c, 0x38, 0xd8, 0xd8, 0x29, 0x70, 0x05, 0x13, 0x96, 0xf4, 0xb3, 0x2b, 0xb9, 0xe5,
+		0xee, 0xfc, 0xe1, 0xe6, 0x8d, 0xa9, 0x0c, 0x0f, 0xaa, 0xf5, 0x2c, 0x15, 0x75, 0xa4, 0xa2, 0x9b,
+		0x1b, 0xd8, 0xd8, 0x59, 0xf0, 0x60, 0xb3, 0x03, 0x1f, 0xf8, 0xcc, 0xd4, 0x01, 0x6f, 0x00, 0xeb,
+		0x30, 0xe0, 0x0f, 0x2c, 0xec, 0xa0, 0x35, 0x48, 0x69, 0xa2, 0xc0, 0xf2, 0x31, 0x1d, 0x3b, 0x7b,
+		0x60, 0x80, 0xfa, 0x3c, 0x58, 0xac, 0x78, 0xf1, 0x8f, 0xa6, 0x25, 0xe5, 0xa5, 0x18, 0xf4, 0x2e,
+		0x5c, 0x5e, 0xd7, 0x74, 0x1b, 0xbd, 0x00, 0x23, 0xbe, 0xb3, 0x85, 0x23, 0xc5, 0xca, 0xcd, 0x1b,
+		0x53, 0xe9, 0xa8, 0x3f, 0x76, 0x19, 0x2a, 0xe6, 0x8a, 0x45, 0x21, 0x89, 0x3f, 0x48, 0x38, 0x84,
+		0xb4, 0xdd, 0x64, 0xd7, 0x1d, 0x6c, 0xbb, 0x0e, 0x65, 0x1f, 0x61, 0xaa, 0x6e, 0x13, 0x1f, 0x08,
+		0x9c, 0x79, 0xe8, 0x63, 0xba, 0x20, 0xaf, 0xf4, 0x7a, 0xaa, 0xe4, 0x1f, 0x7e, 0x02, 0x32, 0xd9,
+		0x74, 0x34, 0x51, 0x7c, 0x2f, 0x2f, 0x4c, 0x48, 0x94, 0xcf, 0xc5, 0x01, 0x16, 0x2e, 0x5f, 0xde,
+		0xb4, 0xf5, 0xaa, 0x81, 0xdd, 0x1f, 0xab, 0x5e, 0x7f, 0x5a, 0x82, 0x83, 0xbe, 0xd6, 0x1c, 0xbb,
+		0x18, 0x51, 0xee, 0x53, 0x37, 0x6f, 0x4c, 0x1d, 0x89, 0x2a, 0x37, 0x80, 0xb6, 0x0f, 0x05, 0x8f,
+		0x7a, 0x8c, 0x36, 0xec, 0x62, 0x63, 0x39, 0x4a, 0x8e, 0xeb, 0xc9, 0x11, 0x6f, 0x2e, 0x47, 0x00,
+		0xed, 0x96, 0xe4, 0x58, 0x70, 0xdc, 0x7a, 0x5b, 0x6f, 0x40, 0xbf, 0x6f, 0x23, 0xf2, 0x0d, 0xa9,
+		0xa4, 0xcb, 0xff, 0xe7, 0x26, 0x57, 0x9a, 0x9b, 0x5c, 0x90, 0x71, 0xb3, 0x7b, 0x94, 0xca, 0xbf,
+		0x8b, 0x01, 0xf8, 0xa3, 0xfa, 0x2f, 0xeb, 0x88, 0x22, 0xd3, 0x29, 0x9f, 0xfc, 0xe2, 0xfb, 0x5a,
+		0x40, 0x73, 0xea, 0x80, 0xb5, 0xfe, 0x24, 0x46, 0xde, 0x6f, 0xf3, 0xc8, 0xff, 0xa6, 0x86, 0xd1,
+		0x3a, 0xf4, 0x61, 0xd3, 0xb5, 0x75, 0xaa, 0x62, 0xe2, 0xad, 0x0f, 0x35, 0xf3, 0xd6, 0x06, 0x5a,
+		0xa3, 0x9f, 0x16, 0x12, 0x87, 0x42, 0x9c, 0x4d, 0x40, 0xd7, 0x1f, 0x8e, 0x43, 0xba, 0x19, 0x15,
+		0x9a, 0x87, 0xe1, 0xa2, 0x8d, 0x29, 0xa0, 0x10, 0xcc, 0x40, 0xe7, 0x32, 0xfe, 0x4e, 0x22, 0x82,
+		0xa0, 0xa8, 0x43, 0x02, 0xc2, 0xd7, 0x06, 0x65, 0x20, 0xcb, 0x7c, 0x32, 0x64, 0x08, 0x56, 0x87,
+		0xeb, 0x7a, 0x85, 0x2f, 0x0e, 0x44, 0x23, 0x61, 0x06, 0x6c, 0x75, 0x30, 0xe4, 0x43, 0x09, 0x21,
+		0x7a, 0x1e, 0x86, 0x75, 0x53, 0x77, 0x75, 0xcd, 0x28, 0x6c, 0x6b, 0x86, 0x66, 0x16, 0xf7, 0xb3,
+		0x4b, 0x62, 0x13, 0x3a, 0x6f, 0x36, 0xc2, 0x4e, 0x51, 0x87, 0x38, 0x24, 0xc7, 0x00, 0xe8, 0x02,
+		0xf4, 0x89, 0xa6, 0x12, 0xfb, 0x5a, 0x4b, 0x0a, 0xf2, 0x80, 0x45, 0x3e, 0x14, 0x87, 0x11, 0x15,
+		0x97, 0xde, 0x34, 0x45, 0x77, 0xa6, 0x58, 0x01, 0x60, 0x81, 0x84, 0xcc, 0x24, 0xfb, 0xbd, 0xe9,
+		0xc4, 0x38, 0x2c, 0x38, 0x6e, 0xc0, 0x1e, 0x7f, 0x16, 0x87, 0x81, 0xa0, 0x3d, 0xde, 0x9c, 0xe2,
+		0x5f, 0x3f, 0x53, 0x3c, 0x5a, 0xf2, 0x43, 0x63, 0x82, 0x7f, 0x21, 0xb6, 0x49, 0x68, 0xac, 0x1b,
+		0x52, 0xcd, 0x63, 0xe2, 0x97, 0xfa, 0xa0, 0x77, 0x5d, 0xb3, 0xb5, 0x8a, 0x83, 0x8a, 0x75, 0x1b,
+		0x1b, 0x91, 0xdf, 0xaf, 0xfb, 0x06, 0x38, 0x4f, 0x8a, 0xb5, 0xd9, 0xd7, 0x7c, 0xbc, 0xc1, 0xbe,
+		0xe6, 0x6d, 0x30, 0x44, 0xf2, 0x31, 0x5e, 0xff, 0x98, 0x31, 0x07, 0x73, 0x13, 0x3e, 0x97, 0x70,
+		0x3d, 0x4b, 0xd7, 0xf8, 0xe7, 0xd0, 0xe8, 0x2c, 0xf4, 0x13, 0x0c, 0x7f, 0x96, 0x20, 0xe4, 0xe3,
+		0x7e, 0x5e, 0x24, 0x50, 0xa9, 0xa8, 0x50, 0xd1, 0xae, 0xe7, 0x59, 0x01, 0x2d, 0x03, 0xda, 0xf5,
+		0x52, 0x73, 0x05, 0x5f, 0x95, 0x84, 0xfe, 0xe8, 0xcd, 0x1b, 0x53, 0x13, 0x8c, 0xbe, 0x1e, 0x47,
+		0x51, 0x47, 0x7c, 0xa0, 0xe0, 0x76, 0x1a, 0x80, 0xf4, 0xab, 0xc0, 0x2e, 0x95, 0xb2, 0xdd, 0xf5,
+		0xc1, 0x9b, 0x37, 0xa6, 0x46, 0x18, 0x17, 0xbf, 0x4e, 0x51, 0x53, 0xa4, 0xb0, 0x40, 0xfe, 0x47,
+		0x1f, 0x92, 0x60, 0xa2, 0x6c, 0x58, 0xdb, 0x9a, 0x51, 0x30, 0xf4, 0xe7, 0x6b, 0x7a, 0xa9, 0xc0,
+		0x6d, 0x57, 0x28, 0x6a, 0x55, 0xbe, 0xa3, 0x56, 0xbb, 0xde, 0x51, 0x4f, 0xb3, 0x36, 0x9b, 0x32,
+		0x56, 0xd4, 0x71, 0x56, 0xb7, 0x4c, 0xab, 0x36, 0x58, 0xcd, 0xbc, 0x56, 0x45, 0x7f, 0x53, 0x82,
+		0x23, 0xbe, 0xd3, 0x36, 0x10, 0x89, 0x7e, 0x57, 0x3b, 0xb7, 0xd5, 0xb5, 0x48, 0x77, 0x47, 0x07,
+		0x44, 0x23, 0xa9, 0x26, 0xbc, 0xea, 0x3a, 0xc1, 0xf8, 0xae, 0x35, 0x92, 0x7f, 0x4b, 0x27, 0xbb,
+		0xde, 0xb5, 0x32, 0x71, 0x02, 0xbb, 0xd6, 0x08, 0x4b, 0xb6, 0x6b, 0x0d, 0xe7, 0xed, 0xd0, 0x47,
+		0x24, 0xc8, 0x70, 0x6d, 0x36, 0xda, 0x3b, 0xa7, 0xa8, 0x14, 0x1b, 0x5d, 0xc7, 0xf7, 0xbb, 0x42,
+		0x76, 0x6a, 0xb8, 0x85, 0x3e, 0xc4, 0x2a, 0x57, 0xea, 0x36, 0xd2, 0xfe, 0x90, 0xfd, 0xbc, 0x04,
+		0xc8, 0xaf, 0x50, 0xb1, 0x53, 0xb5, 0x4c, 0x87, 0x66, 0x0c, 0x02, 0x22, 0x4a, 0xad, 0x33, 0x06,
+		0x3e, 0xbd, 0xc8, 0x18, 0xf8, 0xb4, 0xe4, 0xcb, 0xc2, 0x62, 0x26, 0x8b, 0x75, 0x76, 0x05, 0xb7,
+		0xc1, 0xd4, 0xfe, 0x3b, 0x12, 0x4c, 0xd4, 0xc5, 0x21, 0x4f, 0xd8, 0xff, 0x0f, 0x90, 0x1d, 0xa8,
+		0xe4, 0x1f, 0x89, 0x64, 0x42, 0x77, 0x1d, 0xd6, 0x46, 0xec, 0x68, 0xc5, 0x6d, 0x5c, 0xac, 0xb0,
+		0xcb, 0xdf, 0xff, 0x42, 0x82, 0xb1, 0x60, 0xf3, 0x5e, 0x47, 0x56, 0x61, 0x20, 0xd8, 0x3a, 0xef,
+		0xc2, 0xb1, 0x4e, 0xba, 0xc0, 0xa5, 0x0f, 0xd1, 0xa3, 0xa7, 0xfc, 0x20, 0xcf, 0xd2, 0xfe, 0x0f,
+		0x77, 0xac, 0x0d, 0x21, 0x53, 0x34, 0xd8, 0xb3, 0x1e, 0xfc, 0x85, 0x04, 0x89, 0x75, 0xcb, 0x32,
+		0x90, 0x05, 0x23, 0xa6, 0xe5, 0x16, 0x48, 0x4c, 0xc2, 0xa5, 0x02, 0xcf, 0x0e, 0xb2, 0xf3, 0x80,
+		0xf9, 0xee, 0x94, 0xf4, 0x83, 0x1b, 0x53, 0xf5, 0xac, 0xd4, 0x61, 0xd3, 0x72, 0x73, 0x14, 0xb2,
+		0x49, 0x01, 0xe8, 0xdd, 0x30, 0x18, 0x6e, 0x8c, 0xe5, 0x4a, 0x9f, 0xee, 0xba, 0xb1, 0x30, 0x9b,
+		0x9b, 0x37, 0xa6, 0xc6, 0xfc, 0x58, 0xeb, 0x81, 0x15, 0x75, 0x60, 0x3b, 0xd0, 0xfa, 0x6c, 0x92,
+		0xf4, 0xfe, 0xcf, 0x89, 0x06, 0x7e, 0x26, 0x06, 0xa3, 0x14, 0xa8, 0xbf, 0x80, 0x69, 0x82, 0x51,
+		0xc5, 0x45, 0xcb, 0x2e, 0xa1, 0x21, 0x88, 0xf1, 0xb3, 0xec, 0x84, 0x1a, 0xd3, 0xc9, 0x37, 0x35,
+		0x7b, 0xac, 0x6b, 0x26, 0xbf, 0xd4, 0xd6, 0x79, 0x42, 0x2a, 0xb0, 0x96, 0x61, 0xf4, 0x74, 0xae,
+		0xb3, 0x4a, 0x35, 0x03, 0x93, 0xaf, 0xac, 0x7a, 0x37, 0xda, 0x53, 0xa1, 0xb9, 0x2e, 0x54, 0x4f,
+		0xe6, 0x3a, 0x0a, 0x98, 0x63, 0x65, 0x92, 0x1f, 0xf3, 0x6f, 0xca, 0x27, 0xba, 0x12, 0x27, 0x98,
+		0x1f, 0xf3, 0x78, 0xf8, 0xe3, 0xf3, 0xb6, 0x5f, 0x6f, 0xff, 0x28, 0x34, 0x3d, 0xcf, 0x2b, 0x63,
+		0x13, 0x3b, 0xfa, 0xfe, 0xde, 0x1e, 0x74, 0x74, 0x46, 0xa8, 0xfc, 0x4e, 0x1f, 0x0c, 0x2c, 0xb2,
+		0x56, 0x48, 0x02, 0x1c, 0xa3, 0xb7, 0x90, 0xef, 0xa5, 0x92, 0x55, 0x0d, 0x1f, 0x90, 0x4d, 0xd3,
+		0x5c, 0x6c, 0xed, 0x23, 0x4e, 0xce, 0x18, 0x0d, 0x72, 0xf8, 0x45, 0x3c, 0x7a, 0x17, 0xb3, 0x50,
+		0xb5, 0xae, 0x79, 0xce, 0xb0, 0xd4, 0x75, 0xcc, 0xe7, 0x89, 0xe5, 0x28, 0x3f, 0x85, 0xdd, 0xe9,
+		0xdb, 0x24, 0x90, 0x75, 0x02, 0x40, 0xef, 0x93, 0xe0, 0x20, 0xc5, 0xf2, 0xe7, 0x4c, 0x8a, 0x29,
+		0x36, 0xc2, 0x27, 0x9b, 0x75, 0x61, 0x59, 0x73, 0xfc, 0x7b, 0x7a, 0x94, 0x57, 0xee, 0x18, 0x5f,
+		0x97, 0x1d, 0x09, 0x34, 0x1e, 0x65, 0xab, 0xa8, 0xa3, 0x46, 0x1d, 0xa5, 0x83, 0x16, 0x01, 0x02,
+		0x8b, 0xb3, 0x44, 0x77, 0x47, 0x8f, 0x01, 0x52, 0x74, 0x11, 0xfa, 0xfd, 0x00, 0xe5, 0xf0, 0x5f,
+		0xca, 0xe9, 0x7c, 0x42, 0x0a, 0x12, 0xa3, 0xf7, 0x4b, 0x70, 0xd0, 0x5f, 0x5c, 0x06, 0xd9, 0xb2,
+		0x5f, 0x14, 0xba, 0xbf, 0x8b, 0x24, 0x41, 0x54, 0x39, 0x0d, 0xf9, 0x2a, 0xea, 0x98, 0x07, 0x5f,
+		0x08, 0x08, 0xb2, 0x4e, 0x7e, 0xcb, 0x20, 0xd8, 0xbe, 0xf8, 0x3a, 0x60, 0xe7, 0xf1, 0x3e, 0xcc,
+		0x80, 0xfd, 0xca, 0x49, 0xd5, 0xb2, 0x5d, 0x5c, 0x4a, 0x27, 0xf9, 0xf7, 0x66, 0x78, 0x19, 0x7d,
+		0x40, 0x82, 0x71, 0x97, 0x47, 0x2c, 0x76, 0x4a, 0x52, 0xb0, 0x69, 0xcc, 0x72, 0xd2, 0xa9, 0xd6,
+		0xfd, 0x6e, 0x10, 0xe7, 0x72, 0xf7, 0xf0, 0x7e, 0x1f, 0x65, 0xfd, 0x6e, 0xcc, 0x58, 0x51, 0xc7,
+		0xdc, 0x7a, 0x5a, 0x07, 0x3d, 0x07, 0x47, 0xb9, 0x0b, 0x37, 0xa0, 0x22, 0x77, 0x81, 0xc8, 0x11,
+		0x45, 0x22, 0x77, 0xe2, 0xe6, 0x8d, 0xa9, 0x63, 0x21, 0x8f, 0x6f, 0x8c, 0xae, 0xa8, 0x13, 0xcc,
+		0xfd, 0xeb, 0x9a, 0x5a, 0x2a, 0x29, 0xd7, 0x00, 0xd5, 0xfb, 0x34, 0xba, 0x04, 0x7d, 0xe1, 0x0d,
+		0xe8, 0x3e, 0x22, 0xa1, 0xe0, 0x40, 0x6e, 0x4e, 0xf8, 0xc3, 0x3a, 0xae, 0xb2, 0xc2, 0x6d, 0x8f,
+		0x89, 0x9f, 0x3d, 0x0e, 0x53, 0x4d, 0xe2, 0x97, 0x7b, 0xfd, 0x4e, 0x3c, 0xc5, 0xea, 0x2c, 0x5c,
+		0xb6, 0xbd, 0x06, 0xa1, 0xbc, 0x9a, 0x00, 0xb4, 0xe2, 0x94, 0xe7, 0x6d, 0xcc, 0xbe, 0x8b, 0xc8,
+		0x4f, 0x5c, 0x23, 0xe7, 0x71, 0xd2, 0x2d, 0x9d, 0xc7, 0xad, 0x84, 0x4e, 0xb8, 0x62, 0xdd, 0x9d,
+		0xab, 0x77, 0x7c, 0xcc, 0x15, 0x7f, 0x4d, 0x8e, 0xb9, 0x1a, 0xe7, 0x49, 0x12, 0x3f, 0xc6, 0x74,
+		0x6d, 0xcf, 0x6b, 0x93, 0xae, 0x25, 0xdf, 0x25, 0x67, 0x07, 0xe0, 0xec, 0xd7, 0x9b, 0x78, 0x09,
+		0x3d, 0x2a, 0x7e, 0xcb, 0xa6, 0xaf, 0xb3, 0x0d, 0x04, 0xc3, 0xe6, 0x4b, 0xd5, 0x23, 0x90, 0xa9,
+		0x77, 0x3e, 0xb1, 0xba, 0x55, 0x7e, 0x2d, 0x0e, 0xf2, 0x8a, 0x53, 0xce, 0x97, 0x74, 0xf7, 0x0e,
+		0x79, 0x66, 0xb5, 0x79, 0xfe, 0x7b, 0xfe, 0xe6, 0x8d, 0xa9, 0x21, 0xa6, 0xd0, 0xdb, 0xa9, 0xc6,
+		0x0a, 0x0c, 0x47, 0x77, 0xba, 0xcc, 0x71, 0x17, 0xf6, 0x73, 0xe1, 0xa5, 0x6e, 0x87, 0x3b, 0x14,
+		0xbe, 0x7b, 0x82, 0xae, 0x37, 0x1e, 0x2b, 0x6c, 0xa7, 0x74, 0xe1, 0x4e, 0x1e, 0x07, 0x33, 0x03,
+		0x67, 0x20, 0x1d, 0xb5, 0xa0, 0x67, 0xde, 0xaf, 0xc7, 0xa0, 0x7f, 0xc5, 0x29, 0x7b, 0x4f, 0x49,
+		0xff, 0xb2, 0x1e, 0x84, 0xec, 0xf7, 0x19, 0x2c, 0x57, 0xf1, 0x41, 0x18, 0x0d, 0x68, 0xd1, 0xd3,
+		0xee, 0xb7, 0xe2, 0x34, 0xb0, 0xe7, 0x70, 0x59, 0x37, 0xbd, 0xb5, 0x09, 0x7e, 0x33, 0xcd, 0xfb,
+		0x3a, 0x4a, 0xf3, 0xfa, 0x86, 0x4f, 0xec, 0xc7, 0xf0, 0x57, 0x20, 0x53, 0x6f, 0x60, 0x2f, 0x5d,
+		0xb1, 0x52, 0x7f, 0x2a, 0x22, 0x75, 0x71, 0x4f, 0x3d, 0x72, 0xf6, 0xa1, 0xfc, 0x06, 0xf9, 0xc0,
+		0xb7, 0x53, 0xde, 0x32, 0x4b, 0x6f, 0x0e, 0xd7, 0x5b, 0x1a, 0xae, 0x3b, 0x70, 0x30, 0xa4, 0xc7,
+		0x3b, 0x65, 0xb0, 0xdf, 0x8e, 0xc1, 0x08, 0xb9, 0xba, 0x1d, 0x5c, 0x77, 0x3b, 0x6f, 0x1a, 0x6d,
+		0x7f, 0x46, 0xdb, 0x84, 0x89, 0x3a, 0x5d, 0x7a, 0x86, 0xf3, 0x79, 0x4b, 0x5d, 0xf1, 0x56, 0xfe,
+		0xad, 0x44, 0x67, 0x47, 0x32, 0x78, 0x71, 0x85, 0x32, 0x77, 0xce, 0x5b, 0xf6, 0xeb, 0xc0, 0x52,
+		0x67, 0x43, 0xdf, 0xca, 0xe8, 0x5a, 0x5b, 0xef, 0x80, 0xe9, 0x66, 0xdd, 0xba, 0x75, 0xa5, 0x7d,
+		0x32, 0x0e, 0x47, 0xc8, 0x9a, 0x91, 0xa4, 0x6c, 0x8d, 0x37, 0xef, 0x53, 0xdc, 0xba, 0x8b, 0x37,
+		0x3a, 0x84, 0x4f, 0x74, 0x7b, 0x08, 0xcf, 0x2d, 0x7f, 0x1c, 0x8e, 0xb5, 0xb2, 0x8d, 0xb0, 0xfe,
+		0xa9, 0xcf, 0xf5, 0x41, 0x7c, 0xc5, 0x29, 0x93, 0x13, 0xf5, 0xe8, 0xce, 0xb3, 0x69, 0xf2, 0xab,
+		0x7e, 0xa3, 0x90, 0x39, 0xd5, 0x39, 0xae, 0xe7, 0x78, 0x57, 0x60, 0x30, 0xbc, 0xa1, 0x38, 0xd1,
+		0x82, 0x49, 0x08, 0x33, 0xf3, 0x50, 0xa7, 0x98, 0x5e, 0x63, 0xef, 0x84, 0x24, 0xef, 0x3d, 0x46,
+		0x77, 0xb7, 0xa0, 0x16, 0x48, 0x99, 0xfb, 0x3b, 0x40, 0xf2, 0xb8, 0x3f, 0x0f, 0xc3, 0xd1, 0xe5,
+		0x5d, 0x2b, 0xed, 0x45, 0x70, 0x33, 0xa7, 0x3a, 0xc7, 0xf5, 0x9a, 0xdc, 0x06, 0x08, 0x2c, 0x01,
+		0xee, 0x69, 0xc1, 0xc1, 0x47, 0xcb, 0x3c, 0xd8, 0x11, 0x9a, 0xd7, 0x86, 0x09, 0x43, 0x91, 0x59,
+		0xeb, 0xbe, 0x16, 0x0c, 0xc2, 0xa8, 0x99, 0x87, 0x3b, 0x46, 0xf5, 0xda, 0x7b, 0xaf, 0x04, 0x07,
+		0x1b, 0xc7, 0xe0, 0x56, 0x06, 0x6f, 0x48, 0x91, 0x79, 0xac, 0x5b, 0x0a, 0x4f, 0x8a, 0x0f, 0x4b,
+		0x30, 0xd1, 0x3c, 0xa8, 0x9d, 0x6e, 0xe5, 0xe9, 0xcd, 0xa8, 0x32, 0x6f, 0xd9, 0x0f, 0x95, 0x77,
+		0xb8, 0x74, 0xbb, 0x73, 0x64, 0x5f, 0x8f, 0xc1, 0xc9, 0x60, 0x52, 0xeb, 0xf9, 0x1a, 0xb6, 0xf7,
+		0xbc, 0xbc, 0x55, 0x55, 0x2b, 0xeb, 0x66, 0xf0, 0xa5, 0xd4, 0x44, 0x30, 0x7c, 0x51, 0x5c, 0x21,
+		0xbe, 0x62, 0x42, 0xff, 0xba, 0x56, 0xc6, 0x2a, 0x7e, 0xbe, 0x86, 0x1d, 0xb7, 0xc1, 0x77, 0x4c,
+		0xc8, 0x37, 0x46, 0x76, 0x76, 0xd8, 0x8b, 0x1a, 0x72, 0xd8, 0xc3, 0x4b, 0x24, 0x19, 0x68, 0xe8,
+		0x15, 0x9d, 0x85, 0xc8, 0x84, 0xca, 0x0a, 0xe4, 0x67, 0x20, 0xe8, 0x21, 0x0c, 0xcb, 0xda, 0xa7,
+		0x13, 0xe2, 0x53, 0xba, 0x35, 0x93, 0x65, 0xed, 0x95, 0x27, 0x61, 0x80, 0xb5, 0xc7, 0x6d, 0x35,
+		0x01, 0x49, 0xfa, 0x9c, 0xde, 0x6f, 0xb5, 0x8f, 0x94, 0x2f, 0xb1, 0x6f, 0x9e, 0x30, 0x2e, 0xac,
+		0x61, 0x56, 0xc8, 0xe5, 0x9a, 0xaa, 0xf2, 0x44, 0xfb, 0xb0, 0xcf, 0x14, 0xe5, 0xa9, 0xf1, 0xd7,
+		0x7b, 0xe0, 0x20, 0x5b, 0x10, 0xce, 0x68, 0x55, 0x7d, 0x66, 0xd7, 0x75, 0xc5, 0x37, 0x85, 0x80,
+		0x81, 0xb3, 0x5a, 0x55, 0x57, 0xf6, 0x20, 0x71, 0xc1, 0x75, 0xab, 0xe8, 0x24, 0xf4, 0xd8, 0x35,
+		0x03, 0x8b, 0x1b, 0xa1, 0x63, 0x59, 0x1f, 0x27, 0x4b, 0x10, 0xd4, 0x9a, 0x81, 0x55, 0x86, 0x82,
+		0xf2, 0x30, 0xb5, 0x53, 0x33, 0x8c, 0x3d, 0xf2, 0xdb, 0xf4, 0x56, 0x89, 0xe4, 0x70, 0xf9, 0x6f,
+		0xf9, 0xe2, 0xeb, 0x55, 0xcd, 0xf4, 0x92, 0x77, 0x49, 0xf5, 0x08, 0x45, 0x5b, 0xa0, 0x58, 0xe2,
+		0x77, 0x7c, 0xf3, 0x02, 0x47, 0xf9, 0xc3, 0x18, 0x24, 0x05, 0x6b, 0xfa, 0x11, 0x12, 0x6c, 0xe0,
+		0xa2, 0xff, 0x79, 0x28, 0xaf, 0x8c, 0x10, 0xc4, 0xcb, 0xdc, 0x44, 0xa9, 0x0b, 0x07, 0x54, 0x52,
+		0x20, 0x30, 0xef, 0xd3, 0x30, 0x04, 0x46, 0xbe, 0x18, 0x33, 0x06, 0x89, 0xaa, 0x25, 0xae, 0x3f,
+		0x5d, 0x38, 0xa0, 0xd2, 0x12, 0x4a, 0x43, 0x2f, 0x09, 0x1d, 0x2e, 0xfb, 0x3d, 0x19, 0x02, 0xe7,
+		0x65, 0x34, 0x4e, 0x2e, 0x3e, 0xbb, 0x45, 0xf6, 0x49, 0x05, 0x52, 0xc1, 0x8a, 0x64, 0x86, 0x64,
+		0x9f, 0xd6, 0x8b, 0xfe, 0xcc, 0x37, 0x51, 0x06, 0xfb, 0x0d, 0x03, 0x22, 0xf7, 0xba, 0xe6, 0xba,
+		0xd8, 0x36, 0x09, 0x43, 0x86, 0x4e, 0xbf, 0x37, 0x64, 0x95, 0xf6, 0xf8, 0x4f, 0x8f, 0xd3, 0xff,
+		0xf9, 0x6f, 0x1d, 0x53, 0x7f, 0x28, 0xd0, 0xca, 0x81, 0x69, 0x89, 0xff, 0xd6, 0x31, 0x1b, 0x3e,
+		0x04, 0x29, 0x0f, 0xa3, 0x5a, 0xa9, 0xa4, 0xb3, 0x5f, 0x01, 0x2f, 0x6c, 0xeb, 0x74, 0xa8, 0x91,
+		0x1f, 0x3a, 0x6c, 0x6e, 0x0b, 0xe4, 0x13, 0xe4, 0x38, 0x7e, 0x2e, 0x05, 0x7d, 0x55, 0x26, 0x94,
+		0x72, 0x0e, 0x46, 0xea, 0x24, 0x25, 0xf2, 0x5d, 0xd1, 0xcd, 0x92, 0xf8, 0x5e, 0x0e, 0xf9, 0x9f,
+		0xc0, 0xe8, 0x6f, 0x9e, 0xb0, 0xf7, 0x3d, 0xf4, 0xff, 0xdc, 0x4f, 0x35, 0x7f, 0x2f, 0x38, 0x14,
+		0x78, 0x2f, 0xa8, 0x55, 0xf5, 0x5c, 0x8a, 0xf2, 0xe7, 0xcf, 0x04, 0xe7, 0xea, 0x9f, 0x09, 0x96,
+		0xb1, 0x29, 0x52, 0xd6, 0xa4, 0x4a, 0xab, 0xea, 0x0e, 0x75, 0x47, 0xff, 0x47, 0x58, 0x9c, 0x73,
+		0x81, 0xff, 0xe9, 0xb3, 0xc1, 0xc4, 0xe2, 0xdc, 0xfa, 0x92, 0xe7, 0xc7, 0x5f, 0x8b, 0xc1, 0x91,
+		0x80, 0x1f, 0x07, 0x90, 0xeb, 0xdd, 0x39, 0xd3, 0xd8, 0xe3, 0x3b, 0xf8, 0x55, 0x91, 0x4b, 0x90,
+		0x20, 0xf8, 0xa8, 0xcd, 0x2f, 0x11, 0xa7, 0xbf, 0xf4, 0xdb, 0xff, 0x4c, 0x99, 0x96, 0x9a, 0x5a,
+		0x85, 0x32, 0xc9, 0xbd, 0xbf, 0x73, 0xfd, 0xc9, 0xfe, 0x8f, 0xbb, 0x38, 0xb7, 0x4f, 0x8d, 0x51,
+		0x1d, 0xbe, 0x3a, 0x0f, 0x4a, 0x93, 0x73, 0x00, 0x16, 0x31, 0x5b, 0x9f, 0x3c, 0x74, 0x11, 0x8e,
+		0x9b, 0x3d, 0xc2, 0x6c, 0x65, 0xc1, 0x0e, 0x8f, 0x74, 0xaf, 0xc3, 0xf8, 0x53, 0xa4, 0x6d, 0xff,
+		0xe6, 0x97, 0x08, 0xec, 0xe3, 0xde, 0xf3, 0x28, 0xe6, 0xd9, 0xfe, 0x5b, 0x27, 0xf0, 0xe5, 0xe3,
+		0x7b, 0x94, 0xe3, 0xd9, 0xa6, 0xf3, 0x45, 0x36, 0x30, 0x59, 0xa8, 0x01, 0x4a, 0xe5, 0x17, 0x24,
+		0x38, 0x54, 0xd7, 0x34, 0x8f, 0xf1, 0xe1, 0xd3, 0x51, 0x69, 0xff, 0xa7, 0xa3, 0x8b, 0x0d, 0x84,
+		0xbd, 0xb7, 0xad, 0xb0, 0x4c, 0x8a, 0x90, 0xb4, 0xcf, 0xc3, 0xc1, 0xb0, 0xb0, 0x42, 0x4d, 0xcf,
+		0xc0, 0x50, 0x78, 0x4b, 0xb1, 0xff, 0x63, 0xb3, 0xc1, 0xd0, 0xb6, 0x42, 0x29, 0x44, 0x4d, 0xe3,
+		0xa9, 0x27, 0x1f, 0xbc, 0xaf, 0x20, 0xf1, 0x5f, 0x50, 0xef, 0x50, 0x3b, 0x3e, 0xa5, 0xf2, 0x75,
+		0x09, 0xa6, 0xc3, 0x2d, 0x04, 0xce, 0x60, 0xef, 0x78, 0xff, 0x6e, 0x9b, 0x23, 0x7d, 0x5f, 0x82,
+		0xbb, 0x5a, 0x74, 0x83, 0xeb, 0xec, 0x05, 0x18, 0x0b, 0x5c, 0x84, 0x12, 0x13, 0x85, 0x70, 0xae,
+		0x93, 0xed, 0x0f, 0xcc, 0xbd, 0xa5, 0xd9, 0x61, 0xa2, 0xc7, 0x2f, 0x7e, 0x6b, 0x6a, 0xb4, 0xbe,
+		0xce, 0x51, 0x47, 0xeb, 0x2f, 0x2f, 0xdd, 0x46, 0x2f, 0xfc, 0x5d, 0x09, 0xee, 0x0b, 0x77, 0xb5,
+		0xc1, 0x1a, 0xf2, 0x0d, 0x64, 0xba, 0xff, 0x20, 0xc1, 0xc9, 0x4e, 0xfa, 0xe3, 0x6d, 0x80, 0x46,
+		0xfd, 0x6b, 0x04, 0x51, 0x13, 0x76, 0x75, 0x39, 0x81, 0x8d, 0x05, 0xe4, 0x71, 0xbb, 0x03, 0xb6,
+		0xfa, 0xd7, 0x12, 0x1f, 0xbf, 0x41, 0x37, 0xf1, 0x0c, 0x13, 0x4e, 0x81, 0x74, 0x69, 0x98, 0x40,
+		0x1a, 0x64, 0x30, 0x94, 0x06, 0x69, 0x60, 0xf2, 0xd8, 0x6d, 0x8a, 0x46, 0x57, 0xe1, 0x50, 0x5d,
+		0x6f, 0xb8, 0x59, 0xde, 0x01, 0xa3, 0x0d, 0x86, 0x16, 0x0f, 0x4c, 0x5d, 0x8c, 0x2c, 0x15, 0xd5,
+		0x0f, 0x1e, 0x92, 0xa7, 0x9b, 0xa2, 0x0d, 0x37, 0xdc, 0x2d, 0xbd, 0x71, 0xf5, 0x59, 0x81, 0xe9,
+		0xe6, 0xdd, 0xe2, 0x8a, 0x5d, 0x82, 0x5e, 0xe6, 0xa1, 0x5c, 0x97, 0xfb, 0x70, 0x71, 0xce, 0xc0,
+		0x8f, 0xf5, 0x0b, 0xa2, 0x7f, 0x8d, 0x03, 0xc6, 0x1d, 0xd2, 0xe3, 0xed, 0x0a, 0x18, 0xdf, 0x14,
+		0xb1, 0xbe, 0x71, 0x37, 0xb8, 0xde, 0x8a, 0xb7, 0x2d, 0xd6, 0x33, 0x25, 0xbe, 0x46, 0x41, 0xdd,
+		0xeb, 0x53, 0x9b, 0xa0, 0xfe, 0x3a, 0xb7, 0x91, 0x17, 0xd4, 0xdb, 0xf4, 0xe7, 0x8d, 0x18, 0xd4,
+		0xff, 0x22, 0x06, 0x13, 0xb4, 0x6f, 0xc1, 0x1b, 0x67, 0xaf, 0x81, 0x6d, 0x0a, 0x80, 0xc8, 0x59,
+		0xec, 0xed, 0x8a, 0x45, 0xb2, 0x63, 0x17, 0x2f, 0x87, 0x66, 0xf4, 0x02, 0xa0, 0x92, 0xe3, 0x46,
+		0x1b, 0x88, 0xef, 0xbb, 0x81, 0x52, 0xe0, 0x62, 0x59, 0x03, 0xef, 0x4a, 0xec, 0xdb, 0xbb, 0x5e,
+		0x91, 0x20, 0xd3, 0xc8, 0x02, 0xdc, 0x9b, 0x74, 0x18, 0x0f, 0xdd, 0x78, 0x8f, 0x3a, 0xd4, 0x03,
+		0x9d, 0x5c, 0x21, 0x8c, 0x0c, 0xff, 0x83, 0x36, 0xbe, 0xa3, 0x01, 0xe0, 0x5f, 0x89, 0x29, 0xce,
+		0x1b, 0x30, 0xf5, 0xbb, 0xb1, 0xd7, 0xff, 0xb0, 0xff, 0xe5, 0xba, 0x19, 0xe6, 0x0d, 0xb1, 0xb1,
+		0xfb, 0x7d, 0x09, 0x26, 0x9b, 0x88, 0xfd, 0x46, 0x5e, 0x5e, 0xec, 0x36, 0x75, 0xa9, 0xdb, 0xbd,
+		0x8b, 0x3c, 0xcd, 0xc7, 0x63, 0xf8, 0x7b, 0x4c, 0x81, 0x2c, 0x42, 0xa3, 0x0f, 0x4d, 0x2a, 0xcf,
+		0xc2, 0xe1, 0x86, 0x54, 0x5c, 0xb6, 0x59, 0x48, 0x90, 0xb7, 0x60, 0x69, 0x29, 0xec, 0x8e, 0x51,
+		0xb1, 0x22, 0xd4, 0x94, 0x46, 0x41, 0x20, 0x53, 0xd6, 0xe4, 0x19, 0x06, 0x17, 0x43, 0xb9, 0x04,
+		0x23, 0x01, 0x18, 0x6f, 0xe4, 0x0c, 0x49, 0x6d, 0xf2, 0x6f, 0x37, 0xf7, 0x9f, 0x3a, 0xd2, 0xf4,
+		0xee, 0xba, 0x65, 0x19, 0xbc, 0xdb, 0x14, 0x5f, 0x19, 0x03, 0xc4, 0x98, 0xd1, 0x6b, 0xec, 0xa2,
+		0x89, 0x0d, 0x18, 0x0d, 0x41, 0x79, 0x23, 0xb7, 0x74, 0x45, 0x5e, 0x79, 0x14, 0xee, 0xa6, 0x4c,
+		0x1b, 0x5d, 0x34, 0xde, 0x5b, 0x2a, 0x09, 0x2d, 0x47, 0xde, 0x56, 0x28, 0xcf, 0xc3, 0xb1, 0xd6,
+		0x64, 0xfe, 0x02, 0x93, 0xdd, 0x15, 0x6e, 0xb7, 0xc0, 0x6c, 0xc4, 0x88, 0x4b, 0xca, 0x18, 0x28,
+		0x4f, 0xc0, 0x3d, 0xcd, 0x9a, 0x74, 0xd6, 0xae, 0x99, 0xd8, 0x93, 0x75, 0x4c, 0xbc, 0xfb, 0xe0,
+		0xbf, 0x10, 0x41, 0x0b, 0x4a, 0x0d, 0x8e, 0xb7, 0x23, 0xe7, 0x32, 0x5f, 0x82, 0x3e, 0x71, 0x3b,
+		0x5b, 0x9a, 0x8e, 0xef, 0x4f, 0x68, 0xc1, 0x41, 0x99, 0x82, 0xa3, 0xbc, 0x59, 0x37, 0xf8, 0x6c,
+		0xcf, 0x93, 0x56, 0xd9, 0x85, 0xc9, 0x66, 0x08, 0x5c, 0x1e, 0xff, 0xdb, 0x3f, 0xd2, 0xad, 0x7c,
+		0xfb, 0xe7, 0xd4, 0x1f, 0x65, 0xa0, 0x87, 0x36, 0x85, 0x3e, 0x21, 0x01, 0x04, 0x5e, 0x62, 0x66,
+		0x9b, 0xf5, 0xaf, 0x71, 0xe2, 0x2e, 0x33, 0xd3, 0x31, 0x3e, 0xdf, 0x62, 0x9d, 0xfc, 0xa9, 0xdf,
+		0xfb, 0xde, 0xc7, 0x62, 0xc7, 0x90, 0x32, 0xd3, 0x24, 0x65, 0x18, 0x88, 0xbb, 0x5f, 0x90, 0x82,
+		0xdf, 0x86, 0x7a, 0xb0, 0xb3, 0xa6, 0x84, 0x64, 0xd9, 0x4e, 0xd1, 0xb9, 0x60, 0xe7, 0xa8, 0x60,
+		0x8f, 0xa2, 0x47, 0xda, 0x0b, 0x36, 0xf3, 0xae, 0x70, 0x20, 0x7d, 0x0f, 0xfa, 0x7d, 0x09, 0xc6,
+		0x1a, 0x65, 0x84, 0xd0, 0x63, 0x9d, 0x49, 0x51, 0xbf, 0xf6, 0xce, 0x3c, 0xbe, 0x0f, 0x4a, 0xde,
+		0x95, 0x45, 0xda, 0x95, 0x39, 0xf4, 0xe4, 0x3e, 0xba, 0x32, 0x13, 0x7c, 0xc8, 0xf0, 0xbf, 0x25,
+		0x38, 0xda, 0x32, 0x5b, 0x82, 0xe6, 0x3a, 0x93, 0xb2, 0xc5, 0x26, 0x23, 0x93, 0xbb, 0x15, 0x16,
+		0xbc, 0xc7, 0x4f, 0xd1, 0x1e, 0x5f, 0x42, 0x4b, 0xfb, 0xe9, 0x71, 0xc3, 0xd7, 0x22, 0xe8, 0x37,
+		0xa5, 0xd0, 0xe7, 0x51, 0x5a, 0xbb, 0x53, 0x5d, 0x9a, 0x20, 0x33, 0xd3, 0x31, 0x3e, 0xef, 0xc2,
+		0x33, 0xb4, 0x0b, 0x2a, 0x5a, 0xbf, 0x45, 0xa3, 0xcd, 0xbc, 0x2b, 0xbc, 0x7e, 0x78, 0x0f, 0xfa,
+		0x9f, 0x52, 0xe3, 0xef, 0x91, 0x9c, 0x6d, 0x29, 0x62, 0xf3, 0x14, 0x48, 0xe6, 0xb1, 0xee, 0x09,
+		0x79, 0x27, 0x2b, 0xb4, 0x93, 0x65, 0x84, 0x6f, 0x77, 0x27, 0x1b, 0x1a, 0x11, 0xfd, 0x96, 0x04,
+		0x63, 0x8d, 0x36, 0xef, 0x6d, 0x86, 0x65, 0x8b, 0xb4, 0x45, 0x9b, 0x61, 0xd9, 0x2a, 0x53, 0xa0,
+		0xbc, 0x85, 0x76, 0xfe, 0x0c, 0x3a, 0xdd, 0xac, 0xf3, 0x2d, 0xad, 0x48, 0xc6, 0x62, 0xcb, 0x4d,
+		0x6e, 0x9b, 0xb1, 0xd8, 0xc9, 0x86, 0xbf, 0xcd, 0x58, 0xec, 0x68, 0x8f, 0xdd, 0x7e, 0x2c, 0x7a,
+		0x3d, 0xeb, 0xd0, 0x8c, 0x0e, 0xfa, 0x9a, 0x04, 0x83, 0xa1, 0x2d, 0x18, 0x7a, 0xb8, 0xa5, 0xa0,
+		0x8d, 0x36, 0xcc, 0x99, 0x53, 0xdd, 0x90, 0xf0, 0xbe, 0x2c, 0xd1, 0xbe, 0xcc, 0xa3, 0xb9, 0xfd,
+		0xf4, 0x25, 0xfc, 0x28, 0xec, 0x15, 0x09, 0x46, 0x1b, 0xec, 0x56, 0xda, 0x8c, 0xc2, 0xe6, 0xbb,
+		0xb4, 0xcc, 0x63, 0xdd, 0x13, 0xf2, 0x5e, 0x9d, 0xa7, 0xbd, 0x7a, 0x1b, 0x7a, 0xeb, 0x7e, 0x7a,
+		0x15, 0x98, 0x9f, 0x6f, 0xf8, 0xaf, 0xd6, 0x03, 0xed, 0xa0, 0x33, 0x5d, 0x0a, 0x26, 0x3a, 0x74,
+		0xb6, 0x6b, 0x3a, 0xde, 0x9f, 0xa7, 0x69, 0x7f, 0x9e, 0x42, 0x6b, 0xb7, 0xd6, 0x9f, 0xfa, 0x69,
+		0xfd, 0xcb, 0xf5, 0x9f, 0x7e, 0x6d, 0xed, 0x45, 0x0d, 0xf7, 0x25, 0x99, 0x47, 0xba, 0xa2, 0xe1,
+		0x9d, 0x7a, 0x8c, 0x76, 0xea, 0x14, 0x7a, 0xa8, 0x59, 0xa7, 0x02, 0x1f, 0xb5, 0xd0, 0xcd, 0x1d,
+		0x6b, 0xe6, 0x5d, 0x6c, 0xb7, 0xf3, 0x1e, 0xf4, 0x93, 0xe2, 0x59, 0xf8, 0x89, 0x96, 0xed, 0x06,
+		0xb6, 0x2c, 0x99, 0xfb, 0x3a, 0xc0, 0xe4, 0x72, 0x1d, 0xa3, 0x72, 0x4d, 0xa2, 0x23, 0xcd, 0xe4,
+		0x22, 0xdb, 0x16, 0xf4, 0x41, 0xc9, 0xfb, 0x06, 0xc9, 0xc9, 0xd6, 0xbc, 0x83, 0xfb, 0x9a, 0xcc,
+		0xfd, 0x1d, 0xe1, 0x72, 0x49, 0x8e, 0x53, 0x49, 0xa6, 0xd1, 0x64, 0x53, 0x49, 0x98, 0x00, 0xdf,
+		0x94, 0xe0, 0x50, 0x93, 0xcd, 0x09, 0x3a, 0xd7, 0xb2, 0xc1, 0xd6, 0x3b, 0xa1, 0xcc, 0x5b, 0xf6,
+		0x47, 0xdc, 0xe9, 0x82, 0xb3, 0xf1, 0x6b, 0xce, 0x99, 0x77, 0xe9, 0xa5, 0xf7, 0xa0, 0x6f, 0x4b,
+		0x30, 0xd1, 0x74, 0xfb, 0x82, 0x9e, 0xe8, 0x56, 0xb0, 0xd0, 0xae, 0x29, 0xf3, 0xd6, 0xfd, 0x92,
+		0xf3, 0x9e, 0xe5, 0x69, 0xcf, 0x9e, 0x44, 0x4f, 0x74, 0xd9, 0x33, 0xba, 0x3b, 0x73, 0x66, 0xde,
+		0x45, 0xff, 0xbe, 0x07, 0x7d, 0x45, 0x82, 0x91, 0xba, 0xad, 0x10, 0x7a, 0xb4, 0x8d, 0x70, 0x8d,
+		0xf7, 0x56, 0x99, 0x33, 0xdd, 0x92, 0xf1, 0xbe, 0x3c, 0x42, 0xfb, 0xf2, 0x20, 0xba, 0xbf, 0x79,
+		0x5f, 0xdc, 0xf0, 0x07, 0x5a, 0x70, 0xe9, 0x76, 0x5f, 0xa8, 0xfb, 0x7f, 0x03, 0x00, 0x98, 0xaf,
+		0x8b, 0x24, 0xdc, 0xa8, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2251,6 +2423,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.GlobalMinSelfDelegation.Equal(that1.GlobalMinSelfDelegation) {
+		return false
+	}
 	return true
 }
 func (this *DelegationResponse) Equal(that interface{}) bool {
@@ -2366,6 +2550,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3155,6 +3372,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GlobalMinSelfDelegation.Size()
+		i -= size
+		if _, err := m.GlobalMinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3364,6 +3621,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3696,6 +4002,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalMinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3755,6 +4069,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6295,6 +6633,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalMinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6799,6 +7273,178 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the delegations of tokenize share records.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the bank denom representing the shares of the
+// record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator.String()), r.Id)
+}
+
+// String implements the Stringer interface for a TokenizeShareRecord object.
+func (r TokenizeShareRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// Validate performs a basic validation of the record fields.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+
+	if r.Owner.Empty() {
+		return fmt.Errorf("tokenize share record %d has no owner", r.Id)
+	}
+
+	if r.Validator.Empty() {
+		return fmt.Errorf("tokenize share record %d has no validator", r.Id)
+	}
+
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("tokenize share record %d has an invalid module account %s", r.Id, r.ModuleAccount)
+	}
+
+	return nil
+}
+
+// ParseShareTokenDenom returns the id of the tokenize share record represented
+// by a share token denom.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return 0, sdkerrors.Wrapf(ErrNotTokenizeShareDenom, "%s", denom)
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrNotTokenizeShareDenom, "%s", denom)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTokenizeShareRecordValidate(t *testing.T) {
+	owner := sdk.AccAddress(valAddr2)
+
+	invalidModuleAccount := NewTokenizeShareRecord(1, owner, valAddr1)
+	invalidModuleAccount.ModuleAccount = "tokenizeshare_2"
+
+	tests := []struct {
+		name       string
+		record     TokenizeShareRecord
+		expectPass bool
+	}{
+		{"valid", NewTokenizeShareRecord(1, owner, valAddr1), true},
+		{"zero id", NewTokenizeShareRecord(0, owner, valAddr1), false},
+		{"empty owner", NewTokenizeShareRecord(1, sdk.AccAddress{}, valAddr1), false},
+		{"empty validator", NewTokenizeShareRecord(1, owner, sdk.ValAddress{}), false},
+		{"invalid module account", invalidModuleAccount, false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.record.Validate(), "test: %v", tc.name)
+		} else {
+			require.Error(t, tc.record.Validate(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestShareTokenDenom(t *testing.T) {
+	record := NewTokenizeShareRecord(12, sdk.AccAddress(valAddr2), valAddr1)
+
+	denom := record.GetShareTokenDenom()
+	require.Equal(t, strings.ToLower(valAddr1.String())+"/12", denom)
+	require.NoError(t, sdk.ValidateDenom(denom))
+	require.NotEqual(t, record.GetModuleAddress(), NewTokenizeShareRecord(13, sdk.AccAddress(valAddr2), valAddr1).GetModuleAddress())
+
+	id, err := ParseShareTokenDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), id)
+
+	_, err = ParseShareTokenDenom(sdk.DefaultBondDenom)
+	require.True(t, ErrNotTokenizeShareDenom.Is(err))
+
+	_, err = ParseShareTokenDenom("ibc/transfer")
+	require.True(t, ErrNotTokenizeShareDenom.Is(err))
+}
@@ -59,10 +59,15 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
+The fromVM passed to the handler is the module VersionMap persisted by the previous binary. For a chain started with a
+binary which did not persist it, fromVM is empty and RunMigrations migrates all the registered modules from version 1;
+a module added by such an upgrade must then be initialized by the handler.
+
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
 as well as providing the opportunity for the upgraded software to perform any necessary state migrations. Both the halt
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
@@ -71,8 +76,9 @@ switching the binaries is an ops task and not handled inside the sdk / abci app.
 Here is a sample code to set store migrations with an upgrade:
 
 	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// upgrade changes here
+		return fromVM, nil
 	})
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -1,14 +1,20 @@
 package keeper_test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -53,6 +59,41 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	s.Require().Equal(expected, ui)
 }
 
+func (s *KeeperTestSuite) TestMigrations() {
+	ctx := s.app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the module version map is set by InitChain
+	initialVM := s.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().Equal(uint64(2), initialVM[banktypes.ModuleName])
+
+	var fromVM module.VersionMap
+	s.app.UpgradeKeeper.SetUpgradeHandler("dummy", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		fromVM = vm
+		updatedVM := module.VersionMap{}
+		for name, version := range vm {
+			updatedVM[name] = version
+		}
+		updatedVM[banktypes.ModuleName]++
+		return updatedVM, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(ctx, types.Plan{Name: "dummy", Height: 10})
+
+	s.Require().Equal(initialVM, fromVM)
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().Equal(uint64(3), vm[banktypes.ModuleName])
+	s.Require().Equal(initialVM[stakingtypes.ModuleName], vm[stakingtypes.ModuleName])
+	s.Require().Equal(ctx.BlockHeight(), s.app.UpgradeKeeper.GetDoneHeight(ctx, "dummy"))
+
+	// failing upgrade handlers panic and leave the version map untouched
+	s.app.UpgradeKeeper.SetUpgradeHandler("failing", func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("failed migration")
+	})
+	s.Require().Panics(func() {
+		s.app.UpgradeKeeper.ApplyUpgrade(ctx, types.Plan{Name: "failing", Height: 10})
+	})
+	s.Require().Equal(vm, s.app.UpgradeKeeper.GetModuleVersionMap(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
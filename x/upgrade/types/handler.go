@@ -2,7 +2,18 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-// UpgradeHandler specifies the type of function that is called when an upgrade is applied
-type UpgradeHandler func(ctx sdk.Context, plan Plan)
+// UpgradeHandler specifies the type of function that is called when an upgrade
+// is applied.
+//
+// fromVM is a VersionMap of moduleName to fromVersion, where fromVersion denotes
+// the version from which the module should be migrated, the target version
+// being the module's latest ConsensusVersion. It is read from the upgrade store
+// where it was persisted by the previous binary.
+//
+// The returned VersionMap is the new VersionMap of the app and is persisted in
+// the upgrade store. A handler would typically return the result of
+// module.Manager.RunMigrations.
+type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)